@@ -85,11 +85,14 @@ func main() {
 	dispatcher.Start()
 	defer dispatcher.Stop()
 
+	// Create command manager (receives COMMAND_ACKs through the dispatcher)
+	commands := services.NewCommandManager(node, dispatcher)
+
 	// Create server
 	srv := server.NewServer(cfg)
 
 	// Register services
-	registerServices(srv, node, dispatcher)
+	registerServices(srv, node, dispatcher, commands)
 
 	// Setup graceful shutdown
 	go handleShutdown(srv, node, dispatcher, closeNode)
@@ -101,13 +104,19 @@ func main() {
 }
 
 // Register all services
-func registerServices(srv *server.Server, node *gomavlib.Node, dispatcher *services.MessageDispatcher) {
+func registerServices(
+	srv *server.Server,
+	node *gomavlib.Node,
+	dispatcher *services.MessageDispatcher,
+	commands *services.CommandManager,
+) {
 	// Create shared service context
 	ctx := &services.ServiceContext{
 		Config:     srv.Config(),
 		Logger:     srv.Logger(),
 		Node:       node,
 		Dispatcher: dispatcher,
+		Commands:   commands,
 	}
 
 	// ConnectionService
@@ -119,6 +128,16 @@ func registerServices(srv *server.Server, node *gomavlib.Node, dispatcher *servi
 	telemetryService := services.NewTelemetryService(ctx)
 	telemetryPath, telemetryHandler := flightpathconnect.NewTelemetryServiceHandler(telemetryService)
 	srv.RegisterService(telemetryPath, telemetryHandler)
+
+	// ActionService
+	actionService := services.NewActionService(ctx)
+	actionPath, actionHandler := flightpathconnect.NewActionServiceHandler(actionService)
+	srv.RegisterService(actionPath, actionHandler)
+
+	// EmergencyService
+	emergencyService := services.NewEmergencyService(ctx)
+	emergencyPath, emergencyHandler := flightpathconnect.NewEmergencyServiceHandler(emergencyService)
+	srv.RegisterService(emergencyPath, emergencyHandler)
 }
 
 // handleShutdown handles graceful shutdown on interrupt signals
@@ -0,0 +1,3079 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: flightpath/action.proto
+
+package flightpath
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OrbitYawBehaviour represents yaw behaviours from MAVLink ORBIT_YAW_BEHAVIOUR enum
+// All values are incremented by 1 to accommodate ORBIT_YAW_BEHAVIOUR_UNSPECIFIED
+type OrbitYawBehaviour int32
+
+const (
+	OrbitYawBehaviour_ORBIT_YAW_BEHAVIOUR_UNSPECIFIED OrbitYawBehaviour = 0
+	// Vehicle front points to the center (default)
+	OrbitYawBehaviour_ORBIT_YAW_BEHAVIOUR_HOLD_FRONT_TO_CIRCLE_CENTER OrbitYawBehaviour = 1
+	// Vehicle front holds heading when message received
+	OrbitYawBehaviour_ORBIT_YAW_BEHAVIOUR_HOLD_INITIAL_HEADING OrbitYawBehaviour = 2
+	// Yaw uncontrolled
+	OrbitYawBehaviour_ORBIT_YAW_BEHAVIOUR_UNCONTROLLED OrbitYawBehaviour = 3
+	// Vehicle front follows flight path (tangential to circle)
+	OrbitYawBehaviour_ORBIT_YAW_BEHAVIOUR_HOLD_FRONT_TANGENT_TO_CIRCLE OrbitYawBehaviour = 4
+	// Yaw controlled by RC input
+	OrbitYawBehaviour_ORBIT_YAW_BEHAVIOUR_RC_CONTROLLED OrbitYawBehaviour = 5
+	// Vehicle uses current yaw behaviour (unchanged)
+	OrbitYawBehaviour_ORBIT_YAW_BEHAVIOUR_UNCHANGED OrbitYawBehaviour = 6
+)
+
+// Enum value maps for OrbitYawBehaviour.
+var (
+	OrbitYawBehaviour_name = map[int32]string{
+		0: "ORBIT_YAW_BEHAVIOUR_UNSPECIFIED",
+		1: "ORBIT_YAW_BEHAVIOUR_HOLD_FRONT_TO_CIRCLE_CENTER",
+		2: "ORBIT_YAW_BEHAVIOUR_HOLD_INITIAL_HEADING",
+		3: "ORBIT_YAW_BEHAVIOUR_UNCONTROLLED",
+		4: "ORBIT_YAW_BEHAVIOUR_HOLD_FRONT_TANGENT_TO_CIRCLE",
+		5: "ORBIT_YAW_BEHAVIOUR_RC_CONTROLLED",
+		6: "ORBIT_YAW_BEHAVIOUR_UNCHANGED",
+	}
+	OrbitYawBehaviour_value = map[string]int32{
+		"ORBIT_YAW_BEHAVIOUR_UNSPECIFIED":                  0,
+		"ORBIT_YAW_BEHAVIOUR_HOLD_FRONT_TO_CIRCLE_CENTER":  1,
+		"ORBIT_YAW_BEHAVIOUR_HOLD_INITIAL_HEADING":         2,
+		"ORBIT_YAW_BEHAVIOUR_UNCONTROLLED":                 3,
+		"ORBIT_YAW_BEHAVIOUR_HOLD_FRONT_TANGENT_TO_CIRCLE": 4,
+		"ORBIT_YAW_BEHAVIOUR_RC_CONTROLLED":                5,
+		"ORBIT_YAW_BEHAVIOUR_UNCHANGED":                    6,
+	}
+)
+
+func (x OrbitYawBehaviour) Enum() *OrbitYawBehaviour {
+	p := new(OrbitYawBehaviour)
+	*p = x
+	return p
+}
+
+func (x OrbitYawBehaviour) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrbitYawBehaviour) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_action_proto_enumTypes[0].Descriptor()
+}
+
+func (OrbitYawBehaviour) Type() protoreflect.EnumType {
+	return &file_flightpath_action_proto_enumTypes[0]
+}
+
+func (x OrbitYawBehaviour) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrbitYawBehaviour.Descriptor instead.
+func (OrbitYawBehaviour) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{0}
+}
+
+// RebootShutdownPhase represents the phases of an autopilot reboot or shutdown
+type RebootShutdownPhase int32
+
+const (
+	RebootShutdownPhase_REBOOT_SHUTDOWN_PHASE_UNSPECIFIED RebootShutdownPhase = 0
+	// The command was accepted by the autopilot
+	RebootShutdownPhase_REBOOT_SHUTDOWN_PHASE_ACCEPTED RebootShutdownPhase = 1
+	// The drone's heartbeats have stopped
+	RebootShutdownPhase_REBOOT_SHUTDOWN_PHASE_HEARTBEAT_LOST RebootShutdownPhase = 2
+	// The drone's heartbeats are received again (reboot only)
+	RebootShutdownPhase_REBOOT_SHUTDOWN_PHASE_HEARTBEAT_RESTORED RebootShutdownPhase = 3
+)
+
+// Enum value maps for RebootShutdownPhase.
+var (
+	RebootShutdownPhase_name = map[int32]string{
+		0: "REBOOT_SHUTDOWN_PHASE_UNSPECIFIED",
+		1: "REBOOT_SHUTDOWN_PHASE_ACCEPTED",
+		2: "REBOOT_SHUTDOWN_PHASE_HEARTBEAT_LOST",
+		3: "REBOOT_SHUTDOWN_PHASE_HEARTBEAT_RESTORED",
+	}
+	RebootShutdownPhase_value = map[string]int32{
+		"REBOOT_SHUTDOWN_PHASE_UNSPECIFIED":        0,
+		"REBOOT_SHUTDOWN_PHASE_ACCEPTED":           1,
+		"REBOOT_SHUTDOWN_PHASE_HEARTBEAT_LOST":     2,
+		"REBOOT_SHUTDOWN_PHASE_HEARTBEAT_RESTORED": 3,
+	}
+)
+
+func (x RebootShutdownPhase) Enum() *RebootShutdownPhase {
+	p := new(RebootShutdownPhase)
+	*p = x
+	return p
+}
+
+func (x RebootShutdownPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RebootShutdownPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_action_proto_enumTypes[1].Descriptor()
+}
+
+func (RebootShutdownPhase) Type() protoreflect.EnumType {
+	return &file_flightpath_action_proto_enumTypes[1]
+}
+
+func (x RebootShutdownPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RebootShutdownPhase.Descriptor instead.
+func (RebootShutdownPhase) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{1}
+}
+
+// MavResult represents command results from MAVLink MAV_RESULT enum
+// All values are incremented by 1 to accommodate MAV_RESULT_UNSPECIFIED
+type MavResult int32
+
+const (
+	MavResult_MAV_RESULT_UNSPECIFIED MavResult = 0
+	// Command is valid (is supported and has valid parameters), and was executed
+	MavResult_MAV_RESULT_ACCEPTED MavResult = 1
+	// Command is valid, but cannot be executed at this time
+	MavResult_MAV_RESULT_TEMPORARILY_REJECTED MavResult = 2
+	// Command is invalid (is supported but has invalid parameters)
+	MavResult_MAV_RESULT_DENIED MavResult = 3
+	// Command is not supported (unknown)
+	MavResult_MAV_RESULT_UNSUPPORTED MavResult = 4
+	// Command is valid, but execution has failed
+	MavResult_MAV_RESULT_FAILED MavResult = 5
+	// Command is valid and is being executed
+	MavResult_MAV_RESULT_IN_PROGRESS MavResult = 6
+	// Command has been cancelled (as a result of receiving a COMMAND_CANCEL message)
+	MavResult_MAV_RESULT_CANCELLED MavResult = 7
+	// Command is only accepted when sent as a COMMAND_LONG
+	MavResult_MAV_RESULT_COMMAND_LONG_ONLY MavResult = 8
+	// Command is only accepted when sent as a COMMAND_INT
+	MavResult_MAV_RESULT_COMMAND_INT_ONLY MavResult = 9
+	// Command is invalid because a frame is required and the specified frame is not supported
+	MavResult_MAV_RESULT_COMMAND_UNSUPPORTED_MAV_FRAME MavResult = 10
+	// Command has been rejected because source system is not in control of the target system/component
+	MavResult_MAV_RESULT_NOT_IN_CONTROL MavResult = 11
+)
+
+// Enum value maps for MavResult.
+var (
+	MavResult_name = map[int32]string{
+		0:  "MAV_RESULT_UNSPECIFIED",
+		1:  "MAV_RESULT_ACCEPTED",
+		2:  "MAV_RESULT_TEMPORARILY_REJECTED",
+		3:  "MAV_RESULT_DENIED",
+		4:  "MAV_RESULT_UNSUPPORTED",
+		5:  "MAV_RESULT_FAILED",
+		6:  "MAV_RESULT_IN_PROGRESS",
+		7:  "MAV_RESULT_CANCELLED",
+		8:  "MAV_RESULT_COMMAND_LONG_ONLY",
+		9:  "MAV_RESULT_COMMAND_INT_ONLY",
+		10: "MAV_RESULT_COMMAND_UNSUPPORTED_MAV_FRAME",
+		11: "MAV_RESULT_NOT_IN_CONTROL",
+	}
+	MavResult_value = map[string]int32{
+		"MAV_RESULT_UNSPECIFIED":                   0,
+		"MAV_RESULT_ACCEPTED":                      1,
+		"MAV_RESULT_TEMPORARILY_REJECTED":          2,
+		"MAV_RESULT_DENIED":                        3,
+		"MAV_RESULT_UNSUPPORTED":                   4,
+		"MAV_RESULT_FAILED":                        5,
+		"MAV_RESULT_IN_PROGRESS":                   6,
+		"MAV_RESULT_CANCELLED":                     7,
+		"MAV_RESULT_COMMAND_LONG_ONLY":             8,
+		"MAV_RESULT_COMMAND_INT_ONLY":              9,
+		"MAV_RESULT_COMMAND_UNSUPPORTED_MAV_FRAME": 10,
+		"MAV_RESULT_NOT_IN_CONTROL":                11,
+	}
+)
+
+func (x MavResult) Enum() *MavResult {
+	p := new(MavResult)
+	*p = x
+	return p
+}
+
+func (x MavResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MavResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_action_proto_enumTypes[2].Descriptor()
+}
+
+func (MavResult) Type() protoreflect.EnumType {
+	return &file_flightpath_action_proto_enumTypes[2]
+}
+
+func (x MavResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MavResult.Descriptor instead.
+func (MavResult) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{2}
+}
+
+// SpeedType represents speed types from MAVLink SPEED_TYPE enum
+// All values are incremented by 1 to accommodate SPEED_TYPE_UNSPECIFIED
+type SpeedType int32
+
+const (
+	SpeedType_SPEED_TYPE_UNSPECIFIED SpeedType = 0
+	// Airspeed
+	SpeedType_SPEED_TYPE_AIRSPEED SpeedType = 1
+	// Groundspeed
+	SpeedType_SPEED_TYPE_GROUNDSPEED SpeedType = 2
+	// Climb speed
+	SpeedType_SPEED_TYPE_CLIMB_SPEED SpeedType = 3
+	// Descent speed
+	SpeedType_SPEED_TYPE_DESCENT_SPEED SpeedType = 4
+)
+
+// Enum value maps for SpeedType.
+var (
+	SpeedType_name = map[int32]string{
+		0: "SPEED_TYPE_UNSPECIFIED",
+		1: "SPEED_TYPE_AIRSPEED",
+		2: "SPEED_TYPE_GROUNDSPEED",
+		3: "SPEED_TYPE_CLIMB_SPEED",
+		4: "SPEED_TYPE_DESCENT_SPEED",
+	}
+	SpeedType_value = map[string]int32{
+		"SPEED_TYPE_UNSPECIFIED":   0,
+		"SPEED_TYPE_AIRSPEED":      1,
+		"SPEED_TYPE_GROUNDSPEED":   2,
+		"SPEED_TYPE_CLIMB_SPEED":   3,
+		"SPEED_TYPE_DESCENT_SPEED": 4,
+	}
+)
+
+func (x SpeedType) Enum() *SpeedType {
+	p := new(SpeedType)
+	*p = x
+	return p
+}
+
+func (x SpeedType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SpeedType) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_action_proto_enumTypes[3].Descriptor()
+}
+
+func (SpeedType) Type() protoreflect.EnumType {
+	return &file_flightpath_action_proto_enumTypes[3]
+}
+
+func (x SpeedType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SpeedType.Descriptor instead.
+func (SpeedType) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{3}
+}
+
+// YawDirection represents the direction of a yaw turn
+type YawDirection int32
+
+const (
+	// Shortest direction
+	YawDirection_YAW_DIRECTION_UNSPECIFIED YawDirection = 0
+	// Clockwise (seen from above)
+	YawDirection_YAW_DIRECTION_CLOCKWISE YawDirection = 1
+	// Counter-clockwise (seen from above)
+	YawDirection_YAW_DIRECTION_COUNTER_CLOCKWISE YawDirection = 2
+)
+
+// Enum value maps for YawDirection.
+var (
+	YawDirection_name = map[int32]string{
+		0: "YAW_DIRECTION_UNSPECIFIED",
+		1: "YAW_DIRECTION_CLOCKWISE",
+		2: "YAW_DIRECTION_COUNTER_CLOCKWISE",
+	}
+	YawDirection_value = map[string]int32{
+		"YAW_DIRECTION_UNSPECIFIED":       0,
+		"YAW_DIRECTION_CLOCKWISE":         1,
+		"YAW_DIRECTION_COUNTER_CLOCKWISE": 2,
+	}
+)
+
+func (x YawDirection) Enum() *YawDirection {
+	p := new(YawDirection)
+	*p = x
+	return p
+}
+
+func (x YawDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (YawDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_action_proto_enumTypes[4].Descriptor()
+}
+
+func (YawDirection) Type() protoreflect.EnumType {
+	return &file_flightpath_action_proto_enumTypes[4]
+}
+
+func (x YawDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use YawDirection.Descriptor instead.
+func (YawDirection) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{4}
+}
+
+// FlightPhase represents the phases of a takeoff, landing or return-to-launch manoeuvre.
+// Derived from EXTENDED_SYS_STATE (landed state), GLOBAL_POSITION_INT (altitude) and the
+// HEARTBEAT flight mode.
+type FlightPhase int32
+
+const (
+	FlightPhase_FLIGHT_PHASE_UNSPECIFIED FlightPhase = 0
+	// The command was accepted by the autopilot
+	FlightPhase_FLIGHT_PHASE_ACCEPTED FlightPhase = 1
+	// Climbing to the takeoff altitude
+	FlightPhase_FLIGHT_PHASE_CLIMBING FlightPhase = 2
+	// Hovering (takeoff complete, or return-to-launch finished without landing)
+	FlightPhase_FLIGHT_PHASE_HOVERING FlightPhase = 3
+	// Flying back to the launch position
+	FlightPhase_FLIGHT_PHASE_RETURNING FlightPhase = 4
+	// Descending to land
+	FlightPhase_FLIGHT_PHASE_DESCENDING FlightPhase = 5
+	// On the ground, still armed
+	FlightPhase_FLIGHT_PHASE_LANDED FlightPhase = 6
+	// On the ground and disarmed
+	FlightPhase_FLIGHT_PHASE_DISARMED FlightPhase = 7
+)
+
+// Enum value maps for FlightPhase.
+var (
+	FlightPhase_name = map[int32]string{
+		0: "FLIGHT_PHASE_UNSPECIFIED",
+		1: "FLIGHT_PHASE_ACCEPTED",
+		2: "FLIGHT_PHASE_CLIMBING",
+		3: "FLIGHT_PHASE_HOVERING",
+		4: "FLIGHT_PHASE_RETURNING",
+		5: "FLIGHT_PHASE_DESCENDING",
+		6: "FLIGHT_PHASE_LANDED",
+		7: "FLIGHT_PHASE_DISARMED",
+	}
+	FlightPhase_value = map[string]int32{
+		"FLIGHT_PHASE_UNSPECIFIED": 0,
+		"FLIGHT_PHASE_ACCEPTED":    1,
+		"FLIGHT_PHASE_CLIMBING":    2,
+		"FLIGHT_PHASE_HOVERING":    3,
+		"FLIGHT_PHASE_RETURNING":   4,
+		"FLIGHT_PHASE_DESCENDING":  5,
+		"FLIGHT_PHASE_LANDED":      6,
+		"FLIGHT_PHASE_DISARMED":    7,
+	}
+)
+
+func (x FlightPhase) Enum() *FlightPhase {
+	p := new(FlightPhase)
+	*p = x
+	return p
+}
+
+func (x FlightPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlightPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_action_proto_enumTypes[5].Descriptor()
+}
+
+func (FlightPhase) Type() protoreflect.EnumType {
+	return &file_flightpath_action_proto_enumTypes[5]
+}
+
+func (x FlightPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlightPhase.Descriptor instead.
+func (FlightPhase) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{5}
+}
+
+// ArmRequest is the request message for Arm
+type ArmRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System ID of the drone to arm. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the autopilot to arm. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId   uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArmRequest) Reset() {
+	*x = ArmRequest{}
+	mi := &file_flightpath_action_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmRequest) ProtoMessage() {}
+
+func (x *ArmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmRequest.ProtoReflect.Descriptor instead.
+func (*ArmRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ArmRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *ArmRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+// ArmResponse is the response message for Arm
+type ArmResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArmResponse) Reset() {
+	*x = ArmResponse{}
+	mi := &file_flightpath_action_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmResponse) ProtoMessage() {}
+
+func (x *ArmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmResponse.ProtoReflect.Descriptor instead.
+func (*ArmResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{1}
+}
+
+// DisarmRequest is the request message for Disarm
+type DisarmRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System ID of the drone to disarm. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the autopilot to disarm. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Force disarm, bypassing the autopilot's landed checks (param2 = 21196).
+	// WARNING: Force disarming a flying drone will cause it to fall out of the sky.
+	Force         bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisarmRequest) Reset() {
+	*x = DisarmRequest{}
+	mi := &file_flightpath_action_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisarmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisarmRequest) ProtoMessage() {}
+
+func (x *DisarmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisarmRequest.ProtoReflect.Descriptor instead.
+func (*DisarmRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DisarmRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *DisarmRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *DisarmRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// DisarmResponse is the response message for Disarm
+type DisarmResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisarmResponse) Reset() {
+	*x = DisarmResponse{}
+	mi := &file_flightpath_action_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisarmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisarmResponse) ProtoMessage() {}
+
+func (x *DisarmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisarmResponse.ProtoReflect.Descriptor instead.
+func (*DisarmResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{3}
+}
+
+// TakeoffRequest is the request message for Takeoff
+type TakeoffRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System ID of the drone. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Takeoff altitude above home (m). If not set, the autopilot's default takeoff altitude is used
+	// (PX4 only, other autopilots require an altitude).
+	Altitude      float32 `protobuf:"fixed32,3,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TakeoffRequest) Reset() {
+	*x = TakeoffRequest{}
+	mi := &file_flightpath_action_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakeoffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakeoffRequest) ProtoMessage() {}
+
+func (x *TakeoffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakeoffRequest.ProtoReflect.Descriptor instead.
+func (*TakeoffRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TakeoffRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *TakeoffRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *TakeoffRequest) GetAltitude() float32 {
+	if x != nil {
+		return x.Altitude
+	}
+	return 0
+}
+
+// TakeoffResponse contains a flight phase update for Takeoff
+type TakeoffResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this update was generated (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// Current flight phase
+	Phase FlightPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=flightpath.FlightPhase" json:"phase,omitempty"`
+	// Altitude above home (m)
+	RelativeAltitude float32 `protobuf:"fixed32,3,opt,name=relative_altitude,json=relativeAltitude,proto3" json:"relative_altitude,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TakeoffResponse) Reset() {
+	*x = TakeoffResponse{}
+	mi := &file_flightpath_action_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakeoffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakeoffResponse) ProtoMessage() {}
+
+func (x *TakeoffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakeoffResponse.ProtoReflect.Descriptor instead.
+func (*TakeoffResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TakeoffResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *TakeoffResponse) GetPhase() FlightPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return FlightPhase_FLIGHT_PHASE_UNSPECIFIED
+}
+
+func (x *TakeoffResponse) GetRelativeAltitude() float32 {
+	if x != nil {
+		return x.RelativeAltitude
+	}
+	return 0
+}
+
+// LandRequest is the request message for Land
+type LandRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System ID of the drone. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId   uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LandRequest) Reset() {
+	*x = LandRequest{}
+	mi := &file_flightpath_action_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LandRequest) ProtoMessage() {}
+
+func (x *LandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LandRequest.ProtoReflect.Descriptor instead.
+func (*LandRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LandRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *LandRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+// LandResponse contains a flight phase update for Land
+type LandResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this update was generated (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// Current flight phase
+	Phase FlightPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=flightpath.FlightPhase" json:"phase,omitempty"`
+	// Altitude above home (m)
+	RelativeAltitude float32 `protobuf:"fixed32,3,opt,name=relative_altitude,json=relativeAltitude,proto3" json:"relative_altitude,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LandResponse) Reset() {
+	*x = LandResponse{}
+	mi := &file_flightpath_action_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LandResponse) ProtoMessage() {}
+
+func (x *LandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LandResponse.ProtoReflect.Descriptor instead.
+func (*LandResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LandResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *LandResponse) GetPhase() FlightPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return FlightPhase_FLIGHT_PHASE_UNSPECIFIED
+}
+
+func (x *LandResponse) GetRelativeAltitude() float32 {
+	if x != nil {
+		return x.RelativeAltitude
+	}
+	return 0
+}
+
+// ReturnToLaunchRequest is the request message for ReturnToLaunch
+type ReturnToLaunchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System ID of the drone. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId   uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnToLaunchRequest) Reset() {
+	*x = ReturnToLaunchRequest{}
+	mi := &file_flightpath_action_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnToLaunchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnToLaunchRequest) ProtoMessage() {}
+
+func (x *ReturnToLaunchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnToLaunchRequest.ProtoReflect.Descriptor instead.
+func (*ReturnToLaunchRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReturnToLaunchRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *ReturnToLaunchRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+// ReturnToLaunchResponse contains a flight phase update for ReturnToLaunch
+type ReturnToLaunchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this update was generated (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// Current flight phase
+	Phase FlightPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=flightpath.FlightPhase" json:"phase,omitempty"`
+	// Altitude above home (m)
+	RelativeAltitude float32 `protobuf:"fixed32,3,opt,name=relative_altitude,json=relativeAltitude,proto3" json:"relative_altitude,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReturnToLaunchResponse) Reset() {
+	*x = ReturnToLaunchResponse{}
+	mi := &file_flightpath_action_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnToLaunchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnToLaunchResponse) ProtoMessage() {}
+
+func (x *ReturnToLaunchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnToLaunchResponse.ProtoReflect.Descriptor instead.
+func (*ReturnToLaunchResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReturnToLaunchResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *ReturnToLaunchResponse) GetPhase() FlightPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return FlightPhase_FLIGHT_PHASE_UNSPECIFIED
+}
+
+func (x *ReturnToLaunchResponse) GetRelativeAltitude() float32 {
+	if x != nil {
+		return x.RelativeAltitude
+	}
+	return 0
+}
+
+// SetFlightModeRequest is the request message for SetFlightMode
+type SetFlightModeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System ID of the drone. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Main flight mode (required)
+	MainMode MainMode `protobuf:"varint,3,opt,name=main_mode,json=mainMode,proto3,enum=flightpath.MainMode" json:"main_mode,omitempty"`
+	// Sub mode. If not set, the default sub mode of the main mode is used
+	// (e.g. AUTO_MISSION for AUTO, POSCTL_POSCTL for POSCTL).
+	SubMode       SubMode `protobuf:"varint,4,opt,name=sub_mode,json=subMode,proto3,enum=flightpath.SubMode" json:"sub_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFlightModeRequest) Reset() {
+	*x = SetFlightModeRequest{}
+	mi := &file_flightpath_action_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFlightModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFlightModeRequest) ProtoMessage() {}
+
+func (x *SetFlightModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFlightModeRequest.ProtoReflect.Descriptor instead.
+func (*SetFlightModeRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetFlightModeRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SetFlightModeRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SetFlightModeRequest) GetMainMode() MainMode {
+	if x != nil {
+		return x.MainMode
+	}
+	return MainMode_MAIN_MODE_UNSPECIFIED
+}
+
+func (x *SetFlightModeRequest) GetSubMode() SubMode {
+	if x != nil {
+		return x.SubMode
+	}
+	return SubMode_SUB_MODE_UNSPECIFIED
+}
+
+// SetFlightModeResponse is the response message for SetFlightMode
+type SetFlightModeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Flight mode reported by the drone's HEARTBEAT after the change
+	CustomMode    *CustomMode `protobuf:"bytes,1,opt,name=custom_mode,json=customMode,proto3" json:"custom_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFlightModeResponse) Reset() {
+	*x = SetFlightModeResponse{}
+	mi := &file_flightpath_action_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFlightModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFlightModeResponse) ProtoMessage() {}
+
+func (x *SetFlightModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFlightModeResponse.ProtoReflect.Descriptor instead.
+func (*SetFlightModeResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetFlightModeResponse) GetCustomMode() *CustomMode {
+	if x != nil {
+		return x.CustomMode
+	}
+	return nil
+}
+
+// GotoLocationRequest is the request message for GotoLocation
+type GotoLocationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System ID of the drone. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Target latitude (WGS84) in degrees
+	Latitude float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Target longitude (WGS84) in degrees
+	Longitude float64 `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Target altitude above home (m)
+	Altitude float32 `protobuf:"fixed32,5,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	// Ground speed (m/s). If not set, the autopilot's default speed is used.
+	GroundSpeed float32 `protobuf:"fixed32,6,opt,name=ground_speed,json=groundSpeed,proto3" json:"ground_speed,omitempty"`
+	// Yaw heading (degrees, 0 = north, clockwise). If not set, the autopilot's yaw behaviour is used.
+	Yaw *float32 `protobuf:"fixed32,7,opt,name=yaw,proto3,oneof" json:"yaw,omitempty"`
+	// Distance to the target (m) below which the drone is considered arrived. Defaults to 2 m if not set.
+	AcceptanceRadius float32 `protobuf:"fixed32,8,opt,name=acceptance_radius,json=acceptanceRadius,proto3" json:"acceptance_radius,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GotoLocationRequest) Reset() {
+	*x = GotoLocationRequest{}
+	mi := &file_flightpath_action_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GotoLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GotoLocationRequest) ProtoMessage() {}
+
+func (x *GotoLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GotoLocationRequest.ProtoReflect.Descriptor instead.
+func (*GotoLocationRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GotoLocationRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *GotoLocationRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *GotoLocationRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GotoLocationRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GotoLocationRequest) GetAltitude() float32 {
+	if x != nil {
+		return x.Altitude
+	}
+	return 0
+}
+
+func (x *GotoLocationRequest) GetGroundSpeed() float32 {
+	if x != nil {
+		return x.GroundSpeed
+	}
+	return 0
+}
+
+func (x *GotoLocationRequest) GetYaw() float32 {
+	if x != nil && x.Yaw != nil {
+		return *x.Yaw
+	}
+	return 0
+}
+
+func (x *GotoLocationRequest) GetAcceptanceRadius() float32 {
+	if x != nil {
+		return x.AcceptanceRadius
+	}
+	return 0
+}
+
+// GotoLocationResponse contains a progress update for GotoLocation
+type GotoLocationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this update was generated (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// Distance to the target (m)
+	Distance float32 `protobuf:"fixed32,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	// Horizontal distance to the target (m)
+	HorizontalDistance float32 `protobuf:"fixed32,3,opt,name=horizontal_distance,json=horizontalDistance,proto3" json:"horizontal_distance,omitempty"`
+	// Altitude difference to the target (m, positive if the target is above the drone)
+	VerticalDistance float32 `protobuf:"fixed32,4,opt,name=vertical_distance,json=verticalDistance,proto3" json:"vertical_distance,omitempty"`
+	// Speed towards the target (m/s, negative if moving away)
+	ClosingSpeed float32 `protobuf:"fixed32,5,opt,name=closing_speed,json=closingSpeed,proto3" json:"closing_speed,omitempty"`
+	// Estimated time to arrival (s). Set to -1 when the drone is not moving towards the target.
+	Eta float32 `protobuf:"fixed32,6,opt,name=eta,proto3" json:"eta,omitempty"`
+	// True in the last update, once the drone is within the acceptance radius
+	Arrived       bool `protobuf:"varint,7,opt,name=arrived,proto3" json:"arrived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GotoLocationResponse) Reset() {
+	*x = GotoLocationResponse{}
+	mi := &file_flightpath_action_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GotoLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GotoLocationResponse) ProtoMessage() {}
+
+func (x *GotoLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GotoLocationResponse.ProtoReflect.Descriptor instead.
+func (*GotoLocationResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GotoLocationResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *GotoLocationResponse) GetDistance() float32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *GotoLocationResponse) GetHorizontalDistance() float32 {
+	if x != nil {
+		return x.HorizontalDistance
+	}
+	return 0
+}
+
+func (x *GotoLocationResponse) GetVerticalDistance() float32 {
+	if x != nil {
+		return x.VerticalDistance
+	}
+	return 0
+}
+
+func (x *GotoLocationResponse) GetClosingSpeed() float32 {
+	if x != nil {
+		return x.ClosingSpeed
+	}
+	return 0
+}
+
+func (x *GotoLocationResponse) GetEta() float32 {
+	if x != nil {
+		return x.Eta
+	}
+	return 0
+}
+
+func (x *GotoLocationResponse) GetArrived() bool {
+	if x != nil {
+		return x.Arrived
+	}
+	return false
+}
+
+// DoOrbitRequest is the request message for DoOrbit
+type DoOrbitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System ID of the drone. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Latitude (WGS84) of the orbit center in degrees
+	Latitude float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude (WGS84) of the orbit center in degrees
+	Longitude float64 `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Altitude of the orbit center above home (m). If not set, the current altitude is kept.
+	Altitude *float32 `protobuf:"fixed32,5,opt,name=altitude,proto3,oneof" json:"altitude,omitempty"`
+	// Orbit radius (m), must be positive
+	Radius float32 `protobuf:"fixed32,6,opt,name=radius,proto3" json:"radius,omitempty"`
+	// Orbit counter-clockwise (seen from above) instead of clockwise
+	CounterClockwise bool `protobuf:"varint,7,opt,name=counter_clockwise,json=counterClockwise,proto3" json:"counter_clockwise,omitempty"`
+	// Tangential velocity (m/s). If not set, the autopilot's default velocity is used.
+	Velocity float32 `protobuf:"fixed32,8,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	// Yaw behaviour during the orbit. If not set, the current (or autopilot default) behaviour is used.
+	YawBehaviour OrbitYawBehaviour `protobuf:"varint,9,opt,name=yaw_behaviour,json=yawBehaviour,proto3,enum=flightpath.OrbitYawBehaviour" json:"yaw_behaviour,omitempty"`
+	// Number of turns before the orbit ends. If not set, the drone orbits until it is given another command.
+	Turns         float32 `protobuf:"fixed32,10,opt,name=turns,proto3" json:"turns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoOrbitRequest) Reset() {
+	*x = DoOrbitRequest{}
+	mi := &file_flightpath_action_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoOrbitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoOrbitRequest) ProtoMessage() {}
+
+func (x *DoOrbitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoOrbitRequest.ProtoReflect.Descriptor instead.
+func (*DoOrbitRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DoOrbitRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *DoOrbitRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *DoOrbitRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *DoOrbitRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *DoOrbitRequest) GetAltitude() float32 {
+	if x != nil && x.Altitude != nil {
+		return *x.Altitude
+	}
+	return 0
+}
+
+func (x *DoOrbitRequest) GetRadius() float32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *DoOrbitRequest) GetCounterClockwise() bool {
+	if x != nil {
+		return x.CounterClockwise
+	}
+	return false
+}
+
+func (x *DoOrbitRequest) GetVelocity() float32 {
+	if x != nil {
+		return x.Velocity
+	}
+	return 0
+}
+
+func (x *DoOrbitRequest) GetYawBehaviour() OrbitYawBehaviour {
+	if x != nil {
+		return x.YawBehaviour
+	}
+	return OrbitYawBehaviour_ORBIT_YAW_BEHAVIOUR_UNSPECIFIED
+}
+
+func (x *DoOrbitRequest) GetTurns() float32 {
+	if x != nil {
+		return x.Turns
+	}
+	return 0
+}
+
+// DoOrbitResponse is the response message for DoOrbit
+type DoOrbitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoOrbitResponse) Reset() {
+	*x = DoOrbitResponse{}
+	mi := &file_flightpath_action_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoOrbitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoOrbitResponse) ProtoMessage() {}
+
+func (x *DoOrbitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoOrbitResponse.ProtoReflect.Descriptor instead.
+func (*DoOrbitResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{15}
+}
+
+// RebootAutopilotRequest is the request message for RebootAutopilot
+type RebootAutopilotRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System ID of the drone. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId   uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebootAutopilotRequest) Reset() {
+	*x = RebootAutopilotRequest{}
+	mi := &file_flightpath_action_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebootAutopilotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebootAutopilotRequest) ProtoMessage() {}
+
+func (x *RebootAutopilotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebootAutopilotRequest.ProtoReflect.Descriptor instead.
+func (*RebootAutopilotRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RebootAutopilotRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *RebootAutopilotRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+// RebootAutopilotResponse contains a status update for RebootAutopilot
+type RebootAutopilotResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this update was generated (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// Current reboot phase
+	Phase         RebootShutdownPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=flightpath.RebootShutdownPhase" json:"phase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebootAutopilotResponse) Reset() {
+	*x = RebootAutopilotResponse{}
+	mi := &file_flightpath_action_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebootAutopilotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebootAutopilotResponse) ProtoMessage() {}
+
+func (x *RebootAutopilotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebootAutopilotResponse.ProtoReflect.Descriptor instead.
+func (*RebootAutopilotResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RebootAutopilotResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *RebootAutopilotResponse) GetPhase() RebootShutdownPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return RebootShutdownPhase_REBOOT_SHUTDOWN_PHASE_UNSPECIFIED
+}
+
+// ShutdownAutopilotRequest is the request message for ShutdownAutopilot
+type ShutdownAutopilotRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System ID of the drone. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId   uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShutdownAutopilotRequest) Reset() {
+	*x = ShutdownAutopilotRequest{}
+	mi := &file_flightpath_action_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShutdownAutopilotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShutdownAutopilotRequest) ProtoMessage() {}
+
+func (x *ShutdownAutopilotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShutdownAutopilotRequest.ProtoReflect.Descriptor instead.
+func (*ShutdownAutopilotRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ShutdownAutopilotRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *ShutdownAutopilotRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+// ShutdownAutopilotResponse contains a status update for ShutdownAutopilot
+type ShutdownAutopilotResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this update was generated (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// Current shutdown phase
+	Phase         RebootShutdownPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=flightpath.RebootShutdownPhase" json:"phase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShutdownAutopilotResponse) Reset() {
+	*x = ShutdownAutopilotResponse{}
+	mi := &file_flightpath_action_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShutdownAutopilotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShutdownAutopilotResponse) ProtoMessage() {}
+
+func (x *ShutdownAutopilotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShutdownAutopilotResponse.ProtoReflect.Descriptor instead.
+func (*ShutdownAutopilotResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ShutdownAutopilotResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *ShutdownAutopilotResponse) GetPhase() RebootShutdownPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return RebootShutdownPhase_REBOOT_SHUTDOWN_PHASE_UNSPECIFIED
+}
+
+// SendCommandRequest is the request message for SendCommand
+type SendCommandRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System ID of the target. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the target. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Command ID (MAV_CMD), must be part of the common dialect
+	Command uint32 `protobuf:"varint,3,opt,name=command,proto3" json:"command,omitempty"`
+	// Parameter 1 (for the specific command)
+	Param1 float32 `protobuf:"fixed32,4,opt,name=param1,proto3" json:"param1,omitempty"`
+	// Parameter 2 (for the specific command)
+	Param2 float32 `protobuf:"fixed32,5,opt,name=param2,proto3" json:"param2,omitempty"`
+	// Parameter 3 (for the specific command)
+	Param3 float32 `protobuf:"fixed32,6,opt,name=param3,proto3" json:"param3,omitempty"`
+	// Parameter 4 (for the specific command)
+	Param4 float32 `protobuf:"fixed32,7,opt,name=param4,proto3" json:"param4,omitempty"`
+	// Parameter 5 (for the specific command). Ignored for COMMAND_INT, which uses x instead.
+	Param5 float32 `protobuf:"fixed32,8,opt,name=param5,proto3" json:"param5,omitempty"`
+	// Parameter 6 (for the specific command). Ignored for COMMAND_INT, which uses y instead.
+	Param6 float32 `protobuf:"fixed32,9,opt,name=param6,proto3" json:"param6,omitempty"`
+	// Parameter 7 (for the specific command). Sent as z for COMMAND_INT.
+	Param7 float32 `protobuf:"fixed32,10,opt,name=param7,proto3" json:"param7,omitempty"`
+	// Send the command as COMMAND_INT instead of COMMAND_LONG
+	UseCommandInt bool `protobuf:"varint,11,opt,name=use_command_int,json=useCommandInt,proto3" json:"use_command_int,omitempty"`
+	// COMMAND_INT only: coordinate system of the command (required)
+	Frame MavFrame `protobuf:"varint,12,opt,name=frame,proto3,enum=flightpath.MavFrame" json:"frame,omitempty"`
+	// COMMAND_INT only: local x position in meters * 1e4, or latitude in degrees * 1e7
+	X int32 `protobuf:"varint,13,opt,name=x,proto3" json:"x,omitempty"`
+	// COMMAND_INT only: local y position in meters * 1e4, or longitude in degrees * 1e7
+	Y             int32 `protobuf:"varint,14,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCommandRequest) Reset() {
+	*x = SendCommandRequest{}
+	mi := &file_flightpath_action_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommandRequest) ProtoMessage() {}
+
+func (x *SendCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommandRequest.ProtoReflect.Descriptor instead.
+func (*SendCommandRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SendCommandRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SendCommandRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SendCommandRequest) GetCommand() uint32 {
+	if x != nil {
+		return x.Command
+	}
+	return 0
+}
+
+func (x *SendCommandRequest) GetParam1() float32 {
+	if x != nil {
+		return x.Param1
+	}
+	return 0
+}
+
+func (x *SendCommandRequest) GetParam2() float32 {
+	if x != nil {
+		return x.Param2
+	}
+	return 0
+}
+
+func (x *SendCommandRequest) GetParam3() float32 {
+	if x != nil {
+		return x.Param3
+	}
+	return 0
+}
+
+func (x *SendCommandRequest) GetParam4() float32 {
+	if x != nil {
+		return x.Param4
+	}
+	return 0
+}
+
+func (x *SendCommandRequest) GetParam5() float32 {
+	if x != nil {
+		return x.Param5
+	}
+	return 0
+}
+
+func (x *SendCommandRequest) GetParam6() float32 {
+	if x != nil {
+		return x.Param6
+	}
+	return 0
+}
+
+func (x *SendCommandRequest) GetParam7() float32 {
+	if x != nil {
+		return x.Param7
+	}
+	return 0
+}
+
+func (x *SendCommandRequest) GetUseCommandInt() bool {
+	if x != nil {
+		return x.UseCommandInt
+	}
+	return false
+}
+
+func (x *SendCommandRequest) GetFrame() MavFrame {
+	if x != nil {
+		return x.Frame
+	}
+	return MavFrame_MAV_FRAME_UNSPECIFIED
+}
+
+func (x *SendCommandRequest) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *SendCommandRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// SendCommandResponse is the response message for SendCommand
+type SendCommandResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Final COMMAND_ACK received for the command
+	CommandAck    *CommandAck `protobuf:"bytes,1,opt,name=command_ack,json=commandAck,proto3" json:"command_ack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCommandResponse) Reset() {
+	*x = SendCommandResponse{}
+	mi := &file_flightpath_action_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommandResponse) ProtoMessage() {}
+
+func (x *SendCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommandResponse.ProtoReflect.Descriptor instead.
+func (*SendCommandResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SendCommandResponse) GetCommandAck() *CommandAck {
+	if x != nil {
+		return x.CommandAck
+	}
+	return nil
+}
+
+// CommandAck represents the COMMAND_ACK MAVLink message
+// Report status of a command. Includes feedback whether the command was executed.
+type CommandAck struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Command ID (of acknowledged command)
+	Command uint32 `protobuf:"varint,1,opt,name=command,proto3" json:"command,omitempty"`
+	// Result of command
+	Result MavResult `protobuf:"varint,2,opt,name=result,proto3,enum=flightpath.MavResult" json:"result,omitempty"`
+	// The progress percentage when result is MAV_RESULT_IN_PROGRESS. Values: [0-100], or UINT8_MAX if the progress is unknown.
+	Progress uint32 `protobuf:"varint,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	// Additional result information. Can be set with a command-specific enum containing command-specific error reasons for why the command might be denied.
+	ResultParam2 int32 `protobuf:"varint,4,opt,name=result_param2,json=resultParam2,proto3" json:"result_param2,omitempty"`
+	// System ID of the target recipient (the system that sent the command)
+	TargetSystem uint32 `protobuf:"varint,5,opt,name=target_system,json=targetSystem,proto3" json:"target_system,omitempty"`
+	// Component ID of the target recipient (the component that sent the command)
+	TargetComponent uint32 `protobuf:"varint,6,opt,name=target_component,json=targetComponent,proto3" json:"target_component,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CommandAck) Reset() {
+	*x = CommandAck{}
+	mi := &file_flightpath_action_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandAck) ProtoMessage() {}
+
+func (x *CommandAck) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandAck.ProtoReflect.Descriptor instead.
+func (*CommandAck) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CommandAck) GetCommand() uint32 {
+	if x != nil {
+		return x.Command
+	}
+	return 0
+}
+
+func (x *CommandAck) GetResult() MavResult {
+	if x != nil {
+		return x.Result
+	}
+	return MavResult_MAV_RESULT_UNSPECIFIED
+}
+
+func (x *CommandAck) GetProgress() uint32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *CommandAck) GetResultParam2() int32 {
+	if x != nil {
+		return x.ResultParam2
+	}
+	return 0
+}
+
+func (x *CommandAck) GetTargetSystem() uint32 {
+	if x != nil {
+		return x.TargetSystem
+	}
+	return 0
+}
+
+func (x *CommandAck) GetTargetComponent() uint32 {
+	if x != nil {
+		return x.TargetComponent
+	}
+	return 0
+}
+
+// ChangeSpeedRequest is the request message for ChangeSpeed
+type ChangeSpeedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System ID of the drone. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Speed to change. Defaults to SPEED_TYPE_GROUNDSPEED if not set.
+	SpeedType SpeedType `protobuf:"varint,3,opt,name=speed_type,json=speedType,proto3,enum=flightpath.SpeedType" json:"speed_type,omitempty"`
+	// Target speed (m/s), in (0, 100]
+	Speed float32 `protobuf:"fixed32,4,opt,name=speed,proto3" json:"speed,omitempty"`
+	// Wait (up to 10 s) for VFR_HUD to report the target speed before returning.
+	// Note that the drone only reaches the target speed while it is moving.
+	WaitForConfirmation bool `protobuf:"varint,5,opt,name=wait_for_confirmation,json=waitForConfirmation,proto3" json:"wait_for_confirmation,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ChangeSpeedRequest) Reset() {
+	*x = ChangeSpeedRequest{}
+	mi := &file_flightpath_action_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeSpeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSpeedRequest) ProtoMessage() {}
+
+func (x *ChangeSpeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSpeedRequest.ProtoReflect.Descriptor instead.
+func (*ChangeSpeedRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ChangeSpeedRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *ChangeSpeedRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *ChangeSpeedRequest) GetSpeedType() SpeedType {
+	if x != nil {
+		return x.SpeedType
+	}
+	return SpeedType_SPEED_TYPE_UNSPECIFIED
+}
+
+func (x *ChangeSpeedRequest) GetSpeed() float32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *ChangeSpeedRequest) GetWaitForConfirmation() bool {
+	if x != nil {
+		return x.WaitForConfirmation
+	}
+	return false
+}
+
+// ChangeSpeedResponse is the response message for ChangeSpeed
+type ChangeSpeedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// COMMAND_ACK received for the command
+	CommandAck *CommandAck `protobuf:"bytes,1,opt,name=command_ack,json=commandAck,proto3" json:"command_ack,omitempty"`
+	// True if VFR_HUD reported the target speed (only when wait_for_confirmation is set)
+	Confirmed bool `protobuf:"varint,2,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// Last speed reported by VFR_HUD for the requested speed type (m/s, only when wait_for_confirmation is set)
+	ObservedSpeed float32 `protobuf:"fixed32,3,opt,name=observed_speed,json=observedSpeed,proto3" json:"observed_speed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeSpeedResponse) Reset() {
+	*x = ChangeSpeedResponse{}
+	mi := &file_flightpath_action_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeSpeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSpeedResponse) ProtoMessage() {}
+
+func (x *ChangeSpeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSpeedResponse.ProtoReflect.Descriptor instead.
+func (*ChangeSpeedResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ChangeSpeedResponse) GetCommandAck() *CommandAck {
+	if x != nil {
+		return x.CommandAck
+	}
+	return nil
+}
+
+func (x *ChangeSpeedResponse) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+func (x *ChangeSpeedResponse) GetObservedSpeed() float32 {
+	if x != nil {
+		return x.ObservedSpeed
+	}
+	return 0
+}
+
+// SetYawRequest is the request message for SetYaw
+type SetYawRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System ID of the drone. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Target heading (deg, 0 = north, clockwise), in [0, 360).
+	// If relative is set, the angle to turn by instead, in the given direction.
+	Angle float32 `protobuf:"fixed32,3,opt,name=angle,proto3" json:"angle,omitempty"`
+	// Angular speed (deg/s), in [0, 360]. If not set, the autopilot's default rate is used.
+	AngularSpeed float32 `protobuf:"fixed32,4,opt,name=angular_speed,json=angularSpeed,proto3" json:"angular_speed,omitempty"`
+	// Direction of the turn. Required if relative is set.
+	Direction YawDirection `protobuf:"varint,5,opt,name=direction,proto3,enum=flightpath.YawDirection" json:"direction,omitempty"`
+	// Turn by angle relative to the current heading instead of turning to an absolute heading
+	Relative bool `protobuf:"varint,6,opt,name=relative,proto3" json:"relative,omitempty"`
+	// Wait (up to 10 s) for VFR_HUD to report the target heading before returning (absolute headings only)
+	WaitForConfirmation bool `protobuf:"varint,7,opt,name=wait_for_confirmation,json=waitForConfirmation,proto3" json:"wait_for_confirmation,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SetYawRequest) Reset() {
+	*x = SetYawRequest{}
+	mi := &file_flightpath_action_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetYawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetYawRequest) ProtoMessage() {}
+
+func (x *SetYawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetYawRequest.ProtoReflect.Descriptor instead.
+func (*SetYawRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetYawRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SetYawRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SetYawRequest) GetAngle() float32 {
+	if x != nil {
+		return x.Angle
+	}
+	return 0
+}
+
+func (x *SetYawRequest) GetAngularSpeed() float32 {
+	if x != nil {
+		return x.AngularSpeed
+	}
+	return 0
+}
+
+func (x *SetYawRequest) GetDirection() YawDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return YawDirection_YAW_DIRECTION_UNSPECIFIED
+}
+
+func (x *SetYawRequest) GetRelative() bool {
+	if x != nil {
+		return x.Relative
+	}
+	return false
+}
+
+func (x *SetYawRequest) GetWaitForConfirmation() bool {
+	if x != nil {
+		return x.WaitForConfirmation
+	}
+	return false
+}
+
+// SetYawResponse is the response message for SetYaw
+type SetYawResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// COMMAND_ACK received for the command
+	CommandAck *CommandAck `protobuf:"bytes,1,opt,name=command_ack,json=commandAck,proto3" json:"command_ack,omitempty"`
+	// True if VFR_HUD reported the target heading (only when wait_for_confirmation is set)
+	Confirmed bool `protobuf:"varint,2,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// Last heading reported by VFR_HUD (deg, only when wait_for_confirmation is set)
+	ObservedHeading float32 `protobuf:"fixed32,3,opt,name=observed_heading,json=observedHeading,proto3" json:"observed_heading,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetYawResponse) Reset() {
+	*x = SetYawResponse{}
+	mi := &file_flightpath_action_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetYawResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetYawResponse) ProtoMessage() {}
+
+func (x *SetYawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetYawResponse.ProtoReflect.Descriptor instead.
+func (*SetYawResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetYawResponse) GetCommandAck() *CommandAck {
+	if x != nil {
+		return x.CommandAck
+	}
+	return nil
+}
+
+func (x *SetYawResponse) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+func (x *SetYawResponse) GetObservedHeading() float32 {
+	if x != nil {
+		return x.ObservedHeading
+	}
+	return 0
+}
+
+// SetRoiLocationRequest is the request message for SetRoiLocation
+type SetRoiLocationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System ID of the drone. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Latitude (WGS84) of the region of interest in degrees
+	Latitude float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude (WGS84) of the region of interest in degrees
+	Longitude float64 `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Altitude of the region of interest above home (m)
+	Altitude float32 `protobuf:"fixed32,5,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	// Gimbal device ID to point. If not set, all gimbals are pointed.
+	GimbalDeviceId uint32 `protobuf:"varint,6,opt,name=gimbal_device_id,json=gimbalDeviceId,proto3" json:"gimbal_device_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetRoiLocationRequest) Reset() {
+	*x = SetRoiLocationRequest{}
+	mi := &file_flightpath_action_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRoiLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoiLocationRequest) ProtoMessage() {}
+
+func (x *SetRoiLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoiLocationRequest.ProtoReflect.Descriptor instead.
+func (*SetRoiLocationRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetRoiLocationRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SetRoiLocationRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SetRoiLocationRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *SetRoiLocationRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *SetRoiLocationRequest) GetAltitude() float32 {
+	if x != nil {
+		return x.Altitude
+	}
+	return 0
+}
+
+func (x *SetRoiLocationRequest) GetGimbalDeviceId() uint32 {
+	if x != nil {
+		return x.GimbalDeviceId
+	}
+	return 0
+}
+
+// SetRoiLocationResponse is the response message for SetRoiLocation
+type SetRoiLocationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// COMMAND_ACK received for the command
+	CommandAck    *CommandAck `protobuf:"bytes,1,opt,name=command_ack,json=commandAck,proto3" json:"command_ack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRoiLocationResponse) Reset() {
+	*x = SetRoiLocationResponse{}
+	mi := &file_flightpath_action_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRoiLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoiLocationResponse) ProtoMessage() {}
+
+func (x *SetRoiLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoiLocationResponse.ProtoReflect.Descriptor instead.
+func (*SetRoiLocationResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetRoiLocationResponse) GetCommandAck() *CommandAck {
+	if x != nil {
+		return x.CommandAck
+	}
+	return nil
+}
+
+// ClearRoiRequest is the request message for ClearRoi
+type ClearRoiRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System ID of the drone. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Gimbal device ID to release. If not set, all gimbals are released.
+	GimbalDeviceId uint32 `protobuf:"varint,3,opt,name=gimbal_device_id,json=gimbalDeviceId,proto3" json:"gimbal_device_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ClearRoiRequest) Reset() {
+	*x = ClearRoiRequest{}
+	mi := &file_flightpath_action_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearRoiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearRoiRequest) ProtoMessage() {}
+
+func (x *ClearRoiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearRoiRequest.ProtoReflect.Descriptor instead.
+func (*ClearRoiRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ClearRoiRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *ClearRoiRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *ClearRoiRequest) GetGimbalDeviceId() uint32 {
+	if x != nil {
+		return x.GimbalDeviceId
+	}
+	return 0
+}
+
+// ClearRoiResponse is the response message for ClearRoi
+type ClearRoiResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// COMMAND_ACK received for the command
+	CommandAck    *CommandAck `protobuf:"bytes,1,opt,name=command_ack,json=commandAck,proto3" json:"command_ack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearRoiResponse) Reset() {
+	*x = ClearRoiResponse{}
+	mi := &file_flightpath_action_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearRoiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearRoiResponse) ProtoMessage() {}
+
+func (x *ClearRoiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearRoiResponse.ProtoReflect.Descriptor instead.
+func (*ClearRoiResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ClearRoiResponse) GetCommandAck() *CommandAck {
+	if x != nil {
+		return x.CommandAck
+	}
+	return nil
+}
+
+// SetHomePositionRequest is the request message for SetHomePosition
+type SetHomePositionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System ID of the drone. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Use the current location of the drone as home (latitude, longitude and altitude are ignored)
+	UseCurrentLocation bool `protobuf:"varint,3,opt,name=use_current_location,json=useCurrentLocation,proto3" json:"use_current_location,omitempty"`
+	// Latitude (WGS84) of the new home in degrees
+	Latitude float64 `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude (WGS84) of the new home in degrees
+	Longitude float64 `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Altitude (MSL) of the new home (m)
+	Altitude      float32 `protobuf:"fixed32,6,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHomePositionRequest) Reset() {
+	*x = SetHomePositionRequest{}
+	mi := &file_flightpath_action_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHomePositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHomePositionRequest) ProtoMessage() {}
+
+func (x *SetHomePositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHomePositionRequest.ProtoReflect.Descriptor instead.
+func (*SetHomePositionRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetHomePositionRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SetHomePositionRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SetHomePositionRequest) GetUseCurrentLocation() bool {
+	if x != nil {
+		return x.UseCurrentLocation
+	}
+	return false
+}
+
+func (x *SetHomePositionRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *SetHomePositionRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *SetHomePositionRequest) GetAltitude() float32 {
+	if x != nil {
+		return x.Altitude
+	}
+	return 0
+}
+
+// SetHomePositionResponse is the response message for SetHomePosition
+type SetHomePositionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// COMMAND_ACK received for the command
+	CommandAck    *CommandAck `protobuf:"bytes,1,opt,name=command_ack,json=commandAck,proto3" json:"command_ack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHomePositionResponse) Reset() {
+	*x = SetHomePositionResponse{}
+	mi := &file_flightpath_action_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHomePositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHomePositionResponse) ProtoMessage() {}
+
+func (x *SetHomePositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_action_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHomePositionResponse.ProtoReflect.Descriptor instead.
+func (*SetHomePositionResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_action_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetHomePositionResponse) GetCommandAck() *CommandAck {
+	if x != nil {
+		return x.CommandAck
+	}
+	return nil
+}
+
+var File_flightpath_action_proto protoreflect.FileDescriptor
+
+const file_flightpath_action_proto_rawDesc = "" +
+	"\n" +
+	"\x17flightpath/action.proto\x12\n" +
+	"flightpath\x1a\x1bflightpath/connection.proto\x1a\x1aflightpath/telemetry.proto\"L\n" +
+	"\n" +
+	"ArmRequest\x12\x1b\n" +
+	"\tsystem_id\x18\x01 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\rR\vcomponentId\"\r\n" +
+	"\vArmResponse\"e\n" +
+	"\rDisarmRequest\x12\x1b\n" +
+	"\tsystem_id\x18\x01 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\rR\vcomponentId\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"\x10\n" +
+	"\x0eDisarmResponse\"l\n" +
+	"\x0eTakeoffRequest\x12\x1b\n" +
+	"\tsystem_id\x18\x01 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\rR\vcomponentId\x12\x1a\n" +
+	"\baltitude\x18\x03 \x01(\x02R\baltitude\"\x90\x01\n" +
+	"\x0fTakeoffResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12-\n" +
+	"\x05phase\x18\x02 \x01(\x0e2\x17.flightpath.FlightPhaseR\x05phase\x12+\n" +
+	"\x11relative_altitude\x18\x03 \x01(\x02R\x10relativeAltitude\"M\n" +
+	"\vLandRequest\x12\x1b\n" +
+	"\tsystem_id\x18\x01 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\rR\vcomponentId\"\x8d\x01\n" +
+	"\fLandResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12-\n" +
+	"\x05phase\x18\x02 \x01(\x0e2\x17.flightpath.FlightPhaseR\x05phase\x12+\n" +
+	"\x11relative_altitude\x18\x03 \x01(\x02R\x10relativeAltitude\"W\n" +
+	"\x15ReturnToLaunchRequest\x12\x1b\n" +
+	"\tsystem_id\x18\x01 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\rR\vcomponentId\"\x97\x01\n" +
+	"\x16ReturnToLaunchResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12-\n" +
+	"\x05phase\x18\x02 \x01(\x0e2\x17.flightpath.FlightPhaseR\x05phase\x12+\n" +
+	"\x11relative_altitude\x18\x03 \x01(\x02R\x10relativeAltitude\"\xb9\x01\n" +
+	"\x14SetFlightModeRequest\x12\x1b\n" +
+	"\tsystem_id\x18\x01 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\rR\vcomponentId\x121\n" +
+	"\tmain_mode\x18\x03 \x01(\x0e2\x14.flightpath.MainModeR\bmainMode\x12.\n" +
+	"\bsub_mode\x18\x04 \x01(\x0e2\x13.flightpath.SubModeR\asubMode\"P\n" +
+	"\x15SetFlightModeResponse\x127\n" +
+	"\vcustom_mode\x18\x01 \x01(\v2\x16.flightpath.CustomModeR\n" +
+	"customMode\"\x9a\x02\n" +
+	"\x13GotoLocationRequest\x12\x1b\n" +
+	"\tsystem_id\x18\x01 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\rR\vcomponentId\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\baltitude\x18\x05 \x01(\x02R\baltitude\x12!\n" +
+	"\fground_speed\x18\x06 \x01(\x02R\vgroundSpeed\x12\x15\n" +
+	"\x03yaw\x18\a \x01(\x02H\x00R\x03yaw\x88\x01\x01\x12+\n" +
+	"\x11acceptance_radius\x18\b \x01(\x02R\x10acceptanceRadiusB\x06\n" +
+	"\x04_yaw\"\x84\x02\n" +
+	"\x14GotoLocationResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\x02R\bdistance\x12/\n" +
+	"\x13horizontal_distance\x18\x03 \x01(\x02R\x12horizontalDistance\x12+\n" +
+	"\x11vertical_distance\x18\x04 \x01(\x02R\x10verticalDistance\x12#\n" +
+	"\rclosing_speed\x18\x05 \x01(\x02R\fclosingSpeed\x12\x10\n" +
+	"\x03eta\x18\x06 \x01(\x02R\x03eta\x12\x18\n" +
+	"\aarrived\x18\a \x01(\bR\aarrived\"\xf3\x02\n" +
+	"\x0eDoOrbitRequest\x12\x1b\n" +
+	"\tsystem_id\x18\x01 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\rR\vcomponentId\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x01R\tlongitude\x12\x1f\n" +
+	"\baltitude\x18\x05 \x01(\x02H\x00R\baltitude\x88\x01\x01\x12\x16\n" +
+	"\x06radius\x18\x06 \x01(\x02R\x06radius\x12+\n" +
+	"\x11counter_clockwise\x18\a \x01(\bR\x10counterClockwise\x12\x1a\n" +
+	"\bvelocity\x18\b \x01(\x02R\bvelocity\x12B\n" +
+	"\ryaw_behaviour\x18\t \x01(\x0e2\x1d.flightpath.OrbitYawBehaviourR\fyawBehaviour\x12\x14\n" +
+	"\x05turns\x18\n" +
+	" \x01(\x02R\x05turnsB\v\n" +
+	"\t_altitude\"\x11\n" +
+	"\x0fDoOrbitResponse\"X\n" +
+	"\x16RebootAutopilotRequest\x12\x1b\n" +
+	"\tsystem_id\x18\x01 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\rR\vcomponentId\"s\n" +
+	"\x17RebootAutopilotResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x125\n" +
+	"\x05phase\x18\x02 \x01(\x0e2\x1f.flightpath.RebootShutdownPhaseR\x05phase\"Z\n" +
+	"\x18ShutdownAutopilotRequest\x12\x1b\n" +
+	"\tsystem_id\x18\x01 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\rR\vcomponentId\"u\n" +
+	"\x19ShutdownAutopilotResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x125\n" +
+	"\x05phase\x18\x02 \x01(\x0e2\x1f.flightpath.RebootShutdownPhaseR\x05phase\"\x86\x03\n" +
+	"\x12SendCommandRequest\x12\x1b\n" +
+	"\tsystem_id\x18\x01 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\rR\vcomponentId\x12\x18\n" +
+	"\acommand\x18\x03 \x01(\rR\acommand\x12\x16\n" +
+	"\x06param1\x18\x04 \x01(\x02R\x06param1\x12\x16\n" +
+	"\x06param2\x18\x05 \x01(\x02R\x06param2\x12\x16\n" +
+	"\x06param3\x18\x06 \x01(\x02R\x06param3\x12\x16\n" +
+	"\x06param4\x18\a \x01(\x02R\x06param4\x12\x16\n" +
+	"\x06param5\x18\b \x01(\x02R\x06param5\x12\x16\n" +
+	"\x06param6\x18\t \x01(\x02R\x06param6\x12\x16\n" +
+	"\x06param7\x18\n" +
+	" \x01(\x02R\x06param7\x12&\n" +
+	"\x0fuse_command_int\x18\v \x01(\bR\ruseCommandInt\x12*\n" +
+	"\x05frame\x18\f \x01(\x0e2\x14.flightpath.MavFrameR\x05frame\x12\f\n" +
+	"\x01x\x18\r \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x0e \x01(\x05R\x01y\"N\n" +
+	"\x13SendCommandResponse\x127\n" +
+	"\vcommand_ack\x18\x01 \x01(\v2\x16.flightpath.CommandAckR\n" +
+	"commandAck\"\xe6\x01\n" +
+	"\n" +
+	"CommandAck\x12\x18\n" +
+	"\acommand\x18\x01 \x01(\rR\acommand\x12-\n" +
+	"\x06result\x18\x02 \x01(\x0e2\x15.flightpath.MavResultR\x06result\x12\x1a\n" +
+	"\bprogress\x18\x03 \x01(\rR\bprogress\x12#\n" +
+	"\rresult_param2\x18\x04 \x01(\x05R\fresultParam2\x12#\n" +
+	"\rtarget_system\x18\x05 \x01(\rR\ftargetSystem\x12)\n" +
+	"\x10target_component\x18\x06 \x01(\rR\x0ftargetComponent\"\xd4\x01\n" +
+	"\x12ChangeSpeedRequest\x12\x1b\n" +
+	"\tsystem_id\x18\x01 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\rR\vcomponentId\x124\n" +
+	"\n" +
+	"speed_type\x18\x03 \x01(\x0e2\x15.flightpath.SpeedTypeR\tspeedType\x12\x14\n" +
+	"\x05speed\x18\x04 \x01(\x02R\x05speed\x122\n" +
+	"\x15wait_for_confirmation\x18\x05 \x01(\bR\x13waitForConfirmation\"\x93\x01\n" +
+	"\x13ChangeSpeedResponse\x127\n" +
+	"\vcommand_ack\x18\x01 \x01(\v2\x16.flightpath.CommandAckR\n" +
+	"commandAck\x12\x1c\n" +
+	"\tconfirmed\x18\x02 \x01(\bR\tconfirmed\x12%\n" +
+	"\x0eobserved_speed\x18\x03 \x01(\x02R\robservedSpeed\"\x92\x02\n" +
+	"\rSetYawRequest\x12\x1b\n" +
+	"\tsystem_id\x18\x01 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\rR\vcomponentId\x12\x14\n" +
+	"\x05angle\x18\x03 \x01(\x02R\x05angle\x12#\n" +
+	"\rangular_speed\x18\x04 \x01(\x02R\fangularSpeed\x126\n" +
+	"\tdirection\x18\x05 \x01(\x0e2\x18.flightpath.YawDirectionR\tdirection\x12\x1a\n" +
+	"\brelative\x18\x06 \x01(\bR\brelative\x122\n" +
+	"\x15wait_for_confirmation\x18\a \x01(\bR\x13waitForConfirmation\"\x92\x01\n" +
+	"\x0eSetYawResponse\x127\n" +
+	"\vcommand_ack\x18\x01 \x01(\v2\x16.flightpath.CommandAckR\n" +
+	"commandAck\x12\x1c\n" +
+	"\tconfirmed\x18\x02 \x01(\bR\tconfirmed\x12)\n" +
+	"\x10observed_heading\x18\x03 \x01(\x02R\x0fobservedHeading\"\xd7\x01\n" +
+	"\x15SetRoiLocationRequest\x12\x1b\n" +
+	"\tsystem_id\x18\x01 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\rR\vcomponentId\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\baltitude\x18\x05 \x01(\x02R\baltitude\x12(\n" +
+	"\x10gimbal_device_id\x18\x06 \x01(\rR\x0egimbalDeviceId\"Q\n" +
+	"\x16SetRoiLocationResponse\x127\n" +
+	"\vcommand_ack\x18\x01 \x01(\v2\x16.flightpath.CommandAckR\n" +
+	"commandAck\"{\n" +
+	"\x0fClearRoiRequest\x12\x1b\n" +
+	"\tsystem_id\x18\x01 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\rR\vcomponentId\x12(\n" +
+	"\x10gimbal_device_id\x18\x03 \x01(\rR\x0egimbalDeviceId\"K\n" +
+	"\x10ClearRoiResponse\x127\n" +
+	"\vcommand_ack\x18\x01 \x01(\v2\x16.flightpath.CommandAckR\n" +
+	"commandAck\"\xe0\x01\n" +
+	"\x16SetHomePositionRequest\x12\x1b\n" +
+	"\tsystem_id\x18\x01 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\rR\vcomponentId\x120\n" +
+	"\x14use_current_location\x18\x03 \x01(\bR\x12useCurrentLocation\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\baltitude\x18\x06 \x01(\x02R\baltitude\"R\n" +
+	"\x17SetHomePositionResponse\x127\n" +
+	"\vcommand_ack\x18\x01 \x01(\v2\x16.flightpath.CommandAckR\n" +
+	"commandAck*\xc1\x02\n" +
+	"\x11OrbitYawBehaviour\x12#\n" +
+	"\x1fORBIT_YAW_BEHAVIOUR_UNSPECIFIED\x10\x00\x123\n" +
+	"/ORBIT_YAW_BEHAVIOUR_HOLD_FRONT_TO_CIRCLE_CENTER\x10\x01\x12,\n" +
+	"(ORBIT_YAW_BEHAVIOUR_HOLD_INITIAL_HEADING\x10\x02\x12$\n" +
+	" ORBIT_YAW_BEHAVIOUR_UNCONTROLLED\x10\x03\x124\n" +
+	"0ORBIT_YAW_BEHAVIOUR_HOLD_FRONT_TANGENT_TO_CIRCLE\x10\x04\x12%\n" +
+	"!ORBIT_YAW_BEHAVIOUR_RC_CONTROLLED\x10\x05\x12!\n" +
+	"\x1dORBIT_YAW_BEHAVIOUR_UNCHANGED\x10\x06*\xb8\x01\n" +
+	"\x13RebootShutdownPhase\x12%\n" +
+	"!REBOOT_SHUTDOWN_PHASE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eREBOOT_SHUTDOWN_PHASE_ACCEPTED\x10\x01\x12(\n" +
+	"$REBOOT_SHUTDOWN_PHASE_HEARTBEAT_LOST\x10\x02\x12,\n" +
+	"(REBOOT_SHUTDOWN_PHASE_HEARTBEAT_RESTORED\x10\x03*\xf5\x02\n" +
+	"\tMavResult\x12\x1a\n" +
+	"\x16MAV_RESULT_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13MAV_RESULT_ACCEPTED\x10\x01\x12#\n" +
+	"\x1fMAV_RESULT_TEMPORARILY_REJECTED\x10\x02\x12\x15\n" +
+	"\x11MAV_RESULT_DENIED\x10\x03\x12\x1a\n" +
+	"\x16MAV_RESULT_UNSUPPORTED\x10\x04\x12\x15\n" +
+	"\x11MAV_RESULT_FAILED\x10\x05\x12\x1a\n" +
+	"\x16MAV_RESULT_IN_PROGRESS\x10\x06\x12\x18\n" +
+	"\x14MAV_RESULT_CANCELLED\x10\a\x12 \n" +
+	"\x1cMAV_RESULT_COMMAND_LONG_ONLY\x10\b\x12\x1f\n" +
+	"\x1bMAV_RESULT_COMMAND_INT_ONLY\x10\t\x12,\n" +
+	"(MAV_RESULT_COMMAND_UNSUPPORTED_MAV_FRAME\x10\n" +
+	"\x12\x1d\n" +
+	"\x19MAV_RESULT_NOT_IN_CONTROL\x10\v*\x96\x01\n" +
+	"\tSpeedType\x12\x1a\n" +
+	"\x16SPEED_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SPEED_TYPE_AIRSPEED\x10\x01\x12\x1a\n" +
+	"\x16SPEED_TYPE_GROUNDSPEED\x10\x02\x12\x1a\n" +
+	"\x16SPEED_TYPE_CLIMB_SPEED\x10\x03\x12\x1c\n" +
+	"\x18SPEED_TYPE_DESCENT_SPEED\x10\x04*o\n" +
+	"\fYawDirection\x12\x1d\n" +
+	"\x19YAW_DIRECTION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17YAW_DIRECTION_CLOCKWISE\x10\x01\x12#\n" +
+	"\x1fYAW_DIRECTION_COUNTER_CLOCKWISE\x10\x02*\xe9\x01\n" +
+	"\vFlightPhase\x12\x1c\n" +
+	"\x18FLIGHT_PHASE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15FLIGHT_PHASE_ACCEPTED\x10\x01\x12\x19\n" +
+	"\x15FLIGHT_PHASE_CLIMBING\x10\x02\x12\x19\n" +
+	"\x15FLIGHT_PHASE_HOVERING\x10\x03\x12\x1a\n" +
+	"\x16FLIGHT_PHASE_RETURNING\x10\x04\x12\x1b\n" +
+	"\x17FLIGHT_PHASE_DESCENDING\x10\x05\x12\x17\n" +
+	"\x13FLIGHT_PHASE_LANDED\x10\x06\x12\x19\n" +
+	"\x15FLIGHT_PHASE_DISARMED\x10\a2\xf4\t\n" +
+	"\rActionService\x126\n" +
+	"\x03Arm\x12\x16.flightpath.ArmRequest\x1a\x17.flightpath.ArmResponse\x12?\n" +
+	"\x06Disarm\x12\x19.flightpath.DisarmRequest\x1a\x1a.flightpath.DisarmResponse\x12D\n" +
+	"\aTakeoff\x12\x1a.flightpath.TakeoffRequest\x1a\x1b.flightpath.TakeoffResponse0\x01\x12;\n" +
+	"\x04Land\x12\x17.flightpath.LandRequest\x1a\x18.flightpath.LandResponse0\x01\x12Y\n" +
+	"\x0eReturnToLaunch\x12!.flightpath.ReturnToLaunchRequest\x1a\".flightpath.ReturnToLaunchResponse0\x01\x12T\n" +
+	"\rSetFlightMode\x12 .flightpath.SetFlightModeRequest\x1a!.flightpath.SetFlightModeResponse\x12S\n" +
+	"\fGotoLocation\x12\x1f.flightpath.GotoLocationRequest\x1a .flightpath.GotoLocationResponse0\x01\x12B\n" +
+	"\aDoOrbit\x12\x1a.flightpath.DoOrbitRequest\x1a\x1b.flightpath.DoOrbitResponse\x12\\\n" +
+	"\x0fRebootAutopilot\x12\".flightpath.RebootAutopilotRequest\x1a#.flightpath.RebootAutopilotResponse0\x01\x12b\n" +
+	"\x11ShutdownAutopilot\x12$.flightpath.ShutdownAutopilotRequest\x1a%.flightpath.ShutdownAutopilotResponse0\x01\x12N\n" +
+	"\vSendCommand\x12\x1e.flightpath.SendCommandRequest\x1a\x1f.flightpath.SendCommandResponse\x12N\n" +
+	"\vChangeSpeed\x12\x1e.flightpath.ChangeSpeedRequest\x1a\x1f.flightpath.ChangeSpeedResponse\x12?\n" +
+	"\x06SetYaw\x12\x19.flightpath.SetYawRequest\x1a\x1a.flightpath.SetYawResponse\x12W\n" +
+	"\x0eSetRoiLocation\x12!.flightpath.SetRoiLocationRequest\x1a\".flightpath.SetRoiLocationResponse\x12E\n" +
+	"\bClearRoi\x12\x1b.flightpath.ClearRoiRequest\x1a\x1c.flightpath.ClearRoiResponse\x12Z\n" +
+	"\x0fSetHomePosition\x12\".flightpath.SetHomePositionRequest\x1a#.flightpath.SetHomePositionResponseB\x9d\x01\n" +
+	"\x0ecom.flightpathB\vActionProtoP\x01Z6github.com/flightpath-dev/flightpath/gen/go/flightpath\xa2\x02\x03FXX\xaa\x02\n" +
+	"Flightpath\xca\x02\n" +
+	"Flightpath\xe2\x02\x16Flightpath\\GPBMetadata\xea\x02\n" +
+	"Flightpathb\x06proto3"
+
+var (
+	file_flightpath_action_proto_rawDescOnce sync.Once
+	file_flightpath_action_proto_rawDescData []byte
+)
+
+func file_flightpath_action_proto_rawDescGZIP() []byte {
+	file_flightpath_action_proto_rawDescOnce.Do(func() {
+		file_flightpath_action_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_flightpath_action_proto_rawDesc), len(file_flightpath_action_proto_rawDesc)))
+	})
+	return file_flightpath_action_proto_rawDescData
+}
+
+var file_flightpath_action_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_flightpath_action_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_flightpath_action_proto_goTypes = []any{
+	(OrbitYawBehaviour)(0),            // 0: flightpath.OrbitYawBehaviour
+	(RebootShutdownPhase)(0),          // 1: flightpath.RebootShutdownPhase
+	(MavResult)(0),                    // 2: flightpath.MavResult
+	(SpeedType)(0),                    // 3: flightpath.SpeedType
+	(YawDirection)(0),                 // 4: flightpath.YawDirection
+	(FlightPhase)(0),                  // 5: flightpath.FlightPhase
+	(*ArmRequest)(nil),                // 6: flightpath.ArmRequest
+	(*ArmResponse)(nil),               // 7: flightpath.ArmResponse
+	(*DisarmRequest)(nil),             // 8: flightpath.DisarmRequest
+	(*DisarmResponse)(nil),            // 9: flightpath.DisarmResponse
+	(*TakeoffRequest)(nil),            // 10: flightpath.TakeoffRequest
+	(*TakeoffResponse)(nil),           // 11: flightpath.TakeoffResponse
+	(*LandRequest)(nil),               // 12: flightpath.LandRequest
+	(*LandResponse)(nil),              // 13: flightpath.LandResponse
+	(*ReturnToLaunchRequest)(nil),     // 14: flightpath.ReturnToLaunchRequest
+	(*ReturnToLaunchResponse)(nil),    // 15: flightpath.ReturnToLaunchResponse
+	(*SetFlightModeRequest)(nil),      // 16: flightpath.SetFlightModeRequest
+	(*SetFlightModeResponse)(nil),     // 17: flightpath.SetFlightModeResponse
+	(*GotoLocationRequest)(nil),       // 18: flightpath.GotoLocationRequest
+	(*GotoLocationResponse)(nil),      // 19: flightpath.GotoLocationResponse
+	(*DoOrbitRequest)(nil),            // 20: flightpath.DoOrbitRequest
+	(*DoOrbitResponse)(nil),           // 21: flightpath.DoOrbitResponse
+	(*RebootAutopilotRequest)(nil),    // 22: flightpath.RebootAutopilotRequest
+	(*RebootAutopilotResponse)(nil),   // 23: flightpath.RebootAutopilotResponse
+	(*ShutdownAutopilotRequest)(nil),  // 24: flightpath.ShutdownAutopilotRequest
+	(*ShutdownAutopilotResponse)(nil), // 25: flightpath.ShutdownAutopilotResponse
+	(*SendCommandRequest)(nil),        // 26: flightpath.SendCommandRequest
+	(*SendCommandResponse)(nil),       // 27: flightpath.SendCommandResponse
+	(*CommandAck)(nil),                // 28: flightpath.CommandAck
+	(*ChangeSpeedRequest)(nil),        // 29: flightpath.ChangeSpeedRequest
+	(*ChangeSpeedResponse)(nil),       // 30: flightpath.ChangeSpeedResponse
+	(*SetYawRequest)(nil),             // 31: flightpath.SetYawRequest
+	(*SetYawResponse)(nil),            // 32: flightpath.SetYawResponse
+	(*SetRoiLocationRequest)(nil),     // 33: flightpath.SetRoiLocationRequest
+	(*SetRoiLocationResponse)(nil),    // 34: flightpath.SetRoiLocationResponse
+	(*ClearRoiRequest)(nil),           // 35: flightpath.ClearRoiRequest
+	(*ClearRoiResponse)(nil),          // 36: flightpath.ClearRoiResponse
+	(*SetHomePositionRequest)(nil),    // 37: flightpath.SetHomePositionRequest
+	(*SetHomePositionResponse)(nil),   // 38: flightpath.SetHomePositionResponse
+	(MainMode)(0),                     // 39: flightpath.MainMode
+	(SubMode)(0),                      // 40: flightpath.SubMode
+	(*CustomMode)(nil),                // 41: flightpath.CustomMode
+	(MavFrame)(0),                     // 42: flightpath.MavFrame
+}
+var file_flightpath_action_proto_depIdxs = []int32{
+	5,  // 0: flightpath.TakeoffResponse.phase:type_name -> flightpath.FlightPhase
+	5,  // 1: flightpath.LandResponse.phase:type_name -> flightpath.FlightPhase
+	5,  // 2: flightpath.ReturnToLaunchResponse.phase:type_name -> flightpath.FlightPhase
+	39, // 3: flightpath.SetFlightModeRequest.main_mode:type_name -> flightpath.MainMode
+	40, // 4: flightpath.SetFlightModeRequest.sub_mode:type_name -> flightpath.SubMode
+	41, // 5: flightpath.SetFlightModeResponse.custom_mode:type_name -> flightpath.CustomMode
+	0,  // 6: flightpath.DoOrbitRequest.yaw_behaviour:type_name -> flightpath.OrbitYawBehaviour
+	1,  // 7: flightpath.RebootAutopilotResponse.phase:type_name -> flightpath.RebootShutdownPhase
+	1,  // 8: flightpath.ShutdownAutopilotResponse.phase:type_name -> flightpath.RebootShutdownPhase
+	42, // 9: flightpath.SendCommandRequest.frame:type_name -> flightpath.MavFrame
+	28, // 10: flightpath.SendCommandResponse.command_ack:type_name -> flightpath.CommandAck
+	2,  // 11: flightpath.CommandAck.result:type_name -> flightpath.MavResult
+	3,  // 12: flightpath.ChangeSpeedRequest.speed_type:type_name -> flightpath.SpeedType
+	28, // 13: flightpath.ChangeSpeedResponse.command_ack:type_name -> flightpath.CommandAck
+	4,  // 14: flightpath.SetYawRequest.direction:type_name -> flightpath.YawDirection
+	28, // 15: flightpath.SetYawResponse.command_ack:type_name -> flightpath.CommandAck
+	28, // 16: flightpath.SetRoiLocationResponse.command_ack:type_name -> flightpath.CommandAck
+	28, // 17: flightpath.ClearRoiResponse.command_ack:type_name -> flightpath.CommandAck
+	28, // 18: flightpath.SetHomePositionResponse.command_ack:type_name -> flightpath.CommandAck
+	6,  // 19: flightpath.ActionService.Arm:input_type -> flightpath.ArmRequest
+	8,  // 20: flightpath.ActionService.Disarm:input_type -> flightpath.DisarmRequest
+	10, // 21: flightpath.ActionService.Takeoff:input_type -> flightpath.TakeoffRequest
+	12, // 22: flightpath.ActionService.Land:input_type -> flightpath.LandRequest
+	14, // 23: flightpath.ActionService.ReturnToLaunch:input_type -> flightpath.ReturnToLaunchRequest
+	16, // 24: flightpath.ActionService.SetFlightMode:input_type -> flightpath.SetFlightModeRequest
+	18, // 25: flightpath.ActionService.GotoLocation:input_type -> flightpath.GotoLocationRequest
+	20, // 26: flightpath.ActionService.DoOrbit:input_type -> flightpath.DoOrbitRequest
+	22, // 27: flightpath.ActionService.RebootAutopilot:input_type -> flightpath.RebootAutopilotRequest
+	24, // 28: flightpath.ActionService.ShutdownAutopilot:input_type -> flightpath.ShutdownAutopilotRequest
+	26, // 29: flightpath.ActionService.SendCommand:input_type -> flightpath.SendCommandRequest
+	29, // 30: flightpath.ActionService.ChangeSpeed:input_type -> flightpath.ChangeSpeedRequest
+	31, // 31: flightpath.ActionService.SetYaw:input_type -> flightpath.SetYawRequest
+	33, // 32: flightpath.ActionService.SetRoiLocation:input_type -> flightpath.SetRoiLocationRequest
+	35, // 33: flightpath.ActionService.ClearRoi:input_type -> flightpath.ClearRoiRequest
+	37, // 34: flightpath.ActionService.SetHomePosition:input_type -> flightpath.SetHomePositionRequest
+	7,  // 35: flightpath.ActionService.Arm:output_type -> flightpath.ArmResponse
+	9,  // 36: flightpath.ActionService.Disarm:output_type -> flightpath.DisarmResponse
+	11, // 37: flightpath.ActionService.Takeoff:output_type -> flightpath.TakeoffResponse
+	13, // 38: flightpath.ActionService.Land:output_type -> flightpath.LandResponse
+	15, // 39: flightpath.ActionService.ReturnToLaunch:output_type -> flightpath.ReturnToLaunchResponse
+	17, // 40: flightpath.ActionService.SetFlightMode:output_type -> flightpath.SetFlightModeResponse
+	19, // 41: flightpath.ActionService.GotoLocation:output_type -> flightpath.GotoLocationResponse
+	21, // 42: flightpath.ActionService.DoOrbit:output_type -> flightpath.DoOrbitResponse
+	23, // 43: flightpath.ActionService.RebootAutopilot:output_type -> flightpath.RebootAutopilotResponse
+	25, // 44: flightpath.ActionService.ShutdownAutopilot:output_type -> flightpath.ShutdownAutopilotResponse
+	27, // 45: flightpath.ActionService.SendCommand:output_type -> flightpath.SendCommandResponse
+	30, // 46: flightpath.ActionService.ChangeSpeed:output_type -> flightpath.ChangeSpeedResponse
+	32, // 47: flightpath.ActionService.SetYaw:output_type -> flightpath.SetYawResponse
+	34, // 48: flightpath.ActionService.SetRoiLocation:output_type -> flightpath.SetRoiLocationResponse
+	36, // 49: flightpath.ActionService.ClearRoi:output_type -> flightpath.ClearRoiResponse
+	38, // 50: flightpath.ActionService.SetHomePosition:output_type -> flightpath.SetHomePositionResponse
+	35, // [35:51] is the sub-list for method output_type
+	19, // [19:35] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_flightpath_action_proto_init() }
+func file_flightpath_action_proto_init() {
+	if File_flightpath_action_proto != nil {
+		return
+	}
+	file_flightpath_connection_proto_init()
+	file_flightpath_telemetry_proto_init()
+	file_flightpath_action_proto_msgTypes[12].OneofWrappers = []any{}
+	file_flightpath_action_proto_msgTypes[14].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_flightpath_action_proto_rawDesc), len(file_flightpath_action_proto_rawDesc)),
+			NumEnums:      6,
+			NumMessages:   33,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_flightpath_action_proto_goTypes,
+		DependencyIndexes: file_flightpath_action_proto_depIdxs,
+		EnumInfos:         file_flightpath_action_proto_enumTypes,
+		MessageInfos:      file_flightpath_action_proto_msgTypes,
+	}.Build()
+	File_flightpath_action_proto = out.File
+	file_flightpath_action_proto_goTypes = nil
+	file_flightpath_action_proto_depIdxs = nil
+}
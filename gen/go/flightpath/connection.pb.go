@@ -877,12 +877,16 @@ func (x *BaseMode) GetSafetyArmed() bool {
 	return false
 }
 
-// CustomMode represents flight mode as platform-agnostic abstractions
+// CustomMode represents flight mode as platform-agnostic abstractions.
+// PX4 custom_mode is decoded into its main mode and the sub mode of that main mode
+// (e.g. AUTO/AUTO_TAKEOFF, POSCTL/POSCTL_ORBIT). ArduPilot (Copter and Plane) mode numbers are
+// mapped to the equivalent main/sub mode. Modes of other autopilots, and ArduPilot modes without
+// an equivalent, are reported as unspecified.
 type CustomMode struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Main flight mode
 	MainMode MainMode `protobuf:"varint,1,opt,name=main_mode,json=mainMode,proto3,enum=flightpath.MainMode" json:"main_mode,omitempty"`
-	// Sub mode (context-dependent based on main mode)
+	// Sub mode (context-dependent based on main mode, unspecified if the main mode has none)
 	SubMode       SubMode `protobuf:"varint,2,opt,name=sub_mode,json=subMode,proto3,enum=flightpath.SubMode" json:"sub_mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -0,0 +1,456 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: flightpath/emergency.proto
+
+package flightpath
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EmergencyAction represents the actions of the EmergencyService
+type EmergencyAction int32
+
+const (
+	EmergencyAction_EMERGENCY_ACTION_UNSPECIFIED EmergencyAction = 0
+	// Kill the motors (see Kill)
+	EmergencyAction_EMERGENCY_ACTION_KILL EmergencyAction = 1
+	// Terminate the flight (see FlightTermination)
+	EmergencyAction_EMERGENCY_ACTION_FLIGHT_TERMINATION EmergencyAction = 2
+)
+
+// Enum value maps for EmergencyAction.
+var (
+	EmergencyAction_name = map[int32]string{
+		0: "EMERGENCY_ACTION_UNSPECIFIED",
+		1: "EMERGENCY_ACTION_KILL",
+		2: "EMERGENCY_ACTION_FLIGHT_TERMINATION",
+	}
+	EmergencyAction_value = map[string]int32{
+		"EMERGENCY_ACTION_UNSPECIFIED":        0,
+		"EMERGENCY_ACTION_KILL":               1,
+		"EMERGENCY_ACTION_FLIGHT_TERMINATION": 2,
+	}
+)
+
+func (x EmergencyAction) Enum() *EmergencyAction {
+	p := new(EmergencyAction)
+	*p = x
+	return p
+}
+
+func (x EmergencyAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmergencyAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_emergency_proto_enumTypes[0].Descriptor()
+}
+
+func (EmergencyAction) Type() protoreflect.EnumType {
+	return &file_flightpath_emergency_proto_enumTypes[0]
+}
+
+func (x EmergencyAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmergencyAction.Descriptor instead.
+func (EmergencyAction) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_emergency_proto_rawDescGZIP(), []int{0}
+}
+
+// RequestConfirmationRequest is the request message for RequestConfirmation
+type RequestConfirmationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Emergency action to confirm
+	Action EmergencyAction `protobuf:"varint,1,opt,name=action,proto3,enum=flightpath.EmergencyAction" json:"action,omitempty"`
+	// System ID of the drone. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId   uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestConfirmationRequest) Reset() {
+	*x = RequestConfirmationRequest{}
+	mi := &file_flightpath_emergency_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestConfirmationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestConfirmationRequest) ProtoMessage() {}
+
+func (x *RequestConfirmationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_emergency_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestConfirmationRequest.ProtoReflect.Descriptor instead.
+func (*RequestConfirmationRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_emergency_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RequestConfirmationRequest) GetAction() EmergencyAction {
+	if x != nil {
+		return x.Action
+	}
+	return EmergencyAction_EMERGENCY_ACTION_UNSPECIFIED
+}
+
+func (x *RequestConfirmationRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *RequestConfirmationRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+// RequestConfirmationResponse is the response message for RequestConfirmation
+type RequestConfirmationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Confirmation token to present to the action RPC
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Time after which the token is no longer accepted (milliseconds since Unix epoch)
+	ExpiresAtMs   int64 `protobuf:"varint,2,opt,name=expires_at_ms,json=expiresAtMs,proto3" json:"expires_at_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestConfirmationResponse) Reset() {
+	*x = RequestConfirmationResponse{}
+	mi := &file_flightpath_emergency_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestConfirmationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestConfirmationResponse) ProtoMessage() {}
+
+func (x *RequestConfirmationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_emergency_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestConfirmationResponse.ProtoReflect.Descriptor instead.
+func (*RequestConfirmationResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_emergency_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RequestConfirmationResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RequestConfirmationResponse) GetExpiresAtMs() int64 {
+	if x != nil {
+		return x.ExpiresAtMs
+	}
+	return 0
+}
+
+// KillRequest is the request message for Kill
+type KillRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Confirmation token obtained from RequestConfirmation (EMERGENCY_ACTION_KILL).
+	// The drone targeted is the one the token was requested for.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KillRequest) Reset() {
+	*x = KillRequest{}
+	mi := &file_flightpath_emergency_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillRequest) ProtoMessage() {}
+
+func (x *KillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_emergency_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillRequest.ProtoReflect.Descriptor instead.
+func (*KillRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_emergency_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KillRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// KillResponse is the response message for Kill
+type KillResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KillResponse) Reset() {
+	*x = KillResponse{}
+	mi := &file_flightpath_emergency_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillResponse) ProtoMessage() {}
+
+func (x *KillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_emergency_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillResponse.ProtoReflect.Descriptor instead.
+func (*KillResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_emergency_proto_rawDescGZIP(), []int{3}
+}
+
+// FlightTerminationRequest is the request message for FlightTermination
+type FlightTerminationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Confirmation token obtained from RequestConfirmation (EMERGENCY_ACTION_FLIGHT_TERMINATION).
+	// The drone targeted is the one the token was requested for.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlightTerminationRequest) Reset() {
+	*x = FlightTerminationRequest{}
+	mi := &file_flightpath_emergency_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlightTerminationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightTerminationRequest) ProtoMessage() {}
+
+func (x *FlightTerminationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_emergency_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightTerminationRequest.ProtoReflect.Descriptor instead.
+func (*FlightTerminationRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_emergency_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FlightTerminationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// FlightTerminationResponse is the response message for FlightTermination
+type FlightTerminationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlightTerminationResponse) Reset() {
+	*x = FlightTerminationResponse{}
+	mi := &file_flightpath_emergency_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlightTerminationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightTerminationResponse) ProtoMessage() {}
+
+func (x *FlightTerminationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_emergency_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightTerminationResponse.ProtoReflect.Descriptor instead.
+func (*FlightTerminationResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_emergency_proto_rawDescGZIP(), []int{5}
+}
+
+var File_flightpath_emergency_proto protoreflect.FileDescriptor
+
+const file_flightpath_emergency_proto_rawDesc = "" +
+	"\n" +
+	"\x1aflightpath/emergency.proto\x12\n" +
+	"flightpath\"\x91\x01\n" +
+	"\x1aRequestConfirmationRequest\x123\n" +
+	"\x06action\x18\x01 \x01(\x0e2\x1b.flightpath.EmergencyActionR\x06action\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\"W\n" +
+	"\x1bRequestConfirmationResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\rexpires_at_ms\x18\x02 \x01(\x03R\vexpiresAtMs\"#\n" +
+	"\vKillRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x0e\n" +
+	"\fKillResponse\"0\n" +
+	"\x18FlightTerminationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x1b\n" +
+	"\x19FlightTerminationResponse*w\n" +
+	"\x0fEmergencyAction\x12 \n" +
+	"\x1cEMERGENCY_ACTION_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15EMERGENCY_ACTION_KILL\x10\x01\x12'\n" +
+	"#EMERGENCY_ACTION_FLIGHT_TERMINATION\x10\x022\x97\x02\n" +
+	"\x10EmergencyService\x12f\n" +
+	"\x13RequestConfirmation\x12&.flightpath.RequestConfirmationRequest\x1a'.flightpath.RequestConfirmationResponse\x129\n" +
+	"\x04Kill\x12\x17.flightpath.KillRequest\x1a\x18.flightpath.KillResponse\x12`\n" +
+	"\x11FlightTermination\x12$.flightpath.FlightTerminationRequest\x1a%.flightpath.FlightTerminationResponseB\xa0\x01\n" +
+	"\x0ecom.flightpathB\x0eEmergencyProtoP\x01Z6github.com/flightpath-dev/flightpath/gen/go/flightpath\xa2\x02\x03FXX\xaa\x02\n" +
+	"Flightpath\xca\x02\n" +
+	"Flightpath\xe2\x02\x16Flightpath\\GPBMetadata\xea\x02\n" +
+	"Flightpathb\x06proto3"
+
+var (
+	file_flightpath_emergency_proto_rawDescOnce sync.Once
+	file_flightpath_emergency_proto_rawDescData []byte
+)
+
+func file_flightpath_emergency_proto_rawDescGZIP() []byte {
+	file_flightpath_emergency_proto_rawDescOnce.Do(func() {
+		file_flightpath_emergency_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_flightpath_emergency_proto_rawDesc), len(file_flightpath_emergency_proto_rawDesc)))
+	})
+	return file_flightpath_emergency_proto_rawDescData
+}
+
+var file_flightpath_emergency_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_flightpath_emergency_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_flightpath_emergency_proto_goTypes = []any{
+	(EmergencyAction)(0),                // 0: flightpath.EmergencyAction
+	(*RequestConfirmationRequest)(nil),  // 1: flightpath.RequestConfirmationRequest
+	(*RequestConfirmationResponse)(nil), // 2: flightpath.RequestConfirmationResponse
+	(*KillRequest)(nil),                 // 3: flightpath.KillRequest
+	(*KillResponse)(nil),                // 4: flightpath.KillResponse
+	(*FlightTerminationRequest)(nil),    // 5: flightpath.FlightTerminationRequest
+	(*FlightTerminationResponse)(nil),   // 6: flightpath.FlightTerminationResponse
+}
+var file_flightpath_emergency_proto_depIdxs = []int32{
+	0, // 0: flightpath.RequestConfirmationRequest.action:type_name -> flightpath.EmergencyAction
+	1, // 1: flightpath.EmergencyService.RequestConfirmation:input_type -> flightpath.RequestConfirmationRequest
+	3, // 2: flightpath.EmergencyService.Kill:input_type -> flightpath.KillRequest
+	5, // 3: flightpath.EmergencyService.FlightTermination:input_type -> flightpath.FlightTerminationRequest
+	2, // 4: flightpath.EmergencyService.RequestConfirmation:output_type -> flightpath.RequestConfirmationResponse
+	4, // 5: flightpath.EmergencyService.Kill:output_type -> flightpath.KillResponse
+	6, // 6: flightpath.EmergencyService.FlightTermination:output_type -> flightpath.FlightTerminationResponse
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_flightpath_emergency_proto_init() }
+func file_flightpath_emergency_proto_init() {
+	if File_flightpath_emergency_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_flightpath_emergency_proto_rawDesc), len(file_flightpath_emergency_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_flightpath_emergency_proto_goTypes,
+		DependencyIndexes: file_flightpath_emergency_proto_depIdxs,
+		EnumInfos:         file_flightpath_emergency_proto_enumTypes,
+		MessageInfos:      file_flightpath_emergency_proto_msgTypes,
+	}.Build()
+	File_flightpath_emergency_proto = out.File
+	file_flightpath_emergency_proto_goTypes = nil
+	file_flightpath_emergency_proto_depIdxs = nil
+}
@@ -0,0 +1,611 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: flightpath/action.proto
+
+package flightpathconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	flightpath "github.com/flightpath-dev/flightpath/gen/go/flightpath"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ActionServiceName is the fully-qualified name of the ActionService service.
+	ActionServiceName = "flightpath.ActionService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ActionServiceArmProcedure is the fully-qualified name of the ActionService's Arm RPC.
+	ActionServiceArmProcedure = "/flightpath.ActionService/Arm"
+	// ActionServiceDisarmProcedure is the fully-qualified name of the ActionService's Disarm RPC.
+	ActionServiceDisarmProcedure = "/flightpath.ActionService/Disarm"
+	// ActionServiceTakeoffProcedure is the fully-qualified name of the ActionService's Takeoff RPC.
+	ActionServiceTakeoffProcedure = "/flightpath.ActionService/Takeoff"
+	// ActionServiceLandProcedure is the fully-qualified name of the ActionService's Land RPC.
+	ActionServiceLandProcedure = "/flightpath.ActionService/Land"
+	// ActionServiceReturnToLaunchProcedure is the fully-qualified name of the ActionService's
+	// ReturnToLaunch RPC.
+	ActionServiceReturnToLaunchProcedure = "/flightpath.ActionService/ReturnToLaunch"
+	// ActionServiceSetFlightModeProcedure is the fully-qualified name of the ActionService's
+	// SetFlightMode RPC.
+	ActionServiceSetFlightModeProcedure = "/flightpath.ActionService/SetFlightMode"
+	// ActionServiceGotoLocationProcedure is the fully-qualified name of the ActionService's
+	// GotoLocation RPC.
+	ActionServiceGotoLocationProcedure = "/flightpath.ActionService/GotoLocation"
+	// ActionServiceDoOrbitProcedure is the fully-qualified name of the ActionService's DoOrbit RPC.
+	ActionServiceDoOrbitProcedure = "/flightpath.ActionService/DoOrbit"
+	// ActionServiceRebootAutopilotProcedure is the fully-qualified name of the ActionService's
+	// RebootAutopilot RPC.
+	ActionServiceRebootAutopilotProcedure = "/flightpath.ActionService/RebootAutopilot"
+	// ActionServiceShutdownAutopilotProcedure is the fully-qualified name of the ActionService's
+	// ShutdownAutopilot RPC.
+	ActionServiceShutdownAutopilotProcedure = "/flightpath.ActionService/ShutdownAutopilot"
+	// ActionServiceSendCommandProcedure is the fully-qualified name of the ActionService's SendCommand
+	// RPC.
+	ActionServiceSendCommandProcedure = "/flightpath.ActionService/SendCommand"
+	// ActionServiceChangeSpeedProcedure is the fully-qualified name of the ActionService's ChangeSpeed
+	// RPC.
+	ActionServiceChangeSpeedProcedure = "/flightpath.ActionService/ChangeSpeed"
+	// ActionServiceSetYawProcedure is the fully-qualified name of the ActionService's SetYaw RPC.
+	ActionServiceSetYawProcedure = "/flightpath.ActionService/SetYaw"
+	// ActionServiceSetRoiLocationProcedure is the fully-qualified name of the ActionService's
+	// SetRoiLocation RPC.
+	ActionServiceSetRoiLocationProcedure = "/flightpath.ActionService/SetRoiLocation"
+	// ActionServiceClearRoiProcedure is the fully-qualified name of the ActionService's ClearRoi RPC.
+	ActionServiceClearRoiProcedure = "/flightpath.ActionService/ClearRoi"
+	// ActionServiceSetHomePositionProcedure is the fully-qualified name of the ActionService's
+	// SetHomePosition RPC.
+	ActionServiceSetHomePositionProcedure = "/flightpath.ActionService/SetHomePosition"
+)
+
+// ActionServiceClient is a client for the flightpath.ActionService service.
+type ActionServiceClient interface {
+	// Arm the drone (MAV_CMD_COMPONENT_ARM_DISARM with param1 = 1).
+	// Returns once the drone has acknowledged the command with COMMAND_ACK.
+	Arm(context.Context, *connect.Request[flightpath.ArmRequest]) (*connect.Response[flightpath.ArmResponse], error)
+	// Disarm the drone (MAV_CMD_COMPONENT_ARM_DISARM with param1 = 0).
+	// Returns once the drone has acknowledged the command with COMMAND_ACK.
+	Disarm(context.Context, *connect.Request[flightpath.DisarmRequest]) (*connect.Response[flightpath.DisarmResponse], error)
+	// Take off to the requested altitude (MAV_CMD_NAV_TAKEOFF).
+	// Streams flight phase updates until the drone hovers at the takeoff altitude.
+	Takeoff(context.Context, *connect.Request[flightpath.TakeoffRequest]) (*connect.ServerStreamForClient[flightpath.TakeoffResponse], error)
+	// Land at the current position (MAV_CMD_NAV_LAND).
+	// Streams flight phase updates until the drone has landed and disarmed.
+	Land(context.Context, *connect.Request[flightpath.LandRequest]) (*connect.ServerStreamForClient[flightpath.LandResponse], error)
+	// Return to the launch position and land (MAV_CMD_NAV_RETURN_TO_LAUNCH).
+	// Streams flight phase updates until the drone has landed and disarmed
+	// (or hovers above home if the autopilot is configured not to land).
+	ReturnToLaunch(context.Context, *connect.Request[flightpath.ReturnToLaunchRequest]) (*connect.ServerStreamForClient[flightpath.ReturnToLaunchResponse], error)
+	// Change the flight mode (MAV_CMD_DO_SET_MODE).
+	// The mode is encoded into the autopilot's custom_mode (PX4 or ArduPilot).
+	// Returns once a HEARTBEAT from the drone reports the requested mode.
+	SetFlightMode(context.Context, *connect.Request[flightpath.SetFlightModeRequest]) (*connect.Response[flightpath.SetFlightModeResponse], error)
+	// Fly to a WGS84 location (MAV_CMD_DO_REPOSITION sent as COMMAND_INT).
+	// Streams the distance and estimated time to the target until the drone is within the
+	// acceptance radius. Fails if the drone does not switch to guided/hold mode shortly after
+	// accepting the command, or leaves it on the way.
+	GotoLocation(context.Context, *connect.Request[flightpath.GotoLocationRequest]) (*connect.ServerStreamForClient[flightpath.GotoLocationResponse], error)
+	// Start orbiting around a WGS84 location (MAV_CMD_DO_ORBIT sent as COMMAND_INT).
+	// Returns once the drone has acknowledged the command. Use TelemetryService.SubscribeOrbitExecutionStatus
+	// to follow the orbit.
+	DoOrbit(context.Context, *connect.Request[flightpath.DoOrbitRequest]) (*connect.Response[flightpath.DoOrbitResponse], error)
+	// Reboot the autopilot (MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN with param1 = 1). Refused while armed.
+	// Streams updates as the drone's heartbeats disappear and reappear, and completes once the
+	// drone is heard again.
+	RebootAutopilot(context.Context, *connect.Request[flightpath.RebootAutopilotRequest]) (*connect.ServerStreamForClient[flightpath.RebootAutopilotResponse], error)
+	// Shut down the autopilot (MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN with param1 = 2). Refused while armed.
+	// Streams updates until the drone's heartbeats have disappeared.
+	ShutdownAutopilot(context.Context, *connect.Request[flightpath.ShutdownAutopilotRequest]) (*connect.ServerStreamForClient[flightpath.ShutdownAutopilotResponse], error)
+	// Send any MAV_CMD of the common dialect as COMMAND_LONG or COMMAND_INT.
+	// The command is retransmitted until acknowledged, like the commands of the other RPCs.
+	// Returns the final COMMAND_ACK, whatever its result (a rejection is not an RPC error).
+	SendCommand(context.Context, *connect.Request[flightpath.SendCommandRequest]) (*connect.Response[flightpath.SendCommandResponse], error)
+	// Change the target speed (MAV_CMD_DO_CHANGE_SPEED).
+	// Optionally waits for VFR_HUD to report the new speed.
+	ChangeSpeed(context.Context, *connect.Request[flightpath.ChangeSpeedRequest]) (*connect.Response[flightpath.ChangeSpeedResponse], error)
+	// Turn to a heading (MAV_CMD_CONDITION_YAW).
+	// Optionally waits for VFR_HUD to report the new heading (absolute headings only).
+	SetYaw(context.Context, *connect.Request[flightpath.SetYawRequest]) (*connect.Response[flightpath.SetYawResponse], error)
+	// Point the vehicle and its cameras/gimbals at a location (MAV_CMD_DO_SET_ROI_LOCATION sent as COMMAND_INT).
+	SetRoiLocation(context.Context, *connect.Request[flightpath.SetRoiLocationRequest]) (*connect.Response[flightpath.SetRoiLocationResponse], error)
+	// Cancel the region of interest (MAV_CMD_DO_SET_ROI_NONE).
+	ClearRoi(context.Context, *connect.Request[flightpath.ClearRoiRequest]) (*connect.Response[flightpath.ClearRoiResponse], error)
+	// Set the home position to the current location or to a WGS84 location (MAV_CMD_DO_SET_HOME
+	// sent as COMMAND_INT). Use TelemetryService.SubscribeHomePosition to follow the change.
+	SetHomePosition(context.Context, *connect.Request[flightpath.SetHomePositionRequest]) (*connect.Response[flightpath.SetHomePositionResponse], error)
+}
+
+// NewActionServiceClient constructs a client for the flightpath.ActionService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewActionServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ActionServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	actionServiceMethods := flightpath.File_flightpath_action_proto.Services().ByName("ActionService").Methods()
+	return &actionServiceClient{
+		arm: connect.NewClient[flightpath.ArmRequest, flightpath.ArmResponse](
+			httpClient,
+			baseURL+ActionServiceArmProcedure,
+			connect.WithSchema(actionServiceMethods.ByName("Arm")),
+			connect.WithClientOptions(opts...),
+		),
+		disarm: connect.NewClient[flightpath.DisarmRequest, flightpath.DisarmResponse](
+			httpClient,
+			baseURL+ActionServiceDisarmProcedure,
+			connect.WithSchema(actionServiceMethods.ByName("Disarm")),
+			connect.WithClientOptions(opts...),
+		),
+		takeoff: connect.NewClient[flightpath.TakeoffRequest, flightpath.TakeoffResponse](
+			httpClient,
+			baseURL+ActionServiceTakeoffProcedure,
+			connect.WithSchema(actionServiceMethods.ByName("Takeoff")),
+			connect.WithClientOptions(opts...),
+		),
+		land: connect.NewClient[flightpath.LandRequest, flightpath.LandResponse](
+			httpClient,
+			baseURL+ActionServiceLandProcedure,
+			connect.WithSchema(actionServiceMethods.ByName("Land")),
+			connect.WithClientOptions(opts...),
+		),
+		returnToLaunch: connect.NewClient[flightpath.ReturnToLaunchRequest, flightpath.ReturnToLaunchResponse](
+			httpClient,
+			baseURL+ActionServiceReturnToLaunchProcedure,
+			connect.WithSchema(actionServiceMethods.ByName("ReturnToLaunch")),
+			connect.WithClientOptions(opts...),
+		),
+		setFlightMode: connect.NewClient[flightpath.SetFlightModeRequest, flightpath.SetFlightModeResponse](
+			httpClient,
+			baseURL+ActionServiceSetFlightModeProcedure,
+			connect.WithSchema(actionServiceMethods.ByName("SetFlightMode")),
+			connect.WithClientOptions(opts...),
+		),
+		gotoLocation: connect.NewClient[flightpath.GotoLocationRequest, flightpath.GotoLocationResponse](
+			httpClient,
+			baseURL+ActionServiceGotoLocationProcedure,
+			connect.WithSchema(actionServiceMethods.ByName("GotoLocation")),
+			connect.WithClientOptions(opts...),
+		),
+		doOrbit: connect.NewClient[flightpath.DoOrbitRequest, flightpath.DoOrbitResponse](
+			httpClient,
+			baseURL+ActionServiceDoOrbitProcedure,
+			connect.WithSchema(actionServiceMethods.ByName("DoOrbit")),
+			connect.WithClientOptions(opts...),
+		),
+		rebootAutopilot: connect.NewClient[flightpath.RebootAutopilotRequest, flightpath.RebootAutopilotResponse](
+			httpClient,
+			baseURL+ActionServiceRebootAutopilotProcedure,
+			connect.WithSchema(actionServiceMethods.ByName("RebootAutopilot")),
+			connect.WithClientOptions(opts...),
+		),
+		shutdownAutopilot: connect.NewClient[flightpath.ShutdownAutopilotRequest, flightpath.ShutdownAutopilotResponse](
+			httpClient,
+			baseURL+ActionServiceShutdownAutopilotProcedure,
+			connect.WithSchema(actionServiceMethods.ByName("ShutdownAutopilot")),
+			connect.WithClientOptions(opts...),
+		),
+		sendCommand: connect.NewClient[flightpath.SendCommandRequest, flightpath.SendCommandResponse](
+			httpClient,
+			baseURL+ActionServiceSendCommandProcedure,
+			connect.WithSchema(actionServiceMethods.ByName("SendCommand")),
+			connect.WithClientOptions(opts...),
+		),
+		changeSpeed: connect.NewClient[flightpath.ChangeSpeedRequest, flightpath.ChangeSpeedResponse](
+			httpClient,
+			baseURL+ActionServiceChangeSpeedProcedure,
+			connect.WithSchema(actionServiceMethods.ByName("ChangeSpeed")),
+			connect.WithClientOptions(opts...),
+		),
+		setYaw: connect.NewClient[flightpath.SetYawRequest, flightpath.SetYawResponse](
+			httpClient,
+			baseURL+ActionServiceSetYawProcedure,
+			connect.WithSchema(actionServiceMethods.ByName("SetYaw")),
+			connect.WithClientOptions(opts...),
+		),
+		setRoiLocation: connect.NewClient[flightpath.SetRoiLocationRequest, flightpath.SetRoiLocationResponse](
+			httpClient,
+			baseURL+ActionServiceSetRoiLocationProcedure,
+			connect.WithSchema(actionServiceMethods.ByName("SetRoiLocation")),
+			connect.WithClientOptions(opts...),
+		),
+		clearRoi: connect.NewClient[flightpath.ClearRoiRequest, flightpath.ClearRoiResponse](
+			httpClient,
+			baseURL+ActionServiceClearRoiProcedure,
+			connect.WithSchema(actionServiceMethods.ByName("ClearRoi")),
+			connect.WithClientOptions(opts...),
+		),
+		setHomePosition: connect.NewClient[flightpath.SetHomePositionRequest, flightpath.SetHomePositionResponse](
+			httpClient,
+			baseURL+ActionServiceSetHomePositionProcedure,
+			connect.WithSchema(actionServiceMethods.ByName("SetHomePosition")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// actionServiceClient implements ActionServiceClient.
+type actionServiceClient struct {
+	arm               *connect.Client[flightpath.ArmRequest, flightpath.ArmResponse]
+	disarm            *connect.Client[flightpath.DisarmRequest, flightpath.DisarmResponse]
+	takeoff           *connect.Client[flightpath.TakeoffRequest, flightpath.TakeoffResponse]
+	land              *connect.Client[flightpath.LandRequest, flightpath.LandResponse]
+	returnToLaunch    *connect.Client[flightpath.ReturnToLaunchRequest, flightpath.ReturnToLaunchResponse]
+	setFlightMode     *connect.Client[flightpath.SetFlightModeRequest, flightpath.SetFlightModeResponse]
+	gotoLocation      *connect.Client[flightpath.GotoLocationRequest, flightpath.GotoLocationResponse]
+	doOrbit           *connect.Client[flightpath.DoOrbitRequest, flightpath.DoOrbitResponse]
+	rebootAutopilot   *connect.Client[flightpath.RebootAutopilotRequest, flightpath.RebootAutopilotResponse]
+	shutdownAutopilot *connect.Client[flightpath.ShutdownAutopilotRequest, flightpath.ShutdownAutopilotResponse]
+	sendCommand       *connect.Client[flightpath.SendCommandRequest, flightpath.SendCommandResponse]
+	changeSpeed       *connect.Client[flightpath.ChangeSpeedRequest, flightpath.ChangeSpeedResponse]
+	setYaw            *connect.Client[flightpath.SetYawRequest, flightpath.SetYawResponse]
+	setRoiLocation    *connect.Client[flightpath.SetRoiLocationRequest, flightpath.SetRoiLocationResponse]
+	clearRoi          *connect.Client[flightpath.ClearRoiRequest, flightpath.ClearRoiResponse]
+	setHomePosition   *connect.Client[flightpath.SetHomePositionRequest, flightpath.SetHomePositionResponse]
+}
+
+// Arm calls flightpath.ActionService.Arm.
+func (c *actionServiceClient) Arm(ctx context.Context, req *connect.Request[flightpath.ArmRequest]) (*connect.Response[flightpath.ArmResponse], error) {
+	return c.arm.CallUnary(ctx, req)
+}
+
+// Disarm calls flightpath.ActionService.Disarm.
+func (c *actionServiceClient) Disarm(ctx context.Context, req *connect.Request[flightpath.DisarmRequest]) (*connect.Response[flightpath.DisarmResponse], error) {
+	return c.disarm.CallUnary(ctx, req)
+}
+
+// Takeoff calls flightpath.ActionService.Takeoff.
+func (c *actionServiceClient) Takeoff(ctx context.Context, req *connect.Request[flightpath.TakeoffRequest]) (*connect.ServerStreamForClient[flightpath.TakeoffResponse], error) {
+	return c.takeoff.CallServerStream(ctx, req)
+}
+
+// Land calls flightpath.ActionService.Land.
+func (c *actionServiceClient) Land(ctx context.Context, req *connect.Request[flightpath.LandRequest]) (*connect.ServerStreamForClient[flightpath.LandResponse], error) {
+	return c.land.CallServerStream(ctx, req)
+}
+
+// ReturnToLaunch calls flightpath.ActionService.ReturnToLaunch.
+func (c *actionServiceClient) ReturnToLaunch(ctx context.Context, req *connect.Request[flightpath.ReturnToLaunchRequest]) (*connect.ServerStreamForClient[flightpath.ReturnToLaunchResponse], error) {
+	return c.returnToLaunch.CallServerStream(ctx, req)
+}
+
+// SetFlightMode calls flightpath.ActionService.SetFlightMode.
+func (c *actionServiceClient) SetFlightMode(ctx context.Context, req *connect.Request[flightpath.SetFlightModeRequest]) (*connect.Response[flightpath.SetFlightModeResponse], error) {
+	return c.setFlightMode.CallUnary(ctx, req)
+}
+
+// GotoLocation calls flightpath.ActionService.GotoLocation.
+func (c *actionServiceClient) GotoLocation(ctx context.Context, req *connect.Request[flightpath.GotoLocationRequest]) (*connect.ServerStreamForClient[flightpath.GotoLocationResponse], error) {
+	return c.gotoLocation.CallServerStream(ctx, req)
+}
+
+// DoOrbit calls flightpath.ActionService.DoOrbit.
+func (c *actionServiceClient) DoOrbit(ctx context.Context, req *connect.Request[flightpath.DoOrbitRequest]) (*connect.Response[flightpath.DoOrbitResponse], error) {
+	return c.doOrbit.CallUnary(ctx, req)
+}
+
+// RebootAutopilot calls flightpath.ActionService.RebootAutopilot.
+func (c *actionServiceClient) RebootAutopilot(ctx context.Context, req *connect.Request[flightpath.RebootAutopilotRequest]) (*connect.ServerStreamForClient[flightpath.RebootAutopilotResponse], error) {
+	return c.rebootAutopilot.CallServerStream(ctx, req)
+}
+
+// ShutdownAutopilot calls flightpath.ActionService.ShutdownAutopilot.
+func (c *actionServiceClient) ShutdownAutopilot(ctx context.Context, req *connect.Request[flightpath.ShutdownAutopilotRequest]) (*connect.ServerStreamForClient[flightpath.ShutdownAutopilotResponse], error) {
+	return c.shutdownAutopilot.CallServerStream(ctx, req)
+}
+
+// SendCommand calls flightpath.ActionService.SendCommand.
+func (c *actionServiceClient) SendCommand(ctx context.Context, req *connect.Request[flightpath.SendCommandRequest]) (*connect.Response[flightpath.SendCommandResponse], error) {
+	return c.sendCommand.CallUnary(ctx, req)
+}
+
+// ChangeSpeed calls flightpath.ActionService.ChangeSpeed.
+func (c *actionServiceClient) ChangeSpeed(ctx context.Context, req *connect.Request[flightpath.ChangeSpeedRequest]) (*connect.Response[flightpath.ChangeSpeedResponse], error) {
+	return c.changeSpeed.CallUnary(ctx, req)
+}
+
+// SetYaw calls flightpath.ActionService.SetYaw.
+func (c *actionServiceClient) SetYaw(ctx context.Context, req *connect.Request[flightpath.SetYawRequest]) (*connect.Response[flightpath.SetYawResponse], error) {
+	return c.setYaw.CallUnary(ctx, req)
+}
+
+// SetRoiLocation calls flightpath.ActionService.SetRoiLocation.
+func (c *actionServiceClient) SetRoiLocation(ctx context.Context, req *connect.Request[flightpath.SetRoiLocationRequest]) (*connect.Response[flightpath.SetRoiLocationResponse], error) {
+	return c.setRoiLocation.CallUnary(ctx, req)
+}
+
+// ClearRoi calls flightpath.ActionService.ClearRoi.
+func (c *actionServiceClient) ClearRoi(ctx context.Context, req *connect.Request[flightpath.ClearRoiRequest]) (*connect.Response[flightpath.ClearRoiResponse], error) {
+	return c.clearRoi.CallUnary(ctx, req)
+}
+
+// SetHomePosition calls flightpath.ActionService.SetHomePosition.
+func (c *actionServiceClient) SetHomePosition(ctx context.Context, req *connect.Request[flightpath.SetHomePositionRequest]) (*connect.Response[flightpath.SetHomePositionResponse], error) {
+	return c.setHomePosition.CallUnary(ctx, req)
+}
+
+// ActionServiceHandler is an implementation of the flightpath.ActionService service.
+type ActionServiceHandler interface {
+	// Arm the drone (MAV_CMD_COMPONENT_ARM_DISARM with param1 = 1).
+	// Returns once the drone has acknowledged the command with COMMAND_ACK.
+	Arm(context.Context, *connect.Request[flightpath.ArmRequest]) (*connect.Response[flightpath.ArmResponse], error)
+	// Disarm the drone (MAV_CMD_COMPONENT_ARM_DISARM with param1 = 0).
+	// Returns once the drone has acknowledged the command with COMMAND_ACK.
+	Disarm(context.Context, *connect.Request[flightpath.DisarmRequest]) (*connect.Response[flightpath.DisarmResponse], error)
+	// Take off to the requested altitude (MAV_CMD_NAV_TAKEOFF).
+	// Streams flight phase updates until the drone hovers at the takeoff altitude.
+	Takeoff(context.Context, *connect.Request[flightpath.TakeoffRequest], *connect.ServerStream[flightpath.TakeoffResponse]) error
+	// Land at the current position (MAV_CMD_NAV_LAND).
+	// Streams flight phase updates until the drone has landed and disarmed.
+	Land(context.Context, *connect.Request[flightpath.LandRequest], *connect.ServerStream[flightpath.LandResponse]) error
+	// Return to the launch position and land (MAV_CMD_NAV_RETURN_TO_LAUNCH).
+	// Streams flight phase updates until the drone has landed and disarmed
+	// (or hovers above home if the autopilot is configured not to land).
+	ReturnToLaunch(context.Context, *connect.Request[flightpath.ReturnToLaunchRequest], *connect.ServerStream[flightpath.ReturnToLaunchResponse]) error
+	// Change the flight mode (MAV_CMD_DO_SET_MODE).
+	// The mode is encoded into the autopilot's custom_mode (PX4 or ArduPilot).
+	// Returns once a HEARTBEAT from the drone reports the requested mode.
+	SetFlightMode(context.Context, *connect.Request[flightpath.SetFlightModeRequest]) (*connect.Response[flightpath.SetFlightModeResponse], error)
+	// Fly to a WGS84 location (MAV_CMD_DO_REPOSITION sent as COMMAND_INT).
+	// Streams the distance and estimated time to the target until the drone is within the
+	// acceptance radius. Fails if the drone does not switch to guided/hold mode shortly after
+	// accepting the command, or leaves it on the way.
+	GotoLocation(context.Context, *connect.Request[flightpath.GotoLocationRequest], *connect.ServerStream[flightpath.GotoLocationResponse]) error
+	// Start orbiting around a WGS84 location (MAV_CMD_DO_ORBIT sent as COMMAND_INT).
+	// Returns once the drone has acknowledged the command. Use TelemetryService.SubscribeOrbitExecutionStatus
+	// to follow the orbit.
+	DoOrbit(context.Context, *connect.Request[flightpath.DoOrbitRequest]) (*connect.Response[flightpath.DoOrbitResponse], error)
+	// Reboot the autopilot (MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN with param1 = 1). Refused while armed.
+	// Streams updates as the drone's heartbeats disappear and reappear, and completes once the
+	// drone is heard again.
+	RebootAutopilot(context.Context, *connect.Request[flightpath.RebootAutopilotRequest], *connect.ServerStream[flightpath.RebootAutopilotResponse]) error
+	// Shut down the autopilot (MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN with param1 = 2). Refused while armed.
+	// Streams updates until the drone's heartbeats have disappeared.
+	ShutdownAutopilot(context.Context, *connect.Request[flightpath.ShutdownAutopilotRequest], *connect.ServerStream[flightpath.ShutdownAutopilotResponse]) error
+	// Send any MAV_CMD of the common dialect as COMMAND_LONG or COMMAND_INT.
+	// The command is retransmitted until acknowledged, like the commands of the other RPCs.
+	// Returns the final COMMAND_ACK, whatever its result (a rejection is not an RPC error).
+	SendCommand(context.Context, *connect.Request[flightpath.SendCommandRequest]) (*connect.Response[flightpath.SendCommandResponse], error)
+	// Change the target speed (MAV_CMD_DO_CHANGE_SPEED).
+	// Optionally waits for VFR_HUD to report the new speed.
+	ChangeSpeed(context.Context, *connect.Request[flightpath.ChangeSpeedRequest]) (*connect.Response[flightpath.ChangeSpeedResponse], error)
+	// Turn to a heading (MAV_CMD_CONDITION_YAW).
+	// Optionally waits for VFR_HUD to report the new heading (absolute headings only).
+	SetYaw(context.Context, *connect.Request[flightpath.SetYawRequest]) (*connect.Response[flightpath.SetYawResponse], error)
+	// Point the vehicle and its cameras/gimbals at a location (MAV_CMD_DO_SET_ROI_LOCATION sent as COMMAND_INT).
+	SetRoiLocation(context.Context, *connect.Request[flightpath.SetRoiLocationRequest]) (*connect.Response[flightpath.SetRoiLocationResponse], error)
+	// Cancel the region of interest (MAV_CMD_DO_SET_ROI_NONE).
+	ClearRoi(context.Context, *connect.Request[flightpath.ClearRoiRequest]) (*connect.Response[flightpath.ClearRoiResponse], error)
+	// Set the home position to the current location or to a WGS84 location (MAV_CMD_DO_SET_HOME
+	// sent as COMMAND_INT). Use TelemetryService.SubscribeHomePosition to follow the change.
+	SetHomePosition(context.Context, *connect.Request[flightpath.SetHomePositionRequest]) (*connect.Response[flightpath.SetHomePositionResponse], error)
+}
+
+// NewActionServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewActionServiceHandler(svc ActionServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	actionServiceMethods := flightpath.File_flightpath_action_proto.Services().ByName("ActionService").Methods()
+	actionServiceArmHandler := connect.NewUnaryHandler(
+		ActionServiceArmProcedure,
+		svc.Arm,
+		connect.WithSchema(actionServiceMethods.ByName("Arm")),
+		connect.WithHandlerOptions(opts...),
+	)
+	actionServiceDisarmHandler := connect.NewUnaryHandler(
+		ActionServiceDisarmProcedure,
+		svc.Disarm,
+		connect.WithSchema(actionServiceMethods.ByName("Disarm")),
+		connect.WithHandlerOptions(opts...),
+	)
+	actionServiceTakeoffHandler := connect.NewServerStreamHandler(
+		ActionServiceTakeoffProcedure,
+		svc.Takeoff,
+		connect.WithSchema(actionServiceMethods.ByName("Takeoff")),
+		connect.WithHandlerOptions(opts...),
+	)
+	actionServiceLandHandler := connect.NewServerStreamHandler(
+		ActionServiceLandProcedure,
+		svc.Land,
+		connect.WithSchema(actionServiceMethods.ByName("Land")),
+		connect.WithHandlerOptions(opts...),
+	)
+	actionServiceReturnToLaunchHandler := connect.NewServerStreamHandler(
+		ActionServiceReturnToLaunchProcedure,
+		svc.ReturnToLaunch,
+		connect.WithSchema(actionServiceMethods.ByName("ReturnToLaunch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	actionServiceSetFlightModeHandler := connect.NewUnaryHandler(
+		ActionServiceSetFlightModeProcedure,
+		svc.SetFlightMode,
+		connect.WithSchema(actionServiceMethods.ByName("SetFlightMode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	actionServiceGotoLocationHandler := connect.NewServerStreamHandler(
+		ActionServiceGotoLocationProcedure,
+		svc.GotoLocation,
+		connect.WithSchema(actionServiceMethods.ByName("GotoLocation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	actionServiceDoOrbitHandler := connect.NewUnaryHandler(
+		ActionServiceDoOrbitProcedure,
+		svc.DoOrbit,
+		connect.WithSchema(actionServiceMethods.ByName("DoOrbit")),
+		connect.WithHandlerOptions(opts...),
+	)
+	actionServiceRebootAutopilotHandler := connect.NewServerStreamHandler(
+		ActionServiceRebootAutopilotProcedure,
+		svc.RebootAutopilot,
+		connect.WithSchema(actionServiceMethods.ByName("RebootAutopilot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	actionServiceShutdownAutopilotHandler := connect.NewServerStreamHandler(
+		ActionServiceShutdownAutopilotProcedure,
+		svc.ShutdownAutopilot,
+		connect.WithSchema(actionServiceMethods.ByName("ShutdownAutopilot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	actionServiceSendCommandHandler := connect.NewUnaryHandler(
+		ActionServiceSendCommandProcedure,
+		svc.SendCommand,
+		connect.WithSchema(actionServiceMethods.ByName("SendCommand")),
+		connect.WithHandlerOptions(opts...),
+	)
+	actionServiceChangeSpeedHandler := connect.NewUnaryHandler(
+		ActionServiceChangeSpeedProcedure,
+		svc.ChangeSpeed,
+		connect.WithSchema(actionServiceMethods.ByName("ChangeSpeed")),
+		connect.WithHandlerOptions(opts...),
+	)
+	actionServiceSetYawHandler := connect.NewUnaryHandler(
+		ActionServiceSetYawProcedure,
+		svc.SetYaw,
+		connect.WithSchema(actionServiceMethods.ByName("SetYaw")),
+		connect.WithHandlerOptions(opts...),
+	)
+	actionServiceSetRoiLocationHandler := connect.NewUnaryHandler(
+		ActionServiceSetRoiLocationProcedure,
+		svc.SetRoiLocation,
+		connect.WithSchema(actionServiceMethods.ByName("SetRoiLocation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	actionServiceClearRoiHandler := connect.NewUnaryHandler(
+		ActionServiceClearRoiProcedure,
+		svc.ClearRoi,
+		connect.WithSchema(actionServiceMethods.ByName("ClearRoi")),
+		connect.WithHandlerOptions(opts...),
+	)
+	actionServiceSetHomePositionHandler := connect.NewUnaryHandler(
+		ActionServiceSetHomePositionProcedure,
+		svc.SetHomePosition,
+		connect.WithSchema(actionServiceMethods.ByName("SetHomePosition")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/flightpath.ActionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ActionServiceArmProcedure:
+			actionServiceArmHandler.ServeHTTP(w, r)
+		case ActionServiceDisarmProcedure:
+			actionServiceDisarmHandler.ServeHTTP(w, r)
+		case ActionServiceTakeoffProcedure:
+			actionServiceTakeoffHandler.ServeHTTP(w, r)
+		case ActionServiceLandProcedure:
+			actionServiceLandHandler.ServeHTTP(w, r)
+		case ActionServiceReturnToLaunchProcedure:
+			actionServiceReturnToLaunchHandler.ServeHTTP(w, r)
+		case ActionServiceSetFlightModeProcedure:
+			actionServiceSetFlightModeHandler.ServeHTTP(w, r)
+		case ActionServiceGotoLocationProcedure:
+			actionServiceGotoLocationHandler.ServeHTTP(w, r)
+		case ActionServiceDoOrbitProcedure:
+			actionServiceDoOrbitHandler.ServeHTTP(w, r)
+		case ActionServiceRebootAutopilotProcedure:
+			actionServiceRebootAutopilotHandler.ServeHTTP(w, r)
+		case ActionServiceShutdownAutopilotProcedure:
+			actionServiceShutdownAutopilotHandler.ServeHTTP(w, r)
+		case ActionServiceSendCommandProcedure:
+			actionServiceSendCommandHandler.ServeHTTP(w, r)
+		case ActionServiceChangeSpeedProcedure:
+			actionServiceChangeSpeedHandler.ServeHTTP(w, r)
+		case ActionServiceSetYawProcedure:
+			actionServiceSetYawHandler.ServeHTTP(w, r)
+		case ActionServiceSetRoiLocationProcedure:
+			actionServiceSetRoiLocationHandler.ServeHTTP(w, r)
+		case ActionServiceClearRoiProcedure:
+			actionServiceClearRoiHandler.ServeHTTP(w, r)
+		case ActionServiceSetHomePositionProcedure:
+			actionServiceSetHomePositionHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedActionServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedActionServiceHandler struct{}
+
+func (UnimplementedActionServiceHandler) Arm(context.Context, *connect.Request[flightpath.ArmRequest]) (*connect.Response[flightpath.ArmResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.ActionService.Arm is not implemented"))
+}
+
+func (UnimplementedActionServiceHandler) Disarm(context.Context, *connect.Request[flightpath.DisarmRequest]) (*connect.Response[flightpath.DisarmResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.ActionService.Disarm is not implemented"))
+}
+
+func (UnimplementedActionServiceHandler) Takeoff(context.Context, *connect.Request[flightpath.TakeoffRequest], *connect.ServerStream[flightpath.TakeoffResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.ActionService.Takeoff is not implemented"))
+}
+
+func (UnimplementedActionServiceHandler) Land(context.Context, *connect.Request[flightpath.LandRequest], *connect.ServerStream[flightpath.LandResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.ActionService.Land is not implemented"))
+}
+
+func (UnimplementedActionServiceHandler) ReturnToLaunch(context.Context, *connect.Request[flightpath.ReturnToLaunchRequest], *connect.ServerStream[flightpath.ReturnToLaunchResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.ActionService.ReturnToLaunch is not implemented"))
+}
+
+func (UnimplementedActionServiceHandler) SetFlightMode(context.Context, *connect.Request[flightpath.SetFlightModeRequest]) (*connect.Response[flightpath.SetFlightModeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.ActionService.SetFlightMode is not implemented"))
+}
+
+func (UnimplementedActionServiceHandler) GotoLocation(context.Context, *connect.Request[flightpath.GotoLocationRequest], *connect.ServerStream[flightpath.GotoLocationResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.ActionService.GotoLocation is not implemented"))
+}
+
+func (UnimplementedActionServiceHandler) DoOrbit(context.Context, *connect.Request[flightpath.DoOrbitRequest]) (*connect.Response[flightpath.DoOrbitResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.ActionService.DoOrbit is not implemented"))
+}
+
+func (UnimplementedActionServiceHandler) RebootAutopilot(context.Context, *connect.Request[flightpath.RebootAutopilotRequest], *connect.ServerStream[flightpath.RebootAutopilotResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.ActionService.RebootAutopilot is not implemented"))
+}
+
+func (UnimplementedActionServiceHandler) ShutdownAutopilot(context.Context, *connect.Request[flightpath.ShutdownAutopilotRequest], *connect.ServerStream[flightpath.ShutdownAutopilotResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.ActionService.ShutdownAutopilot is not implemented"))
+}
+
+func (UnimplementedActionServiceHandler) SendCommand(context.Context, *connect.Request[flightpath.SendCommandRequest]) (*connect.Response[flightpath.SendCommandResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.ActionService.SendCommand is not implemented"))
+}
+
+func (UnimplementedActionServiceHandler) ChangeSpeed(context.Context, *connect.Request[flightpath.ChangeSpeedRequest]) (*connect.Response[flightpath.ChangeSpeedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.ActionService.ChangeSpeed is not implemented"))
+}
+
+func (UnimplementedActionServiceHandler) SetYaw(context.Context, *connect.Request[flightpath.SetYawRequest]) (*connect.Response[flightpath.SetYawResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.ActionService.SetYaw is not implemented"))
+}
+
+func (UnimplementedActionServiceHandler) SetRoiLocation(context.Context, *connect.Request[flightpath.SetRoiLocationRequest]) (*connect.Response[flightpath.SetRoiLocationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.ActionService.SetRoiLocation is not implemented"))
+}
+
+func (UnimplementedActionServiceHandler) ClearRoi(context.Context, *connect.Request[flightpath.ClearRoiRequest]) (*connect.Response[flightpath.ClearRoiResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.ActionService.ClearRoi is not implemented"))
+}
+
+func (UnimplementedActionServiceHandler) SetHomePosition(context.Context, *connect.Request[flightpath.SetHomePositionRequest]) (*connect.Response[flightpath.SetHomePositionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.ActionService.SetHomePosition is not implemented"))
+}
@@ -0,0 +1,184 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: flightpath/emergency.proto
+
+package flightpathconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	flightpath "github.com/flightpath-dev/flightpath/gen/go/flightpath"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// EmergencyServiceName is the fully-qualified name of the EmergencyService service.
+	EmergencyServiceName = "flightpath.EmergencyService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// EmergencyServiceRequestConfirmationProcedure is the fully-qualified name of the
+	// EmergencyService's RequestConfirmation RPC.
+	EmergencyServiceRequestConfirmationProcedure = "/flightpath.EmergencyService/RequestConfirmation"
+	// EmergencyServiceKillProcedure is the fully-qualified name of the EmergencyService's Kill RPC.
+	EmergencyServiceKillProcedure = "/flightpath.EmergencyService/Kill"
+	// EmergencyServiceFlightTerminationProcedure is the fully-qualified name of the EmergencyService's
+	// FlightTermination RPC.
+	EmergencyServiceFlightTerminationProcedure = "/flightpath.EmergencyService/FlightTermination"
+)
+
+// EmergencyServiceClient is a client for the flightpath.EmergencyService service.
+type EmergencyServiceClient interface {
+	// Request a confirmation token for an emergency action on a drone.
+	// The token is valid for a single use, for a few seconds only, and only for the client
+	// (host and user agent) that requested it.
+	RequestConfirmation(context.Context, *connect.Request[flightpath.RequestConfirmationRequest]) (*connect.Response[flightpath.RequestConfirmationResponse], error)
+	// Kill the drone's motors immediately, even in flight
+	// (MAV_CMD_COMPONENT_ARM_DISARM with param1 = 0 and param2 = 21196).
+	// Requires a token obtained with EMERGENCY_ACTION_KILL.
+	Kill(context.Context, *connect.Request[flightpath.KillRequest]) (*connect.Response[flightpath.KillResponse], error)
+	// Terminate the flight (MAV_CMD_DO_FLIGHTTERMINATION). Depending on the autopilot configuration,
+	// this cuts the motors and/or deploys a parachute.
+	// Requires a token obtained with EMERGENCY_ACTION_FLIGHT_TERMINATION.
+	FlightTermination(context.Context, *connect.Request[flightpath.FlightTerminationRequest]) (*connect.Response[flightpath.FlightTerminationResponse], error)
+}
+
+// NewEmergencyServiceClient constructs a client for the flightpath.EmergencyService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewEmergencyServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) EmergencyServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	emergencyServiceMethods := flightpath.File_flightpath_emergency_proto.Services().ByName("EmergencyService").Methods()
+	return &emergencyServiceClient{
+		requestConfirmation: connect.NewClient[flightpath.RequestConfirmationRequest, flightpath.RequestConfirmationResponse](
+			httpClient,
+			baseURL+EmergencyServiceRequestConfirmationProcedure,
+			connect.WithSchema(emergencyServiceMethods.ByName("RequestConfirmation")),
+			connect.WithClientOptions(opts...),
+		),
+		kill: connect.NewClient[flightpath.KillRequest, flightpath.KillResponse](
+			httpClient,
+			baseURL+EmergencyServiceKillProcedure,
+			connect.WithSchema(emergencyServiceMethods.ByName("Kill")),
+			connect.WithClientOptions(opts...),
+		),
+		flightTermination: connect.NewClient[flightpath.FlightTerminationRequest, flightpath.FlightTerminationResponse](
+			httpClient,
+			baseURL+EmergencyServiceFlightTerminationProcedure,
+			connect.WithSchema(emergencyServiceMethods.ByName("FlightTermination")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// emergencyServiceClient implements EmergencyServiceClient.
+type emergencyServiceClient struct {
+	requestConfirmation *connect.Client[flightpath.RequestConfirmationRequest, flightpath.RequestConfirmationResponse]
+	kill                *connect.Client[flightpath.KillRequest, flightpath.KillResponse]
+	flightTermination   *connect.Client[flightpath.FlightTerminationRequest, flightpath.FlightTerminationResponse]
+}
+
+// RequestConfirmation calls flightpath.EmergencyService.RequestConfirmation.
+func (c *emergencyServiceClient) RequestConfirmation(ctx context.Context, req *connect.Request[flightpath.RequestConfirmationRequest]) (*connect.Response[flightpath.RequestConfirmationResponse], error) {
+	return c.requestConfirmation.CallUnary(ctx, req)
+}
+
+// Kill calls flightpath.EmergencyService.Kill.
+func (c *emergencyServiceClient) Kill(ctx context.Context, req *connect.Request[flightpath.KillRequest]) (*connect.Response[flightpath.KillResponse], error) {
+	return c.kill.CallUnary(ctx, req)
+}
+
+// FlightTermination calls flightpath.EmergencyService.FlightTermination.
+func (c *emergencyServiceClient) FlightTermination(ctx context.Context, req *connect.Request[flightpath.FlightTerminationRequest]) (*connect.Response[flightpath.FlightTerminationResponse], error) {
+	return c.flightTermination.CallUnary(ctx, req)
+}
+
+// EmergencyServiceHandler is an implementation of the flightpath.EmergencyService service.
+type EmergencyServiceHandler interface {
+	// Request a confirmation token for an emergency action on a drone.
+	// The token is valid for a single use, for a few seconds only, and only for the client
+	// (host and user agent) that requested it.
+	RequestConfirmation(context.Context, *connect.Request[flightpath.RequestConfirmationRequest]) (*connect.Response[flightpath.RequestConfirmationResponse], error)
+	// Kill the drone's motors immediately, even in flight
+	// (MAV_CMD_COMPONENT_ARM_DISARM with param1 = 0 and param2 = 21196).
+	// Requires a token obtained with EMERGENCY_ACTION_KILL.
+	Kill(context.Context, *connect.Request[flightpath.KillRequest]) (*connect.Response[flightpath.KillResponse], error)
+	// Terminate the flight (MAV_CMD_DO_FLIGHTTERMINATION). Depending on the autopilot configuration,
+	// this cuts the motors and/or deploys a parachute.
+	// Requires a token obtained with EMERGENCY_ACTION_FLIGHT_TERMINATION.
+	FlightTermination(context.Context, *connect.Request[flightpath.FlightTerminationRequest]) (*connect.Response[flightpath.FlightTerminationResponse], error)
+}
+
+// NewEmergencyServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewEmergencyServiceHandler(svc EmergencyServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	emergencyServiceMethods := flightpath.File_flightpath_emergency_proto.Services().ByName("EmergencyService").Methods()
+	emergencyServiceRequestConfirmationHandler := connect.NewUnaryHandler(
+		EmergencyServiceRequestConfirmationProcedure,
+		svc.RequestConfirmation,
+		connect.WithSchema(emergencyServiceMethods.ByName("RequestConfirmation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	emergencyServiceKillHandler := connect.NewUnaryHandler(
+		EmergencyServiceKillProcedure,
+		svc.Kill,
+		connect.WithSchema(emergencyServiceMethods.ByName("Kill")),
+		connect.WithHandlerOptions(opts...),
+	)
+	emergencyServiceFlightTerminationHandler := connect.NewUnaryHandler(
+		EmergencyServiceFlightTerminationProcedure,
+		svc.FlightTermination,
+		connect.WithSchema(emergencyServiceMethods.ByName("FlightTermination")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/flightpath.EmergencyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case EmergencyServiceRequestConfirmationProcedure:
+			emergencyServiceRequestConfirmationHandler.ServeHTTP(w, r)
+		case EmergencyServiceKillProcedure:
+			emergencyServiceKillHandler.ServeHTTP(w, r)
+		case EmergencyServiceFlightTerminationProcedure:
+			emergencyServiceFlightTerminationHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedEmergencyServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedEmergencyServiceHandler struct{}
+
+func (UnimplementedEmergencyServiceHandler) RequestConfirmation(context.Context, *connect.Request[flightpath.RequestConfirmationRequest]) (*connect.Response[flightpath.RequestConfirmationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.EmergencyService.RequestConfirmation is not implemented"))
+}
+
+func (UnimplementedEmergencyServiceHandler) Kill(context.Context, *connect.Request[flightpath.KillRequest]) (*connect.Response[flightpath.KillResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.EmergencyService.Kill is not implemented"))
+}
+
+func (UnimplementedEmergencyServiceHandler) FlightTermination(context.Context, *connect.Request[flightpath.FlightTerminationRequest]) (*connect.Response[flightpath.FlightTerminationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.EmergencyService.FlightTermination is not implemented"))
+}
@@ -36,12 +36,142 @@ const (
 	// TelemetryServiceSubscribeRawGpsProcedure is the fully-qualified name of the TelemetryService's
 	// SubscribeRawGps RPC.
 	TelemetryServiceSubscribeRawGpsProcedure = "/flightpath.TelemetryService/SubscribeRawGps"
+	// TelemetryServiceSubscribeOrbitExecutionStatusProcedure is the fully-qualified name of the
+	// TelemetryService's SubscribeOrbitExecutionStatus RPC.
+	TelemetryServiceSubscribeOrbitExecutionStatusProcedure = "/flightpath.TelemetryService/SubscribeOrbitExecutionStatus"
+	// TelemetryServiceSubscribeAttitudeProcedure is the fully-qualified name of the TelemetryService's
+	// SubscribeAttitude RPC.
+	TelemetryServiceSubscribeAttitudeProcedure = "/flightpath.TelemetryService/SubscribeAttitude"
+	// TelemetryServiceSubscribePositionProcedure is the fully-qualified name of the TelemetryService's
+	// SubscribePosition RPC.
+	TelemetryServiceSubscribePositionProcedure = "/flightpath.TelemetryService/SubscribePosition"
+	// TelemetryServiceSubscribeLocalPositionProcedure is the fully-qualified name of the
+	// TelemetryService's SubscribeLocalPosition RPC.
+	TelemetryServiceSubscribeLocalPositionProcedure = "/flightpath.TelemetryService/SubscribeLocalPosition"
+	// TelemetryServiceSubscribeBatteryProcedure is the fully-qualified name of the TelemetryService's
+	// SubscribeBattery RPC.
+	TelemetryServiceSubscribeBatteryProcedure = "/flightpath.TelemetryService/SubscribeBattery"
+	// TelemetryServiceSubscribeFlightMetricsProcedure is the fully-qualified name of the
+	// TelemetryService's SubscribeFlightMetrics RPC.
+	TelemetryServiceSubscribeFlightMetricsProcedure = "/flightpath.TelemetryService/SubscribeFlightMetrics"
+	// TelemetryServiceSubscribeLandedStateProcedure is the fully-qualified name of the
+	// TelemetryService's SubscribeLandedState RPC.
+	TelemetryServiceSubscribeLandedStateProcedure = "/flightpath.TelemetryService/SubscribeLandedState"
+	// TelemetryServiceSubscribeStatusTextProcedure is the fully-qualified name of the
+	// TelemetryService's SubscribeStatusText RPC.
+	TelemetryServiceSubscribeStatusTextProcedure = "/flightpath.TelemetryService/SubscribeStatusText"
+	// TelemetryServiceGetHomePositionProcedure is the fully-qualified name of the TelemetryService's
+	// GetHomePosition RPC.
+	TelemetryServiceGetHomePositionProcedure = "/flightpath.TelemetryService/GetHomePosition"
+	// TelemetryServiceSubscribeHomePositionProcedure is the fully-qualified name of the
+	// TelemetryService's SubscribeHomePosition RPC.
+	TelemetryServiceSubscribeHomePositionProcedure = "/flightpath.TelemetryService/SubscribeHomePosition"
+	// TelemetryServiceSubscribeRcChannelsProcedure is the fully-qualified name of the
+	// TelemetryService's SubscribeRcChannels RPC.
+	TelemetryServiceSubscribeRcChannelsProcedure = "/flightpath.TelemetryService/SubscribeRcChannels"
+	// TelemetryServiceSubscribeActuatorOutputsProcedure is the fully-qualified name of the
+	// TelemetryService's SubscribeActuatorOutputs RPC.
+	TelemetryServiceSubscribeActuatorOutputsProcedure = "/flightpath.TelemetryService/SubscribeActuatorOutputs"
+	// TelemetryServiceSubscribeEstimatorStatusProcedure is the fully-qualified name of the
+	// TelemetryService's SubscribeEstimatorStatus RPC.
+	TelemetryServiceSubscribeEstimatorStatusProcedure = "/flightpath.TelemetryService/SubscribeEstimatorStatus"
+	// TelemetryServiceSubscribeWindProcedure is the fully-qualified name of the TelemetryService's
+	// SubscribeWind RPC.
+	TelemetryServiceSubscribeWindProcedure = "/flightpath.TelemetryService/SubscribeWind"
+	// TelemetryServiceSubscribeDistanceSensorProcedure is the fully-qualified name of the
+	// TelemetryService's SubscribeDistanceSensor RPC.
+	TelemetryServiceSubscribeDistanceSensorProcedure = "/flightpath.TelemetryService/SubscribeDistanceSensor"
+	// TelemetryServiceSubscribeObstacleDistanceProcedure is the fully-qualified name of the
+	// TelemetryService's SubscribeObstacleDistance RPC.
+	TelemetryServiceSubscribeObstacleDistanceProcedure = "/flightpath.TelemetryService/SubscribeObstacleDistance"
+	// TelemetryServiceSubscribeGpsReceiversProcedure is the fully-qualified name of the
+	// TelemetryService's SubscribeGpsReceivers RPC.
+	TelemetryServiceSubscribeGpsReceiversProcedure = "/flightpath.TelemetryService/SubscribeGpsReceivers"
+	// TelemetryServiceSubscribeGpsStatusProcedure is the fully-qualified name of the TelemetryService's
+	// SubscribeGpsStatus RPC.
+	TelemetryServiceSubscribeGpsStatusProcedure = "/flightpath.TelemetryService/SubscribeGpsStatus"
+	// TelemetryServiceSubscribeGpsRtkProcedure is the fully-qualified name of the TelemetryService's
+	// SubscribeGpsRtk RPC.
+	TelemetryServiceSubscribeGpsRtkProcedure = "/flightpath.TelemetryService/SubscribeGpsRtk"
+	// TelemetryServiceSubscribeSystemStatusProcedure is the fully-qualified name of the
+	// TelemetryService's SubscribeSystemStatus RPC.
+	TelemetryServiceSubscribeSystemStatusProcedure = "/flightpath.TelemetryService/SubscribeSystemStatus"
+	// TelemetryServiceSubscribeTrafficProcedure is the fully-qualified name of the TelemetryService's
+	// SubscribeTraffic RPC.
+	TelemetryServiceSubscribeTrafficProcedure = "/flightpath.TelemetryService/SubscribeTraffic"
+	// TelemetryServiceSubscribeVehicleStateProcedure is the fully-qualified name of the
+	// TelemetryService's SubscribeVehicleState RPC.
+	TelemetryServiceSubscribeVehicleStateProcedure = "/flightpath.TelemetryService/SubscribeVehicleState"
 )
 
 // TelemetryServiceClient is a client for the flightpath.TelemetryService service.
 type TelemetryServiceClient interface {
 	// Subscribe to GPS_RAW_INT messages from the drone
 	SubscribeRawGps(context.Context, *connect.Request[flightpath.SubscribeRawGpsRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeRawGpsResponse], error)
+	// Subscribe to ORBIT_EXECUTION_STATUS messages from the drone (sent while an orbit is in progress).
+	// An update with active = false is sent when the drone stops reporting an orbit.
+	SubscribeOrbitExecutionStatus(context.Context, *connect.Request[flightpath.SubscribeOrbitExecutionStatusRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeOrbitExecutionStatusResponse], error)
+	// Subscribe to the attitude of the drone (ATTITUDE and ATTITUDE_QUATERNION messages).
+	// An update is sent whenever either message is received and carries the latest of both.
+	SubscribeAttitude(context.Context, *connect.Request[flightpath.SubscribeAttitudeRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeAttitudeResponse], error)
+	// Subscribe to the fused position estimate of the drone (GLOBAL_POSITION_INT messages)
+	SubscribePosition(context.Context, *connect.Request[flightpath.SubscribePositionRequest]) (*connect.ServerStreamForClient[flightpath.SubscribePositionResponse], error)
+	// Subscribe to the local position of the drone (LOCAL_POSITION_NED and ODOMETRY messages).
+	// An update is sent whenever either message is received and carries the latest of both.
+	SubscribeLocalPosition(context.Context, *connect.Request[flightpath.SubscribeLocalPositionRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeLocalPositionResponse], error)
+	// Subscribe to the state of every battery of the drone (BATTERY_STATUS messages, or SYS_STATUS
+	// for drones that do not send BATTERY_STATUS)
+	SubscribeBattery(context.Context, *connect.Request[flightpath.SubscribeBatteryRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeBatteryResponse], error)
+	// Subscribe to VFR_HUD messages from the drone (airspeed, groundspeed, heading, throttle, altitude, climb rate)
+	SubscribeFlightMetrics(context.Context, *connect.Request[flightpath.SubscribeFlightMetricsRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeFlightMetricsResponse], error)
+	// Subscribe to the landed state and VTOL state of the drone (EXTENDED_SYS_STATE messages).
+	// By default, an update is only sent when either state changes.
+	SubscribeLandedState(context.Context, *connect.Request[flightpath.SubscribeLandedStateRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeLandedStateResponse], error)
+	// Subscribe to STATUSTEXT messages from the drone (e.g. preflight check failures and warnings).
+	// Messages split across several STATUSTEXT chunks are reassembled before being sent.
+	SubscribeStatusText(context.Context, *connect.Request[flightpath.SubscribeStatusTextRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeStatusTextResponse], error)
+	// Get the home position of the drone (HOME_POSITION message).
+	// If no HOME_POSITION has been received yet, it is requested with MAV_CMD_REQUEST_MESSAGE.
+	GetHomePosition(context.Context, *connect.Request[flightpath.GetHomePositionRequest]) (*connect.Response[flightpath.GetHomePositionResponse], error)
+	// Subscribe to changes of the home position of the drone (HOME_POSITION messages).
+	// An update is sent for the first HOME_POSITION of every component, then only when it changes.
+	SubscribeHomePosition(context.Context, *connect.Request[flightpath.SubscribeHomePositionRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeHomePositionResponse], error)
+	// Subscribe to the RC input of the drone (RC_CHANNELS messages)
+	SubscribeRcChannels(context.Context, *connect.Request[flightpath.SubscribeRcChannelsRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeRcChannelsResponse], error)
+	// Subscribe to the servo/actuator outputs of the drone (SERVO_OUTPUT_RAW and ACTUATOR_OUTPUT_STATUS messages).
+	// An update is sent whenever either message is received and carries the latest of both.
+	SubscribeActuatorOutputs(context.Context, *connect.Request[flightpath.SubscribeActuatorOutputsRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeActuatorOutputsResponse], error)
+	// Subscribe to the state estimator health of the drone (ESTIMATOR_STATUS and VIBRATION messages).
+	// An update is sent whenever either message is received and carries the latest of both.
+	SubscribeEstimatorStatus(context.Context, *connect.Request[flightpath.SubscribeEstimatorStatusRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeEstimatorStatusResponse], error)
+	// Subscribe to the wind estimate of the drone (WIND_COV messages).
+	// The ArduPilot WIND message is not part of the common dialect and is not decoded.
+	SubscribeWind(context.Context, *connect.Request[flightpath.SubscribeWindRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeWindResponse], error)
+	// Subscribe to the readings of the distance sensors of the drone (DISTANCE_SENSOR messages).
+	// Every sensor sends its own messages, identified by component and sensor ID.
+	SubscribeDistanceSensor(context.Context, *connect.Request[flightpath.SubscribeDistanceSensorRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeDistanceSensorResponse], error)
+	// Subscribe to the obstacles detected around the drone (OBSTACLE_DISTANCE messages)
+	SubscribeObstacleDistance(context.Context, *connect.Request[flightpath.SubscribeObstacleDistanceRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeObstacleDistanceResponse], error)
+	// Subscribe to the raw readings of the GPS receivers of the drone (GPS_RAW_INT for the first
+	// receiver, GPS2_RAW for the second). Every update carries the instance of the receiver it came from.
+	SubscribeGpsReceivers(context.Context, *connect.Request[flightpath.SubscribeGpsReceiversRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeGpsReceiversResponse], error)
+	// Subscribe to the satellites seen by the GPS receiver of the drone (GPS_STATUS messages).
+	// GPS_STATUS does not identify the receiver it describes.
+	SubscribeGpsStatus(context.Context, *connect.Request[flightpath.SubscribeGpsStatusRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeGpsStatusResponse], error)
+	// Subscribe to the RTK status of the GPS receivers of the drone (GPS_RTK for the first receiver,
+	// GPS2_RTK for the second). Every update carries the instance of the receiver it came from.
+	SubscribeGpsRtk(context.Context, *connect.Request[flightpath.SubscribeGpsRtkRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeGpsRtkResponse], error)
+	// Subscribe to the onboard sensor health, CPU load and communication drop rate of the drone
+	// (SYS_STATUS messages)
+	SubscribeSystemStatus(context.Context, *connect.Request[flightpath.SubscribeSystemStatusRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeSystemStatusResponse], error)
+	// Subscribe to the aircraft reported by the ADS-B receiver of the drone (ADSB_VEHICLE messages),
+	// with their separation from and closing rate to the drone. When an aircraft is no longer reported
+	// for a while, a final update with expired = true is sent.
+	SubscribeTraffic(context.Context, *connect.Request[flightpath.SubscribeTrafficRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeTrafficResponse], error)
+	// Subscribe to a snapshot of the state of every drone (heartbeat, position, attitude, batteries,
+	// GPS, landed state and flight mode), sent at a fixed rate regardless of how fast the underlying
+	// MAVLink messages arrive.
+	SubscribeVehicleState(context.Context, *connect.Request[flightpath.SubscribeVehicleStateRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeVehicleStateResponse], error)
 }
 
 // NewTelemetryServiceClient constructs a client for the flightpath.TelemetryService service. By
@@ -61,12 +191,166 @@ func NewTelemetryServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeRawGps")),
 			connect.WithClientOptions(opts...),
 		),
+		subscribeOrbitExecutionStatus: connect.NewClient[flightpath.SubscribeOrbitExecutionStatusRequest, flightpath.SubscribeOrbitExecutionStatusResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeOrbitExecutionStatusProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeOrbitExecutionStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeAttitude: connect.NewClient[flightpath.SubscribeAttitudeRequest, flightpath.SubscribeAttitudeResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeAttitudeProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeAttitude")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribePosition: connect.NewClient[flightpath.SubscribePositionRequest, flightpath.SubscribePositionResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribePositionProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribePosition")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeLocalPosition: connect.NewClient[flightpath.SubscribeLocalPositionRequest, flightpath.SubscribeLocalPositionResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeLocalPositionProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeLocalPosition")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeBattery: connect.NewClient[flightpath.SubscribeBatteryRequest, flightpath.SubscribeBatteryResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeBatteryProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeBattery")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeFlightMetrics: connect.NewClient[flightpath.SubscribeFlightMetricsRequest, flightpath.SubscribeFlightMetricsResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeFlightMetricsProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeFlightMetrics")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeLandedState: connect.NewClient[flightpath.SubscribeLandedStateRequest, flightpath.SubscribeLandedStateResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeLandedStateProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeLandedState")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeStatusText: connect.NewClient[flightpath.SubscribeStatusTextRequest, flightpath.SubscribeStatusTextResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeStatusTextProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeStatusText")),
+			connect.WithClientOptions(opts...),
+		),
+		getHomePosition: connect.NewClient[flightpath.GetHomePositionRequest, flightpath.GetHomePositionResponse](
+			httpClient,
+			baseURL+TelemetryServiceGetHomePositionProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("GetHomePosition")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeHomePosition: connect.NewClient[flightpath.SubscribeHomePositionRequest, flightpath.SubscribeHomePositionResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeHomePositionProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeHomePosition")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeRcChannels: connect.NewClient[flightpath.SubscribeRcChannelsRequest, flightpath.SubscribeRcChannelsResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeRcChannelsProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeRcChannels")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeActuatorOutputs: connect.NewClient[flightpath.SubscribeActuatorOutputsRequest, flightpath.SubscribeActuatorOutputsResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeActuatorOutputsProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeActuatorOutputs")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeEstimatorStatus: connect.NewClient[flightpath.SubscribeEstimatorStatusRequest, flightpath.SubscribeEstimatorStatusResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeEstimatorStatusProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeEstimatorStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeWind: connect.NewClient[flightpath.SubscribeWindRequest, flightpath.SubscribeWindResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeWindProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeWind")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeDistanceSensor: connect.NewClient[flightpath.SubscribeDistanceSensorRequest, flightpath.SubscribeDistanceSensorResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeDistanceSensorProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeDistanceSensor")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeObstacleDistance: connect.NewClient[flightpath.SubscribeObstacleDistanceRequest, flightpath.SubscribeObstacleDistanceResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeObstacleDistanceProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeObstacleDistance")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeGpsReceivers: connect.NewClient[flightpath.SubscribeGpsReceiversRequest, flightpath.SubscribeGpsReceiversResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeGpsReceiversProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeGpsReceivers")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeGpsStatus: connect.NewClient[flightpath.SubscribeGpsStatusRequest, flightpath.SubscribeGpsStatusResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeGpsStatusProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeGpsStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeGpsRtk: connect.NewClient[flightpath.SubscribeGpsRtkRequest, flightpath.SubscribeGpsRtkResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeGpsRtkProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeGpsRtk")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeSystemStatus: connect.NewClient[flightpath.SubscribeSystemStatusRequest, flightpath.SubscribeSystemStatusResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeSystemStatusProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeSystemStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeTraffic: connect.NewClient[flightpath.SubscribeTrafficRequest, flightpath.SubscribeTrafficResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeTrafficProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeTraffic")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribeVehicleState: connect.NewClient[flightpath.SubscribeVehicleStateRequest, flightpath.SubscribeVehicleStateResponse](
+			httpClient,
+			baseURL+TelemetryServiceSubscribeVehicleStateProcedure,
+			connect.WithSchema(telemetryServiceMethods.ByName("SubscribeVehicleState")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // telemetryServiceClient implements TelemetryServiceClient.
 type telemetryServiceClient struct {
-	subscribeRawGps *connect.Client[flightpath.SubscribeRawGpsRequest, flightpath.SubscribeRawGpsResponse]
+	subscribeRawGps               *connect.Client[flightpath.SubscribeRawGpsRequest, flightpath.SubscribeRawGpsResponse]
+	subscribeOrbitExecutionStatus *connect.Client[flightpath.SubscribeOrbitExecutionStatusRequest, flightpath.SubscribeOrbitExecutionStatusResponse]
+	subscribeAttitude             *connect.Client[flightpath.SubscribeAttitudeRequest, flightpath.SubscribeAttitudeResponse]
+	subscribePosition             *connect.Client[flightpath.SubscribePositionRequest, flightpath.SubscribePositionResponse]
+	subscribeLocalPosition        *connect.Client[flightpath.SubscribeLocalPositionRequest, flightpath.SubscribeLocalPositionResponse]
+	subscribeBattery              *connect.Client[flightpath.SubscribeBatteryRequest, flightpath.SubscribeBatteryResponse]
+	subscribeFlightMetrics        *connect.Client[flightpath.SubscribeFlightMetricsRequest, flightpath.SubscribeFlightMetricsResponse]
+	subscribeLandedState          *connect.Client[flightpath.SubscribeLandedStateRequest, flightpath.SubscribeLandedStateResponse]
+	subscribeStatusText           *connect.Client[flightpath.SubscribeStatusTextRequest, flightpath.SubscribeStatusTextResponse]
+	getHomePosition               *connect.Client[flightpath.GetHomePositionRequest, flightpath.GetHomePositionResponse]
+	subscribeHomePosition         *connect.Client[flightpath.SubscribeHomePositionRequest, flightpath.SubscribeHomePositionResponse]
+	subscribeRcChannels           *connect.Client[flightpath.SubscribeRcChannelsRequest, flightpath.SubscribeRcChannelsResponse]
+	subscribeActuatorOutputs      *connect.Client[flightpath.SubscribeActuatorOutputsRequest, flightpath.SubscribeActuatorOutputsResponse]
+	subscribeEstimatorStatus      *connect.Client[flightpath.SubscribeEstimatorStatusRequest, flightpath.SubscribeEstimatorStatusResponse]
+	subscribeWind                 *connect.Client[flightpath.SubscribeWindRequest, flightpath.SubscribeWindResponse]
+	subscribeDistanceSensor       *connect.Client[flightpath.SubscribeDistanceSensorRequest, flightpath.SubscribeDistanceSensorResponse]
+	subscribeObstacleDistance     *connect.Client[flightpath.SubscribeObstacleDistanceRequest, flightpath.SubscribeObstacleDistanceResponse]
+	subscribeGpsReceivers         *connect.Client[flightpath.SubscribeGpsReceiversRequest, flightpath.SubscribeGpsReceiversResponse]
+	subscribeGpsStatus            *connect.Client[flightpath.SubscribeGpsStatusRequest, flightpath.SubscribeGpsStatusResponse]
+	subscribeGpsRtk               *connect.Client[flightpath.SubscribeGpsRtkRequest, flightpath.SubscribeGpsRtkResponse]
+	subscribeSystemStatus         *connect.Client[flightpath.SubscribeSystemStatusRequest, flightpath.SubscribeSystemStatusResponse]
+	subscribeTraffic              *connect.Client[flightpath.SubscribeTrafficRequest, flightpath.SubscribeTrafficResponse]
+	subscribeVehicleState         *connect.Client[flightpath.SubscribeVehicleStateRequest, flightpath.SubscribeVehicleStateResponse]
 }
 
 // SubscribeRawGps calls flightpath.TelemetryService.SubscribeRawGps.
@@ -74,10 +358,184 @@ func (c *telemetryServiceClient) SubscribeRawGps(ctx context.Context, req *conne
 	return c.subscribeRawGps.CallServerStream(ctx, req)
 }
 
+// SubscribeOrbitExecutionStatus calls flightpath.TelemetryService.SubscribeOrbitExecutionStatus.
+func (c *telemetryServiceClient) SubscribeOrbitExecutionStatus(ctx context.Context, req *connect.Request[flightpath.SubscribeOrbitExecutionStatusRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeOrbitExecutionStatusResponse], error) {
+	return c.subscribeOrbitExecutionStatus.CallServerStream(ctx, req)
+}
+
+// SubscribeAttitude calls flightpath.TelemetryService.SubscribeAttitude.
+func (c *telemetryServiceClient) SubscribeAttitude(ctx context.Context, req *connect.Request[flightpath.SubscribeAttitudeRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeAttitudeResponse], error) {
+	return c.subscribeAttitude.CallServerStream(ctx, req)
+}
+
+// SubscribePosition calls flightpath.TelemetryService.SubscribePosition.
+func (c *telemetryServiceClient) SubscribePosition(ctx context.Context, req *connect.Request[flightpath.SubscribePositionRequest]) (*connect.ServerStreamForClient[flightpath.SubscribePositionResponse], error) {
+	return c.subscribePosition.CallServerStream(ctx, req)
+}
+
+// SubscribeLocalPosition calls flightpath.TelemetryService.SubscribeLocalPosition.
+func (c *telemetryServiceClient) SubscribeLocalPosition(ctx context.Context, req *connect.Request[flightpath.SubscribeLocalPositionRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeLocalPositionResponse], error) {
+	return c.subscribeLocalPosition.CallServerStream(ctx, req)
+}
+
+// SubscribeBattery calls flightpath.TelemetryService.SubscribeBattery.
+func (c *telemetryServiceClient) SubscribeBattery(ctx context.Context, req *connect.Request[flightpath.SubscribeBatteryRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeBatteryResponse], error) {
+	return c.subscribeBattery.CallServerStream(ctx, req)
+}
+
+// SubscribeFlightMetrics calls flightpath.TelemetryService.SubscribeFlightMetrics.
+func (c *telemetryServiceClient) SubscribeFlightMetrics(ctx context.Context, req *connect.Request[flightpath.SubscribeFlightMetricsRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeFlightMetricsResponse], error) {
+	return c.subscribeFlightMetrics.CallServerStream(ctx, req)
+}
+
+// SubscribeLandedState calls flightpath.TelemetryService.SubscribeLandedState.
+func (c *telemetryServiceClient) SubscribeLandedState(ctx context.Context, req *connect.Request[flightpath.SubscribeLandedStateRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeLandedStateResponse], error) {
+	return c.subscribeLandedState.CallServerStream(ctx, req)
+}
+
+// SubscribeStatusText calls flightpath.TelemetryService.SubscribeStatusText.
+func (c *telemetryServiceClient) SubscribeStatusText(ctx context.Context, req *connect.Request[flightpath.SubscribeStatusTextRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeStatusTextResponse], error) {
+	return c.subscribeStatusText.CallServerStream(ctx, req)
+}
+
+// GetHomePosition calls flightpath.TelemetryService.GetHomePosition.
+func (c *telemetryServiceClient) GetHomePosition(ctx context.Context, req *connect.Request[flightpath.GetHomePositionRequest]) (*connect.Response[flightpath.GetHomePositionResponse], error) {
+	return c.getHomePosition.CallUnary(ctx, req)
+}
+
+// SubscribeHomePosition calls flightpath.TelemetryService.SubscribeHomePosition.
+func (c *telemetryServiceClient) SubscribeHomePosition(ctx context.Context, req *connect.Request[flightpath.SubscribeHomePositionRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeHomePositionResponse], error) {
+	return c.subscribeHomePosition.CallServerStream(ctx, req)
+}
+
+// SubscribeRcChannels calls flightpath.TelemetryService.SubscribeRcChannels.
+func (c *telemetryServiceClient) SubscribeRcChannels(ctx context.Context, req *connect.Request[flightpath.SubscribeRcChannelsRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeRcChannelsResponse], error) {
+	return c.subscribeRcChannels.CallServerStream(ctx, req)
+}
+
+// SubscribeActuatorOutputs calls flightpath.TelemetryService.SubscribeActuatorOutputs.
+func (c *telemetryServiceClient) SubscribeActuatorOutputs(ctx context.Context, req *connect.Request[flightpath.SubscribeActuatorOutputsRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeActuatorOutputsResponse], error) {
+	return c.subscribeActuatorOutputs.CallServerStream(ctx, req)
+}
+
+// SubscribeEstimatorStatus calls flightpath.TelemetryService.SubscribeEstimatorStatus.
+func (c *telemetryServiceClient) SubscribeEstimatorStatus(ctx context.Context, req *connect.Request[flightpath.SubscribeEstimatorStatusRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeEstimatorStatusResponse], error) {
+	return c.subscribeEstimatorStatus.CallServerStream(ctx, req)
+}
+
+// SubscribeWind calls flightpath.TelemetryService.SubscribeWind.
+func (c *telemetryServiceClient) SubscribeWind(ctx context.Context, req *connect.Request[flightpath.SubscribeWindRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeWindResponse], error) {
+	return c.subscribeWind.CallServerStream(ctx, req)
+}
+
+// SubscribeDistanceSensor calls flightpath.TelemetryService.SubscribeDistanceSensor.
+func (c *telemetryServiceClient) SubscribeDistanceSensor(ctx context.Context, req *connect.Request[flightpath.SubscribeDistanceSensorRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeDistanceSensorResponse], error) {
+	return c.subscribeDistanceSensor.CallServerStream(ctx, req)
+}
+
+// SubscribeObstacleDistance calls flightpath.TelemetryService.SubscribeObstacleDistance.
+func (c *telemetryServiceClient) SubscribeObstacleDistance(ctx context.Context, req *connect.Request[flightpath.SubscribeObstacleDistanceRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeObstacleDistanceResponse], error) {
+	return c.subscribeObstacleDistance.CallServerStream(ctx, req)
+}
+
+// SubscribeGpsReceivers calls flightpath.TelemetryService.SubscribeGpsReceivers.
+func (c *telemetryServiceClient) SubscribeGpsReceivers(ctx context.Context, req *connect.Request[flightpath.SubscribeGpsReceiversRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeGpsReceiversResponse], error) {
+	return c.subscribeGpsReceivers.CallServerStream(ctx, req)
+}
+
+// SubscribeGpsStatus calls flightpath.TelemetryService.SubscribeGpsStatus.
+func (c *telemetryServiceClient) SubscribeGpsStatus(ctx context.Context, req *connect.Request[flightpath.SubscribeGpsStatusRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeGpsStatusResponse], error) {
+	return c.subscribeGpsStatus.CallServerStream(ctx, req)
+}
+
+// SubscribeGpsRtk calls flightpath.TelemetryService.SubscribeGpsRtk.
+func (c *telemetryServiceClient) SubscribeGpsRtk(ctx context.Context, req *connect.Request[flightpath.SubscribeGpsRtkRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeGpsRtkResponse], error) {
+	return c.subscribeGpsRtk.CallServerStream(ctx, req)
+}
+
+// SubscribeSystemStatus calls flightpath.TelemetryService.SubscribeSystemStatus.
+func (c *telemetryServiceClient) SubscribeSystemStatus(ctx context.Context, req *connect.Request[flightpath.SubscribeSystemStatusRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeSystemStatusResponse], error) {
+	return c.subscribeSystemStatus.CallServerStream(ctx, req)
+}
+
+// SubscribeTraffic calls flightpath.TelemetryService.SubscribeTraffic.
+func (c *telemetryServiceClient) SubscribeTraffic(ctx context.Context, req *connect.Request[flightpath.SubscribeTrafficRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeTrafficResponse], error) {
+	return c.subscribeTraffic.CallServerStream(ctx, req)
+}
+
+// SubscribeVehicleState calls flightpath.TelemetryService.SubscribeVehicleState.
+func (c *telemetryServiceClient) SubscribeVehicleState(ctx context.Context, req *connect.Request[flightpath.SubscribeVehicleStateRequest]) (*connect.ServerStreamForClient[flightpath.SubscribeVehicleStateResponse], error) {
+	return c.subscribeVehicleState.CallServerStream(ctx, req)
+}
+
 // TelemetryServiceHandler is an implementation of the flightpath.TelemetryService service.
 type TelemetryServiceHandler interface {
 	// Subscribe to GPS_RAW_INT messages from the drone
 	SubscribeRawGps(context.Context, *connect.Request[flightpath.SubscribeRawGpsRequest], *connect.ServerStream[flightpath.SubscribeRawGpsResponse]) error
+	// Subscribe to ORBIT_EXECUTION_STATUS messages from the drone (sent while an orbit is in progress).
+	// An update with active = false is sent when the drone stops reporting an orbit.
+	SubscribeOrbitExecutionStatus(context.Context, *connect.Request[flightpath.SubscribeOrbitExecutionStatusRequest], *connect.ServerStream[flightpath.SubscribeOrbitExecutionStatusResponse]) error
+	// Subscribe to the attitude of the drone (ATTITUDE and ATTITUDE_QUATERNION messages).
+	// An update is sent whenever either message is received and carries the latest of both.
+	SubscribeAttitude(context.Context, *connect.Request[flightpath.SubscribeAttitudeRequest], *connect.ServerStream[flightpath.SubscribeAttitudeResponse]) error
+	// Subscribe to the fused position estimate of the drone (GLOBAL_POSITION_INT messages)
+	SubscribePosition(context.Context, *connect.Request[flightpath.SubscribePositionRequest], *connect.ServerStream[flightpath.SubscribePositionResponse]) error
+	// Subscribe to the local position of the drone (LOCAL_POSITION_NED and ODOMETRY messages).
+	// An update is sent whenever either message is received and carries the latest of both.
+	SubscribeLocalPosition(context.Context, *connect.Request[flightpath.SubscribeLocalPositionRequest], *connect.ServerStream[flightpath.SubscribeLocalPositionResponse]) error
+	// Subscribe to the state of every battery of the drone (BATTERY_STATUS messages, or SYS_STATUS
+	// for drones that do not send BATTERY_STATUS)
+	SubscribeBattery(context.Context, *connect.Request[flightpath.SubscribeBatteryRequest], *connect.ServerStream[flightpath.SubscribeBatteryResponse]) error
+	// Subscribe to VFR_HUD messages from the drone (airspeed, groundspeed, heading, throttle, altitude, climb rate)
+	SubscribeFlightMetrics(context.Context, *connect.Request[flightpath.SubscribeFlightMetricsRequest], *connect.ServerStream[flightpath.SubscribeFlightMetricsResponse]) error
+	// Subscribe to the landed state and VTOL state of the drone (EXTENDED_SYS_STATE messages).
+	// By default, an update is only sent when either state changes.
+	SubscribeLandedState(context.Context, *connect.Request[flightpath.SubscribeLandedStateRequest], *connect.ServerStream[flightpath.SubscribeLandedStateResponse]) error
+	// Subscribe to STATUSTEXT messages from the drone (e.g. preflight check failures and warnings).
+	// Messages split across several STATUSTEXT chunks are reassembled before being sent.
+	SubscribeStatusText(context.Context, *connect.Request[flightpath.SubscribeStatusTextRequest], *connect.ServerStream[flightpath.SubscribeStatusTextResponse]) error
+	// Get the home position of the drone (HOME_POSITION message).
+	// If no HOME_POSITION has been received yet, it is requested with MAV_CMD_REQUEST_MESSAGE.
+	GetHomePosition(context.Context, *connect.Request[flightpath.GetHomePositionRequest]) (*connect.Response[flightpath.GetHomePositionResponse], error)
+	// Subscribe to changes of the home position of the drone (HOME_POSITION messages).
+	// An update is sent for the first HOME_POSITION of every component, then only when it changes.
+	SubscribeHomePosition(context.Context, *connect.Request[flightpath.SubscribeHomePositionRequest], *connect.ServerStream[flightpath.SubscribeHomePositionResponse]) error
+	// Subscribe to the RC input of the drone (RC_CHANNELS messages)
+	SubscribeRcChannels(context.Context, *connect.Request[flightpath.SubscribeRcChannelsRequest], *connect.ServerStream[flightpath.SubscribeRcChannelsResponse]) error
+	// Subscribe to the servo/actuator outputs of the drone (SERVO_OUTPUT_RAW and ACTUATOR_OUTPUT_STATUS messages).
+	// An update is sent whenever either message is received and carries the latest of both.
+	SubscribeActuatorOutputs(context.Context, *connect.Request[flightpath.SubscribeActuatorOutputsRequest], *connect.ServerStream[flightpath.SubscribeActuatorOutputsResponse]) error
+	// Subscribe to the state estimator health of the drone (ESTIMATOR_STATUS and VIBRATION messages).
+	// An update is sent whenever either message is received and carries the latest of both.
+	SubscribeEstimatorStatus(context.Context, *connect.Request[flightpath.SubscribeEstimatorStatusRequest], *connect.ServerStream[flightpath.SubscribeEstimatorStatusResponse]) error
+	// Subscribe to the wind estimate of the drone (WIND_COV messages).
+	// The ArduPilot WIND message is not part of the common dialect and is not decoded.
+	SubscribeWind(context.Context, *connect.Request[flightpath.SubscribeWindRequest], *connect.ServerStream[flightpath.SubscribeWindResponse]) error
+	// Subscribe to the readings of the distance sensors of the drone (DISTANCE_SENSOR messages).
+	// Every sensor sends its own messages, identified by component and sensor ID.
+	SubscribeDistanceSensor(context.Context, *connect.Request[flightpath.SubscribeDistanceSensorRequest], *connect.ServerStream[flightpath.SubscribeDistanceSensorResponse]) error
+	// Subscribe to the obstacles detected around the drone (OBSTACLE_DISTANCE messages)
+	SubscribeObstacleDistance(context.Context, *connect.Request[flightpath.SubscribeObstacleDistanceRequest], *connect.ServerStream[flightpath.SubscribeObstacleDistanceResponse]) error
+	// Subscribe to the raw readings of the GPS receivers of the drone (GPS_RAW_INT for the first
+	// receiver, GPS2_RAW for the second). Every update carries the instance of the receiver it came from.
+	SubscribeGpsReceivers(context.Context, *connect.Request[flightpath.SubscribeGpsReceiversRequest], *connect.ServerStream[flightpath.SubscribeGpsReceiversResponse]) error
+	// Subscribe to the satellites seen by the GPS receiver of the drone (GPS_STATUS messages).
+	// GPS_STATUS does not identify the receiver it describes.
+	SubscribeGpsStatus(context.Context, *connect.Request[flightpath.SubscribeGpsStatusRequest], *connect.ServerStream[flightpath.SubscribeGpsStatusResponse]) error
+	// Subscribe to the RTK status of the GPS receivers of the drone (GPS_RTK for the first receiver,
+	// GPS2_RTK for the second). Every update carries the instance of the receiver it came from.
+	SubscribeGpsRtk(context.Context, *connect.Request[flightpath.SubscribeGpsRtkRequest], *connect.ServerStream[flightpath.SubscribeGpsRtkResponse]) error
+	// Subscribe to the onboard sensor health, CPU load and communication drop rate of the drone
+	// (SYS_STATUS messages)
+	SubscribeSystemStatus(context.Context, *connect.Request[flightpath.SubscribeSystemStatusRequest], *connect.ServerStream[flightpath.SubscribeSystemStatusResponse]) error
+	// Subscribe to the aircraft reported by the ADS-B receiver of the drone (ADSB_VEHICLE messages),
+	// with their separation from and closing rate to the drone. When an aircraft is no longer reported
+	// for a while, a final update with expired = true is sent.
+	SubscribeTraffic(context.Context, *connect.Request[flightpath.SubscribeTrafficRequest], *connect.ServerStream[flightpath.SubscribeTrafficResponse]) error
+	// Subscribe to a snapshot of the state of every drone (heartbeat, position, attitude, batteries,
+	// GPS, landed state and flight mode), sent at a fixed rate regardless of how fast the underlying
+	// MAVLink messages arrive.
+	SubscribeVehicleState(context.Context, *connect.Request[flightpath.SubscribeVehicleStateRequest], *connect.ServerStream[flightpath.SubscribeVehicleStateResponse]) error
 }
 
 // NewTelemetryServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -93,10 +551,186 @@ func NewTelemetryServiceHandler(svc TelemetryServiceHandler, opts ...connect.Han
 		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeRawGps")),
 		connect.WithHandlerOptions(opts...),
 	)
+	telemetryServiceSubscribeOrbitExecutionStatusHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeOrbitExecutionStatusProcedure,
+		svc.SubscribeOrbitExecutionStatus,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeOrbitExecutionStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeAttitudeHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeAttitudeProcedure,
+		svc.SubscribeAttitude,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeAttitude")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribePositionHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribePositionProcedure,
+		svc.SubscribePosition,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribePosition")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeLocalPositionHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeLocalPositionProcedure,
+		svc.SubscribeLocalPosition,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeLocalPosition")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeBatteryHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeBatteryProcedure,
+		svc.SubscribeBattery,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeBattery")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeFlightMetricsHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeFlightMetricsProcedure,
+		svc.SubscribeFlightMetrics,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeFlightMetrics")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeLandedStateHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeLandedStateProcedure,
+		svc.SubscribeLandedState,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeLandedState")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeStatusTextHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeStatusTextProcedure,
+		svc.SubscribeStatusText,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeStatusText")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceGetHomePositionHandler := connect.NewUnaryHandler(
+		TelemetryServiceGetHomePositionProcedure,
+		svc.GetHomePosition,
+		connect.WithSchema(telemetryServiceMethods.ByName("GetHomePosition")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeHomePositionHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeHomePositionProcedure,
+		svc.SubscribeHomePosition,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeHomePosition")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeRcChannelsHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeRcChannelsProcedure,
+		svc.SubscribeRcChannels,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeRcChannels")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeActuatorOutputsHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeActuatorOutputsProcedure,
+		svc.SubscribeActuatorOutputs,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeActuatorOutputs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeEstimatorStatusHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeEstimatorStatusProcedure,
+		svc.SubscribeEstimatorStatus,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeEstimatorStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeWindHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeWindProcedure,
+		svc.SubscribeWind,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeWind")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeDistanceSensorHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeDistanceSensorProcedure,
+		svc.SubscribeDistanceSensor,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeDistanceSensor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeObstacleDistanceHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeObstacleDistanceProcedure,
+		svc.SubscribeObstacleDistance,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeObstacleDistance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeGpsReceiversHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeGpsReceiversProcedure,
+		svc.SubscribeGpsReceivers,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeGpsReceivers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeGpsStatusHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeGpsStatusProcedure,
+		svc.SubscribeGpsStatus,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeGpsStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeGpsRtkHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeGpsRtkProcedure,
+		svc.SubscribeGpsRtk,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeGpsRtk")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeSystemStatusHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeSystemStatusProcedure,
+		svc.SubscribeSystemStatus,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeSystemStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeTrafficHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeTrafficProcedure,
+		svc.SubscribeTraffic,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeTraffic")),
+		connect.WithHandlerOptions(opts...),
+	)
+	telemetryServiceSubscribeVehicleStateHandler := connect.NewServerStreamHandler(
+		TelemetryServiceSubscribeVehicleStateProcedure,
+		svc.SubscribeVehicleState,
+		connect.WithSchema(telemetryServiceMethods.ByName("SubscribeVehicleState")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/flightpath.TelemetryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TelemetryServiceSubscribeRawGpsProcedure:
 			telemetryServiceSubscribeRawGpsHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeOrbitExecutionStatusProcedure:
+			telemetryServiceSubscribeOrbitExecutionStatusHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeAttitudeProcedure:
+			telemetryServiceSubscribeAttitudeHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribePositionProcedure:
+			telemetryServiceSubscribePositionHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeLocalPositionProcedure:
+			telemetryServiceSubscribeLocalPositionHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeBatteryProcedure:
+			telemetryServiceSubscribeBatteryHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeFlightMetricsProcedure:
+			telemetryServiceSubscribeFlightMetricsHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeLandedStateProcedure:
+			telemetryServiceSubscribeLandedStateHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeStatusTextProcedure:
+			telemetryServiceSubscribeStatusTextHandler.ServeHTTP(w, r)
+		case TelemetryServiceGetHomePositionProcedure:
+			telemetryServiceGetHomePositionHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeHomePositionProcedure:
+			telemetryServiceSubscribeHomePositionHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeRcChannelsProcedure:
+			telemetryServiceSubscribeRcChannelsHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeActuatorOutputsProcedure:
+			telemetryServiceSubscribeActuatorOutputsHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeEstimatorStatusProcedure:
+			telemetryServiceSubscribeEstimatorStatusHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeWindProcedure:
+			telemetryServiceSubscribeWindHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeDistanceSensorProcedure:
+			telemetryServiceSubscribeDistanceSensorHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeObstacleDistanceProcedure:
+			telemetryServiceSubscribeObstacleDistanceHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeGpsReceiversProcedure:
+			telemetryServiceSubscribeGpsReceiversHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeGpsStatusProcedure:
+			telemetryServiceSubscribeGpsStatusHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeGpsRtkProcedure:
+			telemetryServiceSubscribeGpsRtkHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeSystemStatusProcedure:
+			telemetryServiceSubscribeSystemStatusHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeTrafficProcedure:
+			telemetryServiceSubscribeTrafficHandler.ServeHTTP(w, r)
+		case TelemetryServiceSubscribeVehicleStateProcedure:
+			telemetryServiceSubscribeVehicleStateHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -109,3 +743,91 @@ type UnimplementedTelemetryServiceHandler struct{}
 func (UnimplementedTelemetryServiceHandler) SubscribeRawGps(context.Context, *connect.Request[flightpath.SubscribeRawGpsRequest], *connect.ServerStream[flightpath.SubscribeRawGpsResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeRawGps is not implemented"))
 }
+
+func (UnimplementedTelemetryServiceHandler) SubscribeOrbitExecutionStatus(context.Context, *connect.Request[flightpath.SubscribeOrbitExecutionStatusRequest], *connect.ServerStream[flightpath.SubscribeOrbitExecutionStatusResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeOrbitExecutionStatus is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeAttitude(context.Context, *connect.Request[flightpath.SubscribeAttitudeRequest], *connect.ServerStream[flightpath.SubscribeAttitudeResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeAttitude is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribePosition(context.Context, *connect.Request[flightpath.SubscribePositionRequest], *connect.ServerStream[flightpath.SubscribePositionResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribePosition is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeLocalPosition(context.Context, *connect.Request[flightpath.SubscribeLocalPositionRequest], *connect.ServerStream[flightpath.SubscribeLocalPositionResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeLocalPosition is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeBattery(context.Context, *connect.Request[flightpath.SubscribeBatteryRequest], *connect.ServerStream[flightpath.SubscribeBatteryResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeBattery is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeFlightMetrics(context.Context, *connect.Request[flightpath.SubscribeFlightMetricsRequest], *connect.ServerStream[flightpath.SubscribeFlightMetricsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeFlightMetrics is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeLandedState(context.Context, *connect.Request[flightpath.SubscribeLandedStateRequest], *connect.ServerStream[flightpath.SubscribeLandedStateResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeLandedState is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeStatusText(context.Context, *connect.Request[flightpath.SubscribeStatusTextRequest], *connect.ServerStream[flightpath.SubscribeStatusTextResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeStatusText is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) GetHomePosition(context.Context, *connect.Request[flightpath.GetHomePositionRequest]) (*connect.Response[flightpath.GetHomePositionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.GetHomePosition is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeHomePosition(context.Context, *connect.Request[flightpath.SubscribeHomePositionRequest], *connect.ServerStream[flightpath.SubscribeHomePositionResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeHomePosition is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeRcChannels(context.Context, *connect.Request[flightpath.SubscribeRcChannelsRequest], *connect.ServerStream[flightpath.SubscribeRcChannelsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeRcChannels is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeActuatorOutputs(context.Context, *connect.Request[flightpath.SubscribeActuatorOutputsRequest], *connect.ServerStream[flightpath.SubscribeActuatorOutputsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeActuatorOutputs is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeEstimatorStatus(context.Context, *connect.Request[flightpath.SubscribeEstimatorStatusRequest], *connect.ServerStream[flightpath.SubscribeEstimatorStatusResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeEstimatorStatus is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeWind(context.Context, *connect.Request[flightpath.SubscribeWindRequest], *connect.ServerStream[flightpath.SubscribeWindResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeWind is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeDistanceSensor(context.Context, *connect.Request[flightpath.SubscribeDistanceSensorRequest], *connect.ServerStream[flightpath.SubscribeDistanceSensorResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeDistanceSensor is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeObstacleDistance(context.Context, *connect.Request[flightpath.SubscribeObstacleDistanceRequest], *connect.ServerStream[flightpath.SubscribeObstacleDistanceResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeObstacleDistance is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeGpsReceivers(context.Context, *connect.Request[flightpath.SubscribeGpsReceiversRequest], *connect.ServerStream[flightpath.SubscribeGpsReceiversResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeGpsReceivers is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeGpsStatus(context.Context, *connect.Request[flightpath.SubscribeGpsStatusRequest], *connect.ServerStream[flightpath.SubscribeGpsStatusResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeGpsStatus is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeGpsRtk(context.Context, *connect.Request[flightpath.SubscribeGpsRtkRequest], *connect.ServerStream[flightpath.SubscribeGpsRtkResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeGpsRtk is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeSystemStatus(context.Context, *connect.Request[flightpath.SubscribeSystemStatusRequest], *connect.ServerStream[flightpath.SubscribeSystemStatusResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeSystemStatus is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeTraffic(context.Context, *connect.Request[flightpath.SubscribeTrafficRequest], *connect.ServerStream[flightpath.SubscribeTrafficResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeTraffic is not implemented"))
+}
+
+func (UnimplementedTelemetryServiceHandler) SubscribeVehicleState(context.Context, *connect.Request[flightpath.SubscribeVehicleStateRequest], *connect.ServerStream[flightpath.SubscribeVehicleStateResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("flightpath.TelemetryService.SubscribeVehicleState is not implemented"))
+}
@@ -21,6 +21,216 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// BatterySource is the MAVLink message a battery state was decoded from
+type BatterySource int32
+
+const (
+	BatterySource_BATTERY_SOURCE_UNSPECIFIED BatterySource = 0
+	// BATTERY_STATUS message
+	BatterySource_BATTERY_SOURCE_BATTERY_STATUS BatterySource = 1
+	// SYS_STATUS message (only voltage, current and remaining charge are known)
+	BatterySource_BATTERY_SOURCE_SYS_STATUS BatterySource = 2
+)
+
+// Enum value maps for BatterySource.
+var (
+	BatterySource_name = map[int32]string{
+		0: "BATTERY_SOURCE_UNSPECIFIED",
+		1: "BATTERY_SOURCE_BATTERY_STATUS",
+		2: "BATTERY_SOURCE_SYS_STATUS",
+	}
+	BatterySource_value = map[string]int32{
+		"BATTERY_SOURCE_UNSPECIFIED":    0,
+		"BATTERY_SOURCE_BATTERY_STATUS": 1,
+		"BATTERY_SOURCE_SYS_STATUS":     2,
+	}
+)
+
+func (x BatterySource) Enum() *BatterySource {
+	p := new(BatterySource)
+	*p = x
+	return p
+}
+
+func (x BatterySource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatterySource) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_telemetry_proto_enumTypes[0].Descriptor()
+}
+
+func (BatterySource) Type() protoreflect.EnumType {
+	return &file_flightpath_telemetry_proto_enumTypes[0]
+}
+
+func (x BatterySource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatterySource.Descriptor instead.
+func (BatterySource) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{0}
+}
+
+// AdsbAltitudeType represents ADS-B altitude types from MAVLink ADSB_ALTITUDE_TYPE enum
+// All values are incremented by 1 to accommodate ADSB_ALTITUDE_TYPE_UNSPECIFIED
+type AdsbAltitudeType int32
+
+const (
+	AdsbAltitudeType_ADSB_ALTITUDE_TYPE_UNSPECIFIED AdsbAltitudeType = 0
+	// Altitude reported from a Baro source using QNH reference
+	AdsbAltitudeType_ADSB_ALTITUDE_TYPE_PRESSURE_QNH AdsbAltitudeType = 1
+	// Altitude reported from a GNSS source
+	AdsbAltitudeType_ADSB_ALTITUDE_TYPE_GEOMETRIC AdsbAltitudeType = 2
+)
+
+// Enum value maps for AdsbAltitudeType.
+var (
+	AdsbAltitudeType_name = map[int32]string{
+		0: "ADSB_ALTITUDE_TYPE_UNSPECIFIED",
+		1: "ADSB_ALTITUDE_TYPE_PRESSURE_QNH",
+		2: "ADSB_ALTITUDE_TYPE_GEOMETRIC",
+	}
+	AdsbAltitudeType_value = map[string]int32{
+		"ADSB_ALTITUDE_TYPE_UNSPECIFIED":  0,
+		"ADSB_ALTITUDE_TYPE_PRESSURE_QNH": 1,
+		"ADSB_ALTITUDE_TYPE_GEOMETRIC":    2,
+	}
+)
+
+func (x AdsbAltitudeType) Enum() *AdsbAltitudeType {
+	p := new(AdsbAltitudeType)
+	*p = x
+	return p
+}
+
+func (x AdsbAltitudeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdsbAltitudeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_telemetry_proto_enumTypes[1].Descriptor()
+}
+
+func (AdsbAltitudeType) Type() protoreflect.EnumType {
+	return &file_flightpath_telemetry_proto_enumTypes[1]
+}
+
+func (x AdsbAltitudeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdsbAltitudeType.Descriptor instead.
+func (AdsbAltitudeType) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{1}
+}
+
+// AdsbEmitterType represents ADS-B emitter types from MAVLink ADSB_EMITTER_TYPE enum
+// All values are incremented by 1 to accommodate ADSB_EMITTER_TYPE_UNSPECIFIED
+type AdsbEmitterType int32
+
+const (
+	AdsbEmitterType_ADSB_EMITTER_TYPE_UNSPECIFIED       AdsbEmitterType = 0
+	AdsbEmitterType_ADSB_EMITTER_TYPE_NO_INFO           AdsbEmitterType = 1
+	AdsbEmitterType_ADSB_EMITTER_TYPE_LIGHT             AdsbEmitterType = 2
+	AdsbEmitterType_ADSB_EMITTER_TYPE_SMALL             AdsbEmitterType = 3
+	AdsbEmitterType_ADSB_EMITTER_TYPE_LARGE             AdsbEmitterType = 4
+	AdsbEmitterType_ADSB_EMITTER_TYPE_HIGH_VORTEX_LARGE AdsbEmitterType = 5
+	AdsbEmitterType_ADSB_EMITTER_TYPE_HEAVY             AdsbEmitterType = 6
+	AdsbEmitterType_ADSB_EMITTER_TYPE_HIGHLY_MANUV      AdsbEmitterType = 7
+	AdsbEmitterType_ADSB_EMITTER_TYPE_ROTOCRAFT         AdsbEmitterType = 8
+	AdsbEmitterType_ADSB_EMITTER_TYPE_UNASSIGNED        AdsbEmitterType = 9
+	AdsbEmitterType_ADSB_EMITTER_TYPE_GLIDER            AdsbEmitterType = 10
+	AdsbEmitterType_ADSB_EMITTER_TYPE_LIGHTER_AIR       AdsbEmitterType = 11
+	AdsbEmitterType_ADSB_EMITTER_TYPE_PARACHUTE         AdsbEmitterType = 12
+	AdsbEmitterType_ADSB_EMITTER_TYPE_ULTRA_LIGHT       AdsbEmitterType = 13
+	AdsbEmitterType_ADSB_EMITTER_TYPE_UNASSIGNED2       AdsbEmitterType = 14
+	AdsbEmitterType_ADSB_EMITTER_TYPE_UAV               AdsbEmitterType = 15
+	AdsbEmitterType_ADSB_EMITTER_TYPE_SPACE             AdsbEmitterType = 16
+	AdsbEmitterType_ADSB_EMITTER_TYPE_UNASSGINED3       AdsbEmitterType = 17
+	AdsbEmitterType_ADSB_EMITTER_TYPE_EMERGENCY_SURFACE AdsbEmitterType = 18
+	AdsbEmitterType_ADSB_EMITTER_TYPE_SERVICE_SURFACE   AdsbEmitterType = 19
+	AdsbEmitterType_ADSB_EMITTER_TYPE_POINT_OBSTACLE    AdsbEmitterType = 20
+)
+
+// Enum value maps for AdsbEmitterType.
+var (
+	AdsbEmitterType_name = map[int32]string{
+		0:  "ADSB_EMITTER_TYPE_UNSPECIFIED",
+		1:  "ADSB_EMITTER_TYPE_NO_INFO",
+		2:  "ADSB_EMITTER_TYPE_LIGHT",
+		3:  "ADSB_EMITTER_TYPE_SMALL",
+		4:  "ADSB_EMITTER_TYPE_LARGE",
+		5:  "ADSB_EMITTER_TYPE_HIGH_VORTEX_LARGE",
+		6:  "ADSB_EMITTER_TYPE_HEAVY",
+		7:  "ADSB_EMITTER_TYPE_HIGHLY_MANUV",
+		8:  "ADSB_EMITTER_TYPE_ROTOCRAFT",
+		9:  "ADSB_EMITTER_TYPE_UNASSIGNED",
+		10: "ADSB_EMITTER_TYPE_GLIDER",
+		11: "ADSB_EMITTER_TYPE_LIGHTER_AIR",
+		12: "ADSB_EMITTER_TYPE_PARACHUTE",
+		13: "ADSB_EMITTER_TYPE_ULTRA_LIGHT",
+		14: "ADSB_EMITTER_TYPE_UNASSIGNED2",
+		15: "ADSB_EMITTER_TYPE_UAV",
+		16: "ADSB_EMITTER_TYPE_SPACE",
+		17: "ADSB_EMITTER_TYPE_UNASSGINED3",
+		18: "ADSB_EMITTER_TYPE_EMERGENCY_SURFACE",
+		19: "ADSB_EMITTER_TYPE_SERVICE_SURFACE",
+		20: "ADSB_EMITTER_TYPE_POINT_OBSTACLE",
+	}
+	AdsbEmitterType_value = map[string]int32{
+		"ADSB_EMITTER_TYPE_UNSPECIFIED":       0,
+		"ADSB_EMITTER_TYPE_NO_INFO":           1,
+		"ADSB_EMITTER_TYPE_LIGHT":             2,
+		"ADSB_EMITTER_TYPE_SMALL":             3,
+		"ADSB_EMITTER_TYPE_LARGE":             4,
+		"ADSB_EMITTER_TYPE_HIGH_VORTEX_LARGE": 5,
+		"ADSB_EMITTER_TYPE_HEAVY":             6,
+		"ADSB_EMITTER_TYPE_HIGHLY_MANUV":      7,
+		"ADSB_EMITTER_TYPE_ROTOCRAFT":         8,
+		"ADSB_EMITTER_TYPE_UNASSIGNED":        9,
+		"ADSB_EMITTER_TYPE_GLIDER":            10,
+		"ADSB_EMITTER_TYPE_LIGHTER_AIR":       11,
+		"ADSB_EMITTER_TYPE_PARACHUTE":         12,
+		"ADSB_EMITTER_TYPE_ULTRA_LIGHT":       13,
+		"ADSB_EMITTER_TYPE_UNASSIGNED2":       14,
+		"ADSB_EMITTER_TYPE_UAV":               15,
+		"ADSB_EMITTER_TYPE_SPACE":             16,
+		"ADSB_EMITTER_TYPE_UNASSGINED3":       17,
+		"ADSB_EMITTER_TYPE_EMERGENCY_SURFACE": 18,
+		"ADSB_EMITTER_TYPE_SERVICE_SURFACE":   19,
+		"ADSB_EMITTER_TYPE_POINT_OBSTACLE":    20,
+	}
+)
+
+func (x AdsbEmitterType) Enum() *AdsbEmitterType {
+	p := new(AdsbEmitterType)
+	*p = x
+	return p
+}
+
+func (x AdsbEmitterType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdsbEmitterType) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_telemetry_proto_enumTypes[2].Descriptor()
+}
+
+func (AdsbEmitterType) Type() protoreflect.EnumType {
+	return &file_flightpath_telemetry_proto_enumTypes[2]
+}
+
+func (x AdsbEmitterType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdsbEmitterType.Descriptor instead.
+func (AdsbEmitterType) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{2}
+}
+
 // GpsFixType represents GPS fix types from MAVLink GPS_FIX_TYPE enum
 // All values are incremented by 1 to accommodate GPS_FIX_TYPE_UNSPECIFIED
 type GpsFixType int32
@@ -77,11 +287,11 @@ func (x GpsFixType) String() string {
 }
 
 func (GpsFixType) Descriptor() protoreflect.EnumDescriptor {
-	return file_flightpath_telemetry_proto_enumTypes[0].Descriptor()
+	return file_flightpath_telemetry_proto_enumTypes[3].Descriptor()
 }
 
 func (GpsFixType) Type() protoreflect.EnumType {
-	return &file_flightpath_telemetry_proto_enumTypes[0]
+	return &file_flightpath_telemetry_proto_enumTypes[3]
 }
 
 func (x GpsFixType) Number() protoreflect.EnumNumber {
@@ -90,175 +300,8799 @@ func (x GpsFixType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GpsFixType.Descriptor instead.
 func (GpsFixType) EnumDescriptor() ([]byte, []int) {
-	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{0}
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{3}
 }
 
-// SubscribeRawGpsRequest is the request message for SubscribeRawGps
-type SubscribeRawGpsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// MavSensorOrientation represents sensor orientations from MAVLink MAV_SENSOR_ORIENTATION enum
+// All values are incremented by 1 to accommodate MAV_SENSOR_ORIENTATION_UNSPECIFIED
+type MavSensorOrientation int32
+
+const (
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_UNSPECIFIED MavSensorOrientation = 0
+	// Roll: 0, Pitch: 0, Yaw: 0
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_NONE MavSensorOrientation = 1
+	// Roll: 0, Pitch: 0, Yaw: 45
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_YAW_45 MavSensorOrientation = 2
+	// Roll: 0, Pitch: 0, Yaw: 90
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_YAW_90 MavSensorOrientation = 3
+	// Roll: 0, Pitch: 0, Yaw: 135
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_YAW_135 MavSensorOrientation = 4
+	// Roll: 0, Pitch: 0, Yaw: 180
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_YAW_180 MavSensorOrientation = 5
+	// Roll: 0, Pitch: 0, Yaw: 225
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_YAW_225 MavSensorOrientation = 6
+	// Roll: 0, Pitch: 0, Yaw: 270
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_YAW_270 MavSensorOrientation = 7
+	// Roll: 0, Pitch: 0, Yaw: 315
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_YAW_315 MavSensorOrientation = 8
+	// Roll: 180, Pitch: 0, Yaw: 0
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180 MavSensorOrientation = 9
+	// Roll: 180, Pitch: 0, Yaw: 45
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_45 MavSensorOrientation = 10
+	// Roll: 180, Pitch: 0, Yaw: 90
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_90 MavSensorOrientation = 11
+	// Roll: 180, Pitch: 0, Yaw: 135
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_135 MavSensorOrientation = 12
+	// Roll: 0, Pitch: 180, Yaw: 0
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_PITCH_180 MavSensorOrientation = 13
+	// Roll: 180, Pitch: 0, Yaw: 225
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_225 MavSensorOrientation = 14
+	// Roll: 180, Pitch: 0, Yaw: 270
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_270 MavSensorOrientation = 15
+	// Roll: 180, Pitch: 0, Yaw: 315
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_315 MavSensorOrientation = 16
+	// Roll: 90, Pitch: 0, Yaw: 0
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90 MavSensorOrientation = 17
+	// Roll: 90, Pitch: 0, Yaw: 45
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_45 MavSensorOrientation = 18
+	// Roll: 90, Pitch: 0, Yaw: 90
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_90 MavSensorOrientation = 19
+	// Roll: 90, Pitch: 0, Yaw: 135
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_135 MavSensorOrientation = 20
+	// Roll: 270, Pitch: 0, Yaw: 0
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270 MavSensorOrientation = 21
+	// Roll: 270, Pitch: 0, Yaw: 45
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_YAW_45 MavSensorOrientation = 22
+	// Roll: 270, Pitch: 0, Yaw: 90
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_YAW_90 MavSensorOrientation = 23
+	// Roll: 270, Pitch: 0, Yaw: 135
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_YAW_135 MavSensorOrientation = 24
+	// Roll: 0, Pitch: 90, Yaw: 0
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_PITCH_90 MavSensorOrientation = 25
+	// Roll: 0, Pitch: 270, Yaw: 0
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_PITCH_270 MavSensorOrientation = 26
+	// Roll: 0, Pitch: 180, Yaw: 90
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_PITCH_180_YAW_90 MavSensorOrientation = 27
+	// Roll: 0, Pitch: 180, Yaw: 270
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_PITCH_180_YAW_270 MavSensorOrientation = 28
+	// Roll: 90, Pitch: 90, Yaw: 0
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_90 MavSensorOrientation = 29
+	// Roll: 180, Pitch: 90, Yaw: 0
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_PITCH_90 MavSensorOrientation = 30
+	// Roll: 270, Pitch: 90, Yaw: 0
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_PITCH_90 MavSensorOrientation = 31
+	// Roll: 90, Pitch: 180, Yaw: 0
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_180 MavSensorOrientation = 32
+	// Roll: 270, Pitch: 180, Yaw: 0
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_PITCH_180 MavSensorOrientation = 33
+	// Roll: 90, Pitch: 270, Yaw: 0
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_270 MavSensorOrientation = 34
+	// Roll: 180, Pitch: 270, Yaw: 0
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_PITCH_270 MavSensorOrientation = 35
+	// Roll: 270, Pitch: 270, Yaw: 0
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_PITCH_270 MavSensorOrientation = 36
+	// Roll: 90, Pitch: 180, Yaw: 90
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_180_YAW_90 MavSensorOrientation = 37
+	// Roll: 90, Pitch: 0, Yaw: 270
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_270 MavSensorOrientation = 38
+	// Roll: 90, Pitch: 68, Yaw: 293
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_68_YAW_293 MavSensorOrientation = 39
+	// Pitch: 315
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_PITCH_315 MavSensorOrientation = 40
+	// Roll: 90, Pitch: 315
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_315 MavSensorOrientation = 41
+	// Custom orientation
+	MavSensorOrientation_MAV_SENSOR_ORIENTATION_ROTATION_CUSTOM MavSensorOrientation = 101
+)
+
+// Enum value maps for MavSensorOrientation.
+var (
+	MavSensorOrientation_name = map[int32]string{
+		0:   "MAV_SENSOR_ORIENTATION_UNSPECIFIED",
+		1:   "MAV_SENSOR_ORIENTATION_ROTATION_NONE",
+		2:   "MAV_SENSOR_ORIENTATION_ROTATION_YAW_45",
+		3:   "MAV_SENSOR_ORIENTATION_ROTATION_YAW_90",
+		4:   "MAV_SENSOR_ORIENTATION_ROTATION_YAW_135",
+		5:   "MAV_SENSOR_ORIENTATION_ROTATION_YAW_180",
+		6:   "MAV_SENSOR_ORIENTATION_ROTATION_YAW_225",
+		7:   "MAV_SENSOR_ORIENTATION_ROTATION_YAW_270",
+		8:   "MAV_SENSOR_ORIENTATION_ROTATION_YAW_315",
+		9:   "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180",
+		10:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_45",
+		11:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_90",
+		12:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_135",
+		13:  "MAV_SENSOR_ORIENTATION_ROTATION_PITCH_180",
+		14:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_225",
+		15:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_270",
+		16:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_315",
+		17:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90",
+		18:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_45",
+		19:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_90",
+		20:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_135",
+		21:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270",
+		22:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_YAW_45",
+		23:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_YAW_90",
+		24:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_YAW_135",
+		25:  "MAV_SENSOR_ORIENTATION_ROTATION_PITCH_90",
+		26:  "MAV_SENSOR_ORIENTATION_ROTATION_PITCH_270",
+		27:  "MAV_SENSOR_ORIENTATION_ROTATION_PITCH_180_YAW_90",
+		28:  "MAV_SENSOR_ORIENTATION_ROTATION_PITCH_180_YAW_270",
+		29:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_90",
+		30:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_PITCH_90",
+		31:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_PITCH_90",
+		32:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_180",
+		33:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_PITCH_180",
+		34:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_270",
+		35:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_PITCH_270",
+		36:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_PITCH_270",
+		37:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_180_YAW_90",
+		38:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_270",
+		39:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_68_YAW_293",
+		40:  "MAV_SENSOR_ORIENTATION_ROTATION_PITCH_315",
+		41:  "MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_315",
+		101: "MAV_SENSOR_ORIENTATION_ROTATION_CUSTOM",
+	}
+	MavSensorOrientation_value = map[string]int32{
+		"MAV_SENSOR_ORIENTATION_UNSPECIFIED":                       0,
+		"MAV_SENSOR_ORIENTATION_ROTATION_NONE":                     1,
+		"MAV_SENSOR_ORIENTATION_ROTATION_YAW_45":                   2,
+		"MAV_SENSOR_ORIENTATION_ROTATION_YAW_90":                   3,
+		"MAV_SENSOR_ORIENTATION_ROTATION_YAW_135":                  4,
+		"MAV_SENSOR_ORIENTATION_ROTATION_YAW_180":                  5,
+		"MAV_SENSOR_ORIENTATION_ROTATION_YAW_225":                  6,
+		"MAV_SENSOR_ORIENTATION_ROTATION_YAW_270":                  7,
+		"MAV_SENSOR_ORIENTATION_ROTATION_YAW_315":                  8,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180":                 9,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_45":          10,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_90":          11,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_135":         12,
+		"MAV_SENSOR_ORIENTATION_ROTATION_PITCH_180":                13,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_225":         14,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_270":         15,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_315":         16,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90":                  17,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_45":           18,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_90":           19,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_135":          20,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270":                 21,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_YAW_45":          22,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_YAW_90":          23,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_YAW_135":         24,
+		"MAV_SENSOR_ORIENTATION_ROTATION_PITCH_90":                 25,
+		"MAV_SENSOR_ORIENTATION_ROTATION_PITCH_270":                26,
+		"MAV_SENSOR_ORIENTATION_ROTATION_PITCH_180_YAW_90":         27,
+		"MAV_SENSOR_ORIENTATION_ROTATION_PITCH_180_YAW_270":        28,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_90":         29,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_PITCH_90":        30,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_PITCH_90":        31,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_180":        32,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_PITCH_180":       33,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_270":        34,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_PITCH_270":       35,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_PITCH_270":       36,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_180_YAW_90": 37,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_270":          38,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_68_YAW_293": 39,
+		"MAV_SENSOR_ORIENTATION_ROTATION_PITCH_315":                40,
+		"MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_315":        41,
+		"MAV_SENSOR_ORIENTATION_ROTATION_CUSTOM":                   101,
+	}
+)
+
+func (x MavSensorOrientation) Enum() *MavSensorOrientation {
+	p := new(MavSensorOrientation)
+	*p = x
+	return p
 }
 
-func (x *SubscribeRawGpsRequest) Reset() {
-	*x = SubscribeRawGpsRequest{}
-	mi := &file_flightpath_telemetry_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x MavSensorOrientation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *SubscribeRawGpsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (MavSensorOrientation) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_telemetry_proto_enumTypes[4].Descriptor()
 }
 
-func (*SubscribeRawGpsRequest) ProtoMessage() {}
+func (MavSensorOrientation) Type() protoreflect.EnumType {
+	return &file_flightpath_telemetry_proto_enumTypes[4]
+}
 
-func (x *SubscribeRawGpsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_flightpath_telemetry_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x MavSensorOrientation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MavSensorOrientation.Descriptor instead.
+func (MavSensorOrientation) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{4}
+}
+
+// MavSeverity represents message severities from MAVLink MAV_SEVERITY enum (RFC-5424)
+// All values are incremented by 1 to accommodate MAV_SEVERITY_UNSPECIFIED
+// Lower values are more severe.
+type MavSeverity int32
+
+const (
+	MavSeverity_MAV_SEVERITY_UNSPECIFIED MavSeverity = 0
+	// System is unusable. This is a "panic" condition.
+	MavSeverity_MAV_SEVERITY_EMERGENCY MavSeverity = 1
+	// Action should be taken immediately. Indicates error in non-critical systems.
+	MavSeverity_MAV_SEVERITY_ALERT MavSeverity = 2
+	// Action must be taken immediately. Indicates failure in a primary system.
+	MavSeverity_MAV_SEVERITY_CRITICAL MavSeverity = 3
+	// Indicates an error in secondary/redundant systems
+	MavSeverity_MAV_SEVERITY_ERROR MavSeverity = 4
+	// Indicates about a possible future error if this is not resolved within a given timeframe. Example would be a low battery warning.
+	MavSeverity_MAV_SEVERITY_WARNING MavSeverity = 5
+	// An unusual event has occurred, though not an error condition. This should be investigated for the root cause.
+	MavSeverity_MAV_SEVERITY_NOTICE MavSeverity = 6
+	// Normal operational messages. Useful for logging. No action is required for these messages.
+	MavSeverity_MAV_SEVERITY_INFO MavSeverity = 7
+	// Useful non-operational messages that can assist in debugging. These should not occur during normal operation.
+	MavSeverity_MAV_SEVERITY_DEBUG MavSeverity = 8
+)
+
+// Enum value maps for MavSeverity.
+var (
+	MavSeverity_name = map[int32]string{
+		0: "MAV_SEVERITY_UNSPECIFIED",
+		1: "MAV_SEVERITY_EMERGENCY",
+		2: "MAV_SEVERITY_ALERT",
+		3: "MAV_SEVERITY_CRITICAL",
+		4: "MAV_SEVERITY_ERROR",
+		5: "MAV_SEVERITY_WARNING",
+		6: "MAV_SEVERITY_NOTICE",
+		7: "MAV_SEVERITY_INFO",
+		8: "MAV_SEVERITY_DEBUG",
 	}
-	return mi.MessageOf(x)
+	MavSeverity_value = map[string]int32{
+		"MAV_SEVERITY_UNSPECIFIED": 0,
+		"MAV_SEVERITY_EMERGENCY":   1,
+		"MAV_SEVERITY_ALERT":       2,
+		"MAV_SEVERITY_CRITICAL":    3,
+		"MAV_SEVERITY_ERROR":       4,
+		"MAV_SEVERITY_WARNING":     5,
+		"MAV_SEVERITY_NOTICE":      6,
+		"MAV_SEVERITY_INFO":        7,
+		"MAV_SEVERITY_DEBUG":       8,
+	}
+)
+
+func (x MavSeverity) Enum() *MavSeverity {
+	p := new(MavSeverity)
+	*p = x
+	return p
 }
 
-// Deprecated: Use SubscribeRawGpsRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeRawGpsRequest) Descriptor() ([]byte, []int) {
-	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{0}
+func (x MavSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// SubscribeRawGpsResponse contains GPS_RAW_INT message data
-type SubscribeRawGpsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Timestamp when this GPS data was captured (milliseconds since Unix epoch)
-	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
-	// System ID of the component sending the GPS data
-	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
-	// Component ID of the component sending the GPS data
-	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
-	// GPS_RAW_INT message data
-	GpsRawInt     *GpsRawInt `protobuf:"bytes,4,opt,name=gps_raw_int,json=gpsRawInt,proto3" json:"gps_raw_int,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (MavSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_telemetry_proto_enumTypes[5].Descriptor()
 }
 
-func (x *SubscribeRawGpsResponse) Reset() {
-	*x = SubscribeRawGpsResponse{}
-	mi := &file_flightpath_telemetry_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (MavSeverity) Type() protoreflect.EnumType {
+	return &file_flightpath_telemetry_proto_enumTypes[5]
 }
 
-func (x *SubscribeRawGpsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x MavSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*SubscribeRawGpsResponse) ProtoMessage() {}
+// Deprecated: Use MavSeverity.Descriptor instead.
+func (MavSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{5}
+}
 
-func (x *SubscribeRawGpsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_flightpath_telemetry_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+// MavVtolState represents VTOL states from MAVLink MAV_VTOL_STATE enum
+// All values are incremented by 1 to accommodate MAV_VTOL_STATE_UNSPECIFIED
+type MavVtolState int32
+
+const (
+	MavVtolState_MAV_VTOL_STATE_UNSPECIFIED      MavVtolState = 0
+	MavVtolState_MAV_VTOL_STATE_UNDEFINED        MavVtolState = 1
+	MavVtolState_MAV_VTOL_STATE_TRANSITION_TO_FW MavVtolState = 2
+	MavVtolState_MAV_VTOL_STATE_TRANSITION_TO_MC MavVtolState = 3
+	MavVtolState_MAV_VTOL_STATE_MC               MavVtolState = 4
+	MavVtolState_MAV_VTOL_STATE_FW               MavVtolState = 5
+)
+
+// Enum value maps for MavVtolState.
+var (
+	MavVtolState_name = map[int32]string{
+		0: "MAV_VTOL_STATE_UNSPECIFIED",
+		1: "MAV_VTOL_STATE_UNDEFINED",
+		2: "MAV_VTOL_STATE_TRANSITION_TO_FW",
+		3: "MAV_VTOL_STATE_TRANSITION_TO_MC",
+		4: "MAV_VTOL_STATE_MC",
+		5: "MAV_VTOL_STATE_FW",
 	}
-	return mi.MessageOf(x)
+	MavVtolState_value = map[string]int32{
+		"MAV_VTOL_STATE_UNSPECIFIED":      0,
+		"MAV_VTOL_STATE_UNDEFINED":        1,
+		"MAV_VTOL_STATE_TRANSITION_TO_FW": 2,
+		"MAV_VTOL_STATE_TRANSITION_TO_MC": 3,
+		"MAV_VTOL_STATE_MC":               4,
+		"MAV_VTOL_STATE_FW":               5,
+	}
+)
+
+func (x MavVtolState) Enum() *MavVtolState {
+	p := new(MavVtolState)
+	*p = x
+	return p
 }
 
-// Deprecated: Use SubscribeRawGpsResponse.ProtoReflect.Descriptor instead.
-func (*SubscribeRawGpsResponse) Descriptor() ([]byte, []int) {
-	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{1}
+func (x MavVtolState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *SubscribeRawGpsResponse) GetTimestampMs() int64 {
+func (MavVtolState) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_telemetry_proto_enumTypes[6].Descriptor()
+}
+
+func (MavVtolState) Type() protoreflect.EnumType {
+	return &file_flightpath_telemetry_proto_enumTypes[6]
+}
+
+func (x MavVtolState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MavVtolState.Descriptor instead.
+func (MavVtolState) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{6}
+}
+
+// MavLandedState represents landed detector states from MAVLink MAV_LANDED_STATE enum
+// All values are incremented by 1 to accommodate MAV_LANDED_STATE_UNSPECIFIED
+type MavLandedState int32
+
+const (
+	MavLandedState_MAV_LANDED_STATE_UNSPECIFIED MavLandedState = 0
+	MavLandedState_MAV_LANDED_STATE_UNDEFINED   MavLandedState = 1
+	MavLandedState_MAV_LANDED_STATE_ON_GROUND   MavLandedState = 2
+	MavLandedState_MAV_LANDED_STATE_IN_AIR      MavLandedState = 3
+	MavLandedState_MAV_LANDED_STATE_TAKEOFF     MavLandedState = 4
+	MavLandedState_MAV_LANDED_STATE_LANDING     MavLandedState = 5
+)
+
+// Enum value maps for MavLandedState.
+var (
+	MavLandedState_name = map[int32]string{
+		0: "MAV_LANDED_STATE_UNSPECIFIED",
+		1: "MAV_LANDED_STATE_UNDEFINED",
+		2: "MAV_LANDED_STATE_ON_GROUND",
+		3: "MAV_LANDED_STATE_IN_AIR",
+		4: "MAV_LANDED_STATE_TAKEOFF",
+		5: "MAV_LANDED_STATE_LANDING",
+	}
+	MavLandedState_value = map[string]int32{
+		"MAV_LANDED_STATE_UNSPECIFIED": 0,
+		"MAV_LANDED_STATE_UNDEFINED":   1,
+		"MAV_LANDED_STATE_ON_GROUND":   2,
+		"MAV_LANDED_STATE_IN_AIR":      3,
+		"MAV_LANDED_STATE_TAKEOFF":     4,
+		"MAV_LANDED_STATE_LANDING":     5,
+	}
+)
+
+func (x MavLandedState) Enum() *MavLandedState {
+	p := new(MavLandedState)
+	*p = x
+	return p
+}
+
+func (x MavLandedState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MavLandedState) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_telemetry_proto_enumTypes[7].Descriptor()
+}
+
+func (MavLandedState) Type() protoreflect.EnumType {
+	return &file_flightpath_telemetry_proto_enumTypes[7]
+}
+
+func (x MavLandedState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MavLandedState.Descriptor instead.
+func (MavLandedState) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{7}
+}
+
+// MavBatteryChargeState represents battery charge states from MAVLink MAV_BATTERY_CHARGE_STATE enum
+// All values are incremented by 1 to accommodate MAV_BATTERY_CHARGE_STATE_UNSPECIFIED
+type MavBatteryChargeState int32
+
+const (
+	MavBatteryChargeState_MAV_BATTERY_CHARGE_STATE_UNSPECIFIED MavBatteryChargeState = 0
+	// Low battery state is not provided
+	MavBatteryChargeState_MAV_BATTERY_CHARGE_STATE_UNDEFINED MavBatteryChargeState = 1
+	// Battery is not in low state. Normal operation.
+	MavBatteryChargeState_MAV_BATTERY_CHARGE_STATE_OK MavBatteryChargeState = 2
+	// Battery state is low, warn and monitor close
+	MavBatteryChargeState_MAV_BATTERY_CHARGE_STATE_LOW MavBatteryChargeState = 3
+	// Battery state is critical, return or abort immediately
+	MavBatteryChargeState_MAV_BATTERY_CHARGE_STATE_CRITICAL MavBatteryChargeState = 4
+	// Battery state is too low for ordinary abort sequence. Perform fastest possible emergency stop to prevent damage.
+	MavBatteryChargeState_MAV_BATTERY_CHARGE_STATE_EMERGENCY MavBatteryChargeState = 5
+	// Battery failed, damage unavoidable
+	MavBatteryChargeState_MAV_BATTERY_CHARGE_STATE_FAILED MavBatteryChargeState = 6
+	// Battery is diagnosed to be defective or an error occurred, usage is discouraged / prohibited
+	MavBatteryChargeState_MAV_BATTERY_CHARGE_STATE_UNHEALTHY MavBatteryChargeState = 7
+	// Battery is charging
+	MavBatteryChargeState_MAV_BATTERY_CHARGE_STATE_CHARGING MavBatteryChargeState = 8
+)
+
+// Enum value maps for MavBatteryChargeState.
+var (
+	MavBatteryChargeState_name = map[int32]string{
+		0: "MAV_BATTERY_CHARGE_STATE_UNSPECIFIED",
+		1: "MAV_BATTERY_CHARGE_STATE_UNDEFINED",
+		2: "MAV_BATTERY_CHARGE_STATE_OK",
+		3: "MAV_BATTERY_CHARGE_STATE_LOW",
+		4: "MAV_BATTERY_CHARGE_STATE_CRITICAL",
+		5: "MAV_BATTERY_CHARGE_STATE_EMERGENCY",
+		6: "MAV_BATTERY_CHARGE_STATE_FAILED",
+		7: "MAV_BATTERY_CHARGE_STATE_UNHEALTHY",
+		8: "MAV_BATTERY_CHARGE_STATE_CHARGING",
+	}
+	MavBatteryChargeState_value = map[string]int32{
+		"MAV_BATTERY_CHARGE_STATE_UNSPECIFIED": 0,
+		"MAV_BATTERY_CHARGE_STATE_UNDEFINED":   1,
+		"MAV_BATTERY_CHARGE_STATE_OK":          2,
+		"MAV_BATTERY_CHARGE_STATE_LOW":         3,
+		"MAV_BATTERY_CHARGE_STATE_CRITICAL":    4,
+		"MAV_BATTERY_CHARGE_STATE_EMERGENCY":   5,
+		"MAV_BATTERY_CHARGE_STATE_FAILED":      6,
+		"MAV_BATTERY_CHARGE_STATE_UNHEALTHY":   7,
+		"MAV_BATTERY_CHARGE_STATE_CHARGING":    8,
+	}
+)
+
+func (x MavBatteryChargeState) Enum() *MavBatteryChargeState {
+	p := new(MavBatteryChargeState)
+	*p = x
+	return p
+}
+
+func (x MavBatteryChargeState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MavBatteryChargeState) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_telemetry_proto_enumTypes[8].Descriptor()
+}
+
+func (MavBatteryChargeState) Type() protoreflect.EnumType {
+	return &file_flightpath_telemetry_proto_enumTypes[8]
+}
+
+func (x MavBatteryChargeState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MavBatteryChargeState.Descriptor instead.
+func (MavBatteryChargeState) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{8}
+}
+
+// MavBatteryFunction represents battery functions from MAVLink MAV_BATTERY_FUNCTION enum
+// All values are incremented by 1 to accommodate MAV_BATTERY_FUNCTION_UNSPECIFIED
+type MavBatteryFunction int32
+
+const (
+	MavBatteryFunction_MAV_BATTERY_FUNCTION_UNSPECIFIED MavBatteryFunction = 0
+	MavBatteryFunction_MAV_BATTERY_FUNCTION_UNKNOWN     MavBatteryFunction = 1
+	MavBatteryFunction_MAV_BATTERY_FUNCTION_ALL         MavBatteryFunction = 2
+	MavBatteryFunction_MAV_BATTERY_FUNCTION_PROPULSION  MavBatteryFunction = 3
+	MavBatteryFunction_MAV_BATTERY_FUNCTION_AVIONICS    MavBatteryFunction = 4
+	MavBatteryFunction_MAV_BATTERY_FUNCTION_PAYLOAD     MavBatteryFunction = 5
+)
+
+// Enum value maps for MavBatteryFunction.
+var (
+	MavBatteryFunction_name = map[int32]string{
+		0: "MAV_BATTERY_FUNCTION_UNSPECIFIED",
+		1: "MAV_BATTERY_FUNCTION_UNKNOWN",
+		2: "MAV_BATTERY_FUNCTION_ALL",
+		3: "MAV_BATTERY_FUNCTION_PROPULSION",
+		4: "MAV_BATTERY_FUNCTION_AVIONICS",
+		5: "MAV_BATTERY_FUNCTION_PAYLOAD",
+	}
+	MavBatteryFunction_value = map[string]int32{
+		"MAV_BATTERY_FUNCTION_UNSPECIFIED": 0,
+		"MAV_BATTERY_FUNCTION_UNKNOWN":     1,
+		"MAV_BATTERY_FUNCTION_ALL":         2,
+		"MAV_BATTERY_FUNCTION_PROPULSION":  3,
+		"MAV_BATTERY_FUNCTION_AVIONICS":    4,
+		"MAV_BATTERY_FUNCTION_PAYLOAD":     5,
+	}
+)
+
+func (x MavBatteryFunction) Enum() *MavBatteryFunction {
+	p := new(MavBatteryFunction)
+	*p = x
+	return p
+}
+
+func (x MavBatteryFunction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MavBatteryFunction) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_telemetry_proto_enumTypes[9].Descriptor()
+}
+
+func (MavBatteryFunction) Type() protoreflect.EnumType {
+	return &file_flightpath_telemetry_proto_enumTypes[9]
+}
+
+func (x MavBatteryFunction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MavBatteryFunction.Descriptor instead.
+func (MavBatteryFunction) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{9}
+}
+
+// MavBatteryMode represents battery modes from MAVLink MAV_BATTERY_MODE enum
+// All values are incremented by 1 to accommodate MAV_BATTERY_MODE_UNSPECIFIED
+type MavBatteryMode int32
+
+const (
+	MavBatteryMode_MAV_BATTERY_MODE_UNSPECIFIED MavBatteryMode = 0
+	// Battery mode not supported/unknown battery mode/normal operation
+	MavBatteryMode_MAV_BATTERY_MODE_UNKNOWN MavBatteryMode = 1
+	// Battery is auto discharging (towards storage level)
+	MavBatteryMode_MAV_BATTERY_MODE_AUTO_DISCHARGING MavBatteryMode = 2
+	// Battery in hot-swap mode (current limited to prevent spikes that might damage sensitive electrical circuits)
+	MavBatteryMode_MAV_BATTERY_MODE_HOT_SWAP MavBatteryMode = 3
+)
+
+// Enum value maps for MavBatteryMode.
+var (
+	MavBatteryMode_name = map[int32]string{
+		0: "MAV_BATTERY_MODE_UNSPECIFIED",
+		1: "MAV_BATTERY_MODE_UNKNOWN",
+		2: "MAV_BATTERY_MODE_AUTO_DISCHARGING",
+		3: "MAV_BATTERY_MODE_HOT_SWAP",
+	}
+	MavBatteryMode_value = map[string]int32{
+		"MAV_BATTERY_MODE_UNSPECIFIED":      0,
+		"MAV_BATTERY_MODE_UNKNOWN":          1,
+		"MAV_BATTERY_MODE_AUTO_DISCHARGING": 2,
+		"MAV_BATTERY_MODE_HOT_SWAP":         3,
+	}
+)
+
+func (x MavBatteryMode) Enum() *MavBatteryMode {
+	p := new(MavBatteryMode)
+	*p = x
+	return p
+}
+
+func (x MavBatteryMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MavBatteryMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_telemetry_proto_enumTypes[10].Descriptor()
+}
+
+func (MavBatteryMode) Type() protoreflect.EnumType {
+	return &file_flightpath_telemetry_proto_enumTypes[10]
+}
+
+func (x MavBatteryMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MavBatteryMode.Descriptor instead.
+func (MavBatteryMode) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{10}
+}
+
+// MavBatteryType represents battery chemistries from MAVLink MAV_BATTERY_TYPE enum
+// All values are incremented by 1 to accommodate MAV_BATTERY_TYPE_UNSPECIFIED
+type MavBatteryType int32
+
+const (
+	MavBatteryType_MAV_BATTERY_TYPE_UNSPECIFIED MavBatteryType = 0
+	MavBatteryType_MAV_BATTERY_TYPE_UNKNOWN     MavBatteryType = 1
+	MavBatteryType_MAV_BATTERY_TYPE_LIPO        MavBatteryType = 2
+	MavBatteryType_MAV_BATTERY_TYPE_LIFE        MavBatteryType = 3
+	MavBatteryType_MAV_BATTERY_TYPE_LION        MavBatteryType = 4
+	MavBatteryType_MAV_BATTERY_TYPE_NIMH        MavBatteryType = 5
+)
+
+// Enum value maps for MavBatteryType.
+var (
+	MavBatteryType_name = map[int32]string{
+		0: "MAV_BATTERY_TYPE_UNSPECIFIED",
+		1: "MAV_BATTERY_TYPE_UNKNOWN",
+		2: "MAV_BATTERY_TYPE_LIPO",
+		3: "MAV_BATTERY_TYPE_LIFE",
+		4: "MAV_BATTERY_TYPE_LION",
+		5: "MAV_BATTERY_TYPE_NIMH",
+	}
+	MavBatteryType_value = map[string]int32{
+		"MAV_BATTERY_TYPE_UNSPECIFIED": 0,
+		"MAV_BATTERY_TYPE_UNKNOWN":     1,
+		"MAV_BATTERY_TYPE_LIPO":        2,
+		"MAV_BATTERY_TYPE_LIFE":        3,
+		"MAV_BATTERY_TYPE_LION":        4,
+		"MAV_BATTERY_TYPE_NIMH":        5,
+	}
+)
+
+func (x MavBatteryType) Enum() *MavBatteryType {
+	p := new(MavBatteryType)
+	*p = x
+	return p
+}
+
+func (x MavBatteryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MavBatteryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_telemetry_proto_enumTypes[11].Descriptor()
+}
+
+func (MavBatteryType) Type() protoreflect.EnumType {
+	return &file_flightpath_telemetry_proto_enumTypes[11]
+}
+
+func (x MavBatteryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MavBatteryType.Descriptor instead.
+func (MavBatteryType) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{11}
+}
+
+// MavDistanceSensor represents distance sensor types from MAVLink MAV_DISTANCE_SENSOR enum
+// All values are incremented by 1 to accommodate MAV_DISTANCE_SENSOR_UNSPECIFIED
+type MavDistanceSensor int32
+
+const (
+	MavDistanceSensor_MAV_DISTANCE_SENSOR_UNSPECIFIED MavDistanceSensor = 0
+	// Laser rangefinder, e.g. LightWare SF02/F or PulsedLight units
+	MavDistanceSensor_MAV_DISTANCE_SENSOR_LASER MavDistanceSensor = 1
+	// Ultrasound rangefinder, e.g. MaxBotix units
+	MavDistanceSensor_MAV_DISTANCE_SENSOR_ULTRASOUND MavDistanceSensor = 2
+	// Infrared rangefinder, e.g. Sharp units
+	MavDistanceSensor_MAV_DISTANCE_SENSOR_INFRARED MavDistanceSensor = 3
+	// Radar type, e.g. uLanding units
+	MavDistanceSensor_MAV_DISTANCE_SENSOR_RADAR MavDistanceSensor = 4
+	// Broken or unknown type, e.g. analog units
+	MavDistanceSensor_MAV_DISTANCE_SENSOR_UNKNOWN MavDistanceSensor = 5
+)
+
+// Enum value maps for MavDistanceSensor.
+var (
+	MavDistanceSensor_name = map[int32]string{
+		0: "MAV_DISTANCE_SENSOR_UNSPECIFIED",
+		1: "MAV_DISTANCE_SENSOR_LASER",
+		2: "MAV_DISTANCE_SENSOR_ULTRASOUND",
+		3: "MAV_DISTANCE_SENSOR_INFRARED",
+		4: "MAV_DISTANCE_SENSOR_RADAR",
+		5: "MAV_DISTANCE_SENSOR_UNKNOWN",
+	}
+	MavDistanceSensor_value = map[string]int32{
+		"MAV_DISTANCE_SENSOR_UNSPECIFIED": 0,
+		"MAV_DISTANCE_SENSOR_LASER":       1,
+		"MAV_DISTANCE_SENSOR_ULTRASOUND":  2,
+		"MAV_DISTANCE_SENSOR_INFRARED":    3,
+		"MAV_DISTANCE_SENSOR_RADAR":       4,
+		"MAV_DISTANCE_SENSOR_UNKNOWN":     5,
+	}
+)
+
+func (x MavDistanceSensor) Enum() *MavDistanceSensor {
+	p := new(MavDistanceSensor)
+	*p = x
+	return p
+}
+
+func (x MavDistanceSensor) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MavDistanceSensor) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_telemetry_proto_enumTypes[12].Descriptor()
+}
+
+func (MavDistanceSensor) Type() protoreflect.EnumType {
+	return &file_flightpath_telemetry_proto_enumTypes[12]
+}
+
+func (x MavDistanceSensor) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MavDistanceSensor.Descriptor instead.
+func (MavDistanceSensor) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{12}
+}
+
+// MavEstimatorType represents estimator types from MAVLink MAV_ESTIMATOR_TYPE enum
+// All values are incremented by 1 to accommodate MAV_ESTIMATOR_TYPE_UNSPECIFIED
+type MavEstimatorType int32
+
+const (
+	MavEstimatorType_MAV_ESTIMATOR_TYPE_UNSPECIFIED MavEstimatorType = 0
+	// Unknown type of the estimator
+	MavEstimatorType_MAV_ESTIMATOR_TYPE_UNKNOWN MavEstimatorType = 1
+	// This is a naive estimator without any real covariance feedback
+	MavEstimatorType_MAV_ESTIMATOR_TYPE_NAIVE MavEstimatorType = 2
+	// Computer vision based estimate. Might be up to scale.
+	MavEstimatorType_MAV_ESTIMATOR_TYPE_VISION MavEstimatorType = 3
+	// Visual-inertial estimate
+	MavEstimatorType_MAV_ESTIMATOR_TYPE_VIO MavEstimatorType = 4
+	// Plain GPS estimate
+	MavEstimatorType_MAV_ESTIMATOR_TYPE_GPS MavEstimatorType = 5
+	// Estimator integrating GPS and inertial sensing
+	MavEstimatorType_MAV_ESTIMATOR_TYPE_GPS_INS MavEstimatorType = 6
+	// Estimate from external motion capturing system
+	MavEstimatorType_MAV_ESTIMATOR_TYPE_MOCAP MavEstimatorType = 7
+	// Estimator based on lidar sensor input
+	MavEstimatorType_MAV_ESTIMATOR_TYPE_LIDAR MavEstimatorType = 8
+	// Estimator on autopilot
+	MavEstimatorType_MAV_ESTIMATOR_TYPE_AUTOPILOT MavEstimatorType = 9
+)
+
+// Enum value maps for MavEstimatorType.
+var (
+	MavEstimatorType_name = map[int32]string{
+		0: "MAV_ESTIMATOR_TYPE_UNSPECIFIED",
+		1: "MAV_ESTIMATOR_TYPE_UNKNOWN",
+		2: "MAV_ESTIMATOR_TYPE_NAIVE",
+		3: "MAV_ESTIMATOR_TYPE_VISION",
+		4: "MAV_ESTIMATOR_TYPE_VIO",
+		5: "MAV_ESTIMATOR_TYPE_GPS",
+		6: "MAV_ESTIMATOR_TYPE_GPS_INS",
+		7: "MAV_ESTIMATOR_TYPE_MOCAP",
+		8: "MAV_ESTIMATOR_TYPE_LIDAR",
+		9: "MAV_ESTIMATOR_TYPE_AUTOPILOT",
+	}
+	MavEstimatorType_value = map[string]int32{
+		"MAV_ESTIMATOR_TYPE_UNSPECIFIED": 0,
+		"MAV_ESTIMATOR_TYPE_UNKNOWN":     1,
+		"MAV_ESTIMATOR_TYPE_NAIVE":       2,
+		"MAV_ESTIMATOR_TYPE_VISION":      3,
+		"MAV_ESTIMATOR_TYPE_VIO":         4,
+		"MAV_ESTIMATOR_TYPE_GPS":         5,
+		"MAV_ESTIMATOR_TYPE_GPS_INS":     6,
+		"MAV_ESTIMATOR_TYPE_MOCAP":       7,
+		"MAV_ESTIMATOR_TYPE_LIDAR":       8,
+		"MAV_ESTIMATOR_TYPE_AUTOPILOT":   9,
+	}
+)
+
+func (x MavEstimatorType) Enum() *MavEstimatorType {
+	p := new(MavEstimatorType)
+	*p = x
+	return p
+}
+
+func (x MavEstimatorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MavEstimatorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_telemetry_proto_enumTypes[13].Descriptor()
+}
+
+func (MavEstimatorType) Type() protoreflect.EnumType {
+	return &file_flightpath_telemetry_proto_enumTypes[13]
+}
+
+func (x MavEstimatorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MavEstimatorType.Descriptor instead.
+func (MavEstimatorType) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{13}
+}
+
+// MavFrame represents coordinate frames from MAVLink MAV_FRAME enum
+// All values are incremented by 1 to accommodate MAV_FRAME_UNSPECIFIED
+type MavFrame int32
+
+const (
+	MavFrame_MAV_FRAME_UNSPECIFIED MavFrame = 0
+	// Global (WGS84) coordinate frame + altitude relative to mean sea level (MSL)
+	MavFrame_MAV_FRAME_GLOBAL MavFrame = 1
+	// NED local tangent frame (x: North, y: East, z: Down) with origin fixed relative to earth
+	MavFrame_MAV_FRAME_LOCAL_NED MavFrame = 2
+	// NOT a coordinate frame, indicates a mission command
+	MavFrame_MAV_FRAME_MISSION MavFrame = 3
+	// Global (WGS84) coordinate frame + altitude relative to the home position
+	MavFrame_MAV_FRAME_GLOBAL_RELATIVE_ALT MavFrame = 4
+	// ENU local tangent frame (x: East, y: North, z: Up) with origin fixed relative to earth
+	MavFrame_MAV_FRAME_LOCAL_ENU MavFrame = 5
+	// Global (WGS84) coordinate frame (scaled) + altitude relative to mean sea level (MSL)
+	MavFrame_MAV_FRAME_GLOBAL_INT MavFrame = 6
+	// Global (WGS84) coordinate frame (scaled) + altitude relative to the home position
+	MavFrame_MAV_FRAME_GLOBAL_RELATIVE_ALT_INT MavFrame = 7
+	// NED local tangent frame (x: North, y: East, z: Down) with origin that travels with the vehicle
+	MavFrame_MAV_FRAME_LOCAL_OFFSET_NED MavFrame = 8
+	// Same as MAV_FRAME_LOCAL_NED for positions, same as MAV_FRAME_BODY_FRD for velocities/accelerations
+	MavFrame_MAV_FRAME_BODY_NED MavFrame = 9
+	// Same as MAV_FRAME_BODY_FRD
+	MavFrame_MAV_FRAME_BODY_OFFSET_NED MavFrame = 10
+	// Global (WGS84) coordinate frame with AGL altitude (altitude at ground level)
+	MavFrame_MAV_FRAME_GLOBAL_TERRAIN_ALT MavFrame = 11
+	// Global (WGS84) coordinate frame (scaled) with AGL altitude (altitude at ground level)
+	MavFrame_MAV_FRAME_GLOBAL_TERRAIN_ALT_INT MavFrame = 12
+	// FRD local frame aligned to the vehicle's attitude (x: Forward, y: Right, z: Down) with an origin that travels with the vehicle
+	MavFrame_MAV_FRAME_BODY_FRD MavFrame = 13
+	// FRD local tangent frame (x: Forward, y: Right, z: Down) with origin fixed relative to earth
+	MavFrame_MAV_FRAME_LOCAL_FRD MavFrame = 21
+	// FLU local tangent frame (x: Forward, y: Left, z: Up) with origin fixed relative to earth
+	MavFrame_MAV_FRAME_LOCAL_FLU MavFrame = 22
+)
+
+// Enum value maps for MavFrame.
+var (
+	MavFrame_name = map[int32]string{
+		0:  "MAV_FRAME_UNSPECIFIED",
+		1:  "MAV_FRAME_GLOBAL",
+		2:  "MAV_FRAME_LOCAL_NED",
+		3:  "MAV_FRAME_MISSION",
+		4:  "MAV_FRAME_GLOBAL_RELATIVE_ALT",
+		5:  "MAV_FRAME_LOCAL_ENU",
+		6:  "MAV_FRAME_GLOBAL_INT",
+		7:  "MAV_FRAME_GLOBAL_RELATIVE_ALT_INT",
+		8:  "MAV_FRAME_LOCAL_OFFSET_NED",
+		9:  "MAV_FRAME_BODY_NED",
+		10: "MAV_FRAME_BODY_OFFSET_NED",
+		11: "MAV_FRAME_GLOBAL_TERRAIN_ALT",
+		12: "MAV_FRAME_GLOBAL_TERRAIN_ALT_INT",
+		13: "MAV_FRAME_BODY_FRD",
+		21: "MAV_FRAME_LOCAL_FRD",
+		22: "MAV_FRAME_LOCAL_FLU",
+	}
+	MavFrame_value = map[string]int32{
+		"MAV_FRAME_UNSPECIFIED":             0,
+		"MAV_FRAME_GLOBAL":                  1,
+		"MAV_FRAME_LOCAL_NED":               2,
+		"MAV_FRAME_MISSION":                 3,
+		"MAV_FRAME_GLOBAL_RELATIVE_ALT":     4,
+		"MAV_FRAME_LOCAL_ENU":               5,
+		"MAV_FRAME_GLOBAL_INT":              6,
+		"MAV_FRAME_GLOBAL_RELATIVE_ALT_INT": 7,
+		"MAV_FRAME_LOCAL_OFFSET_NED":        8,
+		"MAV_FRAME_BODY_NED":                9,
+		"MAV_FRAME_BODY_OFFSET_NED":         10,
+		"MAV_FRAME_GLOBAL_TERRAIN_ALT":      11,
+		"MAV_FRAME_GLOBAL_TERRAIN_ALT_INT":  12,
+		"MAV_FRAME_BODY_FRD":                13,
+		"MAV_FRAME_LOCAL_FRD":               21,
+		"MAV_FRAME_LOCAL_FLU":               22,
+	}
+)
+
+func (x MavFrame) Enum() *MavFrame {
+	p := new(MavFrame)
+	*p = x
+	return p
+}
+
+func (x MavFrame) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MavFrame) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_telemetry_proto_enumTypes[14].Descriptor()
+}
+
+func (MavFrame) Type() protoreflect.EnumType {
+	return &file_flightpath_telemetry_proto_enumTypes[14]
+}
+
+func (x MavFrame) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MavFrame.Descriptor instead.
+func (MavFrame) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{14}
+}
+
+// RtkBaselineCoordinateSystem represents RTK baseline coordinate systems from MAVLink RTK_BASELINE_COORDINATE_SYSTEM enum
+// All values are incremented by 1 to accommodate RTK_BASELINE_COORDINATE_SYSTEM_UNSPECIFIED
+type RtkBaselineCoordinateSystem int32
+
+const (
+	RtkBaselineCoordinateSystem_RTK_BASELINE_COORDINATE_SYSTEM_UNSPECIFIED RtkBaselineCoordinateSystem = 0
+	// Earth-centered, Earth-fixed
+	RtkBaselineCoordinateSystem_RTK_BASELINE_COORDINATE_SYSTEM_ECEF RtkBaselineCoordinateSystem = 1
+	// RTK basestation centered, north, east, down
+	RtkBaselineCoordinateSystem_RTK_BASELINE_COORDINATE_SYSTEM_NED RtkBaselineCoordinateSystem = 2
+)
+
+// Enum value maps for RtkBaselineCoordinateSystem.
+var (
+	RtkBaselineCoordinateSystem_name = map[int32]string{
+		0: "RTK_BASELINE_COORDINATE_SYSTEM_UNSPECIFIED",
+		1: "RTK_BASELINE_COORDINATE_SYSTEM_ECEF",
+		2: "RTK_BASELINE_COORDINATE_SYSTEM_NED",
+	}
+	RtkBaselineCoordinateSystem_value = map[string]int32{
+		"RTK_BASELINE_COORDINATE_SYSTEM_UNSPECIFIED": 0,
+		"RTK_BASELINE_COORDINATE_SYSTEM_ECEF":        1,
+		"RTK_BASELINE_COORDINATE_SYSTEM_NED":         2,
+	}
+)
+
+func (x RtkBaselineCoordinateSystem) Enum() *RtkBaselineCoordinateSystem {
+	p := new(RtkBaselineCoordinateSystem)
+	*p = x
+	return p
+}
+
+func (x RtkBaselineCoordinateSystem) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RtkBaselineCoordinateSystem) Descriptor() protoreflect.EnumDescriptor {
+	return file_flightpath_telemetry_proto_enumTypes[15].Descriptor()
+}
+
+func (RtkBaselineCoordinateSystem) Type() protoreflect.EnumType {
+	return &file_flightpath_telemetry_proto_enumTypes[15]
+}
+
+func (x RtkBaselineCoordinateSystem) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RtkBaselineCoordinateSystem.Descriptor instead.
+func (RtkBaselineCoordinateSystem) EnumDescriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{15}
+}
+
+// SubscribeRawGpsRequest is the request message for SubscribeRawGps
+type SubscribeRawGpsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRawGpsRequest) Reset() {
+	*x = SubscribeRawGpsRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRawGpsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRawGpsRequest) ProtoMessage() {}
+
+func (x *SubscribeRawGpsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRawGpsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRawGpsRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{0}
+}
+
+// SubscribeRawGpsResponse contains GPS_RAW_INT message data
+type SubscribeRawGpsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this GPS data was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the GPS data
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the GPS data
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// GPS_RAW_INT message data
+	GpsRawInt     *GpsRawInt `protobuf:"bytes,4,opt,name=gps_raw_int,json=gpsRawInt,proto3" json:"gps_raw_int,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRawGpsResponse) Reset() {
+	*x = SubscribeRawGpsResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRawGpsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRawGpsResponse) ProtoMessage() {}
+
+func (x *SubscribeRawGpsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRawGpsResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeRawGpsResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubscribeRawGpsResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeRawGpsResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeRawGpsResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeRawGpsResponse) GetGpsRawInt() *GpsRawInt {
+	if x != nil {
+		return x.GpsRawInt
+	}
+	return nil
+}
+
+// GpsRawInt represents the GPS_RAW_INT MAVLink message
+// The global position, as returned by the Global Positioning System (GPS).
+// This is NOT the global position estimate of the system, but rather a RAW sensor value.
+type GpsRawInt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
+	TimeUsec uint64 `protobuf:"varint,1,opt,name=time_usec,json=timeUsec,proto3" json:"time_usec,omitempty"`
+	// GPS fix type
+	FixType GpsFixType `protobuf:"varint,2,opt,name=fix_type,json=fixType,proto3,enum=flightpath.GpsFixType" json:"fix_type,omitempty"`
+	// Latitude (WGS84, EGM96 ellipsoid) in degrees * 1E7
+	Lat int32 `protobuf:"varint,3,opt,name=lat,proto3" json:"lat,omitempty"`
+	// Longitude (WGS84, EGM96 ellipsoid) in degrees * 1E7
+	Lon int32 `protobuf:"varint,4,opt,name=lon,proto3" json:"lon,omitempty"`
+	// Altitude (MSL). Positive for up. Note that virtually all GPS modules provide the MSL altitude in addition to the WGS84 altitude. (mm)
+	Alt int32 `protobuf:"varint,5,opt,name=alt,proto3" json:"alt,omitempty"`
+	// GPS HDOP horizontal dilution of position (unitless * 100). If unknown, set to: UINT16_MAX
+	Eph uint32 `protobuf:"varint,6,opt,name=eph,proto3" json:"eph,omitempty"`
+	// GPS VDOP vertical dilution of position (unitless * 100). If unknown, set to: UINT16_MAX
+	Epv uint32 `protobuf:"varint,7,opt,name=epv,proto3" json:"epv,omitempty"`
+	// GPS ground speed (cm/s). If unknown, set to: UINT16_MAX
+	Vel uint32 `protobuf:"varint,8,opt,name=vel,proto3" json:"vel,omitempty"`
+	// Course over ground (NOT heading, but direction of movement) in degrees * 100, 0.0..359.99 degrees. If unknown, set to: UINT16_MAX
+	Cog uint32 `protobuf:"varint,9,opt,name=cog,proto3" json:"cog,omitempty"`
+	// Number of satellites visible. If unknown, set to UINT8_MAX
+	SatellitesVisible uint32 `protobuf:"varint,10,opt,name=satellites_visible,json=satellitesVisible,proto3" json:"satellites_visible,omitempty"`
+	// Altitude (above WGS84, EGM96 ellipsoid). Positive for up. (mm)
+	AltEllipsoid int32 `protobuf:"varint,11,opt,name=alt_ellipsoid,json=altEllipsoid,proto3" json:"alt_ellipsoid,omitempty"`
+	// Position uncertainty (mm)
+	HAcc uint32 `protobuf:"varint,12,opt,name=h_acc,json=hAcc,proto3" json:"h_acc,omitempty"`
+	// Altitude uncertainty (mm)
+	VAcc uint32 `protobuf:"varint,13,opt,name=v_acc,json=vAcc,proto3" json:"v_acc,omitempty"`
+	// Speed uncertainty (mm/s)
+	VelAcc uint32 `protobuf:"varint,14,opt,name=vel_acc,json=velAcc,proto3" json:"vel_acc,omitempty"`
+	// Heading / track uncertainty (degrees * 1E5)
+	HdgAcc uint32 `protobuf:"varint,15,opt,name=hdg_acc,json=hdgAcc,proto3" json:"hdg_acc,omitempty"`
+	// Yaw in earth frame from north. Use 0 if this GPS does not provide yaw. Use UINT16_MAX if this GPS is configured to provide yaw and is currently unable to provide it. Use 36000 for north. (degrees * 100)
+	Yaw           uint32 `protobuf:"varint,16,opt,name=yaw,proto3" json:"yaw,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GpsRawInt) Reset() {
+	*x = GpsRawInt{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GpsRawInt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GpsRawInt) ProtoMessage() {}
+
+func (x *GpsRawInt) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GpsRawInt.ProtoReflect.Descriptor instead.
+func (*GpsRawInt) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GpsRawInt) GetTimeUsec() uint64 {
+	if x != nil {
+		return x.TimeUsec
+	}
+	return 0
+}
+
+func (x *GpsRawInt) GetFixType() GpsFixType {
+	if x != nil {
+		return x.FixType
+	}
+	return GpsFixType_GPS_FIX_TYPE_UNSPECIFIED
+}
+
+func (x *GpsRawInt) GetLat() int32 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *GpsRawInt) GetLon() int32 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *GpsRawInt) GetAlt() int32 {
+	if x != nil {
+		return x.Alt
+	}
+	return 0
+}
+
+func (x *GpsRawInt) GetEph() uint32 {
+	if x != nil {
+		return x.Eph
+	}
+	return 0
+}
+
+func (x *GpsRawInt) GetEpv() uint32 {
+	if x != nil {
+		return x.Epv
+	}
+	return 0
+}
+
+func (x *GpsRawInt) GetVel() uint32 {
+	if x != nil {
+		return x.Vel
+	}
+	return 0
+}
+
+func (x *GpsRawInt) GetCog() uint32 {
+	if x != nil {
+		return x.Cog
+	}
+	return 0
+}
+
+func (x *GpsRawInt) GetSatellitesVisible() uint32 {
+	if x != nil {
+		return x.SatellitesVisible
+	}
+	return 0
+}
+
+func (x *GpsRawInt) GetAltEllipsoid() int32 {
+	if x != nil {
+		return x.AltEllipsoid
+	}
+	return 0
+}
+
+func (x *GpsRawInt) GetHAcc() uint32 {
+	if x != nil {
+		return x.HAcc
+	}
+	return 0
+}
+
+func (x *GpsRawInt) GetVAcc() uint32 {
+	if x != nil {
+		return x.VAcc
+	}
+	return 0
+}
+
+func (x *GpsRawInt) GetVelAcc() uint32 {
+	if x != nil {
+		return x.VelAcc
+	}
+	return 0
+}
+
+func (x *GpsRawInt) GetHdgAcc() uint32 {
+	if x != nil {
+		return x.HdgAcc
+	}
+	return 0
+}
+
+func (x *GpsRawInt) GetYaw() uint32 {
+	if x != nil {
+		return x.Yaw
+	}
+	return 0
+}
+
+// SubscribeOrbitExecutionStatusRequest is the request message for SubscribeOrbitExecutionStatus
+type SubscribeOrbitExecutionStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeOrbitExecutionStatusRequest) Reset() {
+	*x = SubscribeOrbitExecutionStatusRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeOrbitExecutionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeOrbitExecutionStatusRequest) ProtoMessage() {}
+
+func (x *SubscribeOrbitExecutionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeOrbitExecutionStatusRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeOrbitExecutionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{3}
+}
+
+// SubscribeOrbitExecutionStatusResponse contains ORBIT_EXECUTION_STATUS message data
+type SubscribeOrbitExecutionStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this orbit status was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the orbit status
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the orbit status
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// True while the drone reports an orbit in progress.
+	// False once ORBIT_EXECUTION_STATUS has stopped (orbit finished or aborted), in which case
+	// orbit_execution_status holds the last reported orbit.
+	Active bool `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	// ORBIT_EXECUTION_STATUS message data
+	OrbitExecutionStatus *OrbitExecutionStatus `protobuf:"bytes,5,opt,name=orbit_execution_status,json=orbitExecutionStatus,proto3" json:"orbit_execution_status,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SubscribeOrbitExecutionStatusResponse) Reset() {
+	*x = SubscribeOrbitExecutionStatusResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeOrbitExecutionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeOrbitExecutionStatusResponse) ProtoMessage() {}
+
+func (x *SubscribeOrbitExecutionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeOrbitExecutionStatusResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeOrbitExecutionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SubscribeOrbitExecutionStatusResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeOrbitExecutionStatusResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeOrbitExecutionStatusResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeOrbitExecutionStatusResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *SubscribeOrbitExecutionStatusResponse) GetOrbitExecutionStatus() *OrbitExecutionStatus {
+	if x != nil {
+		return x.OrbitExecutionStatus
+	}
+	return nil
+}
+
+// OrbitExecutionStatus represents the ORBIT_EXECUTION_STATUS MAVLink message
+// Vehicle status report that is sent out while orbit execution is in progress (see MAV_CMD_DO_ORBIT).
+type OrbitExecutionStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
+	TimeUsec uint64 `protobuf:"varint,1,opt,name=time_usec,json=timeUsec,proto3" json:"time_usec,omitempty"`
+	// Radius of the orbit circle. Positive values orbit clockwise, negative values orbit counter-clockwise. (m)
+	Radius float32 `protobuf:"fixed32,2,opt,name=radius,proto3" json:"radius,omitempty"`
+	// The coordinate system of the fields: x, y, z
+	Frame MavFrame `protobuf:"varint,3,opt,name=frame,proto3,enum=flightpath.MavFrame" json:"frame,omitempty"`
+	// X coordinate of center point. Coordinate system depends on frame field: local = x position in meters * 1e4, global = latitude in degrees * 1e7.
+	X int32 `protobuf:"varint,4,opt,name=x,proto3" json:"x,omitempty"`
+	// Y coordinate of center point. Coordinate system depends on frame field: local = y position in meters * 1e4, global = longitude in degrees * 1e7.
+	Y int32 `protobuf:"varint,5,opt,name=y,proto3" json:"y,omitempty"`
+	// Altitude of center point. Coordinate system depends on frame field. (m)
+	Z             float32 `protobuf:"fixed32,6,opt,name=z,proto3" json:"z,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrbitExecutionStatus) Reset() {
+	*x = OrbitExecutionStatus{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrbitExecutionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrbitExecutionStatus) ProtoMessage() {}
+
+func (x *OrbitExecutionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrbitExecutionStatus.ProtoReflect.Descriptor instead.
+func (*OrbitExecutionStatus) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OrbitExecutionStatus) GetTimeUsec() uint64 {
+	if x != nil {
+		return x.TimeUsec
+	}
+	return 0
+}
+
+func (x *OrbitExecutionStatus) GetRadius() float32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *OrbitExecutionStatus) GetFrame() MavFrame {
+	if x != nil {
+		return x.Frame
+	}
+	return MavFrame_MAV_FRAME_UNSPECIFIED
+}
+
+func (x *OrbitExecutionStatus) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *OrbitExecutionStatus) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *OrbitExecutionStatus) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+// SubscribeAttitudeRequest is the request message for SubscribeAttitude
+type SubscribeAttitudeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeAttitudeRequest) Reset() {
+	*x = SubscribeAttitudeRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeAttitudeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeAttitudeRequest) ProtoMessage() {}
+
+func (x *SubscribeAttitudeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeAttitudeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeAttitudeRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{6}
+}
+
+// SubscribeAttitudeResponse contains ATTITUDE and ATTITUDE_QUATERNION message data
+type SubscribeAttitudeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this attitude was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the attitude
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the attitude
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Latest ATTITUDE message data (not set until the first ATTITUDE is received)
+	Attitude *Attitude `protobuf:"bytes,4,opt,name=attitude,proto3" json:"attitude,omitempty"`
+	// Latest ATTITUDE_QUATERNION message data (not set until the first ATTITUDE_QUATERNION is received)
+	AttitudeQuaternion *AttitudeQuaternion `protobuf:"bytes,5,opt,name=attitude_quaternion,json=attitudeQuaternion,proto3" json:"attitude_quaternion,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SubscribeAttitudeResponse) Reset() {
+	*x = SubscribeAttitudeResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeAttitudeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeAttitudeResponse) ProtoMessage() {}
+
+func (x *SubscribeAttitudeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeAttitudeResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeAttitudeResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SubscribeAttitudeResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeAttitudeResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeAttitudeResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeAttitudeResponse) GetAttitude() *Attitude {
+	if x != nil {
+		return x.Attitude
+	}
+	return nil
+}
+
+func (x *SubscribeAttitudeResponse) GetAttitudeQuaternion() *AttitudeQuaternion {
+	if x != nil {
+		return x.AttitudeQuaternion
+	}
+	return nil
+}
+
+// Attitude represents the ATTITUDE MAVLink message
+// The attitude in the aeronautical frame (right-handed, Z-down, Y-right, X-front, ZYX, intrinsic).
+// Euler angles are provided both in radians (as sent by the drone) and in degrees.
+type Attitude struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp (time since system boot) (ms)
+	TimeBootMs uint32 `protobuf:"varint,1,opt,name=time_boot_ms,json=timeBootMs,proto3" json:"time_boot_ms,omitempty"`
+	// Roll angle (-pi..+pi) (rad)
+	Roll float32 `protobuf:"fixed32,2,opt,name=roll,proto3" json:"roll,omitempty"`
+	// Pitch angle (-pi..+pi) (rad)
+	Pitch float32 `protobuf:"fixed32,3,opt,name=pitch,proto3" json:"pitch,omitempty"`
+	// Yaw angle (-pi..+pi) (rad)
+	Yaw float32 `protobuf:"fixed32,4,opt,name=yaw,proto3" json:"yaw,omitempty"`
+	// Roll angle (-180..+180) (deg)
+	RollDeg float32 `protobuf:"fixed32,5,opt,name=roll_deg,json=rollDeg,proto3" json:"roll_deg,omitempty"`
+	// Pitch angle (-180..+180) (deg)
+	PitchDeg float32 `protobuf:"fixed32,6,opt,name=pitch_deg,json=pitchDeg,proto3" json:"pitch_deg,omitempty"`
+	// Yaw angle (-180..+180) (deg)
+	YawDeg float32 `protobuf:"fixed32,7,opt,name=yaw_deg,json=yawDeg,proto3" json:"yaw_deg,omitempty"`
+	// Roll angular speed (rad/s)
+	Rollspeed float32 `protobuf:"fixed32,8,opt,name=rollspeed,proto3" json:"rollspeed,omitempty"`
+	// Pitch angular speed (rad/s)
+	Pitchspeed float32 `protobuf:"fixed32,9,opt,name=pitchspeed,proto3" json:"pitchspeed,omitempty"`
+	// Yaw angular speed (rad/s)
+	Yawspeed      float32 `protobuf:"fixed32,10,opt,name=yawspeed,proto3" json:"yawspeed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attitude) Reset() {
+	*x = Attitude{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attitude) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attitude) ProtoMessage() {}
+
+func (x *Attitude) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attitude.ProtoReflect.Descriptor instead.
+func (*Attitude) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Attitude) GetTimeBootMs() uint32 {
+	if x != nil {
+		return x.TimeBootMs
+	}
+	return 0
+}
+
+func (x *Attitude) GetRoll() float32 {
+	if x != nil {
+		return x.Roll
+	}
+	return 0
+}
+
+func (x *Attitude) GetPitch() float32 {
+	if x != nil {
+		return x.Pitch
+	}
+	return 0
+}
+
+func (x *Attitude) GetYaw() float32 {
+	if x != nil {
+		return x.Yaw
+	}
+	return 0
+}
+
+func (x *Attitude) GetRollDeg() float32 {
+	if x != nil {
+		return x.RollDeg
+	}
+	return 0
+}
+
+func (x *Attitude) GetPitchDeg() float32 {
+	if x != nil {
+		return x.PitchDeg
+	}
+	return 0
+}
+
+func (x *Attitude) GetYawDeg() float32 {
+	if x != nil {
+		return x.YawDeg
+	}
+	return 0
+}
+
+func (x *Attitude) GetRollspeed() float32 {
+	if x != nil {
+		return x.Rollspeed
+	}
+	return 0
+}
+
+func (x *Attitude) GetPitchspeed() float32 {
+	if x != nil {
+		return x.Pitchspeed
+	}
+	return 0
+}
+
+func (x *Attitude) GetYawspeed() float32 {
+	if x != nil {
+		return x.Yawspeed
+	}
+	return 0
+}
+
+// AttitudeQuaternion represents the ATTITUDE_QUATERNION MAVLink message
+// The attitude in the aeronautical frame (right-handed, Z-down, X-front, Y-right), expressed as quaternion.
+// Quaternion order is w, x, y, z and a zero rotation would be expressed as (1 0 0 0).
+type AttitudeQuaternion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp (time since system boot) (ms)
+	TimeBootMs uint32 `protobuf:"varint,1,opt,name=time_boot_ms,json=timeBootMs,proto3" json:"time_boot_ms,omitempty"`
+	// Quaternion component 1, w (1 in null-rotation)
+	Q1 float32 `protobuf:"fixed32,2,opt,name=q1,proto3" json:"q1,omitempty"`
+	// Quaternion component 2, x (0 in null-rotation)
+	Q2 float32 `protobuf:"fixed32,3,opt,name=q2,proto3" json:"q2,omitempty"`
+	// Quaternion component 3, y (0 in null-rotation)
+	Q3 float32 `protobuf:"fixed32,4,opt,name=q3,proto3" json:"q3,omitempty"`
+	// Quaternion component 4, z (0 in null-rotation)
+	Q4 float32 `protobuf:"fixed32,5,opt,name=q4,proto3" json:"q4,omitempty"`
+	// Roll angular speed (rad/s)
+	Rollspeed float32 `protobuf:"fixed32,6,opt,name=rollspeed,proto3" json:"rollspeed,omitempty"`
+	// Pitch angular speed (rad/s)
+	Pitchspeed float32 `protobuf:"fixed32,7,opt,name=pitchspeed,proto3" json:"pitchspeed,omitempty"`
+	// Yaw angular speed (rad/s)
+	Yawspeed float32 `protobuf:"fixed32,8,opt,name=yawspeed,proto3" json:"yawspeed,omitempty"`
+	// Rotation offset by which the attitude quaternion and angular speed vector should be rotated for user display (quaternion with [w, x, y, z] order, zero-rotation is [1, 0, 0, 0], [0, 0, 0, 0] if not supported).
+	// For example, tailsitter VTOLs rotate their reference attitude by 90 degrees between hover mode and fixed wing mode.
+	ReprOffsetQ   []float32 `protobuf:"fixed32,9,rep,packed,name=repr_offset_q,json=reprOffsetQ,proto3" json:"repr_offset_q,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttitudeQuaternion) Reset() {
+	*x = AttitudeQuaternion{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttitudeQuaternion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttitudeQuaternion) ProtoMessage() {}
+
+func (x *AttitudeQuaternion) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttitudeQuaternion.ProtoReflect.Descriptor instead.
+func (*AttitudeQuaternion) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AttitudeQuaternion) GetTimeBootMs() uint32 {
+	if x != nil {
+		return x.TimeBootMs
+	}
+	return 0
+}
+
+func (x *AttitudeQuaternion) GetQ1() float32 {
+	if x != nil {
+		return x.Q1
+	}
+	return 0
+}
+
+func (x *AttitudeQuaternion) GetQ2() float32 {
+	if x != nil {
+		return x.Q2
+	}
+	return 0
+}
+
+func (x *AttitudeQuaternion) GetQ3() float32 {
+	if x != nil {
+		return x.Q3
+	}
+	return 0
+}
+
+func (x *AttitudeQuaternion) GetQ4() float32 {
+	if x != nil {
+		return x.Q4
+	}
+	return 0
+}
+
+func (x *AttitudeQuaternion) GetRollspeed() float32 {
+	if x != nil {
+		return x.Rollspeed
+	}
+	return 0
+}
+
+func (x *AttitudeQuaternion) GetPitchspeed() float32 {
+	if x != nil {
+		return x.Pitchspeed
+	}
+	return 0
+}
+
+func (x *AttitudeQuaternion) GetYawspeed() float32 {
+	if x != nil {
+		return x.Yawspeed
+	}
+	return 0
+}
+
+func (x *AttitudeQuaternion) GetReprOffsetQ() []float32 {
+	if x != nil {
+		return x.ReprOffsetQ
+	}
+	return nil
+}
+
+// SubscribePositionRequest is the request message for SubscribePosition
+type SubscribePositionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribePositionRequest) Reset() {
+	*x = SubscribePositionRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribePositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribePositionRequest) ProtoMessage() {}
+
+func (x *SubscribePositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribePositionRequest.ProtoReflect.Descriptor instead.
+func (*SubscribePositionRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{10}
+}
+
+// SubscribePositionResponse contains GLOBAL_POSITION_INT message data
+type SubscribePositionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this position was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the position
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the position
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Position in normalized units
+	Position *Position `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	// GLOBAL_POSITION_INT message data (raw integer fields, for lossless logging)
+	GlobalPositionInt *GlobalPositionInt `protobuf:"bytes,5,opt,name=global_position_int,json=globalPositionInt,proto3" json:"global_position_int,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SubscribePositionResponse) Reset() {
+	*x = SubscribePositionResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribePositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribePositionResponse) ProtoMessage() {}
+
+func (x *SubscribePositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribePositionResponse.ProtoReflect.Descriptor instead.
+func (*SubscribePositionResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SubscribePositionResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribePositionResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribePositionResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribePositionResponse) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *SubscribePositionResponse) GetGlobalPositionInt() *GlobalPositionInt {
+	if x != nil {
+		return x.GlobalPositionInt
+	}
+	return nil
+}
+
+// Position is the fused global position estimate from GLOBAL_POSITION_INT in normalized units
+type Position struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Latitude (WGS84) (deg)
+	Latitude float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude (WGS84) (deg)
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Altitude (MSL) (m)
+	AbsoluteAltitude float32 `protobuf:"fixed32,3,opt,name=absolute_altitude,json=absoluteAltitude,proto3" json:"absolute_altitude,omitempty"`
+	// Altitude above home (m)
+	RelativeAltitude float32 `protobuf:"fixed32,4,opt,name=relative_altitude,json=relativeAltitude,proto3" json:"relative_altitude,omitempty"`
+	// Velocity towards north (m/s)
+	VelocityNorth float32 `protobuf:"fixed32,5,opt,name=velocity_north,json=velocityNorth,proto3" json:"velocity_north,omitempty"`
+	// Velocity towards east (m/s)
+	VelocityEast float32 `protobuf:"fixed32,6,opt,name=velocity_east,json=velocityEast,proto3" json:"velocity_east,omitempty"`
+	// Velocity downwards (m/s)
+	VelocityDown float32 `protobuf:"fixed32,7,opt,name=velocity_down,json=velocityDown,proto3" json:"velocity_down,omitempty"`
+	// Vehicle heading (yaw angle) in 0.0..359.99 degrees. Not set if unknown.
+	Heading       *float32 `protobuf:"fixed32,8,opt,name=heading,proto3,oneof" json:"heading,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Position) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Position) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Position) GetAbsoluteAltitude() float32 {
+	if x != nil {
+		return x.AbsoluteAltitude
+	}
+	return 0
+}
+
+func (x *Position) GetRelativeAltitude() float32 {
+	if x != nil {
+		return x.RelativeAltitude
+	}
+	return 0
+}
+
+func (x *Position) GetVelocityNorth() float32 {
+	if x != nil {
+		return x.VelocityNorth
+	}
+	return 0
+}
+
+func (x *Position) GetVelocityEast() float32 {
+	if x != nil {
+		return x.VelocityEast
+	}
+	return 0
+}
+
+func (x *Position) GetVelocityDown() float32 {
+	if x != nil {
+		return x.VelocityDown
+	}
+	return 0
+}
+
+func (x *Position) GetHeading() float32 {
+	if x != nil && x.Heading != nil {
+		return *x.Heading
+	}
+	return 0
+}
+
+// SubscribeLocalPositionRequest is the request message for SubscribeLocalPosition
+type SubscribeLocalPositionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeLocalPositionRequest) Reset() {
+	*x = SubscribeLocalPositionRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeLocalPositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeLocalPositionRequest) ProtoMessage() {}
+
+func (x *SubscribeLocalPositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeLocalPositionRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeLocalPositionRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{13}
+}
+
+// SubscribeLocalPositionResponse contains LOCAL_POSITION_NED and ODOMETRY message data
+type SubscribeLocalPositionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this local position was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the local position
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the local position
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Latest LOCAL_POSITION_NED message data (not set until the first LOCAL_POSITION_NED is received)
+	LocalPositionNed *LocalPositionNed `protobuf:"bytes,4,opt,name=local_position_ned,json=localPositionNed,proto3" json:"local_position_ned,omitempty"`
+	// Latest ODOMETRY message data (not set until the first ODOMETRY is received)
+	Odometry      *Odometry `protobuf:"bytes,5,opt,name=odometry,proto3" json:"odometry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeLocalPositionResponse) Reset() {
+	*x = SubscribeLocalPositionResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeLocalPositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeLocalPositionResponse) ProtoMessage() {}
+
+func (x *SubscribeLocalPositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeLocalPositionResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeLocalPositionResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SubscribeLocalPositionResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeLocalPositionResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeLocalPositionResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeLocalPositionResponse) GetLocalPositionNed() *LocalPositionNed {
+	if x != nil {
+		return x.LocalPositionNed
+	}
+	return nil
+}
+
+func (x *SubscribeLocalPositionResponse) GetOdometry() *Odometry {
+	if x != nil {
+		return x.Odometry
+	}
+	return nil
+}
+
+// LocalPositionNed represents the LOCAL_POSITION_NED MAVLink message
+// The filtered local position (e.g. fused computer vision and accelerometers).
+// Coordinate frame is right-handed, Z-axis down (aeronautical frame, NED / north-east-down convention).
+type LocalPositionNed struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp (time since system boot) (ms)
+	TimeBootMs uint32 `protobuf:"varint,1,opt,name=time_boot_ms,json=timeBootMs,proto3" json:"time_boot_ms,omitempty"`
+	// X Position (m)
+	X float32 `protobuf:"fixed32,2,opt,name=x,proto3" json:"x,omitempty"`
+	// Y Position (m)
+	Y float32 `protobuf:"fixed32,3,opt,name=y,proto3" json:"y,omitempty"`
+	// Z Position (m)
+	Z float32 `protobuf:"fixed32,4,opt,name=z,proto3" json:"z,omitempty"`
+	// X Speed (m/s)
+	Vx float32 `protobuf:"fixed32,5,opt,name=vx,proto3" json:"vx,omitempty"`
+	// Y Speed (m/s)
+	Vy float32 `protobuf:"fixed32,6,opt,name=vy,proto3" json:"vy,omitempty"`
+	// Z Speed (m/s)
+	Vz            float32 `protobuf:"fixed32,7,opt,name=vz,proto3" json:"vz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalPositionNed) Reset() {
+	*x = LocalPositionNed{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalPositionNed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalPositionNed) ProtoMessage() {}
+
+func (x *LocalPositionNed) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalPositionNed.ProtoReflect.Descriptor instead.
+func (*LocalPositionNed) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LocalPositionNed) GetTimeBootMs() uint32 {
+	if x != nil {
+		return x.TimeBootMs
+	}
+	return 0
+}
+
+func (x *LocalPositionNed) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *LocalPositionNed) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *LocalPositionNed) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *LocalPositionNed) GetVx() float32 {
+	if x != nil {
+		return x.Vx
+	}
+	return 0
+}
+
+func (x *LocalPositionNed) GetVy() float32 {
+	if x != nil {
+		return x.Vy
+	}
+	return 0
+}
+
+func (x *LocalPositionNed) GetVz() float32 {
+	if x != nil {
+		return x.Vz
+	}
+	return 0
+}
+
+// Odometry represents the ODOMETRY MAVLink message
+// Odometry message to communicate odometry information with an external interface.
+// Fits ROS REP 147 standard for aerial vehicles (http://www.ros.org/reps/rep-0147.html).
+type Odometry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
+	TimeUsec uint64 `protobuf:"varint,1,opt,name=time_usec,json=timeUsec,proto3" json:"time_usec,omitempty"`
+	// Coordinate frame of reference for the pose data
+	FrameId MavFrame `protobuf:"varint,2,opt,name=frame_id,json=frameId,proto3,enum=flightpath.MavFrame" json:"frame_id,omitempty"`
+	// Coordinate frame of reference for the velocity in free space (twist) data
+	ChildFrameId MavFrame `protobuf:"varint,3,opt,name=child_frame_id,json=childFrameId,proto3,enum=flightpath.MavFrame" json:"child_frame_id,omitempty"`
+	// X Position (m)
+	X float32 `protobuf:"fixed32,4,opt,name=x,proto3" json:"x,omitempty"`
+	// Y Position (m)
+	Y float32 `protobuf:"fixed32,5,opt,name=y,proto3" json:"y,omitempty"`
+	// Z Position (m)
+	Z float32 `protobuf:"fixed32,6,opt,name=z,proto3" json:"z,omitempty"`
+	// Quaternion components, w, x, y, z (1 0 0 0 is the null-rotation)
+	Q []float32 `protobuf:"fixed32,7,rep,packed,name=q,proto3" json:"q,omitempty"`
+	// X linear speed (m/s)
+	Vx float32 `protobuf:"fixed32,8,opt,name=vx,proto3" json:"vx,omitempty"`
+	// Y linear speed (m/s)
+	Vy float32 `protobuf:"fixed32,9,opt,name=vy,proto3" json:"vy,omitempty"`
+	// Z linear speed (m/s)
+	Vz float32 `protobuf:"fixed32,10,opt,name=vz,proto3" json:"vz,omitempty"`
+	// Roll angular speed (rad/s)
+	Rollspeed float32 `protobuf:"fixed32,11,opt,name=rollspeed,proto3" json:"rollspeed,omitempty"`
+	// Pitch angular speed (rad/s)
+	Pitchspeed float32 `protobuf:"fixed32,12,opt,name=pitchspeed,proto3" json:"pitchspeed,omitempty"`
+	// Yaw angular speed (rad/s)
+	Yawspeed float32 `protobuf:"fixed32,13,opt,name=yawspeed,proto3" json:"yawspeed,omitempty"`
+	// Pose covariance (states: x, y, z, roll, pitch, yaw)
+	PoseCovariance *Covariance `protobuf:"bytes,14,opt,name=pose_covariance,json=poseCovariance,proto3" json:"pose_covariance,omitempty"`
+	// Velocity covariance (states: vx, vy, vz, rollspeed, pitchspeed, yawspeed)
+	VelocityCovariance *Covariance `protobuf:"bytes,15,opt,name=velocity_covariance,json=velocityCovariance,proto3" json:"velocity_covariance,omitempty"`
+	// Estimate reset counter. This should be incremented when the estimate resets in any of the dimensions (position, velocity, attitude, angular speed).
+	ResetCounter uint32 `protobuf:"varint,16,opt,name=reset_counter,json=resetCounter,proto3" json:"reset_counter,omitempty"`
+	// Type of estimator that is providing the odometry
+	EstimatorType MavEstimatorType `protobuf:"varint,17,opt,name=estimator_type,json=estimatorType,proto3,enum=flightpath.MavEstimatorType" json:"estimator_type,omitempty"`
+	// Odometry quality metric as a percentage. -1 = odometry has failed, 0 = unknown/unset quality, 1 = worst quality, 100 = best quality
+	Quality       int32 `protobuf:"varint,18,opt,name=quality,proto3" json:"quality,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Odometry) Reset() {
+	*x = Odometry{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Odometry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Odometry) ProtoMessage() {}
+
+func (x *Odometry) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Odometry.ProtoReflect.Descriptor instead.
+func (*Odometry) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Odometry) GetTimeUsec() uint64 {
+	if x != nil {
+		return x.TimeUsec
+	}
+	return 0
+}
+
+func (x *Odometry) GetFrameId() MavFrame {
+	if x != nil {
+		return x.FrameId
+	}
+	return MavFrame_MAV_FRAME_UNSPECIFIED
+}
+
+func (x *Odometry) GetChildFrameId() MavFrame {
+	if x != nil {
+		return x.ChildFrameId
+	}
+	return MavFrame_MAV_FRAME_UNSPECIFIED
+}
+
+func (x *Odometry) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Odometry) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Odometry) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *Odometry) GetQ() []float32 {
+	if x != nil {
+		return x.Q
+	}
+	return nil
+}
+
+func (x *Odometry) GetVx() float32 {
+	if x != nil {
+		return x.Vx
+	}
+	return 0
+}
+
+func (x *Odometry) GetVy() float32 {
+	if x != nil {
+		return x.Vy
+	}
+	return 0
+}
+
+func (x *Odometry) GetVz() float32 {
+	if x != nil {
+		return x.Vz
+	}
+	return 0
+}
+
+func (x *Odometry) GetRollspeed() float32 {
+	if x != nil {
+		return x.Rollspeed
+	}
+	return 0
+}
+
+func (x *Odometry) GetPitchspeed() float32 {
+	if x != nil {
+		return x.Pitchspeed
+	}
+	return 0
+}
+
+func (x *Odometry) GetYawspeed() float32 {
+	if x != nil {
+		return x.Yawspeed
+	}
+	return 0
+}
+
+func (x *Odometry) GetPoseCovariance() *Covariance {
+	if x != nil {
+		return x.PoseCovariance
+	}
+	return nil
+}
+
+func (x *Odometry) GetVelocityCovariance() *Covariance {
+	if x != nil {
+		return x.VelocityCovariance
+	}
+	return nil
+}
+
+func (x *Odometry) GetResetCounter() uint32 {
+	if x != nil {
+		return x.ResetCounter
+	}
+	return 0
+}
+
+func (x *Odometry) GetEstimatorType() MavEstimatorType {
+	if x != nil {
+		return x.EstimatorType
+	}
+	return MavEstimatorType_MAV_ESTIMATOR_TYPE_UNSPECIFIED
+}
+
+func (x *Odometry) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+// Covariance is a 6x6 cross-covariance matrix decoded from the upper right triangle sent in MAVLink
+// messages (21 values, row-major).
+// MAVLink marks an unknown matrix by setting its first element to NaN. Individual unknown
+// elements are also sent as NaN, and are kept as NaN here.
+type Covariance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// False if the drone does not provide the covariance (first element NaN), in which case
+	// variances and matrix are empty
+	Known bool `protobuf:"varint,1,opt,name=known,proto3" json:"known,omitempty"`
+	// Variances of the six states (diagonal of the matrix)
+	Variances []float32 `protobuf:"fixed32,2,rep,packed,name=variances,proto3" json:"variances,omitempty"`
+	// Full symmetric matrix in row-major order (36 values)
+	Matrix        []float32 `protobuf:"fixed32,3,rep,packed,name=matrix,proto3" json:"matrix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Covariance) Reset() {
+	*x = Covariance{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Covariance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Covariance) ProtoMessage() {}
+
+func (x *Covariance) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Covariance.ProtoReflect.Descriptor instead.
+func (*Covariance) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Covariance) GetKnown() bool {
+	if x != nil {
+		return x.Known
+	}
+	return false
+}
+
+func (x *Covariance) GetVariances() []float32 {
+	if x != nil {
+		return x.Variances
+	}
+	return nil
+}
+
+func (x *Covariance) GetMatrix() []float32 {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+// SubscribeBatteryRequest is the request message for SubscribeBattery
+type SubscribeBatteryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeBatteryRequest) Reset() {
+	*x = SubscribeBatteryRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeBatteryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeBatteryRequest) ProtoMessage() {}
+
+func (x *SubscribeBatteryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeBatteryRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeBatteryRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{18}
+}
+
+// SubscribeBatteryResponse contains the state of a single battery
+type SubscribeBatteryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this battery state was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the battery state
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the battery state
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Battery state in normalized units
+	Battery       *Battery `protobuf:"bytes,4,opt,name=battery,proto3" json:"battery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeBatteryResponse) Reset() {
+	*x = SubscribeBatteryResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeBatteryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeBatteryResponse) ProtoMessage() {}
+
+func (x *SubscribeBatteryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeBatteryResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeBatteryResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SubscribeBatteryResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeBatteryResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeBatteryResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeBatteryResponse) GetBattery() *Battery {
+	if x != nil {
+		return x.Battery
+	}
+	return nil
+}
+
+// Battery is the state of a single battery in normalized units.
+// Values the drone does not report are not set.
+type Battery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Battery ID (the BATTERY_STATUS id, always 0 for SYS_STATUS)
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Message the battery state was decoded from
+	Source BatterySource `protobuf:"varint,2,opt,name=source,proto3,enum=flightpath.BatterySource" json:"source,omitempty"`
+	// Function of the battery
+	Function MavBatteryFunction `protobuf:"varint,3,opt,name=function,proto3,enum=flightpath.MavBatteryFunction" json:"function,omitempty"`
+	// Type (chemistry) of the battery
+	Type MavBatteryType `protobuf:"varint,4,opt,name=type,proto3,enum=flightpath.MavBatteryType" json:"type,omitempty"`
+	// Total battery voltage (V)
+	Voltage *float32 `protobuf:"fixed32,5,opt,name=voltage,proto3,oneof" json:"voltage,omitempty"`
+	// Voltage of every cell, including extended cells 11 to 14 (V).
+	// Empty if the drone only reports the total voltage.
+	CellVoltages []float32 `protobuf:"fixed32,6,rep,packed,name=cell_voltages,json=cellVoltages,proto3" json:"cell_voltages,omitempty"`
+	// Battery current, positive when discharging (A)
+	Current *float32 `protobuf:"fixed32,7,opt,name=current,proto3,oneof" json:"current,omitempty"`
+	// Consumed charge (mAh)
+	ConsumedCharge *float32 `protobuf:"fixed32,8,opt,name=consumed_charge,json=consumedCharge,proto3,oneof" json:"consumed_charge,omitempty"`
+	// Consumed energy (J)
+	ConsumedEnergy *float32 `protobuf:"fixed32,9,opt,name=consumed_energy,json=consumedEnergy,proto3,oneof" json:"consumed_energy,omitempty"`
+	// Temperature of the battery (degC)
+	Temperature *float32 `protobuf:"fixed32,10,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	// Remaining battery energy (0-100) (%)
+	RemainingPercent *float32 `protobuf:"fixed32,11,opt,name=remaining_percent,json=remainingPercent,proto3,oneof" json:"remaining_percent,omitempty"`
+	// Remaining battery time (s)
+	TimeRemaining *uint32 `protobuf:"varint,12,opt,name=time_remaining,json=timeRemaining,proto3,oneof" json:"time_remaining,omitempty"`
+	// State for extent of discharge
+	ChargeState MavBatteryChargeState `protobuf:"varint,13,opt,name=charge_state,json=chargeState,proto3,enum=flightpath.MavBatteryChargeState" json:"charge_state,omitempty"`
+	// Battery mode
+	Mode MavBatteryMode `protobuf:"varint,14,opt,name=mode,proto3,enum=flightpath.MavBatteryMode" json:"mode,omitempty"`
+	// Fault/health indications
+	Faults        *BatteryFaults `protobuf:"bytes,15,opt,name=faults,proto3" json:"faults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Battery) Reset() {
+	*x = Battery{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Battery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Battery) ProtoMessage() {}
+
+func (x *Battery) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Battery.ProtoReflect.Descriptor instead.
+func (*Battery) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Battery) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Battery) GetSource() BatterySource {
+	if x != nil {
+		return x.Source
+	}
+	return BatterySource_BATTERY_SOURCE_UNSPECIFIED
+}
+
+func (x *Battery) GetFunction() MavBatteryFunction {
+	if x != nil {
+		return x.Function
+	}
+	return MavBatteryFunction_MAV_BATTERY_FUNCTION_UNSPECIFIED
+}
+
+func (x *Battery) GetType() MavBatteryType {
+	if x != nil {
+		return x.Type
+	}
+	return MavBatteryType_MAV_BATTERY_TYPE_UNSPECIFIED
+}
+
+func (x *Battery) GetVoltage() float32 {
+	if x != nil && x.Voltage != nil {
+		return *x.Voltage
+	}
+	return 0
+}
+
+func (x *Battery) GetCellVoltages() []float32 {
+	if x != nil {
+		return x.CellVoltages
+	}
+	return nil
+}
+
+func (x *Battery) GetCurrent() float32 {
+	if x != nil && x.Current != nil {
+		return *x.Current
+	}
+	return 0
+}
+
+func (x *Battery) GetConsumedCharge() float32 {
+	if x != nil && x.ConsumedCharge != nil {
+		return *x.ConsumedCharge
+	}
+	return 0
+}
+
+func (x *Battery) GetConsumedEnergy() float32 {
+	if x != nil && x.ConsumedEnergy != nil {
+		return *x.ConsumedEnergy
+	}
+	return 0
+}
+
+func (x *Battery) GetTemperature() float32 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *Battery) GetRemainingPercent() float32 {
+	if x != nil && x.RemainingPercent != nil {
+		return *x.RemainingPercent
+	}
+	return 0
+}
+
+func (x *Battery) GetTimeRemaining() uint32 {
+	if x != nil && x.TimeRemaining != nil {
+		return *x.TimeRemaining
+	}
+	return 0
+}
+
+func (x *Battery) GetChargeState() MavBatteryChargeState {
+	if x != nil {
+		return x.ChargeState
+	}
+	return MavBatteryChargeState_MAV_BATTERY_CHARGE_STATE_UNSPECIFIED
+}
+
+func (x *Battery) GetMode() MavBatteryMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MavBatteryMode_MAV_BATTERY_MODE_UNSPECIFIED
+}
+
+func (x *Battery) GetFaults() *BatteryFaults {
+	if x != nil {
+		return x.Faults
+	}
+	return nil
+}
+
+// BatteryFaults represents the MAV_BATTERY_FAULT bitfield as structured boolean flags.
+// Bits are ordered from least significant (bit 0) to most significant (bit 8),
+// matching https://mavlink.io/en/messages/common.html#MAV_BATTERY_FAULT.
+type BatteryFaults struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bit 0 (1): Battery has deep discharged
+	DeepDischarge bool `protobuf:"varint,1,opt,name=deep_discharge,json=deepDischarge,proto3" json:"deep_discharge,omitempty"`
+	// Bit 1 (2): Voltage spikes
+	Spikes bool `protobuf:"varint,2,opt,name=spikes,proto3" json:"spikes,omitempty"`
+	// Bit 2 (4): One or more cells have failed
+	CellFail bool `protobuf:"varint,3,opt,name=cell_fail,json=cellFail,proto3" json:"cell_fail,omitempty"`
+	// Bit 3 (8): Over-current fault
+	OverCurrent bool `protobuf:"varint,4,opt,name=over_current,json=overCurrent,proto3" json:"over_current,omitempty"`
+	// Bit 4 (16): Over-temperature fault
+	OverTemperature bool `protobuf:"varint,5,opt,name=over_temperature,json=overTemperature,proto3" json:"over_temperature,omitempty"`
+	// Bit 5 (32): Under-temperature fault
+	UnderTemperature bool `protobuf:"varint,6,opt,name=under_temperature,json=underTemperature,proto3" json:"under_temperature,omitempty"`
+	// Bit 6 (64): Vehicle voltage is not compatible with this battery
+	IncompatibleVoltage bool `protobuf:"varint,7,opt,name=incompatible_voltage,json=incompatibleVoltage,proto3" json:"incompatible_voltage,omitempty"`
+	// Bit 7 (128): Battery firmware is not compatible with current autopilot firmware
+	IncompatibleFirmware bool `protobuf:"varint,8,opt,name=incompatible_firmware,json=incompatibleFirmware,proto3" json:"incompatible_firmware,omitempty"`
+	// Bit 8 (256): Battery is not compatible due to cell configuration
+	IncompatibleCellsConfiguration bool `protobuf:"varint,9,opt,name=incompatible_cells_configuration,json=incompatibleCellsConfiguration,proto3" json:"incompatible_cells_configuration,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *BatteryFaults) Reset() {
+	*x = BatteryFaults{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatteryFaults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatteryFaults) ProtoMessage() {}
+
+func (x *BatteryFaults) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatteryFaults.ProtoReflect.Descriptor instead.
+func (*BatteryFaults) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BatteryFaults) GetDeepDischarge() bool {
+	if x != nil {
+		return x.DeepDischarge
+	}
+	return false
+}
+
+func (x *BatteryFaults) GetSpikes() bool {
+	if x != nil {
+		return x.Spikes
+	}
+	return false
+}
+
+func (x *BatteryFaults) GetCellFail() bool {
+	if x != nil {
+		return x.CellFail
+	}
+	return false
+}
+
+func (x *BatteryFaults) GetOverCurrent() bool {
+	if x != nil {
+		return x.OverCurrent
+	}
+	return false
+}
+
+func (x *BatteryFaults) GetOverTemperature() bool {
+	if x != nil {
+		return x.OverTemperature
+	}
+	return false
+}
+
+func (x *BatteryFaults) GetUnderTemperature() bool {
+	if x != nil {
+		return x.UnderTemperature
+	}
+	return false
+}
+
+func (x *BatteryFaults) GetIncompatibleVoltage() bool {
+	if x != nil {
+		return x.IncompatibleVoltage
+	}
+	return false
+}
+
+func (x *BatteryFaults) GetIncompatibleFirmware() bool {
+	if x != nil {
+		return x.IncompatibleFirmware
+	}
+	return false
+}
+
+func (x *BatteryFaults) GetIncompatibleCellsConfiguration() bool {
+	if x != nil {
+		return x.IncompatibleCellsConfiguration
+	}
+	return false
+}
+
+// BatteryStatus represents the BATTERY_STATUS MAVLink message
+// Battery information. Updates GCS with flight controller battery status.
+type BatteryStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Battery ID
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Function of the battery
+	BatteryFunction MavBatteryFunction `protobuf:"varint,2,opt,name=battery_function,json=batteryFunction,proto3,enum=flightpath.MavBatteryFunction" json:"battery_function,omitempty"`
+	// Type (chemistry) of the battery
+	Type MavBatteryType `protobuf:"varint,3,opt,name=type,proto3,enum=flightpath.MavBatteryType" json:"type,omitempty"`
+	// Temperature of the battery. INT16_MAX for unknown temperature. (cdegC)
+	Temperature int32 `protobuf:"varint,4,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	// Battery voltage of cells 1 to 10 (see voltages_ext for cells 11-14). Cells in this field above the valid cell count for this battery should have the UINT16_MAX value. If individual cell voltages are unknown or not measured for this battery, then the overall battery voltage should be filled in cell 0, with all others set to UINT16_MAX. (mV)
+	Voltages []uint32 `protobuf:"varint,5,rep,packed,name=voltages,proto3" json:"voltages,omitempty"`
+	// Battery current, -1: autopilot does not measure the current (cA)
+	CurrentBattery int32 `protobuf:"varint,6,opt,name=current_battery,json=currentBattery,proto3" json:"current_battery,omitempty"`
+	// Consumed charge, -1: autopilot does not provide consumption estimate (mAh)
+	CurrentConsumed int32 `protobuf:"varint,7,opt,name=current_consumed,json=currentConsumed,proto3" json:"current_consumed,omitempty"`
+	// Consumed energy, -1: autopilot does not provide energy consumption estimate (hJ)
+	EnergyConsumed int32 `protobuf:"varint,8,opt,name=energy_consumed,json=energyConsumed,proto3" json:"energy_consumed,omitempty"`
+	// Remaining battery energy. Values: [0-100], -1: autopilot does not estimate the remaining battery. (%)
+	BatteryRemaining int32 `protobuf:"varint,9,opt,name=battery_remaining,json=batteryRemaining,proto3" json:"battery_remaining,omitempty"`
+	// Remaining battery time, 0: autopilot does not provide remaining battery time estimate (s)
+	TimeRemaining int32 `protobuf:"varint,10,opt,name=time_remaining,json=timeRemaining,proto3" json:"time_remaining,omitempty"`
+	// State for extent of discharge, provided by autopilot for warning or external reactions
+	ChargeState MavBatteryChargeState `protobuf:"varint,11,opt,name=charge_state,json=chargeState,proto3,enum=flightpath.MavBatteryChargeState" json:"charge_state,omitempty"`
+	// Battery voltages for cells 11 to 14. Cells above the valid cell count for this battery should have a value of 0, where zero indicates not supported. If the measured value is 0 then 1 should be sent instead. (mV)
+	VoltagesExt []uint32 `protobuf:"varint,12,rep,packed,name=voltages_ext,json=voltagesExt,proto3" json:"voltages_ext,omitempty"`
+	// Battery mode. Default (0) is that battery mode reporting is not supported or battery is in normal-use mode.
+	Mode MavBatteryMode `protobuf:"varint,13,opt,name=mode,proto3,enum=flightpath.MavBatteryMode" json:"mode,omitempty"`
+	// Fault/health indications (MAV_BATTERY_FAULT bitmask). These should be set when charge_state is MAV_BATTERY_CHARGE_STATE_FAILED or MAV_BATTERY_CHARGE_STATE_UNHEALTHY (if not, fault reporting is not supported).
+	FaultBitmask  uint32 `protobuf:"varint,14,opt,name=fault_bitmask,json=faultBitmask,proto3" json:"fault_bitmask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatteryStatus) Reset() {
+	*x = BatteryStatus{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatteryStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatteryStatus) ProtoMessage() {}
+
+func (x *BatteryStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatteryStatus.ProtoReflect.Descriptor instead.
+func (*BatteryStatus) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BatteryStatus) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BatteryStatus) GetBatteryFunction() MavBatteryFunction {
+	if x != nil {
+		return x.BatteryFunction
+	}
+	return MavBatteryFunction_MAV_BATTERY_FUNCTION_UNSPECIFIED
+}
+
+func (x *BatteryStatus) GetType() MavBatteryType {
+	if x != nil {
+		return x.Type
+	}
+	return MavBatteryType_MAV_BATTERY_TYPE_UNSPECIFIED
+}
+
+func (x *BatteryStatus) GetTemperature() int32 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *BatteryStatus) GetVoltages() []uint32 {
+	if x != nil {
+		return x.Voltages
+	}
+	return nil
+}
+
+func (x *BatteryStatus) GetCurrentBattery() int32 {
+	if x != nil {
+		return x.CurrentBattery
+	}
+	return 0
+}
+
+func (x *BatteryStatus) GetCurrentConsumed() int32 {
+	if x != nil {
+		return x.CurrentConsumed
+	}
+	return 0
+}
+
+func (x *BatteryStatus) GetEnergyConsumed() int32 {
+	if x != nil {
+		return x.EnergyConsumed
+	}
+	return 0
+}
+
+func (x *BatteryStatus) GetBatteryRemaining() int32 {
+	if x != nil {
+		return x.BatteryRemaining
+	}
+	return 0
+}
+
+func (x *BatteryStatus) GetTimeRemaining() int32 {
+	if x != nil {
+		return x.TimeRemaining
+	}
+	return 0
+}
+
+func (x *BatteryStatus) GetChargeState() MavBatteryChargeState {
+	if x != nil {
+		return x.ChargeState
+	}
+	return MavBatteryChargeState_MAV_BATTERY_CHARGE_STATE_UNSPECIFIED
+}
+
+func (x *BatteryStatus) GetVoltagesExt() []uint32 {
+	if x != nil {
+		return x.VoltagesExt
+	}
+	return nil
+}
+
+func (x *BatteryStatus) GetMode() MavBatteryMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MavBatteryMode_MAV_BATTERY_MODE_UNSPECIFIED
+}
+
+func (x *BatteryStatus) GetFaultBitmask() uint32 {
+	if x != nil {
+		return x.FaultBitmask
+	}
+	return 0
+}
+
+// SubscribeSystemStatusRequest is the request message for SubscribeSystemStatus
+type SubscribeSystemStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeSystemStatusRequest) Reset() {
+	*x = SubscribeSystemStatusRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeSystemStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeSystemStatusRequest) ProtoMessage() {}
+
+func (x *SubscribeSystemStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeSystemStatusRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeSystemStatusRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{23}
+}
+
+// SubscribeSystemStatusResponse contains SYS_STATUS message data
+type SubscribeSystemStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this system status was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the system status
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the system status
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// System status with the sensor bitmaps decoded
+	SystemStatus *SystemStatus `protobuf:"bytes,4,opt,name=system_status,json=systemStatus,proto3" json:"system_status,omitempty"`
+	// SYS_STATUS message data
+	SysStatus     *SysStatus `protobuf:"bytes,5,opt,name=sys_status,json=sysStatus,proto3" json:"sys_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeSystemStatusResponse) Reset() {
+	*x = SubscribeSystemStatusResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeSystemStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeSystemStatusResponse) ProtoMessage() {}
+
+func (x *SubscribeSystemStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeSystemStatusResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeSystemStatusResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SubscribeSystemStatusResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeSystemStatusResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeSystemStatusResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeSystemStatusResponse) GetSystemStatus() *SystemStatus {
+	if x != nil {
+		return x.SystemStatus
+	}
+	return nil
+}
+
+func (x *SubscribeSystemStatusResponse) GetSysStatus() *SysStatus {
+	if x != nil {
+		return x.SysStatus
+	}
+	return nil
+}
+
+// SystemStatus is the decoded system status of a drone
+type SystemStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// State of the onboard controllers and sensors
+	Sensors *SysStatusSensors `protobuf:"bytes,1,opt,name=sensors,proto3" json:"sensors,omitempty"`
+	// Maximum usage of the mainloop time (%)
+	CpuLoad float32 `protobuf:"fixed32,2,opt,name=cpu_load,json=cpuLoad,proto3" json:"cpu_load,omitempty"`
+	// Communication drop rate (UART, I2C, SPI, CAN), dropped packets on all links (%)
+	DropRateComm float32 `protobuf:"fixed32,3,opt,name=drop_rate_comm,json=dropRateComm,proto3" json:"drop_rate_comm,omitempty"`
+	// Communication errors (UART, I2C, SPI, CAN), dropped packets on all links
+	ErrorsComm    uint32 `protobuf:"varint,4,opt,name=errors_comm,json=errorsComm,proto3" json:"errors_comm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemStatus) Reset() {
+	*x = SystemStatus{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemStatus) ProtoMessage() {}
+
+func (x *SystemStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemStatus.ProtoReflect.Descriptor instead.
+func (*SystemStatus) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SystemStatus) GetSensors() *SysStatusSensors {
+	if x != nil {
+		return x.Sensors
+	}
+	return nil
+}
+
+func (x *SystemStatus) GetCpuLoad() float32 {
+	if x != nil {
+		return x.CpuLoad
+	}
+	return 0
+}
+
+func (x *SystemStatus) GetDropRateComm() float32 {
+	if x != nil {
+		return x.DropRateComm
+	}
+	return 0
+}
+
+func (x *SystemStatus) GetErrorsComm() uint32 {
+	if x != nil {
+		return x.ErrorsComm
+	}
+	return 0
+}
+
+// SensorStatus is the state of an onboard controller or sensor
+type SensorStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the controller or sensor is present
+	Present bool `protobuf:"varint,1,opt,name=present,proto3" json:"present,omitempty"`
+	// Whether the controller or sensor is enabled
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Whether the controller or sensor is operational (false: error)
+	Healthy       bool `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SensorStatus) Reset() {
+	*x = SensorStatus{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensorStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensorStatus) ProtoMessage() {}
+
+func (x *SensorStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensorStatus.ProtoReflect.Descriptor instead.
+func (*SensorStatus) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SensorStatus) GetPresent() bool {
+	if x != nil {
+		return x.Present
+	}
+	return false
+}
+
+func (x *SensorStatus) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SensorStatus) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+// SysStatusSensors represents the MAVLink MAV_SYS_STATUS_SENSOR and MAV_SYS_STATUS_SENSOR_EXTENDED
+// bitmaps of SYS_STATUS as structured data
+type SysStatusSensors struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bit 0 (0x01): 3D gyro
+	Gyro *SensorStatus `protobuf:"bytes,1,opt,name=gyro,proto3" json:"gyro,omitempty"`
+	// Bit 1 (0x02): 3D accelerometer
+	Accel *SensorStatus `protobuf:"bytes,2,opt,name=accel,proto3" json:"accel,omitempty"`
+	// Bit 2 (0x04): 3D magnetometer
+	Mag *SensorStatus `protobuf:"bytes,3,opt,name=mag,proto3" json:"mag,omitempty"`
+	// Bit 3 (0x08): Absolute pressure
+	AbsolutePressure *SensorStatus `protobuf:"bytes,4,opt,name=absolute_pressure,json=absolutePressure,proto3" json:"absolute_pressure,omitempty"`
+	// Bit 4 (0x10): Differential pressure
+	DifferentialPressure *SensorStatus `protobuf:"bytes,5,opt,name=differential_pressure,json=differentialPressure,proto3" json:"differential_pressure,omitempty"`
+	// Bit 5 (0x20): GPS
+	Gps *SensorStatus `protobuf:"bytes,6,opt,name=gps,proto3" json:"gps,omitempty"`
+	// Bit 6 (0x40): Optical flow
+	OpticalFlow *SensorStatus `protobuf:"bytes,7,opt,name=optical_flow,json=opticalFlow,proto3" json:"optical_flow,omitempty"`
+	// Bit 7 (0x80): Computer vision position
+	VisionPosition *SensorStatus `protobuf:"bytes,8,opt,name=vision_position,json=visionPosition,proto3" json:"vision_position,omitempty"`
+	// Bit 8 (0x100): Laser based position
+	LaserPosition *SensorStatus `protobuf:"bytes,9,opt,name=laser_position,json=laserPosition,proto3" json:"laser_position,omitempty"`
+	// Bit 9 (0x200): External ground truth (Vicon or Leica)
+	ExternalGroundTruth *SensorStatus `protobuf:"bytes,10,opt,name=external_ground_truth,json=externalGroundTruth,proto3" json:"external_ground_truth,omitempty"`
+	// Bit 10 (0x400): 3D angular rate control
+	AngularRateControl *SensorStatus `protobuf:"bytes,11,opt,name=angular_rate_control,json=angularRateControl,proto3" json:"angular_rate_control,omitempty"`
+	// Bit 11 (0x800): Attitude stabilization
+	AttitudeStabilization *SensorStatus `protobuf:"bytes,12,opt,name=attitude_stabilization,json=attitudeStabilization,proto3" json:"attitude_stabilization,omitempty"`
+	// Bit 12 (0x1000): Yaw position
+	YawPosition *SensorStatus `protobuf:"bytes,13,opt,name=yaw_position,json=yawPosition,proto3" json:"yaw_position,omitempty"`
+	// Bit 13 (0x2000): Z/altitude control
+	ZAltitudeControl *SensorStatus `protobuf:"bytes,14,opt,name=z_altitude_control,json=zAltitudeControl,proto3" json:"z_altitude_control,omitempty"`
+	// Bit 14 (0x4000): X/Y position control
+	XyPositionControl *SensorStatus `protobuf:"bytes,15,opt,name=xy_position_control,json=xyPositionControl,proto3" json:"xy_position_control,omitempty"`
+	// Bit 15 (0x8000): Motor outputs / control
+	MotorOutputs *SensorStatus `protobuf:"bytes,16,opt,name=motor_outputs,json=motorOutputs,proto3" json:"motor_outputs,omitempty"`
+	// Bit 16 (0x10000): RC receiver
+	RcReceiver *SensorStatus `protobuf:"bytes,17,opt,name=rc_receiver,json=rcReceiver,proto3" json:"rc_receiver,omitempty"`
+	// Bit 17 (0x20000): 2nd 3D gyro
+	Gyro2 *SensorStatus `protobuf:"bytes,18,opt,name=gyro2,proto3" json:"gyro2,omitempty"`
+	// Bit 18 (0x40000): 2nd 3D accelerometer
+	Accel2 *SensorStatus `protobuf:"bytes,19,opt,name=accel2,proto3" json:"accel2,omitempty"`
+	// Bit 19 (0x80000): 2nd 3D magnetometer
+	Mag2 *SensorStatus `protobuf:"bytes,20,opt,name=mag2,proto3" json:"mag2,omitempty"`
+	// Bit 20 (0x100000): Geofence
+	Geofence *SensorStatus `protobuf:"bytes,21,opt,name=geofence,proto3" json:"geofence,omitempty"`
+	// Bit 21 (0x200000): AHRS subsystem health
+	Ahrs *SensorStatus `protobuf:"bytes,22,opt,name=ahrs,proto3" json:"ahrs,omitempty"`
+	// Bit 22 (0x400000): Terrain subsystem health
+	Terrain *SensorStatus `protobuf:"bytes,23,opt,name=terrain,proto3" json:"terrain,omitempty"`
+	// Bit 23 (0x800000): Motors are reversed
+	ReverseMotor *SensorStatus `protobuf:"bytes,24,opt,name=reverse_motor,json=reverseMotor,proto3" json:"reverse_motor,omitempty"`
+	// Bit 24 (0x1000000): Logging
+	Logging *SensorStatus `protobuf:"bytes,25,opt,name=logging,proto3" json:"logging,omitempty"`
+	// Bit 25 (0x2000000): Battery
+	Battery *SensorStatus `protobuf:"bytes,26,opt,name=battery,proto3" json:"battery,omitempty"`
+	// Bit 26 (0x4000000): Proximity
+	Proximity *SensorStatus `protobuf:"bytes,27,opt,name=proximity,proto3" json:"proximity,omitempty"`
+	// Bit 27 (0x8000000): Satellite communication
+	Satcom *SensorStatus `protobuf:"bytes,28,opt,name=satcom,proto3" json:"satcom,omitempty"`
+	// Bit 28 (0x10000000): Pre-arm check status. Always healthy when armed.
+	PrearmCheck *SensorStatus `protobuf:"bytes,29,opt,name=prearm_check,json=prearmCheck,proto3" json:"prearm_check,omitempty"`
+	// Bit 29 (0x20000000): Avoidance/collision prevention
+	ObstacleAvoidance *SensorStatus `protobuf:"bytes,30,opt,name=obstacle_avoidance,json=obstacleAvoidance,proto3" json:"obstacle_avoidance,omitempty"`
+	// Bit 30 (0x40000000): Propulsion (actuator, esc, motor or propellor)
+	Propulsion *SensorStatus `protobuf:"bytes,31,opt,name=propulsion,proto3" json:"propulsion,omitempty"`
+	// Extended bit 0 (0x01): Recovery system (parachute, balloon, retracts etc)
+	RecoverySystem *SensorStatus `protobuf:"bytes,32,opt,name=recovery_system,json=recoverySystem,proto3" json:"recovery_system,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SysStatusSensors) Reset() {
+	*x = SysStatusSensors{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SysStatusSensors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysStatusSensors) ProtoMessage() {}
+
+func (x *SysStatusSensors) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysStatusSensors.ProtoReflect.Descriptor instead.
+func (*SysStatusSensors) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SysStatusSensors) GetGyro() *SensorStatus {
+	if x != nil {
+		return x.Gyro
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetAccel() *SensorStatus {
+	if x != nil {
+		return x.Accel
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetMag() *SensorStatus {
+	if x != nil {
+		return x.Mag
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetAbsolutePressure() *SensorStatus {
+	if x != nil {
+		return x.AbsolutePressure
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetDifferentialPressure() *SensorStatus {
+	if x != nil {
+		return x.DifferentialPressure
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetGps() *SensorStatus {
+	if x != nil {
+		return x.Gps
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetOpticalFlow() *SensorStatus {
+	if x != nil {
+		return x.OpticalFlow
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetVisionPosition() *SensorStatus {
+	if x != nil {
+		return x.VisionPosition
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetLaserPosition() *SensorStatus {
+	if x != nil {
+		return x.LaserPosition
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetExternalGroundTruth() *SensorStatus {
+	if x != nil {
+		return x.ExternalGroundTruth
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetAngularRateControl() *SensorStatus {
+	if x != nil {
+		return x.AngularRateControl
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetAttitudeStabilization() *SensorStatus {
+	if x != nil {
+		return x.AttitudeStabilization
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetYawPosition() *SensorStatus {
+	if x != nil {
+		return x.YawPosition
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetZAltitudeControl() *SensorStatus {
+	if x != nil {
+		return x.ZAltitudeControl
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetXyPositionControl() *SensorStatus {
+	if x != nil {
+		return x.XyPositionControl
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetMotorOutputs() *SensorStatus {
+	if x != nil {
+		return x.MotorOutputs
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetRcReceiver() *SensorStatus {
+	if x != nil {
+		return x.RcReceiver
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetGyro2() *SensorStatus {
+	if x != nil {
+		return x.Gyro2
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetAccel2() *SensorStatus {
+	if x != nil {
+		return x.Accel2
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetMag2() *SensorStatus {
+	if x != nil {
+		return x.Mag2
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetGeofence() *SensorStatus {
+	if x != nil {
+		return x.Geofence
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetAhrs() *SensorStatus {
+	if x != nil {
+		return x.Ahrs
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetTerrain() *SensorStatus {
+	if x != nil {
+		return x.Terrain
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetReverseMotor() *SensorStatus {
+	if x != nil {
+		return x.ReverseMotor
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetLogging() *SensorStatus {
+	if x != nil {
+		return x.Logging
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetBattery() *SensorStatus {
+	if x != nil {
+		return x.Battery
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetProximity() *SensorStatus {
+	if x != nil {
+		return x.Proximity
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetSatcom() *SensorStatus {
+	if x != nil {
+		return x.Satcom
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetPrearmCheck() *SensorStatus {
+	if x != nil {
+		return x.PrearmCheck
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetObstacleAvoidance() *SensorStatus {
+	if x != nil {
+		return x.ObstacleAvoidance
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetPropulsion() *SensorStatus {
+	if x != nil {
+		return x.Propulsion
+	}
+	return nil
+}
+
+func (x *SysStatusSensors) GetRecoverySystem() *SensorStatus {
+	if x != nil {
+		return x.RecoverySystem
+	}
+	return nil
+}
+
+// SysStatus represents the SYS_STATUS MAVLink message
+// The general system state.
+type SysStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bitmap showing which onboard controllers and sensors are present (MAV_SYS_STATUS_SENSOR). Value of 0: not present. Value of 1: present.
+	OnboardControlSensorsPresent uint32 `protobuf:"varint,1,opt,name=onboard_control_sensors_present,json=onboardControlSensorsPresent,proto3" json:"onboard_control_sensors_present,omitempty"`
+	// Bitmap showing which onboard controllers and sensors are enabled (MAV_SYS_STATUS_SENSOR). Value of 0: not enabled. Value of 1: enabled.
+	OnboardControlSensorsEnabled uint32 `protobuf:"varint,2,opt,name=onboard_control_sensors_enabled,json=onboardControlSensorsEnabled,proto3" json:"onboard_control_sensors_enabled,omitempty"`
+	// Bitmap showing which onboard controllers and sensors have an error (or are operational) (MAV_SYS_STATUS_SENSOR). Value of 0: error. Value of 1: healthy.
+	OnboardControlSensorsHealth uint32 `protobuf:"varint,3,opt,name=onboard_control_sensors_health,json=onboardControlSensorsHealth,proto3" json:"onboard_control_sensors_health,omitempty"`
+	// Maximum usage in percent of the mainloop time. Values: [0-1000] - should always be below 1000 (d%)
+	Load uint32 `protobuf:"varint,4,opt,name=load,proto3" json:"load,omitempty"`
+	// Battery voltage, UINT16_MAX: Voltage not sent by autopilot (mV)
+	VoltageBattery uint32 `protobuf:"varint,5,opt,name=voltage_battery,json=voltageBattery,proto3" json:"voltage_battery,omitempty"`
+	// Battery current, -1: Current not sent by autopilot (cA)
+	CurrentBattery int32 `protobuf:"varint,6,opt,name=current_battery,json=currentBattery,proto3" json:"current_battery,omitempty"`
+	// Battery energy remaining, -1: Battery remaining energy not sent by autopilot (%)
+	BatteryRemaining int32 `protobuf:"varint,7,opt,name=battery_remaining,json=batteryRemaining,proto3" json:"battery_remaining,omitempty"`
+	// Communication drop rate, (UART, I2C, SPI, CAN), dropped packets on all links (packets that were corrupted on reception on the MAV) (c%)
+	DropRateComm uint32 `protobuf:"varint,8,opt,name=drop_rate_comm,json=dropRateComm,proto3" json:"drop_rate_comm,omitempty"`
+	// Communication errors (UART, I2C, SPI, CAN), dropped packets on all links (packets that were corrupted on reception on the MAV)
+	ErrorsComm uint32 `protobuf:"varint,9,opt,name=errors_comm,json=errorsComm,proto3" json:"errors_comm,omitempty"`
+	// Autopilot-specific errors
+	ErrorsCount1 uint32 `protobuf:"varint,10,opt,name=errors_count1,json=errorsCount1,proto3" json:"errors_count1,omitempty"`
+	// Autopilot-specific errors
+	ErrorsCount2 uint32 `protobuf:"varint,11,opt,name=errors_count2,json=errorsCount2,proto3" json:"errors_count2,omitempty"`
+	// Autopilot-specific errors
+	ErrorsCount3 uint32 `protobuf:"varint,12,opt,name=errors_count3,json=errorsCount3,proto3" json:"errors_count3,omitempty"`
+	// Autopilot-specific errors
+	ErrorsCount4 uint32 `protobuf:"varint,13,opt,name=errors_count4,json=errorsCount4,proto3" json:"errors_count4,omitempty"`
+	// Bitmap showing which onboard controllers and sensors are present (MAV_SYS_STATUS_SENSOR_EXTENDED). Value of 0: not present. Value of 1: present.
+	OnboardControlSensorsPresentExtended uint32 `protobuf:"varint,14,opt,name=onboard_control_sensors_present_extended,json=onboardControlSensorsPresentExtended,proto3" json:"onboard_control_sensors_present_extended,omitempty"`
+	// Bitmap showing which onboard controllers and sensors are enabled (MAV_SYS_STATUS_SENSOR_EXTENDED). Value of 0: not enabled. Value of 1: enabled.
+	OnboardControlSensorsEnabledExtended uint32 `protobuf:"varint,15,opt,name=onboard_control_sensors_enabled_extended,json=onboardControlSensorsEnabledExtended,proto3" json:"onboard_control_sensors_enabled_extended,omitempty"`
+	// Bitmap showing which onboard controllers and sensors have an error (or are operational) (MAV_SYS_STATUS_SENSOR_EXTENDED). Value of 0: error. Value of 1: healthy.
+	OnboardControlSensorsHealthExtended uint32 `protobuf:"varint,16,opt,name=onboard_control_sensors_health_extended,json=onboardControlSensorsHealthExtended,proto3" json:"onboard_control_sensors_health_extended,omitempty"`
+	unknownFields                       protoimpl.UnknownFields
+	sizeCache                           protoimpl.SizeCache
+}
+
+func (x *SysStatus) Reset() {
+	*x = SysStatus{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SysStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SysStatus) ProtoMessage() {}
+
+func (x *SysStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SysStatus.ProtoReflect.Descriptor instead.
+func (*SysStatus) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SysStatus) GetOnboardControlSensorsPresent() uint32 {
+	if x != nil {
+		return x.OnboardControlSensorsPresent
+	}
+	return 0
+}
+
+func (x *SysStatus) GetOnboardControlSensorsEnabled() uint32 {
+	if x != nil {
+		return x.OnboardControlSensorsEnabled
+	}
+	return 0
+}
+
+func (x *SysStatus) GetOnboardControlSensorsHealth() uint32 {
+	if x != nil {
+		return x.OnboardControlSensorsHealth
+	}
+	return 0
+}
+
+func (x *SysStatus) GetLoad() uint32 {
+	if x != nil {
+		return x.Load
+	}
+	return 0
+}
+
+func (x *SysStatus) GetVoltageBattery() uint32 {
+	if x != nil {
+		return x.VoltageBattery
+	}
+	return 0
+}
+
+func (x *SysStatus) GetCurrentBattery() int32 {
+	if x != nil {
+		return x.CurrentBattery
+	}
+	return 0
+}
+
+func (x *SysStatus) GetBatteryRemaining() int32 {
+	if x != nil {
+		return x.BatteryRemaining
+	}
+	return 0
+}
+
+func (x *SysStatus) GetDropRateComm() uint32 {
+	if x != nil {
+		return x.DropRateComm
+	}
+	return 0
+}
+
+func (x *SysStatus) GetErrorsComm() uint32 {
+	if x != nil {
+		return x.ErrorsComm
+	}
+	return 0
+}
+
+func (x *SysStatus) GetErrorsCount1() uint32 {
+	if x != nil {
+		return x.ErrorsCount1
+	}
+	return 0
+}
+
+func (x *SysStatus) GetErrorsCount2() uint32 {
+	if x != nil {
+		return x.ErrorsCount2
+	}
+	return 0
+}
+
+func (x *SysStatus) GetErrorsCount3() uint32 {
+	if x != nil {
+		return x.ErrorsCount3
+	}
+	return 0
+}
+
+func (x *SysStatus) GetErrorsCount4() uint32 {
+	if x != nil {
+		return x.ErrorsCount4
+	}
+	return 0
+}
+
+func (x *SysStatus) GetOnboardControlSensorsPresentExtended() uint32 {
+	if x != nil {
+		return x.OnboardControlSensorsPresentExtended
+	}
+	return 0
+}
+
+func (x *SysStatus) GetOnboardControlSensorsEnabledExtended() uint32 {
+	if x != nil {
+		return x.OnboardControlSensorsEnabledExtended
+	}
+	return 0
+}
+
+func (x *SysStatus) GetOnboardControlSensorsHealthExtended() uint32 {
+	if x != nil {
+		return x.OnboardControlSensorsHealthExtended
+	}
+	return 0
+}
+
+// SubscribeFlightMetricsRequest is the request message for SubscribeFlightMetrics
+type SubscribeFlightMetricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeFlightMetricsRequest) Reset() {
+	*x = SubscribeFlightMetricsRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeFlightMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeFlightMetricsRequest) ProtoMessage() {}
+
+func (x *SubscribeFlightMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeFlightMetricsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeFlightMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{29}
+}
+
+// SubscribeFlightMetricsResponse contains VFR_HUD message data
+type SubscribeFlightMetricsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when these flight metrics were captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the flight metrics
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the flight metrics
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// VFR_HUD message data
+	VfrHud        *VfrHud `protobuf:"bytes,4,opt,name=vfr_hud,json=vfrHud,proto3" json:"vfr_hud,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeFlightMetricsResponse) Reset() {
+	*x = SubscribeFlightMetricsResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeFlightMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeFlightMetricsResponse) ProtoMessage() {}
+
+func (x *SubscribeFlightMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeFlightMetricsResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeFlightMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SubscribeFlightMetricsResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeFlightMetricsResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeFlightMetricsResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeFlightMetricsResponse) GetVfrHud() *VfrHud {
+	if x != nil {
+		return x.VfrHud
+	}
+	return nil
+}
+
+// SubscribeStatusTextRequest is the request message for SubscribeStatusText
+type SubscribeStatusTextRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only send messages at least as severe as this severity (all messages if unspecified)
+	MinSeverity   MavSeverity `protobuf:"varint,1,opt,name=min_severity,json=minSeverity,proto3,enum=flightpath.MavSeverity" json:"min_severity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeStatusTextRequest) Reset() {
+	*x = SubscribeStatusTextRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeStatusTextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeStatusTextRequest) ProtoMessage() {}
+
+func (x *SubscribeStatusTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeStatusTextRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeStatusTextRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SubscribeStatusTextRequest) GetMinSeverity() MavSeverity {
+	if x != nil {
+		return x.MinSeverity
+	}
+	return MavSeverity_MAV_SEVERITY_UNSPECIFIED
+}
+
+// SubscribeStatusTextResponse contains a (reassembled) STATUSTEXT message
+type SubscribeStatusTextResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this message was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the message
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the message
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Severity of the message
+	Severity MavSeverity `protobuf:"varint,4,opt,name=severity,proto3,enum=flightpath.MavSeverity" json:"severity,omitempty"`
+	// Full text of the message (all chunks joined)
+	Text string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	// True if some chunks of the message were not received in time (text only holds the received ones)
+	Incomplete    bool `protobuf:"varint,6,opt,name=incomplete,proto3" json:"incomplete,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeStatusTextResponse) Reset() {
+	*x = SubscribeStatusTextResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeStatusTextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeStatusTextResponse) ProtoMessage() {}
+
+func (x *SubscribeStatusTextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeStatusTextResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeStatusTextResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SubscribeStatusTextResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeStatusTextResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeStatusTextResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeStatusTextResponse) GetSeverity() MavSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return MavSeverity_MAV_SEVERITY_UNSPECIFIED
+}
+
+func (x *SubscribeStatusTextResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SubscribeStatusTextResponse) GetIncomplete() bool {
+	if x != nil {
+		return x.Incomplete
+	}
+	return false
+}
+
+// StatusText represents the STATUSTEXT MAVLink message
+// Status text message. These messages are printed in yellow in the COMM console of QGroundControl.
+// Long messages are split into chunks that share the same id.
+type StatusText struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Severity of status. Relies on the definitions within RFC-5424.
+	Severity MavSeverity `protobuf:"varint,1,opt,name=severity,proto3,enum=flightpath.MavSeverity" json:"severity,omitempty"`
+	// Status text message, without null termination character
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// Unique (opaque) identifier for this statustext message. May be used to reassemble a logical long-statustext message from a sequence of chunks. A value of zero indicates this is the only chunk in the sequence and the message can be emitted immediately.
+	Id uint32 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// This chunk's sequence number; indexing is from zero. Any null character in the text field is taken to mean this was the last chunk.
+	ChunkSeq      uint32 `protobuf:"varint,4,opt,name=chunk_seq,json=chunkSeq,proto3" json:"chunk_seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusText) Reset() {
+	*x = StatusText{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusText) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusText) ProtoMessage() {}
+
+func (x *StatusText) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusText.ProtoReflect.Descriptor instead.
+func (*StatusText) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StatusText) GetSeverity() MavSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return MavSeverity_MAV_SEVERITY_UNSPECIFIED
+}
+
+func (x *StatusText) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *StatusText) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StatusText) GetChunkSeq() uint32 {
+	if x != nil {
+		return x.ChunkSeq
+	}
+	return 0
+}
+
+// GetHomePositionRequest is the request message for GetHomePosition
+type GetHomePositionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// System ID of the drone. Defaults to 1 if not set.
+	SystemId uint32 `protobuf:"varint,1,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+	ComponentId   uint32 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHomePositionRequest) Reset() {
+	*x = GetHomePositionRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHomePositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHomePositionRequest) ProtoMessage() {}
+
+func (x *GetHomePositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHomePositionRequest.ProtoReflect.Descriptor instead.
+func (*GetHomePositionRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetHomePositionRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *GetHomePositionRequest) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+// GetHomePositionResponse contains HOME_POSITION message data
+type GetHomePositionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Home location in normalized units
+	Location *HomeLocation `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// HOME_POSITION message data
+	HomePosition  *HomePosition `protobuf:"bytes,2,opt,name=home_position,json=homePosition,proto3" json:"home_position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHomePositionResponse) Reset() {
+	*x = GetHomePositionResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHomePositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHomePositionResponse) ProtoMessage() {}
+
+func (x *GetHomePositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHomePositionResponse.ProtoReflect.Descriptor instead.
+func (*GetHomePositionResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetHomePositionResponse) GetLocation() *HomeLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *GetHomePositionResponse) GetHomePosition() *HomePosition {
+	if x != nil {
+		return x.HomePosition
+	}
+	return nil
+}
+
+// SubscribeHomePositionRequest is the request message for SubscribeHomePosition
+type SubscribeHomePositionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeHomePositionRequest) Reset() {
+	*x = SubscribeHomePositionRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeHomePositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeHomePositionRequest) ProtoMessage() {}
+
+func (x *SubscribeHomePositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeHomePositionRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeHomePositionRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{36}
+}
+
+// SubscribeHomePositionResponse contains HOME_POSITION message data
+type SubscribeHomePositionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this home position was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the home position
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the home position
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Home location in normalized units
+	Location *HomeLocation `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	// HOME_POSITION message data
+	HomePosition  *HomePosition `protobuf:"bytes,5,opt,name=home_position,json=homePosition,proto3" json:"home_position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeHomePositionResponse) Reset() {
+	*x = SubscribeHomePositionResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeHomePositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeHomePositionResponse) ProtoMessage() {}
+
+func (x *SubscribeHomePositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeHomePositionResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeHomePositionResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SubscribeHomePositionResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeHomePositionResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeHomePositionResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeHomePositionResponse) GetLocation() *HomeLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *SubscribeHomePositionResponse) GetHomePosition() *HomePosition {
+	if x != nil {
+		return x.HomePosition
+	}
+	return nil
+}
+
+// HomeLocation is the global home location from HOME_POSITION in normalized units
+type HomeLocation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Latitude (WGS84) (deg)
+	Latitude float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude (WGS84) (deg)
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Altitude (MSL) (m)
+	AbsoluteAltitude float32 `protobuf:"fixed32,3,opt,name=absolute_altitude,json=absoluteAltitude,proto3" json:"absolute_altitude,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *HomeLocation) Reset() {
+	*x = HomeLocation{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HomeLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HomeLocation) ProtoMessage() {}
+
+func (x *HomeLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HomeLocation.ProtoReflect.Descriptor instead.
+func (*HomeLocation) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *HomeLocation) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *HomeLocation) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *HomeLocation) GetAbsoluteAltitude() float32 {
+	if x != nil {
+		return x.AbsoluteAltitude
+	}
+	return 0
+}
+
+// HomePosition represents the HOME_POSITION MAVLink message
+// Contains the home position. The home position is the default position that the system will
+// return to and land on.
+type HomePosition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Latitude (WGS84) in degrees * 1E7
+	Latitude int32 `protobuf:"varint,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude (WGS84) in degrees * 1E7
+	Longitude int32 `protobuf:"varint,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Altitude (MSL). Positive for up. (mm)
+	Altitude int32 `protobuf:"varint,3,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	// Local X position of this position in the local coordinate frame (NED) (m)
+	X float32 `protobuf:"fixed32,4,opt,name=x,proto3" json:"x,omitempty"`
+	// Local Y position of this position in the local coordinate frame (NED) (m)
+	Y float32 `protobuf:"fixed32,5,opt,name=y,proto3" json:"y,omitempty"`
+	// Local Z position of this position in the local coordinate frame (NED: positive "down") (m)
+	Z float32 `protobuf:"fixed32,6,opt,name=z,proto3" json:"z,omitempty"`
+	// Quaternion indicating world-to-surface-normal and heading transformation of the takeoff position. Used to indicate the heading and slope of the ground.
+	Q []float32 `protobuf:"fixed32,7,rep,packed,name=q,proto3" json:"q,omitempty"`
+	// Local X position of the end of the approach vector (m)
+	ApproachX float32 `protobuf:"fixed32,8,opt,name=approach_x,json=approachX,proto3" json:"approach_x,omitempty"`
+	// Local Y position of the end of the approach vector (m)
+	ApproachY float32 `protobuf:"fixed32,9,opt,name=approach_y,json=approachY,proto3" json:"approach_y,omitempty"`
+	// Local Z position of the end of the approach vector (m)
+	ApproachZ float32 `protobuf:"fixed32,10,opt,name=approach_z,json=approachZ,proto3" json:"approach_z,omitempty"`
+	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
+	TimeUsec      uint64 `protobuf:"varint,11,opt,name=time_usec,json=timeUsec,proto3" json:"time_usec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HomePosition) Reset() {
+	*x = HomePosition{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HomePosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HomePosition) ProtoMessage() {}
+
+func (x *HomePosition) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HomePosition.ProtoReflect.Descriptor instead.
+func (*HomePosition) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *HomePosition) GetLatitude() int32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *HomePosition) GetLongitude() int32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *HomePosition) GetAltitude() int32 {
+	if x != nil {
+		return x.Altitude
+	}
+	return 0
+}
+
+func (x *HomePosition) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *HomePosition) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *HomePosition) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *HomePosition) GetQ() []float32 {
+	if x != nil {
+		return x.Q
+	}
+	return nil
+}
+
+func (x *HomePosition) GetApproachX() float32 {
+	if x != nil {
+		return x.ApproachX
+	}
+	return 0
+}
+
+func (x *HomePosition) GetApproachY() float32 {
+	if x != nil {
+		return x.ApproachY
+	}
+	return 0
+}
+
+func (x *HomePosition) GetApproachZ() float32 {
+	if x != nil {
+		return x.ApproachZ
+	}
+	return 0
+}
+
+func (x *HomePosition) GetTimeUsec() uint64 {
+	if x != nil {
+		return x.TimeUsec
+	}
+	return 0
+}
+
+// SubscribeRcChannelsRequest is the request message for SubscribeRcChannels
+type SubscribeRcChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRcChannelsRequest) Reset() {
+	*x = SubscribeRcChannelsRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRcChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRcChannelsRequest) ProtoMessage() {}
+
+func (x *SubscribeRcChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRcChannelsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRcChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{40}
+}
+
+// SubscribeRcChannelsResponse contains RC_CHANNELS message data
+type SubscribeRcChannelsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this RC input was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the RC input
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the RC input
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// RC input with unused channels left out
+	RcInput       *RcInput `protobuf:"bytes,4,opt,name=rc_input,json=rcInput,proto3" json:"rc_input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRcChannelsResponse) Reset() {
+	*x = SubscribeRcChannelsResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRcChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRcChannelsResponse) ProtoMessage() {}
+
+func (x *SubscribeRcChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRcChannelsResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeRcChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SubscribeRcChannelsResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeRcChannelsResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeRcChannelsResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeRcChannelsResponse) GetRcInput() *RcInput {
+	if x != nil {
+		return x.RcInput
+	}
+	return nil
+}
+
+// RcInput is the RC input from RC_CHANNELS with unused channels left out
+type RcInput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp (time since system boot) (ms)
+	TimeBootMs uint32 `protobuf:"varint,1,opt,name=time_boot_ms,json=timeBootMs,proto3" json:"time_boot_ms,omitempty"`
+	// Total number of RC channels being received. This can be larger than 18, indicating that more channels are available but not given in RC_CHANNELS. 0 when no RC channels are available.
+	ChannelCount uint32 `protobuf:"varint,2,opt,name=channel_count,json=channelCount,proto3" json:"channel_count,omitempty"`
+	// Values of the used channels (us). Channels reported as unused (UINT16_MAX) are left out.
+	Channels []*ChannelValue `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty"`
+	// Receive signal strength indicator in device-dependent units/scale [0-254]. Not set if invalid/unknown.
+	Rssi          *uint32 `protobuf:"varint,4,opt,name=rssi,proto3,oneof" json:"rssi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RcInput) Reset() {
+	*x = RcInput{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RcInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RcInput) ProtoMessage() {}
+
+func (x *RcInput) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RcInput.ProtoReflect.Descriptor instead.
+func (*RcInput) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RcInput) GetTimeBootMs() uint32 {
+	if x != nil {
+		return x.TimeBootMs
+	}
+	return 0
+}
+
+func (x *RcInput) GetChannelCount() uint32 {
+	if x != nil {
+		return x.ChannelCount
+	}
+	return 0
+}
+
+func (x *RcInput) GetChannels() []*ChannelValue {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *RcInput) GetRssi() uint32 {
+	if x != nil && x.Rssi != nil {
+		return *x.Rssi
+	}
+	return 0
+}
+
+// SubscribeActuatorOutputsRequest is the request message for SubscribeActuatorOutputs
+type SubscribeActuatorOutputsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeActuatorOutputsRequest) Reset() {
+	*x = SubscribeActuatorOutputsRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeActuatorOutputsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeActuatorOutputsRequest) ProtoMessage() {}
+
+func (x *SubscribeActuatorOutputsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeActuatorOutputsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeActuatorOutputsRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{43}
+}
+
+// SubscribeActuatorOutputsResponse contains SERVO_OUTPUT_RAW and ACTUATOR_OUTPUT_STATUS message data
+type SubscribeActuatorOutputsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when these outputs were captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the outputs
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the outputs
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Latest servo outputs of all ports (not set until the first SERVO_OUTPUT_RAW is received)
+	ServoOutputs *ServoOutputs `protobuf:"bytes,4,opt,name=servo_outputs,json=servoOutputs,proto3" json:"servo_outputs,omitempty"`
+	// Latest actuator outputs (not set until the first ACTUATOR_OUTPUT_STATUS is received)
+	ActuatorOutputs *ActuatorOutputs `protobuf:"bytes,5,opt,name=actuator_outputs,json=actuatorOutputs,proto3" json:"actuator_outputs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SubscribeActuatorOutputsResponse) Reset() {
+	*x = SubscribeActuatorOutputsResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeActuatorOutputsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeActuatorOutputsResponse) ProtoMessage() {}
+
+func (x *SubscribeActuatorOutputsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeActuatorOutputsResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeActuatorOutputsResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SubscribeActuatorOutputsResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeActuatorOutputsResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeActuatorOutputsResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeActuatorOutputsResponse) GetServoOutputs() *ServoOutputs {
+	if x != nil {
+		return x.ServoOutputs
+	}
+	return nil
+}
+
+func (x *SubscribeActuatorOutputsResponse) GetActuatorOutputs() *ActuatorOutputs {
+	if x != nil {
+		return x.ActuatorOutputs
+	}
+	return nil
+}
+
+// ServoOutputs are the servo outputs from the SERVO_OUTPUT_RAW messages of all ports, grouped
+// into a single channel list (port 0 holds channels 1-16, port 1 channels 17-32, etc.)
+type ServoOutputs struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ports a SERVO_OUTPUT_RAW has been received for
+	Ports []uint32 `protobuf:"varint,1,rep,packed,name=ports,proto3" json:"ports,omitempty"`
+	// Values of the used channels in channel order (us). Channels reported as unused are left out.
+	Channels      []*ChannelValue `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServoOutputs) Reset() {
+	*x = ServoOutputs{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServoOutputs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServoOutputs) ProtoMessage() {}
+
+func (x *ServoOutputs) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServoOutputs.ProtoReflect.Descriptor instead.
+func (*ServoOutputs) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ServoOutputs) GetPorts() []uint32 {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *ServoOutputs) GetChannels() []*ChannelValue {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+// ChannelValue is the value of a single RC or servo channel
+type ChannelValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Channel number (1-based)
+	Channel uint32 `protobuf:"varint,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Channel value (us)
+	Value         uint32 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelValue) Reset() {
+	*x = ChannelValue{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelValue) ProtoMessage() {}
+
+func (x *ChannelValue) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelValue.ProtoReflect.Descriptor instead.
+func (*ChannelValue) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ChannelValue) GetChannel() uint32 {
+	if x != nil {
+		return x.Channel
+	}
+	return 0
+}
+
+func (x *ChannelValue) GetValue() uint32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// ActuatorOutputs are the actuator outputs from ACTUATOR_OUTPUT_STATUS with unused actuators left out
+type ActuatorOutputs struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp (since system boot) (us)
+	TimeUsec uint64 `protobuf:"varint,1,opt,name=time_usec,json=timeUsec,proto3" json:"time_usec,omitempty"`
+	// Values of the used actuators
+	Actuators     []*ActuatorValue `protobuf:"bytes,2,rep,name=actuators,proto3" json:"actuators,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActuatorOutputs) Reset() {
+	*x = ActuatorOutputs{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActuatorOutputs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActuatorOutputs) ProtoMessage() {}
+
+func (x *ActuatorOutputs) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActuatorOutputs.ProtoReflect.Descriptor instead.
+func (*ActuatorOutputs) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ActuatorOutputs) GetTimeUsec() uint64 {
+	if x != nil {
+		return x.TimeUsec
+	}
+	return 0
+}
+
+func (x *ActuatorOutputs) GetActuators() []*ActuatorValue {
+	if x != nil {
+		return x.Actuators
+	}
+	return nil
+}
+
+// ActuatorValue is the value of a single actuator output
+type ActuatorValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Actuator number (1-based)
+	Actuator uint32 `protobuf:"varint,1,opt,name=actuator,proto3" json:"actuator,omitempty"`
+	// Servo / motor output value
+	Value         float32 `protobuf:"fixed32,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActuatorValue) Reset() {
+	*x = ActuatorValue{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActuatorValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActuatorValue) ProtoMessage() {}
+
+func (x *ActuatorValue) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActuatorValue.ProtoReflect.Descriptor instead.
+func (*ActuatorValue) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ActuatorValue) GetActuator() uint32 {
+	if x != nil {
+		return x.Actuator
+	}
+	return 0
+}
+
+func (x *ActuatorValue) GetValue() float32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// RcChannels represents the RC_CHANNELS MAVLink message
+// The PPM values of the RC channels received. The standard PPM modulation is as follows: 1000 microseconds: 0%, 2000 microseconds: 100%.
+type RcChannels struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp (time since system boot) (ms)
+	TimeBootMs uint32 `protobuf:"varint,1,opt,name=time_boot_ms,json=timeBootMs,proto3" json:"time_boot_ms,omitempty"`
+	// Total number of RC channels being received. This can be larger than 18, indicating that more channels are available but not given in this message. This value should be 0 when no RC channels are available.
+	Chancount uint32 `protobuf:"varint,2,opt,name=chancount,proto3" json:"chancount,omitempty"`
+	// RC channel 1 to 18 values. A channel should be disregarded if set to UINT16_MAX. (us)
+	ChanRaw []uint32 `protobuf:"varint,3,rep,packed,name=chan_raw,json=chanRaw,proto3" json:"chan_raw,omitempty"`
+	// Receive signal strength indicator in device-dependent units/scale. Values: [0-254], UINT8_MAX: invalid/unknown.
+	Rssi          uint32 `protobuf:"varint,4,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RcChannels) Reset() {
+	*x = RcChannels{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RcChannels) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RcChannels) ProtoMessage() {}
+
+func (x *RcChannels) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RcChannels.ProtoReflect.Descriptor instead.
+func (*RcChannels) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RcChannels) GetTimeBootMs() uint32 {
+	if x != nil {
+		return x.TimeBootMs
+	}
+	return 0
+}
+
+func (x *RcChannels) GetChancount() uint32 {
+	if x != nil {
+		return x.Chancount
+	}
+	return 0
+}
+
+func (x *RcChannels) GetChanRaw() []uint32 {
+	if x != nil {
+		return x.ChanRaw
+	}
+	return nil
+}
+
+func (x *RcChannels) GetRssi() uint32 {
+	if x != nil {
+		return x.Rssi
+	}
+	return 0
+}
+
+// ServoOutputRaw represents the SERVO_OUTPUT_RAW MAVLink message
+// Superseded by ACTUATOR_OUTPUT_STATUS. The RAW values of the servo outputs.
+type ServoOutputRaw struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number. (us)
+	TimeUsec uint32 `protobuf:"varint,1,opt,name=time_usec,json=timeUsec,proto3" json:"time_usec,omitempty"`
+	// Servo output port (set of 16 outputs = 1 port). Flight stacks running on Pixhawk should use: 0 = MAIN, 1 = AUX.
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// Servo output 1 to 16 values (us)
+	ServoRaw      []uint32 `protobuf:"varint,3,rep,packed,name=servo_raw,json=servoRaw,proto3" json:"servo_raw,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServoOutputRaw) Reset() {
+	*x = ServoOutputRaw{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServoOutputRaw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServoOutputRaw) ProtoMessage() {}
+
+func (x *ServoOutputRaw) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServoOutputRaw.ProtoReflect.Descriptor instead.
+func (*ServoOutputRaw) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ServoOutputRaw) GetTimeUsec() uint32 {
+	if x != nil {
+		return x.TimeUsec
+	}
+	return 0
+}
+
+func (x *ServoOutputRaw) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ServoOutputRaw) GetServoRaw() []uint32 {
+	if x != nil {
+		return x.ServoRaw
+	}
+	return nil
+}
+
+// ActuatorOutputStatus represents the ACTUATOR_OUTPUT_STATUS MAVLink message
+// The raw values of the actuator outputs (e.g. on Pixhawk, from MAIN, AUX ports).
+type ActuatorOutputStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp (since system boot) (us)
+	TimeUsec uint64 `protobuf:"varint,1,opt,name=time_usec,json=timeUsec,proto3" json:"time_usec,omitempty"`
+	// Active outputs
+	Active uint32 `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	// Servo / motor output array values. Zero values indicate unused channels.
+	Actuator      []float32 `protobuf:"fixed32,3,rep,packed,name=actuator,proto3" json:"actuator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActuatorOutputStatus) Reset() {
+	*x = ActuatorOutputStatus{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActuatorOutputStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActuatorOutputStatus) ProtoMessage() {}
+
+func (x *ActuatorOutputStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActuatorOutputStatus.ProtoReflect.Descriptor instead.
+func (*ActuatorOutputStatus) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ActuatorOutputStatus) GetTimeUsec() uint64 {
+	if x != nil {
+		return x.TimeUsec
+	}
+	return 0
+}
+
+func (x *ActuatorOutputStatus) GetActive() uint32 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *ActuatorOutputStatus) GetActuator() []float32 {
+	if x != nil {
+		return x.Actuator
+	}
+	return nil
+}
+
+// SubscribeEstimatorStatusRequest is the request message for SubscribeEstimatorStatus
+type SubscribeEstimatorStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeEstimatorStatusRequest) Reset() {
+	*x = SubscribeEstimatorStatusRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeEstimatorStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeEstimatorStatusRequest) ProtoMessage() {}
+
+func (x *SubscribeEstimatorStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeEstimatorStatusRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeEstimatorStatusRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{52}
+}
+
+// SubscribeEstimatorStatusResponse contains ESTIMATOR_STATUS and VIBRATION message data
+type SubscribeEstimatorStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this estimator status was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the estimator status
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the estimator status
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Latest ESTIMATOR_STATUS message data (not set until the first ESTIMATOR_STATUS is received)
+	EstimatorStatus *EstimatorStatus `protobuf:"bytes,4,opt,name=estimator_status,json=estimatorStatus,proto3" json:"estimator_status,omitempty"`
+	// Latest VIBRATION message data (not set until the first VIBRATION is received)
+	Vibration *Vibration `protobuf:"bytes,5,opt,name=vibration,proto3" json:"vibration,omitempty"`
+	// True if the estimator reports a valid attitude, velocity and position without GPS glitch or
+	// accelerometer error, all innovation test ratios are within the configured maximum, and the
+	// vibration levels (if known) are within the configured maximum.
+	// False until ESTIMATOR_STATUS is received.
+	EstimatorHealthy bool `protobuf:"varint,6,opt,name=estimator_healthy,json=estimatorHealthy,proto3" json:"estimator_healthy,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SubscribeEstimatorStatusResponse) Reset() {
+	*x = SubscribeEstimatorStatusResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeEstimatorStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeEstimatorStatusResponse) ProtoMessage() {}
+
+func (x *SubscribeEstimatorStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeEstimatorStatusResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeEstimatorStatusResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SubscribeEstimatorStatusResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeEstimatorStatusResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeEstimatorStatusResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeEstimatorStatusResponse) GetEstimatorStatus() *EstimatorStatus {
+	if x != nil {
+		return x.EstimatorStatus
+	}
+	return nil
+}
+
+func (x *SubscribeEstimatorStatusResponse) GetVibration() *Vibration {
+	if x != nil {
+		return x.Vibration
+	}
+	return nil
+}
+
+func (x *SubscribeEstimatorStatusResponse) GetEstimatorHealthy() bool {
+	if x != nil {
+		return x.EstimatorHealthy
+	}
+	return false
+}
+
+// EstimatorStatus represents the ESTIMATOR_STATUS MAVLink message
+// Estimator status message including flags, innovation test ratios and estimated accuracies.
+// The innovation test ratios show the magnitude of the sensor innovation divided by the innovation check threshold. Under normal operation the innovation test ratios should be below 0.5 with occasional values up to 1.0. Values greater than 1.0 should be rare under normal operation and indicate that a measurement has been rejected by the filter.
+type EstimatorStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number. (us)
+	TimeUsec uint64 `protobuf:"varint,1,opt,name=time_usec,json=timeUsec,proto3" json:"time_usec,omitempty"`
+	// Which EKF outputs are valid
+	Flags *EstimatorStatusFlags `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	// Velocity innovation test ratio
+	VelRatio float32 `protobuf:"fixed32,3,opt,name=vel_ratio,json=velRatio,proto3" json:"vel_ratio,omitempty"`
+	// Horizontal position innovation test ratio
+	PosHorizRatio float32 `protobuf:"fixed32,4,opt,name=pos_horiz_ratio,json=posHorizRatio,proto3" json:"pos_horiz_ratio,omitempty"`
+	// Vertical position innovation test ratio
+	PosVertRatio float32 `protobuf:"fixed32,5,opt,name=pos_vert_ratio,json=posVertRatio,proto3" json:"pos_vert_ratio,omitempty"`
+	// Magnetometer innovation test ratio
+	MagRatio float32 `protobuf:"fixed32,6,opt,name=mag_ratio,json=magRatio,proto3" json:"mag_ratio,omitempty"`
+	// Height above terrain innovation test ratio
+	HaglRatio float32 `protobuf:"fixed32,7,opt,name=hagl_ratio,json=haglRatio,proto3" json:"hagl_ratio,omitempty"`
+	// True airspeed innovation test ratio
+	TasRatio float32 `protobuf:"fixed32,8,opt,name=tas_ratio,json=tasRatio,proto3" json:"tas_ratio,omitempty"`
+	// Horizontal position 1-STD accuracy relative to the EKF local origin (m)
+	PosHorizAccuracy float32 `protobuf:"fixed32,9,opt,name=pos_horiz_accuracy,json=posHorizAccuracy,proto3" json:"pos_horiz_accuracy,omitempty"`
+	// Vertical position 1-STD accuracy relative to the EKF local origin (m)
+	PosVertAccuracy float32 `protobuf:"fixed32,10,opt,name=pos_vert_accuracy,json=posVertAccuracy,proto3" json:"pos_vert_accuracy,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EstimatorStatus) Reset() {
+	*x = EstimatorStatus{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstimatorStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimatorStatus) ProtoMessage() {}
+
+func (x *EstimatorStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimatorStatus.ProtoReflect.Descriptor instead.
+func (*EstimatorStatus) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *EstimatorStatus) GetTimeUsec() uint64 {
+	if x != nil {
+		return x.TimeUsec
+	}
+	return 0
+}
+
+func (x *EstimatorStatus) GetFlags() *EstimatorStatusFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *EstimatorStatus) GetVelRatio() float32 {
+	if x != nil {
+		return x.VelRatio
+	}
+	return 0
+}
+
+func (x *EstimatorStatus) GetPosHorizRatio() float32 {
+	if x != nil {
+		return x.PosHorizRatio
+	}
+	return 0
+}
+
+func (x *EstimatorStatus) GetPosVertRatio() float32 {
+	if x != nil {
+		return x.PosVertRatio
+	}
+	return 0
+}
+
+func (x *EstimatorStatus) GetMagRatio() float32 {
+	if x != nil {
+		return x.MagRatio
+	}
+	return 0
+}
+
+func (x *EstimatorStatus) GetHaglRatio() float32 {
+	if x != nil {
+		return x.HaglRatio
+	}
+	return 0
+}
+
+func (x *EstimatorStatus) GetTasRatio() float32 {
+	if x != nil {
+		return x.TasRatio
+	}
+	return 0
+}
+
+func (x *EstimatorStatus) GetPosHorizAccuracy() float32 {
+	if x != nil {
+		return x.PosHorizAccuracy
+	}
+	return 0
+}
+
+func (x *EstimatorStatus) GetPosVertAccuracy() float32 {
+	if x != nil {
+		return x.PosVertAccuracy
+	}
+	return 0
+}
+
+// EstimatorStatusFlags represents the ESTIMATOR_STATUS_FLAGS bitfield as structured boolean flags.
+// Bits are ordered from least significant (bit 0) to most significant (bit 11),
+// matching https://mavlink.io/en/messages/common.html#ESTIMATOR_STATUS_FLAGS.
+type EstimatorStatusFlags struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bit 0 (1): True if the attitude estimate is good
+	Attitude bool `protobuf:"varint,1,opt,name=attitude,proto3" json:"attitude,omitempty"`
+	// Bit 1 (2): True if the horizontal velocity estimate is good
+	VelocityHoriz bool `protobuf:"varint,2,opt,name=velocity_horiz,json=velocityHoriz,proto3" json:"velocity_horiz,omitempty"`
+	// Bit 2 (4): True if the vertical velocity estimate is good
+	VelocityVert bool `protobuf:"varint,3,opt,name=velocity_vert,json=velocityVert,proto3" json:"velocity_vert,omitempty"`
+	// Bit 3 (8): True if the horizontal position (relative) estimate is good
+	PosHorizRel bool `protobuf:"varint,4,opt,name=pos_horiz_rel,json=posHorizRel,proto3" json:"pos_horiz_rel,omitempty"`
+	// Bit 4 (16): True if the horizontal position (absolute) estimate is good
+	PosHorizAbs bool `protobuf:"varint,5,opt,name=pos_horiz_abs,json=posHorizAbs,proto3" json:"pos_horiz_abs,omitempty"`
+	// Bit 5 (32): True if the vertical position (absolute) estimate is good
+	PosVertAbs bool `protobuf:"varint,6,opt,name=pos_vert_abs,json=posVertAbs,proto3" json:"pos_vert_abs,omitempty"`
+	// Bit 6 (64): True if the vertical position (above ground) estimate is good
+	PosVertAgl bool `protobuf:"varint,7,opt,name=pos_vert_agl,json=posVertAgl,proto3" json:"pos_vert_agl,omitempty"`
+	// Bit 7 (128): True if the EKF is in a constant position mode and is not using external measurements (eg GPS or optical flow)
+	ConstPosMode bool `protobuf:"varint,8,opt,name=const_pos_mode,json=constPosMode,proto3" json:"const_pos_mode,omitempty"`
+	// Bit 8 (256): True if the EKF has sufficient data to enter a mode that will provide a (relative) position estimate
+	PredPosHorizRel bool `protobuf:"varint,9,opt,name=pred_pos_horiz_rel,json=predPosHorizRel,proto3" json:"pred_pos_horiz_rel,omitempty"`
+	// Bit 9 (512): True if the EKF has sufficient data to enter a mode that will provide a (absolute) position estimate
+	PredPosHorizAbs bool `protobuf:"varint,10,opt,name=pred_pos_horiz_abs,json=predPosHorizAbs,proto3" json:"pred_pos_horiz_abs,omitempty"`
+	// Bit 10 (1024): True if the EKF has detected a GPS glitch
+	GpsGlitch bool `protobuf:"varint,11,opt,name=gps_glitch,json=gpsGlitch,proto3" json:"gps_glitch,omitempty"`
+	// Bit 11 (2048): True if the EKF has detected bad accelerometer data
+	AccelError    bool `protobuf:"varint,12,opt,name=accel_error,json=accelError,proto3" json:"accel_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EstimatorStatusFlags) Reset() {
+	*x = EstimatorStatusFlags{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstimatorStatusFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimatorStatusFlags) ProtoMessage() {}
+
+func (x *EstimatorStatusFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimatorStatusFlags.ProtoReflect.Descriptor instead.
+func (*EstimatorStatusFlags) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *EstimatorStatusFlags) GetAttitude() bool {
+	if x != nil {
+		return x.Attitude
+	}
+	return false
+}
+
+func (x *EstimatorStatusFlags) GetVelocityHoriz() bool {
+	if x != nil {
+		return x.VelocityHoriz
+	}
+	return false
+}
+
+func (x *EstimatorStatusFlags) GetVelocityVert() bool {
+	if x != nil {
+		return x.VelocityVert
+	}
+	return false
+}
+
+func (x *EstimatorStatusFlags) GetPosHorizRel() bool {
+	if x != nil {
+		return x.PosHorizRel
+	}
+	return false
+}
+
+func (x *EstimatorStatusFlags) GetPosHorizAbs() bool {
+	if x != nil {
+		return x.PosHorizAbs
+	}
+	return false
+}
+
+func (x *EstimatorStatusFlags) GetPosVertAbs() bool {
+	if x != nil {
+		return x.PosVertAbs
+	}
+	return false
+}
+
+func (x *EstimatorStatusFlags) GetPosVertAgl() bool {
+	if x != nil {
+		return x.PosVertAgl
+	}
+	return false
+}
+
+func (x *EstimatorStatusFlags) GetConstPosMode() bool {
+	if x != nil {
+		return x.ConstPosMode
+	}
+	return false
+}
+
+func (x *EstimatorStatusFlags) GetPredPosHorizRel() bool {
+	if x != nil {
+		return x.PredPosHorizRel
+	}
+	return false
+}
+
+func (x *EstimatorStatusFlags) GetPredPosHorizAbs() bool {
+	if x != nil {
+		return x.PredPosHorizAbs
+	}
+	return false
+}
+
+func (x *EstimatorStatusFlags) GetGpsGlitch() bool {
+	if x != nil {
+		return x.GpsGlitch
+	}
+	return false
+}
+
+func (x *EstimatorStatusFlags) GetAccelError() bool {
+	if x != nil {
+		return x.AccelError
+	}
+	return false
+}
+
+// Vibration represents the VIBRATION MAVLink message
+// Vibration levels and accelerometer clipping.
+type Vibration struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number. (us)
+	TimeUsec uint64 `protobuf:"varint,1,opt,name=time_usec,json=timeUsec,proto3" json:"time_usec,omitempty"`
+	// Vibration levels on X-axis
+	VibrationX float32 `protobuf:"fixed32,2,opt,name=vibration_x,json=vibrationX,proto3" json:"vibration_x,omitempty"`
+	// Vibration levels on Y-axis
+	VibrationY float32 `protobuf:"fixed32,3,opt,name=vibration_y,json=vibrationY,proto3" json:"vibration_y,omitempty"`
+	// Vibration levels on Z-axis
+	VibrationZ float32 `protobuf:"fixed32,4,opt,name=vibration_z,json=vibrationZ,proto3" json:"vibration_z,omitempty"`
+	// First accelerometer clipping count
+	Clipping0 uint32 `protobuf:"varint,5,opt,name=clipping0,proto3" json:"clipping0,omitempty"`
+	// Second accelerometer clipping count
+	Clipping1 uint32 `protobuf:"varint,6,opt,name=clipping1,proto3" json:"clipping1,omitempty"`
+	// Third accelerometer clipping count
+	Clipping2     uint32 `protobuf:"varint,7,opt,name=clipping2,proto3" json:"clipping2,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vibration) Reset() {
+	*x = Vibration{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vibration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vibration) ProtoMessage() {}
+
+func (x *Vibration) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vibration.ProtoReflect.Descriptor instead.
+func (*Vibration) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Vibration) GetTimeUsec() uint64 {
+	if x != nil {
+		return x.TimeUsec
+	}
+	return 0
+}
+
+func (x *Vibration) GetVibrationX() float32 {
+	if x != nil {
+		return x.VibrationX
+	}
+	return 0
+}
+
+func (x *Vibration) GetVibrationY() float32 {
+	if x != nil {
+		return x.VibrationY
+	}
+	return 0
+}
+
+func (x *Vibration) GetVibrationZ() float32 {
+	if x != nil {
+		return x.VibrationZ
+	}
+	return 0
+}
+
+func (x *Vibration) GetClipping0() uint32 {
+	if x != nil {
+		return x.Clipping0
+	}
+	return 0
+}
+
+func (x *Vibration) GetClipping1() uint32 {
+	if x != nil {
+		return x.Clipping1
+	}
+	return 0
+}
+
+func (x *Vibration) GetClipping2() uint32 {
+	if x != nil {
+		return x.Clipping2
+	}
+	return 0
+}
+
+// SubscribeWindRequest is the request message for SubscribeWind
+type SubscribeWindRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeWindRequest) Reset() {
+	*x = SubscribeWindRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeWindRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeWindRequest) ProtoMessage() {}
+
+func (x *SubscribeWindRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeWindRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeWindRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{57}
+}
+
+// SubscribeWindResponse contains WIND_COV message data
+type SubscribeWindResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this wind estimate was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the wind estimate
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the wind estimate
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Wind as speed and direction. Not set if the horizontal wind is unknown.
+	Wind *Wind `protobuf:"bytes,4,opt,name=wind,proto3" json:"wind,omitempty"`
+	// WIND_COV message data
+	WindCov       *WindCov `protobuf:"bytes,5,opt,name=wind_cov,json=windCov,proto3" json:"wind_cov,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeWindResponse) Reset() {
+	*x = SubscribeWindResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeWindResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeWindResponse) ProtoMessage() {}
+
+func (x *SubscribeWindResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeWindResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeWindResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SubscribeWindResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeWindResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeWindResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeWindResponse) GetWind() *Wind {
+	if x != nil {
+		return x.Wind
+	}
+	return nil
+}
+
+func (x *SubscribeWindResponse) GetWindCov() *WindCov {
+	if x != nil {
+		return x.WindCov
+	}
+	return nil
+}
+
+// Wind is the horizontal wind from WIND_COV as speed and direction
+type Wind struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Horizontal wind speed (m/s)
+	Speed float32 `protobuf:"fixed32,1,opt,name=speed,proto3" json:"speed,omitempty"`
+	// Direction the wind is blowing from, clockwise from north (0..360) (deg)
+	Direction float32 `protobuf:"fixed32,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	// Vertical wind speed, positive down. Not set if unknown. (m/s)
+	SpeedDown     *float32 `protobuf:"fixed32,3,opt,name=speed_down,json=speedDown,proto3,oneof" json:"speed_down,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Wind) Reset() {
+	*x = Wind{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Wind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wind) ProtoMessage() {}
+
+func (x *Wind) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wind.ProtoReflect.Descriptor instead.
+func (*Wind) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Wind) GetSpeed() float32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *Wind) GetDirection() float32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *Wind) GetSpeedDown() float32 {
+	if x != nil && x.SpeedDown != nil {
+		return *x.SpeedDown
+	}
+	return 0
+}
+
+// WindCov represents the WIND_COV MAVLink message
+// Wind estimate from vehicle. Note that despite the name, this message does not actually contain any covariances but instead variability and accuracy fields in terms of standard deviation (1-STD).
+type WindCov struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number. (us)
+	TimeUsec uint64 `protobuf:"varint,1,opt,name=time_usec,json=timeUsec,proto3" json:"time_usec,omitempty"`
+	// Wind in North (NED) direction (NAN if unknown) (m/s)
+	WindX float32 `protobuf:"fixed32,2,opt,name=wind_x,json=windX,proto3" json:"wind_x,omitempty"`
+	// Wind in East (NED) direction (NAN if unknown) (m/s)
+	WindY float32 `protobuf:"fixed32,3,opt,name=wind_y,json=windY,proto3" json:"wind_y,omitempty"`
+	// Wind in down (NED) direction (NAN if unknown) (m/s)
+	WindZ float32 `protobuf:"fixed32,4,opt,name=wind_z,json=windZ,proto3" json:"wind_z,omitempty"`
+	// Variability of wind in XY, 1-STD estimated from a 1 Hz lowpassed wind estimate (NAN if unknown) (m/s)
+	VarHoriz float32 `protobuf:"fixed32,5,opt,name=var_horiz,json=varHoriz,proto3" json:"var_horiz,omitempty"`
+	// Variability of wind in Z, 1-STD estimated from a 1 Hz lowpassed wind estimate (NAN if unknown) (m/s)
+	VarVert float32 `protobuf:"fixed32,6,opt,name=var_vert,json=varVert,proto3" json:"var_vert,omitempty"`
+	// Altitude (MSL) that this measurement was taken at (NAN if unknown) (m)
+	WindAlt float32 `protobuf:"fixed32,7,opt,name=wind_alt,json=windAlt,proto3" json:"wind_alt,omitempty"`
+	// Horizontal speed 1-STD accuracy (0 if unknown) (m/s)
+	HorizAccuracy float32 `protobuf:"fixed32,8,opt,name=horiz_accuracy,json=horizAccuracy,proto3" json:"horiz_accuracy,omitempty"`
+	// Vertical speed 1-STD accuracy (0 if unknown) (m/s)
+	VertAccuracy  float32 `protobuf:"fixed32,9,opt,name=vert_accuracy,json=vertAccuracy,proto3" json:"vert_accuracy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WindCov) Reset() {
+	*x = WindCov{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WindCov) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindCov) ProtoMessage() {}
+
+func (x *WindCov) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindCov.ProtoReflect.Descriptor instead.
+func (*WindCov) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *WindCov) GetTimeUsec() uint64 {
+	if x != nil {
+		return x.TimeUsec
+	}
+	return 0
+}
+
+func (x *WindCov) GetWindX() float32 {
+	if x != nil {
+		return x.WindX
+	}
+	return 0
+}
+
+func (x *WindCov) GetWindY() float32 {
+	if x != nil {
+		return x.WindY
+	}
+	return 0
+}
+
+func (x *WindCov) GetWindZ() float32 {
+	if x != nil {
+		return x.WindZ
+	}
+	return 0
+}
+
+func (x *WindCov) GetVarHoriz() float32 {
+	if x != nil {
+		return x.VarHoriz
+	}
+	return 0
+}
+
+func (x *WindCov) GetVarVert() float32 {
+	if x != nil {
+		return x.VarVert
+	}
+	return 0
+}
+
+func (x *WindCov) GetWindAlt() float32 {
+	if x != nil {
+		return x.WindAlt
+	}
+	return 0
+}
+
+func (x *WindCov) GetHorizAccuracy() float32 {
+	if x != nil {
+		return x.HorizAccuracy
+	}
+	return 0
+}
+
+func (x *WindCov) GetVertAccuracy() float32 {
+	if x != nil {
+		return x.VertAccuracy
+	}
+	return 0
+}
+
+// SubscribeDistanceSensorRequest is the request message for SubscribeDistanceSensor
+type SubscribeDistanceSensorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeDistanceSensorRequest) Reset() {
+	*x = SubscribeDistanceSensorRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeDistanceSensorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeDistanceSensorRequest) ProtoMessage() {}
+
+func (x *SubscribeDistanceSensorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeDistanceSensorRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeDistanceSensorRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{61}
+}
+
+// SubscribeDistanceSensorResponse contains DISTANCE_SENSOR message data
+type SubscribeDistanceSensorResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this reading was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the reading
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the reading
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// DISTANCE_SENSOR message data (sensors are identified by distance_sensor.id)
+	DistanceSensor *DistanceSensor `protobuf:"bytes,4,opt,name=distance_sensor,json=distanceSensor,proto3" json:"distance_sensor,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubscribeDistanceSensorResponse) Reset() {
+	*x = SubscribeDistanceSensorResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeDistanceSensorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeDistanceSensorResponse) ProtoMessage() {}
+
+func (x *SubscribeDistanceSensorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeDistanceSensorResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeDistanceSensorResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SubscribeDistanceSensorResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeDistanceSensorResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeDistanceSensorResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeDistanceSensorResponse) GetDistanceSensor() *DistanceSensor {
+	if x != nil {
+		return x.DistanceSensor
+	}
+	return nil
+}
+
+// DistanceSensor represents the DISTANCE_SENSOR MAVLink message
+// Distance sensor information for an onboard rangefinder.
+type DistanceSensor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp (time since system boot) (ms)
+	TimeBootMs uint32 `protobuf:"varint,1,opt,name=time_boot_ms,json=timeBootMs,proto3" json:"time_boot_ms,omitempty"`
+	// Minimum distance the sensor can measure (cm)
+	MinDistance uint32 `protobuf:"varint,2,opt,name=min_distance,json=minDistance,proto3" json:"min_distance,omitempty"`
+	// Maximum distance the sensor can measure (cm)
+	MaxDistance uint32 `protobuf:"varint,3,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	// Current distance reading (cm)
+	CurrentDistance uint32 `protobuf:"varint,4,opt,name=current_distance,json=currentDistance,proto3" json:"current_distance,omitempty"`
+	// Type of distance sensor
+	Type MavDistanceSensor `protobuf:"varint,5,opt,name=type,proto3,enum=flightpath.MavDistanceSensor" json:"type,omitempty"`
+	// Onboard ID of the sensor
+	Id uint32 `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"`
+	// Direction the sensor faces. downward-facing: ROTATION_PITCH_270, upward-facing: ROTATION_PITCH_90, backward-facing: ROTATION_PITCH_180, forward-facing: ROTATION_NONE, left-facing: ROTATION_YAW_90, right-facing: ROTATION_YAW_270
+	Orientation MavSensorOrientation `protobuf:"varint,7,opt,name=orientation,proto3,enum=flightpath.MavSensorOrientation" json:"orientation,omitempty"`
+	// Measurement variance. Max standard deviation is 6cm. UINT8_MAX if unknown. (cm^2)
+	Covariance uint32 `protobuf:"varint,8,opt,name=covariance,proto3" json:"covariance,omitempty"`
+	// Horizontal Field of View (angle) where the distance measurement is valid and the field of view is known. Otherwise this is set to 0. (rad)
+	HorizontalFov float32 `protobuf:"fixed32,9,opt,name=horizontal_fov,json=horizontalFov,proto3" json:"horizontal_fov,omitempty"`
+	// Vertical Field of View (angle) where the distance measurement is valid and the field of view is known. Otherwise this is set to 0. (rad)
+	VerticalFov float32 `protobuf:"fixed32,10,opt,name=vertical_fov,json=verticalFov,proto3" json:"vertical_fov,omitempty"`
+	// Quaternion of the sensor orientation in vehicle body frame (w, x, y, z order, zero-rotation is 1, 0, 0, 0). Zero-rotation is along the vehicle body x-axis. This field is required if the orientation is set to MAV_SENSOR_ROTATION_CUSTOM. Set it to 0 if invalid.
+	Quaternion []float32 `protobuf:"fixed32,11,rep,packed,name=quaternion,proto3" json:"quaternion,omitempty"`
+	// Signal quality of the sensor. Specific to each sensor type, representing the relation of the signal strength with the target reflectivity, distance, size or aspect, but normalised as a percentage. 0 = unknown/unset signal quality, 1 = invalid signal, 100 = perfect signal. (%)
+	SignalQuality uint32 `protobuf:"varint,12,opt,name=signal_quality,json=signalQuality,proto3" json:"signal_quality,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DistanceSensor) Reset() {
+	*x = DistanceSensor{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistanceSensor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistanceSensor) ProtoMessage() {}
+
+func (x *DistanceSensor) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistanceSensor.ProtoReflect.Descriptor instead.
+func (*DistanceSensor) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DistanceSensor) GetTimeBootMs() uint32 {
+	if x != nil {
+		return x.TimeBootMs
+	}
+	return 0
+}
+
+func (x *DistanceSensor) GetMinDistance() uint32 {
+	if x != nil {
+		return x.MinDistance
+	}
+	return 0
+}
+
+func (x *DistanceSensor) GetMaxDistance() uint32 {
+	if x != nil {
+		return x.MaxDistance
+	}
+	return 0
+}
+
+func (x *DistanceSensor) GetCurrentDistance() uint32 {
+	if x != nil {
+		return x.CurrentDistance
+	}
+	return 0
+}
+
+func (x *DistanceSensor) GetType() MavDistanceSensor {
+	if x != nil {
+		return x.Type
+	}
+	return MavDistanceSensor_MAV_DISTANCE_SENSOR_UNSPECIFIED
+}
+
+func (x *DistanceSensor) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DistanceSensor) GetOrientation() MavSensorOrientation {
+	if x != nil {
+		return x.Orientation
+	}
+	return MavSensorOrientation_MAV_SENSOR_ORIENTATION_UNSPECIFIED
+}
+
+func (x *DistanceSensor) GetCovariance() uint32 {
+	if x != nil {
+		return x.Covariance
+	}
+	return 0
+}
+
+func (x *DistanceSensor) GetHorizontalFov() float32 {
+	if x != nil {
+		return x.HorizontalFov
+	}
+	return 0
+}
+
+func (x *DistanceSensor) GetVerticalFov() float32 {
+	if x != nil {
+		return x.VerticalFov
+	}
+	return 0
+}
+
+func (x *DistanceSensor) GetQuaternion() []float32 {
+	if x != nil {
+		return x.Quaternion
+	}
+	return nil
+}
+
+func (x *DistanceSensor) GetSignalQuality() uint32 {
+	if x != nil {
+		return x.SignalQuality
+	}
+	return 0
+}
+
+// SubscribeObstacleDistanceRequest is the request message for SubscribeObstacleDistance
+type SubscribeObstacleDistanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeObstacleDistanceRequest) Reset() {
+	*x = SubscribeObstacleDistanceRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeObstacleDistanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeObstacleDistanceRequest) ProtoMessage() {}
+
+func (x *SubscribeObstacleDistanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeObstacleDistanceRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeObstacleDistanceRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{64}
+}
+
+// SubscribeObstacleDistanceResponse contains OBSTACLE_DISTANCE message data
+type SubscribeObstacleDistanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when these obstacles were captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the obstacles
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the obstacles
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Distance to obstacles per direction, with angles resolved. Directions marked as unknown/not
+	// used (UINT16_MAX) are left out.
+	Sectors []*ObstacleSector `protobuf:"bytes,4,rep,name=sectors,proto3" json:"sectors,omitempty"`
+	// OBSTACLE_DISTANCE message data
+	ObstacleDistance *ObstacleDistance `protobuf:"bytes,5,opt,name=obstacle_distance,json=obstacleDistance,proto3" json:"obstacle_distance,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SubscribeObstacleDistanceResponse) Reset() {
+	*x = SubscribeObstacleDistanceResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeObstacleDistanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeObstacleDistanceResponse) ProtoMessage() {}
+
+func (x *SubscribeObstacleDistanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeObstacleDistanceResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeObstacleDistanceResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SubscribeObstacleDistanceResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeObstacleDistanceResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeObstacleDistanceResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeObstacleDistanceResponse) GetSectors() []*ObstacleSector {
+	if x != nil {
+		return x.Sectors
+	}
+	return nil
+}
+
+func (x *SubscribeObstacleDistanceResponse) GetObstacleDistance() *ObstacleDistance {
+	if x != nil {
+		return x.ObstacleDistance
+	}
+	return nil
+}
+
+// ObstacleSector is the distance to the nearest obstacle in one direction of an OBSTACLE_DISTANCE message
+type ObstacleSector struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Direction of the sector, clockwise from north (obstacle_distance.frame MAV_FRAME_GLOBAL) or
+	// from the vehicle front (MAV_FRAME_BODY_FRD) (0..360) (deg)
+	Angle float32 `protobuf:"fixed32,1,opt,name=angle,proto3" json:"angle,omitempty"`
+	// Distance to the obstacle. Not set if no obstacle is present within the sensor range. (m)
+	Distance      *float32 `protobuf:"fixed32,2,opt,name=distance,proto3,oneof" json:"distance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObstacleSector) Reset() {
+	*x = ObstacleSector{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObstacleSector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObstacleSector) ProtoMessage() {}
+
+func (x *ObstacleSector) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObstacleSector.ProtoReflect.Descriptor instead.
+func (*ObstacleSector) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ObstacleSector) GetAngle() float32 {
+	if x != nil {
+		return x.Angle
+	}
+	return 0
+}
+
+func (x *ObstacleSector) GetDistance() float32 {
+	if x != nil && x.Distance != nil {
+		return *x.Distance
+	}
+	return 0
+}
+
+// ObstacleDistance represents the OBSTACLE_DISTANCE MAVLink message
+// Obstacle distances in front of the sensor, starting from the left in increment degrees to the right
+type ObstacleDistance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number. (us)
+	TimeUsec uint64 `protobuf:"varint,1,opt,name=time_usec,json=timeUsec,proto3" json:"time_usec,omitempty"`
+	// Class id of the distance sensor type
+	SensorType MavDistanceSensor `protobuf:"varint,2,opt,name=sensor_type,json=sensorType,proto3,enum=flightpath.MavDistanceSensor" json:"sensor_type,omitempty"`
+	// Distance of obstacles around the vehicle with index 0 corresponding to north + angle_offset, unless otherwise specified in the frame. A value of 0 is valid and means that the obstacle is practically touching the sensor. A value of max_distance +1 means no obstacle is present. A value of UINT16_MAX for unknown/not used. In a array element, one unit corresponds to 1cm. (cm)
+	Distances []uint32 `protobuf:"varint,3,rep,packed,name=distances,proto3" json:"distances,omitempty"`
+	// Angular width in degrees of each array element. Increment direction is clockwise. This field is ignored if increment_f is non-zero. (deg)
+	Increment uint32 `protobuf:"varint,4,opt,name=increment,proto3" json:"increment,omitempty"`
+	// Minimum distance the sensor can measure (cm)
+	MinDistance uint32 `protobuf:"varint,5,opt,name=min_distance,json=minDistance,proto3" json:"min_distance,omitempty"`
+	// Maximum distance the sensor can measure (cm)
+	MaxDistance uint32 `protobuf:"varint,6,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	// Angular width in degrees of each array element as a float. If non-zero then this value is used instead of the uint8_t increment field. Positive is clockwise direction, negative is counter-clockwise. (deg)
+	IncrementF float32 `protobuf:"fixed32,7,opt,name=increment_f,json=incrementF,proto3" json:"increment_f,omitempty"`
+	// Relative angle offset of the 0-index element in the distances array. Value of 0 corresponds to forward. Positive is clockwise direction, negative is counter-clockwise. (deg)
+	AngleOffset float32 `protobuf:"fixed32,8,opt,name=angle_offset,json=angleOffset,proto3" json:"angle_offset,omitempty"`
+	// Coordinate frame of reference for the yaw rotation and offset of the sensor data. Defaults to MAV_FRAME_GLOBAL, which is north aligned. For body-mounted sensors use MAV_FRAME_BODY_FRD, which is vehicle front aligned.
+	Frame         MavFrame `protobuf:"varint,9,opt,name=frame,proto3,enum=flightpath.MavFrame" json:"frame,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObstacleDistance) Reset() {
+	*x = ObstacleDistance{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObstacleDistance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObstacleDistance) ProtoMessage() {}
+
+func (x *ObstacleDistance) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObstacleDistance.ProtoReflect.Descriptor instead.
+func (*ObstacleDistance) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ObstacleDistance) GetTimeUsec() uint64 {
+	if x != nil {
+		return x.TimeUsec
+	}
+	return 0
+}
+
+func (x *ObstacleDistance) GetSensorType() MavDistanceSensor {
+	if x != nil {
+		return x.SensorType
+	}
+	return MavDistanceSensor_MAV_DISTANCE_SENSOR_UNSPECIFIED
+}
+
+func (x *ObstacleDistance) GetDistances() []uint32 {
+	if x != nil {
+		return x.Distances
+	}
+	return nil
+}
+
+func (x *ObstacleDistance) GetIncrement() uint32 {
+	if x != nil {
+		return x.Increment
+	}
+	return 0
+}
+
+func (x *ObstacleDistance) GetMinDistance() uint32 {
+	if x != nil {
+		return x.MinDistance
+	}
+	return 0
+}
+
+func (x *ObstacleDistance) GetMaxDistance() uint32 {
+	if x != nil {
+		return x.MaxDistance
+	}
+	return 0
+}
+
+func (x *ObstacleDistance) GetIncrementF() float32 {
+	if x != nil {
+		return x.IncrementF
+	}
+	return 0
+}
+
+func (x *ObstacleDistance) GetAngleOffset() float32 {
+	if x != nil {
+		return x.AngleOffset
+	}
+	return 0
+}
+
+func (x *ObstacleDistance) GetFrame() MavFrame {
+	if x != nil {
+		return x.Frame
+	}
+	return MavFrame_MAV_FRAME_UNSPECIFIED
+}
+
+// SubscribeGpsReceiversRequest is the request message for SubscribeGpsReceivers
+type SubscribeGpsReceiversRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeGpsReceiversRequest) Reset() {
+	*x = SubscribeGpsReceiversRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeGpsReceiversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeGpsReceiversRequest) ProtoMessage() {}
+
+func (x *SubscribeGpsReceiversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeGpsReceiversRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeGpsReceiversRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{68}
+}
+
+// SubscribeGpsReceiversResponse contains GPS_RAW_INT or GPS2_RAW message data
+type SubscribeGpsReceiversResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this GPS data was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the GPS data
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the GPS data
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Instance of the GPS receiver (0 = GPS_RAW_INT, 1 = GPS2_RAW)
+	GpsInstance uint32 `protobuf:"varint,4,opt,name=gps_instance,json=gpsInstance,proto3" json:"gps_instance,omitempty"`
+	// GPS reading with unknown values left unset, for comparing receivers
+	Receiver *GpsReceiver `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// GPS_RAW_INT message data (first receiver only)
+	GpsRawInt *GpsRawInt `protobuf:"bytes,6,opt,name=gps_raw_int,json=gpsRawInt,proto3" json:"gps_raw_int,omitempty"`
+	// GPS2_RAW message data (second receiver only)
+	Gps2Raw       *Gps2Raw `protobuf:"bytes,7,opt,name=gps2_raw,json=gps2Raw,proto3" json:"gps2_raw,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeGpsReceiversResponse) Reset() {
+	*x = SubscribeGpsReceiversResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeGpsReceiversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeGpsReceiversResponse) ProtoMessage() {}
+
+func (x *SubscribeGpsReceiversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeGpsReceiversResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeGpsReceiversResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SubscribeGpsReceiversResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeGpsReceiversResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeGpsReceiversResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeGpsReceiversResponse) GetGpsInstance() uint32 {
+	if x != nil {
+		return x.GpsInstance
+	}
+	return 0
+}
+
+func (x *SubscribeGpsReceiversResponse) GetReceiver() *GpsReceiver {
+	if x != nil {
+		return x.Receiver
+	}
+	return nil
+}
+
+func (x *SubscribeGpsReceiversResponse) GetGpsRawInt() *GpsRawInt {
+	if x != nil {
+		return x.GpsRawInt
+	}
+	return nil
+}
+
+func (x *SubscribeGpsReceiversResponse) GetGps2Raw() *Gps2Raw {
+	if x != nil {
+		return x.Gps2Raw
+	}
+	return nil
+}
+
+// GpsReceiver is a GPS reading in SI units, independent of the MAVLink message it came from
+type GpsReceiver struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GPS fix type
+	FixType GpsFixType `protobuf:"varint,1,opt,name=fix_type,json=fixType,proto3,enum=flightpath.GpsFixType" json:"fix_type,omitempty"`
+	// Latitude (WGS84) (deg)
+	Latitude float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude (WGS84) (deg)
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Altitude (MSL), positive for up (m)
+	Altitude float32 `protobuf:"fixed32,4,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	// Horizontal dilution of position. Not set if unknown.
+	Hdop *float32 `protobuf:"fixed32,5,opt,name=hdop,proto3,oneof" json:"hdop,omitempty"`
+	// Vertical dilution of position. Not set if unknown.
+	Vdop *float32 `protobuf:"fixed32,6,opt,name=vdop,proto3,oneof" json:"vdop,omitempty"`
+	// Ground speed. Not set if unknown. (m/s)
+	GroundSpeed *float32 `protobuf:"fixed32,7,opt,name=ground_speed,json=groundSpeed,proto3,oneof" json:"ground_speed,omitempty"`
+	// Course over ground (direction of movement, not heading) (0..360). Not set if unknown. (deg)
+	Course *float32 `protobuf:"fixed32,8,opt,name=course,proto3,oneof" json:"course,omitempty"`
+	// Number of satellites visible. Not set if unknown.
+	SatellitesVisible *uint32 `protobuf:"varint,9,opt,name=satellites_visible,json=satellitesVisible,proto3,oneof" json:"satellites_visible,omitempty"`
+	// Position uncertainty. Not set if not reported. (m)
+	HorizontalAccuracy *float32 `protobuf:"fixed32,10,opt,name=horizontal_accuracy,json=horizontalAccuracy,proto3,oneof" json:"horizontal_accuracy,omitempty"`
+	// Altitude uncertainty. Not set if not reported. (m)
+	VerticalAccuracy *float32 `protobuf:"fixed32,11,opt,name=vertical_accuracy,json=verticalAccuracy,proto3,oneof" json:"vertical_accuracy,omitempty"`
+	// Speed uncertainty. Not set if not reported. (m/s)
+	SpeedAccuracy *float32 `protobuf:"fixed32,12,opt,name=speed_accuracy,json=speedAccuracy,proto3,oneof" json:"speed_accuracy,omitempty"`
+	// Yaw in earth frame from north (0..360). Not set if the receiver does not provide yaw or is currently unable to. (deg)
+	Yaw           *float32 `protobuf:"fixed32,13,opt,name=yaw,proto3,oneof" json:"yaw,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GpsReceiver) Reset() {
+	*x = GpsReceiver{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GpsReceiver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GpsReceiver) ProtoMessage() {}
+
+func (x *GpsReceiver) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GpsReceiver.ProtoReflect.Descriptor instead.
+func (*GpsReceiver) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GpsReceiver) GetFixType() GpsFixType {
+	if x != nil {
+		return x.FixType
+	}
+	return GpsFixType_GPS_FIX_TYPE_UNSPECIFIED
+}
+
+func (x *GpsReceiver) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GpsReceiver) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GpsReceiver) GetAltitude() float32 {
+	if x != nil {
+		return x.Altitude
+	}
+	return 0
+}
+
+func (x *GpsReceiver) GetHdop() float32 {
+	if x != nil && x.Hdop != nil {
+		return *x.Hdop
+	}
+	return 0
+}
+
+func (x *GpsReceiver) GetVdop() float32 {
+	if x != nil && x.Vdop != nil {
+		return *x.Vdop
+	}
+	return 0
+}
+
+func (x *GpsReceiver) GetGroundSpeed() float32 {
+	if x != nil && x.GroundSpeed != nil {
+		return *x.GroundSpeed
+	}
+	return 0
+}
+
+func (x *GpsReceiver) GetCourse() float32 {
+	if x != nil && x.Course != nil {
+		return *x.Course
+	}
+	return 0
+}
+
+func (x *GpsReceiver) GetSatellitesVisible() uint32 {
+	if x != nil && x.SatellitesVisible != nil {
+		return *x.SatellitesVisible
+	}
+	return 0
+}
+
+func (x *GpsReceiver) GetHorizontalAccuracy() float32 {
+	if x != nil && x.HorizontalAccuracy != nil {
+		return *x.HorizontalAccuracy
+	}
+	return 0
+}
+
+func (x *GpsReceiver) GetVerticalAccuracy() float32 {
+	if x != nil && x.VerticalAccuracy != nil {
+		return *x.VerticalAccuracy
+	}
+	return 0
+}
+
+func (x *GpsReceiver) GetSpeedAccuracy() float32 {
+	if x != nil && x.SpeedAccuracy != nil {
+		return *x.SpeedAccuracy
+	}
+	return 0
+}
+
+func (x *GpsReceiver) GetYaw() float32 {
+	if x != nil && x.Yaw != nil {
+		return *x.Yaw
+	}
+	return 0
+}
+
+// Gps2Raw represents the GPS2_RAW MAVLink message
+// Second GPS data.
+type Gps2Raw struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number. (us)
+	TimeUsec uint64 `protobuf:"varint,1,opt,name=time_usec,json=timeUsec,proto3" json:"time_usec,omitempty"`
+	// GPS fix type
+	FixType GpsFixType `protobuf:"varint,2,opt,name=fix_type,json=fixType,proto3,enum=flightpath.GpsFixType" json:"fix_type,omitempty"`
+	// Latitude (WGS84) (degE7)
+	Lat int32 `protobuf:"varint,3,opt,name=lat,proto3" json:"lat,omitempty"`
+	// Longitude (WGS84) (degE7)
+	Lon int32 `protobuf:"varint,4,opt,name=lon,proto3" json:"lon,omitempty"`
+	// Altitude (MSL). Positive for up. (mm)
+	Alt int32 `protobuf:"varint,5,opt,name=alt,proto3" json:"alt,omitempty"`
+	// GPS HDOP horizontal dilution of position (unitless * 100). If unknown, set to: UINT16_MAX
+	Eph uint32 `protobuf:"varint,6,opt,name=eph,proto3" json:"eph,omitempty"`
+	// GPS VDOP vertical dilution of position (unitless * 100). If unknown, set to: UINT16_MAX
+	Epv uint32 `protobuf:"varint,7,opt,name=epv,proto3" json:"epv,omitempty"`
+	// GPS ground speed. If unknown, set to: UINT16_MAX (cm/s)
+	Vel uint32 `protobuf:"varint,8,opt,name=vel,proto3" json:"vel,omitempty"`
+	// Course over ground (NOT heading, but direction of movement): 0.0..359.99 degrees. If unknown, set to: UINT16_MAX (cdeg)
+	Cog uint32 `protobuf:"varint,9,opt,name=cog,proto3" json:"cog,omitempty"`
+	// Number of satellites visible. If unknown, set to UINT8_MAX
+	SatellitesVisible uint32 `protobuf:"varint,10,opt,name=satellites_visible,json=satellitesVisible,proto3" json:"satellites_visible,omitempty"`
+	// Number of DGPS satellites
+	DgpsNumch uint32 `protobuf:"varint,11,opt,name=dgps_numch,json=dgpsNumch,proto3" json:"dgps_numch,omitempty"`
+	// Age of DGPS info (ms)
+	DgpsAge uint32 `protobuf:"varint,12,opt,name=dgps_age,json=dgpsAge,proto3" json:"dgps_age,omitempty"`
+	// Yaw in earth frame from north. Use 0 if this GPS does not provide yaw. Use UINT16_MAX if this GPS is configured to provide yaw and is currently unable to provide it. Use 36000 for north. (cdeg)
+	Yaw uint32 `protobuf:"varint,13,opt,name=yaw,proto3" json:"yaw,omitempty"`
+	// Altitude (above WGS84, EGM96 ellipsoid). Positive for up. (mm)
+	AltEllipsoid int32 `protobuf:"varint,14,opt,name=alt_ellipsoid,json=altEllipsoid,proto3" json:"alt_ellipsoid,omitempty"`
+	// Position uncertainty (mm)
+	HAcc uint32 `protobuf:"varint,15,opt,name=h_acc,json=hAcc,proto3" json:"h_acc,omitempty"`
+	// Altitude uncertainty (mm)
+	VAcc uint32 `protobuf:"varint,16,opt,name=v_acc,json=vAcc,proto3" json:"v_acc,omitempty"`
+	// Speed uncertainty (mm/s)
+	VelAcc uint32 `protobuf:"varint,17,opt,name=vel_acc,json=velAcc,proto3" json:"vel_acc,omitempty"`
+	// Heading / track uncertainty (degE5)
+	HdgAcc        uint32 `protobuf:"varint,18,opt,name=hdg_acc,json=hdgAcc,proto3" json:"hdg_acc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Gps2Raw) Reset() {
+	*x = Gps2Raw{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Gps2Raw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gps2Raw) ProtoMessage() {}
+
+func (x *Gps2Raw) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gps2Raw.ProtoReflect.Descriptor instead.
+func (*Gps2Raw) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Gps2Raw) GetTimeUsec() uint64 {
+	if x != nil {
+		return x.TimeUsec
+	}
+	return 0
+}
+
+func (x *Gps2Raw) GetFixType() GpsFixType {
+	if x != nil {
+		return x.FixType
+	}
+	return GpsFixType_GPS_FIX_TYPE_UNSPECIFIED
+}
+
+func (x *Gps2Raw) GetLat() int32 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *Gps2Raw) GetLon() int32 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *Gps2Raw) GetAlt() int32 {
+	if x != nil {
+		return x.Alt
+	}
+	return 0
+}
+
+func (x *Gps2Raw) GetEph() uint32 {
+	if x != nil {
+		return x.Eph
+	}
+	return 0
+}
+
+func (x *Gps2Raw) GetEpv() uint32 {
+	if x != nil {
+		return x.Epv
+	}
+	return 0
+}
+
+func (x *Gps2Raw) GetVel() uint32 {
+	if x != nil {
+		return x.Vel
+	}
+	return 0
+}
+
+func (x *Gps2Raw) GetCog() uint32 {
+	if x != nil {
+		return x.Cog
+	}
+	return 0
+}
+
+func (x *Gps2Raw) GetSatellitesVisible() uint32 {
+	if x != nil {
+		return x.SatellitesVisible
+	}
+	return 0
+}
+
+func (x *Gps2Raw) GetDgpsNumch() uint32 {
+	if x != nil {
+		return x.DgpsNumch
+	}
+	return 0
+}
+
+func (x *Gps2Raw) GetDgpsAge() uint32 {
+	if x != nil {
+		return x.DgpsAge
+	}
+	return 0
+}
+
+func (x *Gps2Raw) GetYaw() uint32 {
+	if x != nil {
+		return x.Yaw
+	}
+	return 0
+}
+
+func (x *Gps2Raw) GetAltEllipsoid() int32 {
+	if x != nil {
+		return x.AltEllipsoid
+	}
+	return 0
+}
+
+func (x *Gps2Raw) GetHAcc() uint32 {
+	if x != nil {
+		return x.HAcc
+	}
+	return 0
+}
+
+func (x *Gps2Raw) GetVAcc() uint32 {
+	if x != nil {
+		return x.VAcc
+	}
+	return 0
+}
+
+func (x *Gps2Raw) GetVelAcc() uint32 {
+	if x != nil {
+		return x.VelAcc
+	}
+	return 0
+}
+
+func (x *Gps2Raw) GetHdgAcc() uint32 {
+	if x != nil {
+		return x.HdgAcc
+	}
+	return 0
+}
+
+// SubscribeGpsStatusRequest is the request message for SubscribeGpsStatus
+type SubscribeGpsStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeGpsStatusRequest) Reset() {
+	*x = SubscribeGpsStatusRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeGpsStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeGpsStatusRequest) ProtoMessage() {}
+
+func (x *SubscribeGpsStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeGpsStatusRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeGpsStatusRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{72}
+}
+
+// SubscribeGpsStatusResponse contains GPS_STATUS message data
+type SubscribeGpsStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this GPS status was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the GPS status
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the GPS status
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Visible satellites (at most 20)
+	Satellites []*GpsSatellite `protobuf:"bytes,4,rep,name=satellites,proto3" json:"satellites,omitempty"`
+	// GPS_STATUS message data
+	GpsStatus     *GpsStatus `protobuf:"bytes,5,opt,name=gps_status,json=gpsStatus,proto3" json:"gps_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeGpsStatusResponse) Reset() {
+	*x = SubscribeGpsStatusResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeGpsStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeGpsStatusResponse) ProtoMessage() {}
+
+func (x *SubscribeGpsStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeGpsStatusResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeGpsStatusResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SubscribeGpsStatusResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeGpsStatusResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeGpsStatusResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeGpsStatusResponse) GetSatellites() []*GpsSatellite {
+	if x != nil {
+		return x.Satellites
+	}
+	return nil
+}
+
+func (x *SubscribeGpsStatusResponse) GetGpsStatus() *GpsStatus {
+	if x != nil {
+		return x.GpsStatus
+	}
+	return nil
+}
+
+// GpsSatellite is a satellite seen by a GPS receiver
+type GpsSatellite struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Global satellite ID
+	Prn uint32 `protobuf:"varint,1,opt,name=prn,proto3" json:"prn,omitempty"`
+	// Whether the satellite is used for localization
+	Used bool `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	// Elevation of the satellite (deg)
+	Elevation uint32 `protobuf:"varint,3,opt,name=elevation,proto3" json:"elevation,omitempty"`
+	// Direction of the satellite (0..360) (deg)
+	Azimuth float32 `protobuf:"fixed32,4,opt,name=azimuth,proto3" json:"azimuth,omitempty"`
+	// Signal to noise ratio of the satellite (dB)
+	Snr           uint32 `protobuf:"varint,5,opt,name=snr,proto3" json:"snr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GpsSatellite) Reset() {
+	*x = GpsSatellite{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GpsSatellite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GpsSatellite) ProtoMessage() {}
+
+func (x *GpsSatellite) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GpsSatellite.ProtoReflect.Descriptor instead.
+func (*GpsSatellite) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GpsSatellite) GetPrn() uint32 {
+	if x != nil {
+		return x.Prn
+	}
+	return 0
+}
+
+func (x *GpsSatellite) GetUsed() bool {
+	if x != nil {
+		return x.Used
+	}
+	return false
+}
+
+func (x *GpsSatellite) GetElevation() uint32 {
+	if x != nil {
+		return x.Elevation
+	}
+	return 0
+}
+
+func (x *GpsSatellite) GetAzimuth() float32 {
+	if x != nil {
+		return x.Azimuth
+	}
+	return 0
+}
+
+func (x *GpsSatellite) GetSnr() uint32 {
+	if x != nil {
+		return x.Snr
+	}
+	return 0
+}
+
+// GpsStatus represents the GPS_STATUS MAVLink message
+// The positioning status, as reported by GPS. This message is intended to display status information about each satellite visible to the receiver. This message can contain information for up to 20 satellites.
+type GpsStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of satellites visible
+	SatellitesVisible uint32 `protobuf:"varint,1,opt,name=satellites_visible,json=satellitesVisible,proto3" json:"satellites_visible,omitempty"`
+	// Global satellite ID
+	SatellitePrn []uint32 `protobuf:"varint,2,rep,packed,name=satellite_prn,json=satellitePrn,proto3" json:"satellite_prn,omitempty"`
+	// 0: Satellite not used, 1: used for localization
+	SatelliteUsed []uint32 `protobuf:"varint,3,rep,packed,name=satellite_used,json=satelliteUsed,proto3" json:"satellite_used,omitempty"`
+	// Elevation (0: right on top of receiver, 90: on the horizon) of satellite (deg)
+	SatelliteElevation []uint32 `protobuf:"varint,4,rep,packed,name=satellite_elevation,json=satelliteElevation,proto3" json:"satellite_elevation,omitempty"`
+	// Direction of satellite, 0: 0 deg, 255: 360 deg.
+	SatelliteAzimuth []uint32 `protobuf:"varint,5,rep,packed,name=satellite_azimuth,json=satelliteAzimuth,proto3" json:"satellite_azimuth,omitempty"`
+	// Signal to noise ratio of satellite (dB)
+	SatelliteSnr  []uint32 `protobuf:"varint,6,rep,packed,name=satellite_snr,json=satelliteSnr,proto3" json:"satellite_snr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GpsStatus) Reset() {
+	*x = GpsStatus{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GpsStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GpsStatus) ProtoMessage() {}
+
+func (x *GpsStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GpsStatus.ProtoReflect.Descriptor instead.
+func (*GpsStatus) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GpsStatus) GetSatellitesVisible() uint32 {
+	if x != nil {
+		return x.SatellitesVisible
+	}
+	return 0
+}
+
+func (x *GpsStatus) GetSatellitePrn() []uint32 {
+	if x != nil {
+		return x.SatellitePrn
+	}
+	return nil
+}
+
+func (x *GpsStatus) GetSatelliteUsed() []uint32 {
+	if x != nil {
+		return x.SatelliteUsed
+	}
+	return nil
+}
+
+func (x *GpsStatus) GetSatelliteElevation() []uint32 {
+	if x != nil {
+		return x.SatelliteElevation
+	}
+	return nil
+}
+
+func (x *GpsStatus) GetSatelliteAzimuth() []uint32 {
+	if x != nil {
+		return x.SatelliteAzimuth
+	}
+	return nil
+}
+
+func (x *GpsStatus) GetSatelliteSnr() []uint32 {
+	if x != nil {
+		return x.SatelliteSnr
+	}
+	return nil
+}
+
+// SubscribeGpsRtkRequest is the request message for SubscribeGpsRtk
+type SubscribeGpsRtkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeGpsRtkRequest) Reset() {
+	*x = SubscribeGpsRtkRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeGpsRtkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeGpsRtkRequest) ProtoMessage() {}
+
+func (x *SubscribeGpsRtkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeGpsRtkRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeGpsRtkRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{76}
+}
+
+// SubscribeGpsRtkResponse contains GPS_RTK or GPS2_RTK message data
+type SubscribeGpsRtkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this RTK status was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the RTK status
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the RTK status
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Instance of the GPS receiver (0 = GPS_RTK, 1 = GPS2_RTK)
+	GpsInstance uint32 `protobuf:"varint,4,opt,name=gps_instance,json=gpsInstance,proto3" json:"gps_instance,omitempty"`
+	// RTK baseline in meters
+	Baseline *RtkBaseline `protobuf:"bytes,5,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	// GPS_RTK or GPS2_RTK message data (both messages have the same fields)
+	GpsRtk        *GpsRtk `protobuf:"bytes,6,opt,name=gps_rtk,json=gpsRtk,proto3" json:"gps_rtk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeGpsRtkResponse) Reset() {
+	*x = SubscribeGpsRtkResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeGpsRtkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeGpsRtkResponse) ProtoMessage() {}
+
+func (x *SubscribeGpsRtkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeGpsRtkResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeGpsRtkResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SubscribeGpsRtkResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeGpsRtkResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeGpsRtkResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeGpsRtkResponse) GetGpsInstance() uint32 {
+	if x != nil {
+		return x.GpsInstance
+	}
+	return 0
+}
+
+func (x *SubscribeGpsRtkResponse) GetBaseline() *RtkBaseline {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+func (x *SubscribeGpsRtkResponse) GetGpsRtk() *GpsRtk {
+	if x != nil {
+		return x.GpsRtk
+	}
+	return nil
+}
+
+// RtkBaseline is the RTK baseline (vector from the base station to the receiver) in meters
+type RtkBaseline struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Coordinate system of the baseline
+	CoordinateSystem RtkBaselineCoordinateSystem `protobuf:"varint,1,opt,name=coordinate_system,json=coordinateSystem,proto3,enum=flightpath.RtkBaselineCoordinateSystem" json:"coordinate_system,omitempty"`
+	// ECEF x or NED north component (m)
+	A float32 `protobuf:"fixed32,2,opt,name=a,proto3" json:"a,omitempty"`
+	// ECEF y or NED east component (m)
+	B float32 `protobuf:"fixed32,3,opt,name=b,proto3" json:"b,omitempty"`
+	// ECEF z or NED down component (m)
+	C float32 `protobuf:"fixed32,4,opt,name=c,proto3" json:"c,omitempty"`
+	// Length of the baseline (m)
+	Length        float32 `protobuf:"fixed32,5,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RtkBaseline) Reset() {
+	*x = RtkBaseline{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RtkBaseline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RtkBaseline) ProtoMessage() {}
+
+func (x *RtkBaseline) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RtkBaseline.ProtoReflect.Descriptor instead.
+func (*RtkBaseline) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RtkBaseline) GetCoordinateSystem() RtkBaselineCoordinateSystem {
+	if x != nil {
+		return x.CoordinateSystem
+	}
+	return RtkBaselineCoordinateSystem_RTK_BASELINE_COORDINATE_SYSTEM_UNSPECIFIED
+}
+
+func (x *RtkBaseline) GetA() float32 {
+	if x != nil {
+		return x.A
+	}
+	return 0
+}
+
+func (x *RtkBaseline) GetB() float32 {
+	if x != nil {
+		return x.B
+	}
+	return 0
+}
+
+func (x *RtkBaseline) GetC() float32 {
+	if x != nil {
+		return x.C
+	}
+	return 0
+}
+
+func (x *RtkBaseline) GetLength() float32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// GpsRtk represents the GPS_RTK and GPS2_RTK MAVLink messages
+// RTK GPS data. Gives information on the relative baseline calculation the GPS is reporting
+type GpsRtk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Time since boot of last baseline message received. (ms)
+	TimeLastBaselineMs uint32 `protobuf:"varint,1,opt,name=time_last_baseline_ms,json=timeLastBaselineMs,proto3" json:"time_last_baseline_ms,omitempty"`
+	// Identification of connected RTK receiver.
+	RtkReceiverId uint32 `protobuf:"varint,2,opt,name=rtk_receiver_id,json=rtkReceiverId,proto3" json:"rtk_receiver_id,omitempty"`
+	// GPS Week Number of last baseline
+	Wn uint32 `protobuf:"varint,3,opt,name=wn,proto3" json:"wn,omitempty"`
+	// GPS Time of Week of last baseline (ms)
+	Tow uint32 `protobuf:"varint,4,opt,name=tow,proto3" json:"tow,omitempty"`
+	// GPS-specific health report for RTK data.
+	RtkHealth uint32 `protobuf:"varint,5,opt,name=rtk_health,json=rtkHealth,proto3" json:"rtk_health,omitempty"`
+	// Rate of baseline messages being received by GPS (Hz)
+	RtkRate uint32 `protobuf:"varint,6,opt,name=rtk_rate,json=rtkRate,proto3" json:"rtk_rate,omitempty"`
+	// Current number of sats used for RTK calculation.
+	Nsats uint32 `protobuf:"varint,7,opt,name=nsats,proto3" json:"nsats,omitempty"`
+	// Coordinate system of baseline
+	BaselineCoordsType RtkBaselineCoordinateSystem `protobuf:"varint,8,opt,name=baseline_coords_type,json=baselineCoordsType,proto3,enum=flightpath.RtkBaselineCoordinateSystem" json:"baseline_coords_type,omitempty"`
+	// Current baseline in ECEF x or NED north component. (mm)
+	BaselineAMm int32 `protobuf:"varint,9,opt,name=baseline_a_mm,json=baselineAMm,proto3" json:"baseline_a_mm,omitempty"`
+	// Current baseline in ECEF y or NED east component. (mm)
+	BaselineBMm int32 `protobuf:"varint,10,opt,name=baseline_b_mm,json=baselineBMm,proto3" json:"baseline_b_mm,omitempty"`
+	// Current baseline in ECEF z or NED down component. (mm)
+	BaselineCMm int32 `protobuf:"varint,11,opt,name=baseline_c_mm,json=baselineCMm,proto3" json:"baseline_c_mm,omitempty"`
+	// Current estimate of baseline accuracy.
+	Accuracy uint32 `protobuf:"varint,12,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	// Current number of integer ambiguity hypotheses.
+	IarNumHypotheses int32 `protobuf:"varint,13,opt,name=iar_num_hypotheses,json=iarNumHypotheses,proto3" json:"iar_num_hypotheses,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GpsRtk) Reset() {
+	*x = GpsRtk{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GpsRtk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GpsRtk) ProtoMessage() {}
+
+func (x *GpsRtk) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GpsRtk.ProtoReflect.Descriptor instead.
+func (*GpsRtk) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GpsRtk) GetTimeLastBaselineMs() uint32 {
+	if x != nil {
+		return x.TimeLastBaselineMs
+	}
+	return 0
+}
+
+func (x *GpsRtk) GetRtkReceiverId() uint32 {
+	if x != nil {
+		return x.RtkReceiverId
+	}
+	return 0
+}
+
+func (x *GpsRtk) GetWn() uint32 {
+	if x != nil {
+		return x.Wn
+	}
+	return 0
+}
+
+func (x *GpsRtk) GetTow() uint32 {
+	if x != nil {
+		return x.Tow
+	}
+	return 0
+}
+
+func (x *GpsRtk) GetRtkHealth() uint32 {
+	if x != nil {
+		return x.RtkHealth
+	}
+	return 0
+}
+
+func (x *GpsRtk) GetRtkRate() uint32 {
+	if x != nil {
+		return x.RtkRate
+	}
+	return 0
+}
+
+func (x *GpsRtk) GetNsats() uint32 {
+	if x != nil {
+		return x.Nsats
+	}
+	return 0
+}
+
+func (x *GpsRtk) GetBaselineCoordsType() RtkBaselineCoordinateSystem {
+	if x != nil {
+		return x.BaselineCoordsType
+	}
+	return RtkBaselineCoordinateSystem_RTK_BASELINE_COORDINATE_SYSTEM_UNSPECIFIED
+}
+
+func (x *GpsRtk) GetBaselineAMm() int32 {
+	if x != nil {
+		return x.BaselineAMm
+	}
+	return 0
+}
+
+func (x *GpsRtk) GetBaselineBMm() int32 {
+	if x != nil {
+		return x.BaselineBMm
+	}
+	return 0
+}
+
+func (x *GpsRtk) GetBaselineCMm() int32 {
+	if x != nil {
+		return x.BaselineCMm
+	}
+	return 0
+}
+
+func (x *GpsRtk) GetAccuracy() uint32 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *GpsRtk) GetIarNumHypotheses() int32 {
+	if x != nil {
+		return x.IarNumHypotheses
+	}
+	return 0
+}
+
+// SubscribeTrafficRequest is the request message for SubscribeTraffic
+type SubscribeTrafficRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Also send unmanned aircraft, space vehicles, surface vehicles and point obstacles
+	// (by default only manned aircraft and emitters of unknown type are sent)
+	IncludeAllEmitters bool `protobuf:"varint,1,opt,name=include_all_emitters,json=includeAllEmitters,proto3" json:"include_all_emitters,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SubscribeTrafficRequest) Reset() {
+	*x = SubscribeTrafficRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeTrafficRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeTrafficRequest) ProtoMessage() {}
+
+func (x *SubscribeTrafficRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeTrafficRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeTrafficRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SubscribeTrafficRequest) GetIncludeAllEmitters() bool {
+	if x != nil {
+		return x.IncludeAllEmitters
+	}
+	return false
+}
+
+// SubscribeTrafficResponse contains ADSB_VEHICLE message data
+type SubscribeTrafficResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this track was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the track
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the track
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// Aircraft track with unknown values left unset
+	Track *TrafficTrack `protobuf:"bytes,4,opt,name=track,proto3" json:"track,omitempty"`
+	// Separation from the drone (the latest position of the system sending the track). Not set if
+	// the position of the drone or of the aircraft is unknown.
+	Separation *TrafficSeparation `protobuf:"bytes,5,opt,name=separation,proto3" json:"separation,omitempty"`
+	// Whether the aircraft has not been reported for a while and its track has been dropped
+	// (the last reported track is sent)
+	Expired bool `protobuf:"varint,6,opt,name=expired,proto3" json:"expired,omitempty"`
+	// ADSB_VEHICLE message data
+	AdsbVehicle   *AdsbVehicle `protobuf:"bytes,7,opt,name=adsb_vehicle,json=adsbVehicle,proto3" json:"adsb_vehicle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeTrafficResponse) Reset() {
+	*x = SubscribeTrafficResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeTrafficResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeTrafficResponse) ProtoMessage() {}
+
+func (x *SubscribeTrafficResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeTrafficResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeTrafficResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SubscribeTrafficResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeTrafficResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeTrafficResponse) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *SubscribeTrafficResponse) GetTrack() *TrafficTrack {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+func (x *SubscribeTrafficResponse) GetSeparation() *TrafficSeparation {
+	if x != nil {
+		return x.Separation
+	}
+	return nil
+}
+
+func (x *SubscribeTrafficResponse) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *SubscribeTrafficResponse) GetAdsbVehicle() *AdsbVehicle {
+	if x != nil {
+		return x.AdsbVehicle
+	}
+	return nil
+}
+
+// TrafficTrack is an aircraft reported by ADS-B in SI units.
+// Values flagged as invalid are not set.
+type TrafficTrack struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ICAO address
+	IcaoAddress uint32 `protobuf:"varint,1,opt,name=icao_address,json=icaoAddress,proto3" json:"icao_address,omitempty"`
+	// Callsign
+	Callsign *string `protobuf:"bytes,2,opt,name=callsign,proto3,oneof" json:"callsign,omitempty"`
+	// Squawk code
+	Squawk *uint32 `protobuf:"varint,3,opt,name=squawk,proto3,oneof" json:"squawk,omitempty"`
+	// Emitter type
+	EmitterType AdsbEmitterType `protobuf:"varint,4,opt,name=emitter_type,json=emitterType,proto3,enum=flightpath.AdsbEmitterType" json:"emitter_type,omitempty"`
+	// Latitude (deg)
+	Latitude *float64 `protobuf:"fixed64,5,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	// Longitude (deg)
+	Longitude *float64 `protobuf:"fixed64,6,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	// Altitude (ASL) (m)
+	Altitude *float32 `protobuf:"fixed32,7,opt,name=altitude,proto3,oneof" json:"altitude,omitempty"`
+	// Whether the altitude is barometric (QNH) or geometric
+	AltitudeType AdsbAltitudeType `protobuf:"varint,8,opt,name=altitude_type,json=altitudeType,proto3,enum=flightpath.AdsbAltitudeType" json:"altitude_type,omitempty"`
+	// Course over ground (0..360) (deg)
+	Heading *float32 `protobuf:"fixed32,9,opt,name=heading,proto3,oneof" json:"heading,omitempty"`
+	// Horizontal speed (m/s)
+	HorizontalSpeed *float32 `protobuf:"fixed32,10,opt,name=horizontal_speed,json=horizontalSpeed,proto3,oneof" json:"horizontal_speed,omitempty"`
+	// Vertical speed, positive up (m/s)
+	VerticalSpeed *float32 `protobuf:"fixed32,11,opt,name=vertical_speed,json=verticalSpeed,proto3,oneof" json:"vertical_speed,omitempty"`
+	// Whether the track is simulated
+	Simulated bool `protobuf:"varint,12,opt,name=simulated,proto3" json:"simulated,omitempty"`
+	// Time since last communication with the aircraft (s)
+	TimeSinceLastContact uint32 `protobuf:"varint,13,opt,name=time_since_last_contact,json=timeSinceLastContact,proto3" json:"time_since_last_contact,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TrafficTrack) Reset() {
+	*x = TrafficTrack{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrafficTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficTrack) ProtoMessage() {}
+
+func (x *TrafficTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficTrack.ProtoReflect.Descriptor instead.
+func (*TrafficTrack) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *TrafficTrack) GetIcaoAddress() uint32 {
+	if x != nil {
+		return x.IcaoAddress
+	}
+	return 0
+}
+
+func (x *TrafficTrack) GetCallsign() string {
+	if x != nil && x.Callsign != nil {
+		return *x.Callsign
+	}
+	return ""
+}
+
+func (x *TrafficTrack) GetSquawk() uint32 {
+	if x != nil && x.Squawk != nil {
+		return *x.Squawk
+	}
+	return 0
+}
+
+func (x *TrafficTrack) GetEmitterType() AdsbEmitterType {
+	if x != nil {
+		return x.EmitterType
+	}
+	return AdsbEmitterType_ADSB_EMITTER_TYPE_UNSPECIFIED
+}
+
+func (x *TrafficTrack) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *TrafficTrack) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *TrafficTrack) GetAltitude() float32 {
+	if x != nil && x.Altitude != nil {
+		return *x.Altitude
+	}
+	return 0
+}
+
+func (x *TrafficTrack) GetAltitudeType() AdsbAltitudeType {
+	if x != nil {
+		return x.AltitudeType
+	}
+	return AdsbAltitudeType_ADSB_ALTITUDE_TYPE_UNSPECIFIED
+}
+
+func (x *TrafficTrack) GetHeading() float32 {
+	if x != nil && x.Heading != nil {
+		return *x.Heading
+	}
+	return 0
+}
+
+func (x *TrafficTrack) GetHorizontalSpeed() float32 {
+	if x != nil && x.HorizontalSpeed != nil {
+		return *x.HorizontalSpeed
+	}
+	return 0
+}
+
+func (x *TrafficTrack) GetVerticalSpeed() float32 {
+	if x != nil && x.VerticalSpeed != nil {
+		return *x.VerticalSpeed
+	}
+	return 0
+}
+
+func (x *TrafficTrack) GetSimulated() bool {
+	if x != nil {
+		return x.Simulated
+	}
+	return false
+}
+
+func (x *TrafficTrack) GetTimeSinceLastContact() uint32 {
+	if x != nil {
+		return x.TimeSinceLastContact
+	}
+	return 0
+}
+
+// TrafficSeparation is the separation between the drone and an aircraft
+type TrafficSeparation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Horizontal distance to the aircraft (m)
+	HorizontalDistance float32 `protobuf:"fixed32,1,opt,name=horizontal_distance,json=horizontalDistance,proto3" json:"horizontal_distance,omitempty"`
+	// Direction of the aircraft from the drone, clockwise from north (0..360) (deg)
+	Bearing float32 `protobuf:"fixed32,2,opt,name=bearing,proto3" json:"bearing,omitempty"`
+	// Altitude of the aircraft above the drone (negative if below). Not set if the aircraft altitude
+	// is unknown. (m)
+	VerticalSeparation *float32 `protobuf:"fixed32,3,opt,name=vertical_separation,json=verticalSeparation,proto3,oneof" json:"vertical_separation,omitempty"`
+	// Rate at which the distance to the aircraft decreases (negative if diverging). Not set if the
+	// aircraft velocity is unknown. (m/s)
+	ClosingRate   *float32 `protobuf:"fixed32,4,opt,name=closing_rate,json=closingRate,proto3,oneof" json:"closing_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrafficSeparation) Reset() {
+	*x = TrafficSeparation{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrafficSeparation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficSeparation) ProtoMessage() {}
+
+func (x *TrafficSeparation) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficSeparation.ProtoReflect.Descriptor instead.
+func (*TrafficSeparation) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *TrafficSeparation) GetHorizontalDistance() float32 {
+	if x != nil {
+		return x.HorizontalDistance
+	}
+	return 0
+}
+
+func (x *TrafficSeparation) GetBearing() float32 {
+	if x != nil {
+		return x.Bearing
+	}
+	return 0
+}
+
+func (x *TrafficSeparation) GetVerticalSeparation() float32 {
+	if x != nil && x.VerticalSeparation != nil {
+		return *x.VerticalSeparation
+	}
+	return 0
+}
+
+func (x *TrafficSeparation) GetClosingRate() float32 {
+	if x != nil && x.ClosingRate != nil {
+		return *x.ClosingRate
+	}
+	return 0
+}
+
+// AdsbVehicle represents the ADSB_VEHICLE MAVLink message
+// The location and information of an ADSB vehicle
+type AdsbVehicle struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ICAO address
+	IcaoAddress uint32 `protobuf:"varint,1,opt,name=icao_address,json=icaoAddress,proto3" json:"icao_address,omitempty"`
+	// Latitude (degE7)
+	Lat int32 `protobuf:"varint,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	// Longitude (degE7)
+	Lon int32 `protobuf:"varint,3,opt,name=lon,proto3" json:"lon,omitempty"`
+	// ADSB altitude type.
+	AltitudeType AdsbAltitudeType `protobuf:"varint,4,opt,name=altitude_type,json=altitudeType,proto3,enum=flightpath.AdsbAltitudeType" json:"altitude_type,omitempty"`
+	// Altitude(ASL) (mm)
+	Altitude int32 `protobuf:"varint,5,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	// Course over ground (cdeg)
+	Heading uint32 `protobuf:"varint,6,opt,name=heading,proto3" json:"heading,omitempty"`
+	// The horizontal velocity (cm/s)
+	HorVelocity uint32 `protobuf:"varint,7,opt,name=hor_velocity,json=horVelocity,proto3" json:"hor_velocity,omitempty"`
+	// The vertical velocity. Positive is up (cm/s)
+	VerVelocity int32 `protobuf:"varint,8,opt,name=ver_velocity,json=verVelocity,proto3" json:"ver_velocity,omitempty"`
+	// The callsign, 8+null
+	Callsign string `protobuf:"bytes,9,opt,name=callsign,proto3" json:"callsign,omitempty"`
+	// ADSB emitter type.
+	EmitterType AdsbEmitterType `protobuf:"varint,10,opt,name=emitter_type,json=emitterType,proto3,enum=flightpath.AdsbEmitterType" json:"emitter_type,omitempty"`
+	// Time since last communication in seconds (s)
+	Tslc uint32 `protobuf:"varint,11,opt,name=tslc,proto3" json:"tslc,omitempty"`
+	// Bitmap to indicate various statuses including valid data fields (ADSB_FLAGS)
+	Flags uint32 `protobuf:"varint,12,opt,name=flags,proto3" json:"flags,omitempty"`
+	// Squawk code. Note that the code is in decimal: e.g. 7700 (general emergency) is encoded as binary 0b0001_1110_0001_0100, not(!) as 0b0000_111_111_000_000
+	Squawk        uint32 `protobuf:"varint,13,opt,name=squawk,proto3" json:"squawk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdsbVehicle) Reset() {
+	*x = AdsbVehicle{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdsbVehicle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdsbVehicle) ProtoMessage() {}
+
+func (x *AdsbVehicle) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdsbVehicle.ProtoReflect.Descriptor instead.
+func (*AdsbVehicle) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AdsbVehicle) GetIcaoAddress() uint32 {
+	if x != nil {
+		return x.IcaoAddress
+	}
+	return 0
+}
+
+func (x *AdsbVehicle) GetLat() int32 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *AdsbVehicle) GetLon() int32 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *AdsbVehicle) GetAltitudeType() AdsbAltitudeType {
+	if x != nil {
+		return x.AltitudeType
+	}
+	return AdsbAltitudeType_ADSB_ALTITUDE_TYPE_UNSPECIFIED
+}
+
+func (x *AdsbVehicle) GetAltitude() int32 {
+	if x != nil {
+		return x.Altitude
+	}
+	return 0
+}
+
+func (x *AdsbVehicle) GetHeading() uint32 {
+	if x != nil {
+		return x.Heading
+	}
+	return 0
+}
+
+func (x *AdsbVehicle) GetHorVelocity() uint32 {
+	if x != nil {
+		return x.HorVelocity
+	}
+	return 0
+}
+
+func (x *AdsbVehicle) GetVerVelocity() int32 {
+	if x != nil {
+		return x.VerVelocity
+	}
+	return 0
+}
+
+func (x *AdsbVehicle) GetCallsign() string {
+	if x != nil {
+		return x.Callsign
+	}
+	return ""
+}
+
+func (x *AdsbVehicle) GetEmitterType() AdsbEmitterType {
+	if x != nil {
+		return x.EmitterType
+	}
+	return AdsbEmitterType_ADSB_EMITTER_TYPE_UNSPECIFIED
+}
+
+func (x *AdsbVehicle) GetTslc() uint32 {
+	if x != nil {
+		return x.Tslc
+	}
+	return 0
+}
+
+func (x *AdsbVehicle) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *AdsbVehicle) GetSquawk() uint32 {
+	if x != nil {
+		return x.Squawk
+	}
+	return 0
+}
+
+// SubscribeVehicleStateRequest is the request message for SubscribeVehicleState
+type SubscribeVehicleStateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rate at which snapshots are sent (0 = 1 Hz, at most 50 Hz) (Hz)
+	RateHz float32 `protobuf:"fixed32,1,opt,name=rate_hz,json=rateHz,proto3" json:"rate_hz,omitempty"`
+	// Only send the state of this system (0 = all systems)
+	SystemId      uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeVehicleStateRequest) Reset() {
+	*x = SubscribeVehicleStateRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeVehicleStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeVehicleStateRequest) ProtoMessage() {}
+
+func (x *SubscribeVehicleStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeVehicleStateRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeVehicleStateRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SubscribeVehicleStateRequest) GetRateHz() float32 {
+	if x != nil {
+		return x.RateHz
+	}
+	return 0
+}
+
+func (x *SubscribeVehicleStateRequest) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+// SubscribeVehicleStateResponse contains the state of a single drone
+type SubscribeVehicleStateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this snapshot was taken (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the drone
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// State of the drone
+	VehicleState  *VehicleState `protobuf:"bytes,3,opt,name=vehicle_state,json=vehicleState,proto3" json:"vehicle_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeVehicleStateResponse) Reset() {
+	*x = SubscribeVehicleStateResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeVehicleStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeVehicleStateResponse) ProtoMessage() {}
+
+func (x *SubscribeVehicleStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeVehicleStateResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeVehicleStateResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SubscribeVehicleStateResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *SubscribeVehicleStateResponse) GetSystemId() uint32 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SubscribeVehicleStateResponse) GetVehicleState() *VehicleState {
+	if x != nil {
+		return x.VehicleState
+	}
+	return nil
+}
+
+// VehicleState is the latest known state of a drone, aggregated from several MAVLink messages.
+// Parts of the state that have not been received yet are not set.
+type VehicleState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp of the latest message received from the drone (milliseconds since Unix epoch)
+	LastMessageMs int64 `protobuf:"varint,1,opt,name=last_message_ms,json=lastMessageMs,proto3" json:"last_message_ms,omitempty"`
+	// Latest HEARTBEAT of the autopilot
+	Heartbeat *Heartbeat `protobuf:"bytes,2,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	// Whether the drone is armed (from the heartbeat)
+	Armed bool `protobuf:"varint,3,opt,name=armed,proto3" json:"armed,omitempty"`
+	// Flight mode (from the heartbeat)
+	FlightMode *CustomMode `protobuf:"bytes,4,opt,name=flight_mode,json=flightMode,proto3" json:"flight_mode,omitempty"`
+	// Latest fused position estimate (GLOBAL_POSITION_INT)
+	Position *Position `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"`
+	// Latest attitude (ATTITUDE)
+	Attitude *Attitude `protobuf:"bytes,6,opt,name=attitude,proto3" json:"attitude,omitempty"`
+	// Latest state of every battery, by ID (BATTERY_STATUS, or SYS_STATUS for drones that do not send BATTERY_STATUS)
+	Batteries []*Battery `protobuf:"bytes,7,rep,name=batteries,proto3" json:"batteries,omitempty"`
+	// Latest reading of the first GPS receiver (GPS_RAW_INT)
+	Gps *GpsReceiver `protobuf:"bytes,8,opt,name=gps,proto3" json:"gps,omitempty"`
+	// Latest landed state (EXTENDED_SYS_STATE)
+	LandedState   MavLandedState `protobuf:"varint,9,opt,name=landed_state,json=landedState,proto3,enum=flightpath.MavLandedState" json:"landed_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VehicleState) Reset() {
+	*x = VehicleState{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VehicleState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleState) ProtoMessage() {}
+
+func (x *VehicleState) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleState.ProtoReflect.Descriptor instead.
+func (*VehicleState) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *VehicleState) GetLastMessageMs() int64 {
+	if x != nil {
+		return x.LastMessageMs
+	}
+	return 0
+}
+
+func (x *VehicleState) GetHeartbeat() *Heartbeat {
+	if x != nil {
+		return x.Heartbeat
+	}
+	return nil
+}
+
+func (x *VehicleState) GetArmed() bool {
+	if x != nil {
+		return x.Armed
+	}
+	return false
+}
+
+func (x *VehicleState) GetFlightMode() *CustomMode {
+	if x != nil {
+		return x.FlightMode
+	}
+	return nil
+}
+
+func (x *VehicleState) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *VehicleState) GetAttitude() *Attitude {
+	if x != nil {
+		return x.Attitude
+	}
+	return nil
+}
+
+func (x *VehicleState) GetBatteries() []*Battery {
+	if x != nil {
+		return x.Batteries
+	}
+	return nil
+}
+
+func (x *VehicleState) GetGps() *GpsReceiver {
+	if x != nil {
+		return x.Gps
+	}
+	return nil
+}
+
+func (x *VehicleState) GetLandedState() MavLandedState {
+	if x != nil {
+		return x.LandedState
+	}
+	return MavLandedState_MAV_LANDED_STATE_UNSPECIFIED
+}
+
+// VfrHud represents the VFR_HUD MAVLink message
+// Metrics typically displayed on a HUD for fixed wing aircraft.
+type VfrHud struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Vehicle speed in form appropriate for vehicle type. For standard aircraft this is typically calibrated airspeed (CAS) or indicated airspeed (IAS). (m/s)
+	Airspeed float32 `protobuf:"fixed32,1,opt,name=airspeed,proto3" json:"airspeed,omitempty"`
+	// Current ground speed (m/s)
+	Groundspeed float32 `protobuf:"fixed32,2,opt,name=groundspeed,proto3" json:"groundspeed,omitempty"`
+	// Current heading in compass units (0-360, 0=north) (deg)
+	Heading int32 `protobuf:"varint,3,opt,name=heading,proto3" json:"heading,omitempty"`
+	// Current throttle setting (0 to 100) (%)
+	Throttle uint32 `protobuf:"varint,4,opt,name=throttle,proto3" json:"throttle,omitempty"`
+	// Current altitude (MSL) (m)
+	Alt float32 `protobuf:"fixed32,5,opt,name=alt,proto3" json:"alt,omitempty"`
+	// Current climb rate (m/s)
+	Climb         float32 `protobuf:"fixed32,6,opt,name=climb,proto3" json:"climb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VfrHud) Reset() {
+	*x = VfrHud{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VfrHud) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VfrHud) ProtoMessage() {}
+
+func (x *VfrHud) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VfrHud.ProtoReflect.Descriptor instead.
+func (*VfrHud) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *VfrHud) GetAirspeed() float32 {
+	if x != nil {
+		return x.Airspeed
+	}
+	return 0
+}
+
+func (x *VfrHud) GetGroundspeed() float32 {
+	if x != nil {
+		return x.Groundspeed
+	}
+	return 0
+}
+
+func (x *VfrHud) GetHeading() int32 {
 	if x != nil {
-		return x.TimestampMs
+		return x.Heading
 	}
 	return 0
 }
 
-func (x *SubscribeRawGpsResponse) GetSystemId() uint32 {
+func (x *VfrHud) GetThrottle() uint32 {
 	if x != nil {
-		return x.SystemId
+		return x.Throttle
 	}
 	return 0
 }
 
-func (x *SubscribeRawGpsResponse) GetComponentId() uint32 {
+func (x *VfrHud) GetAlt() float32 {
 	if x != nil {
-		return x.ComponentId
+		return x.Alt
 	}
 	return 0
 }
 
-func (x *SubscribeRawGpsResponse) GetGpsRawInt() *GpsRawInt {
+func (x *VfrHud) GetClimb() float32 {
 	if x != nil {
-		return x.GpsRawInt
+		return x.Climb
 	}
-	return nil
+	return 0
 }
 
-// GpsRawInt represents the GPS_RAW_INT MAVLink message
-// The global position, as returned by the Global Positioning System (GPS).
-// This is NOT the global position estimate of the system, but rather a RAW sensor value.
-type GpsRawInt struct {
+// GlobalPositionInt represents the GLOBAL_POSITION_INT MAVLink message
+// The filtered global position (e.g. fused GPS and accelerometers).
+// This is the position estimate the vehicle actually flies on.
+type GlobalPositionInt struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
-	TimeUsec uint64 `protobuf:"varint,1,opt,name=time_usec,json=timeUsec,proto3" json:"time_usec,omitempty"`
-	// GPS fix type
-	FixType GpsFixType `protobuf:"varint,2,opt,name=fix_type,json=fixType,proto3,enum=flightpath.GpsFixType" json:"fix_type,omitempty"`
-	// Latitude (WGS84, EGM96 ellipsoid) in degrees * 1E7
-	Lat int32 `protobuf:"varint,3,opt,name=lat,proto3" json:"lat,omitempty"`
-	// Longitude (WGS84, EGM96 ellipsoid) in degrees * 1E7
-	Lon int32 `protobuf:"varint,4,opt,name=lon,proto3" json:"lon,omitempty"`
-	// Altitude (MSL). Positive for up. Note that virtually all GPS modules provide the MSL altitude in addition to the WGS84 altitude. (mm)
-	Alt int32 `protobuf:"varint,5,opt,name=alt,proto3" json:"alt,omitempty"`
-	// GPS HDOP horizontal dilution of position (unitless * 100). If unknown, set to: UINT16_MAX
-	Eph uint32 `protobuf:"varint,6,opt,name=eph,proto3" json:"eph,omitempty"`
-	// GPS VDOP vertical dilution of position (unitless * 100). If unknown, set to: UINT16_MAX
-	Epv uint32 `protobuf:"varint,7,opt,name=epv,proto3" json:"epv,omitempty"`
-	// GPS ground speed (cm/s). If unknown, set to: UINT16_MAX
-	Vel uint32 `protobuf:"varint,8,opt,name=vel,proto3" json:"vel,omitempty"`
-	// Course over ground (NOT heading, but direction of movement) in degrees * 100, 0.0..359.99 degrees. If unknown, set to: UINT16_MAX
-	Cog uint32 `protobuf:"varint,9,opt,name=cog,proto3" json:"cog,omitempty"`
-	// Number of satellites visible. If unknown, set to UINT8_MAX
-	SatellitesVisible uint32 `protobuf:"varint,10,opt,name=satellites_visible,json=satellitesVisible,proto3" json:"satellites_visible,omitempty"`
-	// Altitude (above WGS84, EGM96 ellipsoid). Positive for up. (mm)
-	AltEllipsoid int32 `protobuf:"varint,11,opt,name=alt_ellipsoid,json=altEllipsoid,proto3" json:"alt_ellipsoid,omitempty"`
-	// Position uncertainty (mm)
-	HAcc uint32 `protobuf:"varint,12,opt,name=h_acc,json=hAcc,proto3" json:"h_acc,omitempty"`
-	// Altitude uncertainty (mm)
-	VAcc uint32 `protobuf:"varint,13,opt,name=v_acc,json=vAcc,proto3" json:"v_acc,omitempty"`
-	// Speed uncertainty (mm/s)
-	VelAcc uint32 `protobuf:"varint,14,opt,name=vel_acc,json=velAcc,proto3" json:"vel_acc,omitempty"`
-	// Heading / track uncertainty (degrees * 1E5)
-	HdgAcc uint32 `protobuf:"varint,15,opt,name=hdg_acc,json=hdgAcc,proto3" json:"hdg_acc,omitempty"`
-	// Yaw in earth frame from north. Use 0 if this GPS does not provide yaw. Use UINT16_MAX if this GPS is configured to provide yaw and is currently unable to provide it. Use 36000 for north. (degrees * 100)
-	Yaw           uint32 `protobuf:"varint,16,opt,name=yaw,proto3" json:"yaw,omitempty"`
+	// Timestamp (time since system boot) (ms)
+	TimeBootMs uint32 `protobuf:"varint,1,opt,name=time_boot_ms,json=timeBootMs,proto3" json:"time_boot_ms,omitempty"`
+	// Latitude (WGS84) in degrees * 1E7
+	Lat int32 `protobuf:"varint,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	// Longitude (WGS84) in degrees * 1E7
+	Lon int32 `protobuf:"varint,3,opt,name=lon,proto3" json:"lon,omitempty"`
+	// Altitude (MSL). Note that virtually all GPS modules provide both WGS84 and MSL. (mm)
+	Alt int32 `protobuf:"varint,4,opt,name=alt,proto3" json:"alt,omitempty"`
+	// Altitude above home (mm)
+	RelativeAlt int32 `protobuf:"varint,5,opt,name=relative_alt,json=relativeAlt,proto3" json:"relative_alt,omitempty"`
+	// Ground X speed (latitude, positive north) (cm/s)
+	Vx int32 `protobuf:"varint,6,opt,name=vx,proto3" json:"vx,omitempty"`
+	// Ground Y speed (longitude, positive east) (cm/s)
+	Vy int32 `protobuf:"varint,7,opt,name=vy,proto3" json:"vy,omitempty"`
+	// Ground Z speed (altitude, positive down) (cm/s)
+	Vz int32 `protobuf:"varint,8,opt,name=vz,proto3" json:"vz,omitempty"`
+	// Vehicle heading (yaw angle) in degrees * 100, 0.0..359.99 degrees. If unknown, set to: UINT16_MAX
+	Hdg           uint32 `protobuf:"varint,9,opt,name=hdg,proto3" json:"hdg,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GpsRawInt) Reset() {
-	*x = GpsRawInt{}
-	mi := &file_flightpath_telemetry_proto_msgTypes[2]
+func (x *GlobalPositionInt) Reset() {
+	*x = GlobalPositionInt{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GpsRawInt) String() string {
+func (x *GlobalPositionInt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GpsRawInt) ProtoMessage() {}
+func (*GlobalPositionInt) ProtoMessage() {}
 
-func (x *GpsRawInt) ProtoReflect() protoreflect.Message {
-	mi := &file_flightpath_telemetry_proto_msgTypes[2]
+func (x *GlobalPositionInt) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,129 +9103,256 @@ func (x *GpsRawInt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GpsRawInt.ProtoReflect.Descriptor instead.
-func (*GpsRawInt) Descriptor() ([]byte, []int) {
-	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GlobalPositionInt.ProtoReflect.Descriptor instead.
+func (*GlobalPositionInt) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *GpsRawInt) GetTimeUsec() uint64 {
+func (x *GlobalPositionInt) GetTimeBootMs() uint32 {
 	if x != nil {
-		return x.TimeUsec
+		return x.TimeBootMs
 	}
 	return 0
 }
 
-func (x *GpsRawInt) GetFixType() GpsFixType {
-	if x != nil {
-		return x.FixType
-	}
-	return GpsFixType_GPS_FIX_TYPE_UNSPECIFIED
-}
-
-func (x *GpsRawInt) GetLat() int32 {
+func (x *GlobalPositionInt) GetLat() int32 {
 	if x != nil {
 		return x.Lat
 	}
 	return 0
 }
 
-func (x *GpsRawInt) GetLon() int32 {
+func (x *GlobalPositionInt) GetLon() int32 {
 	if x != nil {
 		return x.Lon
 	}
 	return 0
 }
 
-func (x *GpsRawInt) GetAlt() int32 {
+func (x *GlobalPositionInt) GetAlt() int32 {
 	if x != nil {
 		return x.Alt
 	}
 	return 0
 }
 
-func (x *GpsRawInt) GetEph() uint32 {
+func (x *GlobalPositionInt) GetRelativeAlt() int32 {
 	if x != nil {
-		return x.Eph
+		return x.RelativeAlt
 	}
 	return 0
 }
 
-func (x *GpsRawInt) GetEpv() uint32 {
+func (x *GlobalPositionInt) GetVx() int32 {
 	if x != nil {
-		return x.Epv
+		return x.Vx
 	}
 	return 0
 }
 
-func (x *GpsRawInt) GetVel() uint32 {
+func (x *GlobalPositionInt) GetVy() int32 {
 	if x != nil {
-		return x.Vel
+		return x.Vy
 	}
 	return 0
 }
 
-func (x *GpsRawInt) GetCog() uint32 {
+func (x *GlobalPositionInt) GetVz() int32 {
 	if x != nil {
-		return x.Cog
+		return x.Vz
 	}
 	return 0
 }
 
-func (x *GpsRawInt) GetSatellitesVisible() uint32 {
+func (x *GlobalPositionInt) GetHdg() uint32 {
 	if x != nil {
-		return x.SatellitesVisible
+		return x.Hdg
 	}
 	return 0
 }
 
-func (x *GpsRawInt) GetAltEllipsoid() int32 {
+// SubscribeLandedStateRequest is the request message for SubscribeLandedState
+type SubscribeLandedStateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Send every EXTENDED_SYS_STATE message instead of only the ones where the landed state or
+	// VTOL state has changed
+	EveryMessage  bool `protobuf:"varint,1,opt,name=every_message,json=everyMessage,proto3" json:"every_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeLandedStateRequest) Reset() {
+	*x = SubscribeLandedStateRequest{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeLandedStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeLandedStateRequest) ProtoMessage() {}
+
+func (x *SubscribeLandedStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[90]
 	if x != nil {
-		return x.AltEllipsoid
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GpsRawInt) GetHAcc() uint32 {
+// Deprecated: Use SubscribeLandedStateRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeLandedStateRequest) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SubscribeLandedStateRequest) GetEveryMessage() bool {
 	if x != nil {
-		return x.HAcc
+		return x.EveryMessage
 	}
-	return 0
+	return false
 }
 
-func (x *GpsRawInt) GetVAcc() uint32 {
+// SubscribeLandedStateResponse contains EXTENDED_SYS_STATE message data
+type SubscribeLandedStateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp when this state was captured (milliseconds since Unix epoch)
+	TimestampMs int64 `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// System ID of the component sending the state
+	SystemId uint32 `protobuf:"varint,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Component ID of the component sending the state
+	ComponentId uint32 `protobuf:"varint,3,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	// EXTENDED_SYS_STATE message data
+	ExtendedSysState *ExtendedSysState `protobuf:"bytes,4,opt,name=extended_sys_state,json=extendedSysState,proto3" json:"extended_sys_state,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SubscribeLandedStateResponse) Reset() {
+	*x = SubscribeLandedStateResponse{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeLandedStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeLandedStateResponse) ProtoMessage() {}
+
+func (x *SubscribeLandedStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[91]
 	if x != nil {
-		return x.VAcc
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GpsRawInt) GetVelAcc() uint32 {
+// Deprecated: Use SubscribeLandedStateResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeLandedStateResponse) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SubscribeLandedStateResponse) GetTimestampMs() int64 {
 	if x != nil {
-		return x.VelAcc
+		return x.TimestampMs
 	}
 	return 0
 }
 
-func (x *GpsRawInt) GetHdgAcc() uint32 {
+func (x *SubscribeLandedStateResponse) GetSystemId() uint32 {
 	if x != nil {
-		return x.HdgAcc
+		return x.SystemId
 	}
 	return 0
 }
 
-func (x *GpsRawInt) GetYaw() uint32 {
+func (x *SubscribeLandedStateResponse) GetComponentId() uint32 {
 	if x != nil {
-		return x.Yaw
+		return x.ComponentId
 	}
 	return 0
 }
 
+func (x *SubscribeLandedStateResponse) GetExtendedSysState() *ExtendedSysState {
+	if x != nil {
+		return x.ExtendedSysState
+	}
+	return nil
+}
+
+// ExtendedSysState represents the EXTENDED_SYS_STATE MAVLink message
+// Provides state for additional features (VTOL state, landed state).
+type ExtendedSysState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The VTOL state if applicable. Is set to MAV_VTOL_STATE_UNDEFINED if UAV is not in VTOL configuration.
+	VtolState MavVtolState `protobuf:"varint,1,opt,name=vtol_state,json=vtolState,proto3,enum=flightpath.MavVtolState" json:"vtol_state,omitempty"`
+	// The landed state. Is set to MAV_LANDED_STATE_UNDEFINED if landed state is unknown.
+	LandedState   MavLandedState `protobuf:"varint,2,opt,name=landed_state,json=landedState,proto3,enum=flightpath.MavLandedState" json:"landed_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendedSysState) Reset() {
+	*x = ExtendedSysState{}
+	mi := &file_flightpath_telemetry_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendedSysState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendedSysState) ProtoMessage() {}
+
+func (x *ExtendedSysState) ProtoReflect() protoreflect.Message {
+	mi := &file_flightpath_telemetry_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendedSysState.ProtoReflect.Descriptor instead.
+func (*ExtendedSysState) Descriptor() ([]byte, []int) {
+	return file_flightpath_telemetry_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ExtendedSysState) GetVtolState() MavVtolState {
+	if x != nil {
+		return x.VtolState
+	}
+	return MavVtolState_MAV_VTOL_STATE_UNSPECIFIED
+}
+
+func (x *ExtendedSysState) GetLandedState() MavLandedState {
+	if x != nil {
+		return x.LandedState
+	}
+	return MavLandedState_MAV_LANDED_STATE_UNSPECIFIED
+}
+
 var File_flightpath_telemetry_proto protoreflect.FileDescriptor
 
 const file_flightpath_telemetry_proto_rawDesc = "" +
 	"\n" +
 	"\x1aflightpath/telemetry.proto\x12\n" +
-	"flightpath\"\x18\n" +
+	"flightpath\x1a\x1bflightpath/connection.proto\"\x18\n" +
 	"\x16SubscribeRawGpsRequest\"\xb3\x01\n" +
 	"\x17SubscribeRawGpsResponse\x12!\n" +
 	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
@@ -415,7 +9376,717 @@ const file_flightpath_telemetry_proto_rawDesc = "" +
 	"\x05v_acc\x18\r \x01(\rR\x04vAcc\x12\x17\n" +
 	"\avel_acc\x18\x0e \x01(\rR\x06velAcc\x12\x17\n" +
 	"\ahdg_acc\x18\x0f \x01(\rR\x06hdgAcc\x12\x10\n" +
-	"\x03yaw\x18\x10 \x01(\rR\x03yaw*\x8c\x02\n" +
+	"\x03yaw\x18\x10 \x01(\rR\x03yaw\"&\n" +
+	"$SubscribeOrbitExecutionStatusRequest\"\xfa\x01\n" +
+	"%SubscribeOrbitExecutionStatusResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\x12V\n" +
+	"\x16orbit_execution_status\x18\x05 \x01(\v2 .flightpath.OrbitExecutionStatusR\x14orbitExecutionStatus\"\xa1\x01\n" +
+	"\x14OrbitExecutionStatus\x12\x1b\n" +
+	"\ttime_usec\x18\x01 \x01(\x04R\btimeUsec\x12\x16\n" +
+	"\x06radius\x18\x02 \x01(\x02R\x06radius\x12*\n" +
+	"\x05frame\x18\x03 \x01(\x0e2\x14.flightpath.MavFrameR\x05frame\x12\f\n" +
+	"\x01x\x18\x04 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x05 \x01(\x05R\x01y\x12\f\n" +
+	"\x01z\x18\x06 \x01(\x02R\x01z\"\x1a\n" +
+	"\x18SubscribeAttitudeRequest\"\x81\x02\n" +
+	"\x19SubscribeAttitudeResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x120\n" +
+	"\battitude\x18\x04 \x01(\v2\x14.flightpath.AttitudeR\battitude\x12O\n" +
+	"\x13attitude_quaternion\x18\x05 \x01(\v2\x1e.flightpath.AttitudeQuaternionR\x12attitudeQuaternion\"\x93\x02\n" +
+	"\bAttitude\x12 \n" +
+	"\ftime_boot_ms\x18\x01 \x01(\rR\n" +
+	"timeBootMs\x12\x12\n" +
+	"\x04roll\x18\x02 \x01(\x02R\x04roll\x12\x14\n" +
+	"\x05pitch\x18\x03 \x01(\x02R\x05pitch\x12\x10\n" +
+	"\x03yaw\x18\x04 \x01(\x02R\x03yaw\x12\x19\n" +
+	"\broll_deg\x18\x05 \x01(\x02R\arollDeg\x12\x1b\n" +
+	"\tpitch_deg\x18\x06 \x01(\x02R\bpitchDeg\x12\x17\n" +
+	"\ayaw_deg\x18\a \x01(\x02R\x06yawDeg\x12\x1c\n" +
+	"\trollspeed\x18\b \x01(\x02R\trollspeed\x12\x1e\n" +
+	"\n" +
+	"pitchspeed\x18\t \x01(\x02R\n" +
+	"pitchspeed\x12\x1a\n" +
+	"\byawspeed\x18\n" +
+	" \x01(\x02R\byawspeed\"\xf4\x01\n" +
+	"\x12AttitudeQuaternion\x12 \n" +
+	"\ftime_boot_ms\x18\x01 \x01(\rR\n" +
+	"timeBootMs\x12\x0e\n" +
+	"\x02q1\x18\x02 \x01(\x02R\x02q1\x12\x0e\n" +
+	"\x02q2\x18\x03 \x01(\x02R\x02q2\x12\x0e\n" +
+	"\x02q3\x18\x04 \x01(\x02R\x02q3\x12\x0e\n" +
+	"\x02q4\x18\x05 \x01(\x02R\x02q4\x12\x1c\n" +
+	"\trollspeed\x18\x06 \x01(\x02R\trollspeed\x12\x1e\n" +
+	"\n" +
+	"pitchspeed\x18\a \x01(\x02R\n" +
+	"pitchspeed\x12\x1a\n" +
+	"\byawspeed\x18\b \x01(\x02R\byawspeed\x12\"\n" +
+	"\rrepr_offset_q\x18\t \x03(\x02R\vreprOffsetQ\"\x1a\n" +
+	"\x18SubscribePositionRequest\"\xff\x01\n" +
+	"\x19SubscribePositionResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x120\n" +
+	"\bposition\x18\x04 \x01(\v2\x14.flightpath.PositionR\bposition\x12M\n" +
+	"\x13global_position_int\x18\x05 \x01(\v2\x1d.flightpath.GlobalPositionIntR\x11globalPositionInt\"\xba\x02\n" +
+	"\bPosition\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12+\n" +
+	"\x11absolute_altitude\x18\x03 \x01(\x02R\x10absoluteAltitude\x12+\n" +
+	"\x11relative_altitude\x18\x04 \x01(\x02R\x10relativeAltitude\x12%\n" +
+	"\x0evelocity_north\x18\x05 \x01(\x02R\rvelocityNorth\x12#\n" +
+	"\rvelocity_east\x18\x06 \x01(\x02R\fvelocityEast\x12#\n" +
+	"\rvelocity_down\x18\a \x01(\x02R\fvelocityDown\x12\x1d\n" +
+	"\aheading\x18\b \x01(\x02H\x00R\aheading\x88\x01\x01B\n" +
+	"\n" +
+	"\b_heading\"\x1f\n" +
+	"\x1dSubscribeLocalPositionRequest\"\x81\x02\n" +
+	"\x1eSubscribeLocalPositionResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x12J\n" +
+	"\x12local_position_ned\x18\x04 \x01(\v2\x1c.flightpath.LocalPositionNedR\x10localPositionNed\x120\n" +
+	"\bodometry\x18\x05 \x01(\v2\x14.flightpath.OdometryR\bodometry\"\x8e\x01\n" +
+	"\x10LocalPositionNed\x12 \n" +
+	"\ftime_boot_ms\x18\x01 \x01(\rR\n" +
+	"timeBootMs\x12\f\n" +
+	"\x01x\x18\x02 \x01(\x02R\x01x\x12\f\n" +
+	"\x01y\x18\x03 \x01(\x02R\x01y\x12\f\n" +
+	"\x01z\x18\x04 \x01(\x02R\x01z\x12\x0e\n" +
+	"\x02vx\x18\x05 \x01(\x02R\x02vx\x12\x0e\n" +
+	"\x02vy\x18\x06 \x01(\x02R\x02vy\x12\x0e\n" +
+	"\x02vz\x18\a \x01(\x02R\x02vz\"\xe4\x04\n" +
+	"\bOdometry\x12\x1b\n" +
+	"\ttime_usec\x18\x01 \x01(\x04R\btimeUsec\x12/\n" +
+	"\bframe_id\x18\x02 \x01(\x0e2\x14.flightpath.MavFrameR\aframeId\x12:\n" +
+	"\x0echild_frame_id\x18\x03 \x01(\x0e2\x14.flightpath.MavFrameR\fchildFrameId\x12\f\n" +
+	"\x01x\x18\x04 \x01(\x02R\x01x\x12\f\n" +
+	"\x01y\x18\x05 \x01(\x02R\x01y\x12\f\n" +
+	"\x01z\x18\x06 \x01(\x02R\x01z\x12\f\n" +
+	"\x01q\x18\a \x03(\x02R\x01q\x12\x0e\n" +
+	"\x02vx\x18\b \x01(\x02R\x02vx\x12\x0e\n" +
+	"\x02vy\x18\t \x01(\x02R\x02vy\x12\x0e\n" +
+	"\x02vz\x18\n" +
+	" \x01(\x02R\x02vz\x12\x1c\n" +
+	"\trollspeed\x18\v \x01(\x02R\trollspeed\x12\x1e\n" +
+	"\n" +
+	"pitchspeed\x18\f \x01(\x02R\n" +
+	"pitchspeed\x12\x1a\n" +
+	"\byawspeed\x18\r \x01(\x02R\byawspeed\x12?\n" +
+	"\x0fpose_covariance\x18\x0e \x01(\v2\x16.flightpath.CovarianceR\x0eposeCovariance\x12G\n" +
+	"\x13velocity_covariance\x18\x0f \x01(\v2\x16.flightpath.CovarianceR\x12velocityCovariance\x12#\n" +
+	"\rreset_counter\x18\x10 \x01(\rR\fresetCounter\x12C\n" +
+	"\x0eestimator_type\x18\x11 \x01(\x0e2\x1c.flightpath.MavEstimatorTypeR\restimatorType\x12\x18\n" +
+	"\aquality\x18\x12 \x01(\x05R\aquality\"X\n" +
+	"\n" +
+	"Covariance\x12\x14\n" +
+	"\x05known\x18\x01 \x01(\bR\x05known\x12\x1c\n" +
+	"\tvariances\x18\x02 \x03(\x02R\tvariances\x12\x16\n" +
+	"\x06matrix\x18\x03 \x03(\x02R\x06matrix\"\x19\n" +
+	"\x17SubscribeBatteryRequest\"\xac\x01\n" +
+	"\x18SubscribeBatteryResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x12-\n" +
+	"\abattery\x18\x04 \x01(\v2\x13.flightpath.BatteryR\abattery\"\x9e\x06\n" +
+	"\aBattery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x121\n" +
+	"\x06source\x18\x02 \x01(\x0e2\x19.flightpath.BatterySourceR\x06source\x12:\n" +
+	"\bfunction\x18\x03 \x01(\x0e2\x1e.flightpath.MavBatteryFunctionR\bfunction\x12.\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x1a.flightpath.MavBatteryTypeR\x04type\x12\x1d\n" +
+	"\avoltage\x18\x05 \x01(\x02H\x00R\avoltage\x88\x01\x01\x12#\n" +
+	"\rcell_voltages\x18\x06 \x03(\x02R\fcellVoltages\x12\x1d\n" +
+	"\acurrent\x18\a \x01(\x02H\x01R\acurrent\x88\x01\x01\x12,\n" +
+	"\x0fconsumed_charge\x18\b \x01(\x02H\x02R\x0econsumedCharge\x88\x01\x01\x12,\n" +
+	"\x0fconsumed_energy\x18\t \x01(\x02H\x03R\x0econsumedEnergy\x88\x01\x01\x12%\n" +
+	"\vtemperature\x18\n" +
+	" \x01(\x02H\x04R\vtemperature\x88\x01\x01\x120\n" +
+	"\x11remaining_percent\x18\v \x01(\x02H\x05R\x10remainingPercent\x88\x01\x01\x12*\n" +
+	"\x0etime_remaining\x18\f \x01(\rH\x06R\rtimeRemaining\x88\x01\x01\x12D\n" +
+	"\fcharge_state\x18\r \x01(\x0e2!.flightpath.MavBatteryChargeStateR\vchargeState\x12.\n" +
+	"\x04mode\x18\x0e \x01(\x0e2\x1a.flightpath.MavBatteryModeR\x04mode\x121\n" +
+	"\x06faults\x18\x0f \x01(\v2\x19.flightpath.BatteryFaultsR\x06faultsB\n" +
+	"\n" +
+	"\b_voltageB\n" +
+	"\n" +
+	"\b_currentB\x12\n" +
+	"\x10_consumed_chargeB\x12\n" +
+	"\x10_consumed_energyB\x0e\n" +
+	"\f_temperatureB\x14\n" +
+	"\x12_remaining_percentB\x11\n" +
+	"\x0f_time_remaining\"\x98\x03\n" +
+	"\rBatteryFaults\x12%\n" +
+	"\x0edeep_discharge\x18\x01 \x01(\bR\rdeepDischarge\x12\x16\n" +
+	"\x06spikes\x18\x02 \x01(\bR\x06spikes\x12\x1b\n" +
+	"\tcell_fail\x18\x03 \x01(\bR\bcellFail\x12!\n" +
+	"\fover_current\x18\x04 \x01(\bR\voverCurrent\x12)\n" +
+	"\x10over_temperature\x18\x05 \x01(\bR\x0foverTemperature\x12+\n" +
+	"\x11under_temperature\x18\x06 \x01(\bR\x10underTemperature\x121\n" +
+	"\x14incompatible_voltage\x18\a \x01(\bR\x13incompatibleVoltage\x123\n" +
+	"\x15incompatible_firmware\x18\b \x01(\bR\x14incompatibleFirmware\x12H\n" +
+	" incompatible_cells_configuration\x18\t \x01(\bR\x1eincompatibleCellsConfiguration\"\xe7\x04\n" +
+	"\rBatteryStatus\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12I\n" +
+	"\x10battery_function\x18\x02 \x01(\x0e2\x1e.flightpath.MavBatteryFunctionR\x0fbatteryFunction\x12.\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1a.flightpath.MavBatteryTypeR\x04type\x12 \n" +
+	"\vtemperature\x18\x04 \x01(\x05R\vtemperature\x12\x1a\n" +
+	"\bvoltages\x18\x05 \x03(\rR\bvoltages\x12'\n" +
+	"\x0fcurrent_battery\x18\x06 \x01(\x05R\x0ecurrentBattery\x12)\n" +
+	"\x10current_consumed\x18\a \x01(\x05R\x0fcurrentConsumed\x12'\n" +
+	"\x0fenergy_consumed\x18\b \x01(\x05R\x0eenergyConsumed\x12+\n" +
+	"\x11battery_remaining\x18\t \x01(\x05R\x10batteryRemaining\x12%\n" +
+	"\x0etime_remaining\x18\n" +
+	" \x01(\x05R\rtimeRemaining\x12D\n" +
+	"\fcharge_state\x18\v \x01(\x0e2!.flightpath.MavBatteryChargeStateR\vchargeState\x12!\n" +
+	"\fvoltages_ext\x18\f \x03(\rR\vvoltagesExt\x12.\n" +
+	"\x04mode\x18\r \x01(\x0e2\x1a.flightpath.MavBatteryModeR\x04mode\x12#\n" +
+	"\rfault_bitmask\x18\x0e \x01(\rR\ffaultBitmask\"\x1e\n" +
+	"\x1cSubscribeSystemStatusRequest\"\xf7\x01\n" +
+	"\x1dSubscribeSystemStatusResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x12=\n" +
+	"\rsystem_status\x18\x04 \x01(\v2\x18.flightpath.SystemStatusR\fsystemStatus\x124\n" +
+	"\n" +
+	"sys_status\x18\x05 \x01(\v2\x15.flightpath.SysStatusR\tsysStatus\"\xa8\x01\n" +
+	"\fSystemStatus\x126\n" +
+	"\asensors\x18\x01 \x01(\v2\x1c.flightpath.SysStatusSensorsR\asensors\x12\x19\n" +
+	"\bcpu_load\x18\x02 \x01(\x02R\acpuLoad\x12$\n" +
+	"\x0edrop_rate_comm\x18\x03 \x01(\x02R\fdropRateComm\x12\x1f\n" +
+	"\verrors_comm\x18\x04 \x01(\rR\n" +
+	"errorsComm\"\\\n" +
+	"\fSensorStatus\x12\x18\n" +
+	"\apresent\x18\x01 \x01(\bR\apresent\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x18\n" +
+	"\ahealthy\x18\x03 \x01(\bR\ahealthy\"\x8f\x0f\n" +
+	"\x10SysStatusSensors\x12,\n" +
+	"\x04gyro\x18\x01 \x01(\v2\x18.flightpath.SensorStatusR\x04gyro\x12.\n" +
+	"\x05accel\x18\x02 \x01(\v2\x18.flightpath.SensorStatusR\x05accel\x12*\n" +
+	"\x03mag\x18\x03 \x01(\v2\x18.flightpath.SensorStatusR\x03mag\x12E\n" +
+	"\x11absolute_pressure\x18\x04 \x01(\v2\x18.flightpath.SensorStatusR\x10absolutePressure\x12M\n" +
+	"\x15differential_pressure\x18\x05 \x01(\v2\x18.flightpath.SensorStatusR\x14differentialPressure\x12*\n" +
+	"\x03gps\x18\x06 \x01(\v2\x18.flightpath.SensorStatusR\x03gps\x12;\n" +
+	"\foptical_flow\x18\a \x01(\v2\x18.flightpath.SensorStatusR\vopticalFlow\x12A\n" +
+	"\x0fvision_position\x18\b \x01(\v2\x18.flightpath.SensorStatusR\x0evisionPosition\x12?\n" +
+	"\x0elaser_position\x18\t \x01(\v2\x18.flightpath.SensorStatusR\rlaserPosition\x12L\n" +
+	"\x15external_ground_truth\x18\n" +
+	" \x01(\v2\x18.flightpath.SensorStatusR\x13externalGroundTruth\x12J\n" +
+	"\x14angular_rate_control\x18\v \x01(\v2\x18.flightpath.SensorStatusR\x12angularRateControl\x12O\n" +
+	"\x16attitude_stabilization\x18\f \x01(\v2\x18.flightpath.SensorStatusR\x15attitudeStabilization\x12;\n" +
+	"\fyaw_position\x18\r \x01(\v2\x18.flightpath.SensorStatusR\vyawPosition\x12F\n" +
+	"\x12z_altitude_control\x18\x0e \x01(\v2\x18.flightpath.SensorStatusR\x10zAltitudeControl\x12H\n" +
+	"\x13xy_position_control\x18\x0f \x01(\v2\x18.flightpath.SensorStatusR\x11xyPositionControl\x12=\n" +
+	"\rmotor_outputs\x18\x10 \x01(\v2\x18.flightpath.SensorStatusR\fmotorOutputs\x129\n" +
+	"\vrc_receiver\x18\x11 \x01(\v2\x18.flightpath.SensorStatusR\n" +
+	"rcReceiver\x12.\n" +
+	"\x05gyro2\x18\x12 \x01(\v2\x18.flightpath.SensorStatusR\x05gyro2\x120\n" +
+	"\x06accel2\x18\x13 \x01(\v2\x18.flightpath.SensorStatusR\x06accel2\x12,\n" +
+	"\x04mag2\x18\x14 \x01(\v2\x18.flightpath.SensorStatusR\x04mag2\x124\n" +
+	"\bgeofence\x18\x15 \x01(\v2\x18.flightpath.SensorStatusR\bgeofence\x12,\n" +
+	"\x04ahrs\x18\x16 \x01(\v2\x18.flightpath.SensorStatusR\x04ahrs\x122\n" +
+	"\aterrain\x18\x17 \x01(\v2\x18.flightpath.SensorStatusR\aterrain\x12=\n" +
+	"\rreverse_motor\x18\x18 \x01(\v2\x18.flightpath.SensorStatusR\freverseMotor\x122\n" +
+	"\alogging\x18\x19 \x01(\v2\x18.flightpath.SensorStatusR\alogging\x122\n" +
+	"\abattery\x18\x1a \x01(\v2\x18.flightpath.SensorStatusR\abattery\x126\n" +
+	"\tproximity\x18\x1b \x01(\v2\x18.flightpath.SensorStatusR\tproximity\x120\n" +
+	"\x06satcom\x18\x1c \x01(\v2\x18.flightpath.SensorStatusR\x06satcom\x12;\n" +
+	"\fprearm_check\x18\x1d \x01(\v2\x18.flightpath.SensorStatusR\vprearmCheck\x12G\n" +
+	"\x12obstacle_avoidance\x18\x1e \x01(\v2\x18.flightpath.SensorStatusR\x11obstacleAvoidance\x128\n" +
+	"\n" +
+	"propulsion\x18\x1f \x01(\v2\x18.flightpath.SensorStatusR\n" +
+	"propulsion\x12A\n" +
+	"\x0frecovery_system\x18  \x01(\v2\x18.flightpath.SensorStatusR\x0erecoverySystem\"\xd2\x06\n" +
+	"\tSysStatus\x12E\n" +
+	"\x1fonboard_control_sensors_present\x18\x01 \x01(\rR\x1conboardControlSensorsPresent\x12E\n" +
+	"\x1fonboard_control_sensors_enabled\x18\x02 \x01(\rR\x1conboardControlSensorsEnabled\x12C\n" +
+	"\x1eonboard_control_sensors_health\x18\x03 \x01(\rR\x1bonboardControlSensorsHealth\x12\x12\n" +
+	"\x04load\x18\x04 \x01(\rR\x04load\x12'\n" +
+	"\x0fvoltage_battery\x18\x05 \x01(\rR\x0evoltageBattery\x12'\n" +
+	"\x0fcurrent_battery\x18\x06 \x01(\x05R\x0ecurrentBattery\x12+\n" +
+	"\x11battery_remaining\x18\a \x01(\x05R\x10batteryRemaining\x12$\n" +
+	"\x0edrop_rate_comm\x18\b \x01(\rR\fdropRateComm\x12\x1f\n" +
+	"\verrors_comm\x18\t \x01(\rR\n" +
+	"errorsComm\x12#\n" +
+	"\rerrors_count1\x18\n" +
+	" \x01(\rR\ferrorsCount1\x12#\n" +
+	"\rerrors_count2\x18\v \x01(\rR\ferrorsCount2\x12#\n" +
+	"\rerrors_count3\x18\f \x01(\rR\ferrorsCount3\x12#\n" +
+	"\rerrors_count4\x18\r \x01(\rR\ferrorsCount4\x12V\n" +
+	"(onboard_control_sensors_present_extended\x18\x0e \x01(\rR$onboardControlSensorsPresentExtended\x12V\n" +
+	"(onboard_control_sensors_enabled_extended\x18\x0f \x01(\rR$onboardControlSensorsEnabledExtended\x12T\n" +
+	"'onboard_control_sensors_health_extended\x18\x10 \x01(\rR#onboardControlSensorsHealthExtended\"\x1f\n" +
+	"\x1dSubscribeFlightMetricsRequest\"\xb0\x01\n" +
+	"\x1eSubscribeFlightMetricsResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x12+\n" +
+	"\avfr_hud\x18\x04 \x01(\v2\x12.flightpath.VfrHudR\x06vfrHud\"X\n" +
+	"\x1aSubscribeStatusTextRequest\x12:\n" +
+	"\fmin_severity\x18\x01 \x01(\x0e2\x17.flightpath.MavSeverityR\vminSeverity\"\xe9\x01\n" +
+	"\x1bSubscribeStatusTextResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x123\n" +
+	"\bseverity\x18\x04 \x01(\x0e2\x17.flightpath.MavSeverityR\bseverity\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\x12\x1e\n" +
+	"\n" +
+	"incomplete\x18\x06 \x01(\bR\n" +
+	"incomplete\"\x82\x01\n" +
+	"\n" +
+	"StatusText\x123\n" +
+	"\bseverity\x18\x01 \x01(\x0e2\x17.flightpath.MavSeverityR\bseverity\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\rR\x02id\x12\x1b\n" +
+	"\tchunk_seq\x18\x04 \x01(\rR\bchunkSeq\"X\n" +
+	"\x16GetHomePositionRequest\x12\x1b\n" +
+	"\tsystem_id\x18\x01 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\rR\vcomponentId\"\x8e\x01\n" +
+	"\x17GetHomePositionResponse\x124\n" +
+	"\blocation\x18\x01 \x01(\v2\x18.flightpath.HomeLocationR\blocation\x12=\n" +
+	"\rhome_position\x18\x02 \x01(\v2\x18.flightpath.HomePositionR\fhomePosition\"\x1e\n" +
+	"\x1cSubscribeHomePositionRequest\"\xf7\x01\n" +
+	"\x1dSubscribeHomePositionResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x124\n" +
+	"\blocation\x18\x04 \x01(\v2\x18.flightpath.HomeLocationR\blocation\x12=\n" +
+	"\rhome_position\x18\x05 \x01(\v2\x18.flightpath.HomePositionR\fhomePosition\"u\n" +
+	"\fHomeLocation\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12+\n" +
+	"\x11absolute_altitude\x18\x03 \x01(\x02R\x10absoluteAltitude\"\x96\x02\n" +
+	"\fHomePosition\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x05R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x05R\tlongitude\x12\x1a\n" +
+	"\baltitude\x18\x03 \x01(\x05R\baltitude\x12\f\n" +
+	"\x01x\x18\x04 \x01(\x02R\x01x\x12\f\n" +
+	"\x01y\x18\x05 \x01(\x02R\x01y\x12\f\n" +
+	"\x01z\x18\x06 \x01(\x02R\x01z\x12\f\n" +
+	"\x01q\x18\a \x03(\x02R\x01q\x12\x1d\n" +
+	"\n" +
+	"approach_x\x18\b \x01(\x02R\tapproachX\x12\x1d\n" +
+	"\n" +
+	"approach_y\x18\t \x01(\x02R\tapproachY\x12\x1d\n" +
+	"\n" +
+	"approach_z\x18\n" +
+	" \x01(\x02R\tapproachZ\x12\x1b\n" +
+	"\ttime_usec\x18\v \x01(\x04R\btimeUsec\"\x1c\n" +
+	"\x1aSubscribeRcChannelsRequest\"\xb0\x01\n" +
+	"\x1bSubscribeRcChannelsResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x12.\n" +
+	"\brc_input\x18\x04 \x01(\v2\x13.flightpath.RcInputR\arcInput\"\xa8\x01\n" +
+	"\aRcInput\x12 \n" +
+	"\ftime_boot_ms\x18\x01 \x01(\rR\n" +
+	"timeBootMs\x12#\n" +
+	"\rchannel_count\x18\x02 \x01(\rR\fchannelCount\x124\n" +
+	"\bchannels\x18\x03 \x03(\v2\x18.flightpath.ChannelValueR\bchannels\x12\x17\n" +
+	"\x04rssi\x18\x04 \x01(\rH\x00R\x04rssi\x88\x01\x01B\a\n" +
+	"\x05_rssi\"!\n" +
+	"\x1fSubscribeActuatorOutputsRequest\"\x8c\x02\n" +
+	" SubscribeActuatorOutputsResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x12=\n" +
+	"\rservo_outputs\x18\x04 \x01(\v2\x18.flightpath.ServoOutputsR\fservoOutputs\x12F\n" +
+	"\x10actuator_outputs\x18\x05 \x01(\v2\x1b.flightpath.ActuatorOutputsR\x0factuatorOutputs\"Z\n" +
+	"\fServoOutputs\x12\x14\n" +
+	"\x05ports\x18\x01 \x03(\rR\x05ports\x124\n" +
+	"\bchannels\x18\x02 \x03(\v2\x18.flightpath.ChannelValueR\bchannels\">\n" +
+	"\fChannelValue\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\rR\achannel\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\rR\x05value\"g\n" +
+	"\x0fActuatorOutputs\x12\x1b\n" +
+	"\ttime_usec\x18\x01 \x01(\x04R\btimeUsec\x127\n" +
+	"\tactuators\x18\x02 \x03(\v2\x19.flightpath.ActuatorValueR\tactuators\"A\n" +
+	"\rActuatorValue\x12\x1a\n" +
+	"\bactuator\x18\x01 \x01(\rR\bactuator\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x02R\x05value\"{\n" +
+	"\n" +
+	"RcChannels\x12 \n" +
+	"\ftime_boot_ms\x18\x01 \x01(\rR\n" +
+	"timeBootMs\x12\x1c\n" +
+	"\tchancount\x18\x02 \x01(\rR\tchancount\x12\x19\n" +
+	"\bchan_raw\x18\x03 \x03(\rR\achanRaw\x12\x12\n" +
+	"\x04rssi\x18\x04 \x01(\rR\x04rssi\"^\n" +
+	"\x0eServoOutputRaw\x12\x1b\n" +
+	"\ttime_usec\x18\x01 \x01(\rR\btimeUsec\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\rR\x04port\x12\x1b\n" +
+	"\tservo_raw\x18\x03 \x03(\rR\bservoRaw\"g\n" +
+	"\x14ActuatorOutputStatus\x12\x1b\n" +
+	"\ttime_usec\x18\x01 \x01(\x04R\btimeUsec\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\rR\x06active\x12\x1a\n" +
+	"\bactuator\x18\x03 \x03(\x02R\bactuator\"!\n" +
+	"\x1fSubscribeEstimatorStatusRequest\"\xaf\x02\n" +
+	" SubscribeEstimatorStatusResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x12F\n" +
+	"\x10estimator_status\x18\x04 \x01(\v2\x1b.flightpath.EstimatorStatusR\x0festimatorStatus\x123\n" +
+	"\tvibration\x18\x05 \x01(\v2\x15.flightpath.VibrationR\tvibration\x12+\n" +
+	"\x11estimator_healthy\x18\x06 \x01(\bR\x10estimatorHealthy\"\x84\x03\n" +
+	"\x0fEstimatorStatus\x12\x1b\n" +
+	"\ttime_usec\x18\x01 \x01(\x04R\btimeUsec\x126\n" +
+	"\x05flags\x18\x02 \x01(\v2 .flightpath.EstimatorStatusFlagsR\x05flags\x12\x1b\n" +
+	"\tvel_ratio\x18\x03 \x01(\x02R\bvelRatio\x12&\n" +
+	"\x0fpos_horiz_ratio\x18\x04 \x01(\x02R\rposHorizRatio\x12$\n" +
+	"\x0epos_vert_ratio\x18\x05 \x01(\x02R\fposVertRatio\x12\x1b\n" +
+	"\tmag_ratio\x18\x06 \x01(\x02R\bmagRatio\x12\x1d\n" +
+	"\n" +
+	"hagl_ratio\x18\a \x01(\x02R\thaglRatio\x12\x1b\n" +
+	"\ttas_ratio\x18\b \x01(\x02R\btasRatio\x12,\n" +
+	"\x12pos_horiz_accuracy\x18\t \x01(\x02R\x10posHorizAccuracy\x12*\n" +
+	"\x11pos_vert_accuracy\x18\n" +
+	" \x01(\x02R\x0fposVertAccuracy\"\xca\x03\n" +
+	"\x14EstimatorStatusFlags\x12\x1a\n" +
+	"\battitude\x18\x01 \x01(\bR\battitude\x12%\n" +
+	"\x0evelocity_horiz\x18\x02 \x01(\bR\rvelocityHoriz\x12#\n" +
+	"\rvelocity_vert\x18\x03 \x01(\bR\fvelocityVert\x12\"\n" +
+	"\rpos_horiz_rel\x18\x04 \x01(\bR\vposHorizRel\x12\"\n" +
+	"\rpos_horiz_abs\x18\x05 \x01(\bR\vposHorizAbs\x12 \n" +
+	"\fpos_vert_abs\x18\x06 \x01(\bR\n" +
+	"posVertAbs\x12 \n" +
+	"\fpos_vert_agl\x18\a \x01(\bR\n" +
+	"posVertAgl\x12$\n" +
+	"\x0econst_pos_mode\x18\b \x01(\bR\fconstPosMode\x12+\n" +
+	"\x12pred_pos_horiz_rel\x18\t \x01(\bR\x0fpredPosHorizRel\x12+\n" +
+	"\x12pred_pos_horiz_abs\x18\n" +
+	" \x01(\bR\x0fpredPosHorizAbs\x12\x1d\n" +
+	"\n" +
+	"gps_glitch\x18\v \x01(\bR\tgpsGlitch\x12\x1f\n" +
+	"\vaccel_error\x18\f \x01(\bR\n" +
+	"accelError\"\xe5\x01\n" +
+	"\tVibration\x12\x1b\n" +
+	"\ttime_usec\x18\x01 \x01(\x04R\btimeUsec\x12\x1f\n" +
+	"\vvibration_x\x18\x02 \x01(\x02R\n" +
+	"vibrationX\x12\x1f\n" +
+	"\vvibration_y\x18\x03 \x01(\x02R\n" +
+	"vibrationY\x12\x1f\n" +
+	"\vvibration_z\x18\x04 \x01(\x02R\n" +
+	"vibrationZ\x12\x1c\n" +
+	"\tclipping0\x18\x05 \x01(\rR\tclipping0\x12\x1c\n" +
+	"\tclipping1\x18\x06 \x01(\rR\tclipping1\x12\x1c\n" +
+	"\tclipping2\x18\a \x01(\rR\tclipping2\"\x16\n" +
+	"\x14SubscribeWindRequest\"\xd0\x01\n" +
+	"\x15SubscribeWindResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x12$\n" +
+	"\x04wind\x18\x04 \x01(\v2\x10.flightpath.WindR\x04wind\x12.\n" +
+	"\bwind_cov\x18\x05 \x01(\v2\x13.flightpath.WindCovR\awindCov\"m\n" +
+	"\x04Wind\x12\x14\n" +
+	"\x05speed\x18\x01 \x01(\x02R\x05speed\x12\x1c\n" +
+	"\tdirection\x18\x02 \x01(\x02R\tdirection\x12\"\n" +
+	"\n" +
+	"speed_down\x18\x03 \x01(\x02H\x00R\tspeedDown\x88\x01\x01B\r\n" +
+	"\v_speed_down\"\x8a\x02\n" +
+	"\aWindCov\x12\x1b\n" +
+	"\ttime_usec\x18\x01 \x01(\x04R\btimeUsec\x12\x15\n" +
+	"\x06wind_x\x18\x02 \x01(\x02R\x05windX\x12\x15\n" +
+	"\x06wind_y\x18\x03 \x01(\x02R\x05windY\x12\x15\n" +
+	"\x06wind_z\x18\x04 \x01(\x02R\x05windZ\x12\x1b\n" +
+	"\tvar_horiz\x18\x05 \x01(\x02R\bvarHoriz\x12\x19\n" +
+	"\bvar_vert\x18\x06 \x01(\x02R\avarVert\x12\x19\n" +
+	"\bwind_alt\x18\a \x01(\x02R\awindAlt\x12%\n" +
+	"\x0ehoriz_accuracy\x18\b \x01(\x02R\rhorizAccuracy\x12#\n" +
+	"\rvert_accuracy\x18\t \x01(\x02R\fvertAccuracy\" \n" +
+	"\x1eSubscribeDistanceSensorRequest\"\xc9\x01\n" +
+	"\x1fSubscribeDistanceSensorResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x12C\n" +
+	"\x0fdistance_sensor\x18\x04 \x01(\v2\x1a.flightpath.DistanceSensorR\x0edistanceSensor\"\xdb\x03\n" +
+	"\x0eDistanceSensor\x12 \n" +
+	"\ftime_boot_ms\x18\x01 \x01(\rR\n" +
+	"timeBootMs\x12!\n" +
+	"\fmin_distance\x18\x02 \x01(\rR\vminDistance\x12!\n" +
+	"\fmax_distance\x18\x03 \x01(\rR\vmaxDistance\x12)\n" +
+	"\x10current_distance\x18\x04 \x01(\rR\x0fcurrentDistance\x121\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x1d.flightpath.MavDistanceSensorR\x04type\x12\x0e\n" +
+	"\x02id\x18\x06 \x01(\rR\x02id\x12B\n" +
+	"\vorientation\x18\a \x01(\x0e2 .flightpath.MavSensorOrientationR\vorientation\x12\x1e\n" +
+	"\n" +
+	"covariance\x18\b \x01(\rR\n" +
+	"covariance\x12%\n" +
+	"\x0ehorizontal_fov\x18\t \x01(\x02R\rhorizontalFov\x12!\n" +
+	"\fvertical_fov\x18\n" +
+	" \x01(\x02R\vverticalFov\x12\x1e\n" +
+	"\n" +
+	"quaternion\x18\v \x03(\x02R\n" +
+	"quaternion\x12%\n" +
+	"\x0esignal_quality\x18\f \x01(\rR\rsignalQuality\"\"\n" +
+	" SubscribeObstacleDistanceRequest\"\x87\x02\n" +
+	"!SubscribeObstacleDistanceResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x124\n" +
+	"\asectors\x18\x04 \x03(\v2\x1a.flightpath.ObstacleSectorR\asectors\x12I\n" +
+	"\x11obstacle_distance\x18\x05 \x01(\v2\x1c.flightpath.ObstacleDistanceR\x10obstacleDistance\"T\n" +
+	"\x0eObstacleSector\x12\x14\n" +
+	"\x05angle\x18\x01 \x01(\x02R\x05angle\x12\x1f\n" +
+	"\bdistance\x18\x02 \x01(\x02H\x00R\bdistance\x88\x01\x01B\v\n" +
+	"\t_distance\"\xe1\x02\n" +
+	"\x10ObstacleDistance\x12\x1b\n" +
+	"\ttime_usec\x18\x01 \x01(\x04R\btimeUsec\x12>\n" +
+	"\vsensor_type\x18\x02 \x01(\x0e2\x1d.flightpath.MavDistanceSensorR\n" +
+	"sensorType\x12\x1c\n" +
+	"\tdistances\x18\x03 \x03(\rR\tdistances\x12\x1c\n" +
+	"\tincrement\x18\x04 \x01(\rR\tincrement\x12!\n" +
+	"\fmin_distance\x18\x05 \x01(\rR\vminDistance\x12!\n" +
+	"\fmax_distance\x18\x06 \x01(\rR\vmaxDistance\x12\x1f\n" +
+	"\vincrement_f\x18\a \x01(\x02R\n" +
+	"incrementF\x12!\n" +
+	"\fangle_offset\x18\b \x01(\x02R\vangleOffset\x12*\n" +
+	"\x05frame\x18\t \x01(\x0e2\x14.flightpath.MavFrameR\x05frame\"\x1e\n" +
+	"\x1cSubscribeGpsReceiversRequest\"\xc1\x02\n" +
+	"\x1dSubscribeGpsReceiversResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x12!\n" +
+	"\fgps_instance\x18\x04 \x01(\rR\vgpsInstance\x123\n" +
+	"\breceiver\x18\x05 \x01(\v2\x17.flightpath.GpsReceiverR\breceiver\x125\n" +
+	"\vgps_raw_int\x18\x06 \x01(\v2\x15.flightpath.GpsRawIntR\tgpsRawInt\x12.\n" +
+	"\bgps2_raw\x18\a \x01(\v2\x13.flightpath.Gps2RawR\agps2Raw\"\xfa\x04\n" +
+	"\vGpsReceiver\x121\n" +
+	"\bfix_type\x18\x01 \x01(\x0e2\x16.flightpath.GpsFixTypeR\afixType\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\baltitude\x18\x04 \x01(\x02R\baltitude\x12\x17\n" +
+	"\x04hdop\x18\x05 \x01(\x02H\x00R\x04hdop\x88\x01\x01\x12\x17\n" +
+	"\x04vdop\x18\x06 \x01(\x02H\x01R\x04vdop\x88\x01\x01\x12&\n" +
+	"\fground_speed\x18\a \x01(\x02H\x02R\vgroundSpeed\x88\x01\x01\x12\x1b\n" +
+	"\x06course\x18\b \x01(\x02H\x03R\x06course\x88\x01\x01\x122\n" +
+	"\x12satellites_visible\x18\t \x01(\rH\x04R\x11satellitesVisible\x88\x01\x01\x124\n" +
+	"\x13horizontal_accuracy\x18\n" +
+	" \x01(\x02H\x05R\x12horizontalAccuracy\x88\x01\x01\x120\n" +
+	"\x11vertical_accuracy\x18\v \x01(\x02H\x06R\x10verticalAccuracy\x88\x01\x01\x12*\n" +
+	"\x0espeed_accuracy\x18\f \x01(\x02H\aR\rspeedAccuracy\x88\x01\x01\x12\x15\n" +
+	"\x03yaw\x18\r \x01(\x02H\bR\x03yaw\x88\x01\x01B\a\n" +
+	"\x05_hdopB\a\n" +
+	"\x05_vdopB\x0f\n" +
+	"\r_ground_speedB\t\n" +
+	"\a_courseB\x15\n" +
+	"\x13_satellites_visibleB\x16\n" +
+	"\x14_horizontal_accuracyB\x14\n" +
+	"\x12_vertical_accuracyB\x11\n" +
+	"\x0f_speed_accuracyB\x06\n" +
+	"\x04_yaw\"\xd3\x03\n" +
+	"\aGps2Raw\x12\x1b\n" +
+	"\ttime_usec\x18\x01 \x01(\x04R\btimeUsec\x121\n" +
+	"\bfix_type\x18\x02 \x01(\x0e2\x16.flightpath.GpsFixTypeR\afixType\x12\x10\n" +
+	"\x03lat\x18\x03 \x01(\x05R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x04 \x01(\x05R\x03lon\x12\x10\n" +
+	"\x03alt\x18\x05 \x01(\x05R\x03alt\x12\x10\n" +
+	"\x03eph\x18\x06 \x01(\rR\x03eph\x12\x10\n" +
+	"\x03epv\x18\a \x01(\rR\x03epv\x12\x10\n" +
+	"\x03vel\x18\b \x01(\rR\x03vel\x12\x10\n" +
+	"\x03cog\x18\t \x01(\rR\x03cog\x12-\n" +
+	"\x12satellites_visible\x18\n" +
+	" \x01(\rR\x11satellitesVisible\x12\x1d\n" +
+	"\n" +
+	"dgps_numch\x18\v \x01(\rR\tdgpsNumch\x12\x19\n" +
+	"\bdgps_age\x18\f \x01(\rR\adgpsAge\x12\x10\n" +
+	"\x03yaw\x18\r \x01(\rR\x03yaw\x12#\n" +
+	"\ralt_ellipsoid\x18\x0e \x01(\x05R\faltEllipsoid\x12\x13\n" +
+	"\x05h_acc\x18\x0f \x01(\rR\x04hAcc\x12\x13\n" +
+	"\x05v_acc\x18\x10 \x01(\rR\x04vAcc\x12\x17\n" +
+	"\avel_acc\x18\x11 \x01(\rR\x06velAcc\x12\x17\n" +
+	"\ahdg_acc\x18\x12 \x01(\rR\x06hdgAcc\"\x1b\n" +
+	"\x19SubscribeGpsStatusRequest\"\xef\x01\n" +
+	"\x1aSubscribeGpsStatusResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x128\n" +
+	"\n" +
+	"satellites\x18\x04 \x03(\v2\x18.flightpath.GpsSatelliteR\n" +
+	"satellites\x124\n" +
+	"\n" +
+	"gps_status\x18\x05 \x01(\v2\x15.flightpath.GpsStatusR\tgpsStatus\"~\n" +
+	"\fGpsSatellite\x12\x10\n" +
+	"\x03prn\x18\x01 \x01(\rR\x03prn\x12\x12\n" +
+	"\x04used\x18\x02 \x01(\bR\x04used\x12\x1c\n" +
+	"\televation\x18\x03 \x01(\rR\televation\x12\x18\n" +
+	"\aazimuth\x18\x04 \x01(\x02R\aazimuth\x12\x10\n" +
+	"\x03snr\x18\x05 \x01(\rR\x03snr\"\x89\x02\n" +
+	"\tGpsStatus\x12-\n" +
+	"\x12satellites_visible\x18\x01 \x01(\rR\x11satellitesVisible\x12#\n" +
+	"\rsatellite_prn\x18\x02 \x03(\rR\fsatellitePrn\x12%\n" +
+	"\x0esatellite_used\x18\x03 \x03(\rR\rsatelliteUsed\x12/\n" +
+	"\x13satellite_elevation\x18\x04 \x03(\rR\x12satelliteElevation\x12+\n" +
+	"\x11satellite_azimuth\x18\x05 \x03(\rR\x10satelliteAzimuth\x12#\n" +
+	"\rsatellite_snr\x18\x06 \x03(\rR\fsatelliteSnr\"\x18\n" +
+	"\x16SubscribeGpsRtkRequest\"\x81\x02\n" +
+	"\x17SubscribeGpsRtkResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x12!\n" +
+	"\fgps_instance\x18\x04 \x01(\rR\vgpsInstance\x123\n" +
+	"\bbaseline\x18\x05 \x01(\v2\x17.flightpath.RtkBaselineR\bbaseline\x12+\n" +
+	"\agps_rtk\x18\x06 \x01(\v2\x12.flightpath.GpsRtkR\x06gpsRtk\"\xa5\x01\n" +
+	"\vRtkBaseline\x12T\n" +
+	"\x11coordinate_system\x18\x01 \x01(\x0e2'.flightpath.RtkBaselineCoordinateSystemR\x10coordinateSystem\x12\f\n" +
+	"\x01a\x18\x02 \x01(\x02R\x01a\x12\f\n" +
+	"\x01b\x18\x03 \x01(\x02R\x01b\x12\f\n" +
+	"\x01c\x18\x04 \x01(\x02R\x01c\x12\x16\n" +
+	"\x06length\x18\x05 \x01(\x02R\x06length\"\xe6\x03\n" +
+	"\x06GpsRtk\x121\n" +
+	"\x15time_last_baseline_ms\x18\x01 \x01(\rR\x12timeLastBaselineMs\x12&\n" +
+	"\x0frtk_receiver_id\x18\x02 \x01(\rR\rrtkReceiverId\x12\x0e\n" +
+	"\x02wn\x18\x03 \x01(\rR\x02wn\x12\x10\n" +
+	"\x03tow\x18\x04 \x01(\rR\x03tow\x12\x1d\n" +
+	"\n" +
+	"rtk_health\x18\x05 \x01(\rR\trtkHealth\x12\x19\n" +
+	"\brtk_rate\x18\x06 \x01(\rR\artkRate\x12\x14\n" +
+	"\x05nsats\x18\a \x01(\rR\x05nsats\x12Y\n" +
+	"\x14baseline_coords_type\x18\b \x01(\x0e2'.flightpath.RtkBaselineCoordinateSystemR\x12baselineCoordsType\x12\"\n" +
+	"\rbaseline_a_mm\x18\t \x01(\x05R\vbaselineAMm\x12\"\n" +
+	"\rbaseline_b_mm\x18\n" +
+	" \x01(\x05R\vbaselineBMm\x12\"\n" +
+	"\rbaseline_c_mm\x18\v \x01(\x05R\vbaselineCMm\x12\x1a\n" +
+	"\baccuracy\x18\f \x01(\rR\baccuracy\x12,\n" +
+	"\x12iar_num_hypotheses\x18\r \x01(\x05R\x10iarNumHypotheses\"K\n" +
+	"\x17SubscribeTrafficRequest\x120\n" +
+	"\x14include_all_emitters\x18\x01 \x01(\bR\x12includeAllEmitters\"\xc2\x02\n" +
+	"\x18SubscribeTrafficResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x12.\n" +
+	"\x05track\x18\x04 \x01(\v2\x18.flightpath.TrafficTrackR\x05track\x12=\n" +
+	"\n" +
+	"separation\x18\x05 \x01(\v2\x1d.flightpath.TrafficSeparationR\n" +
+	"separation\x12\x18\n" +
+	"\aexpired\x18\x06 \x01(\bR\aexpired\x12:\n" +
+	"\fadsb_vehicle\x18\a \x01(\v2\x17.flightpath.AdsbVehicleR\vadsbVehicle\"\x9b\x05\n" +
+	"\fTrafficTrack\x12!\n" +
+	"\ficao_address\x18\x01 \x01(\rR\vicaoAddress\x12\x1f\n" +
+	"\bcallsign\x18\x02 \x01(\tH\x00R\bcallsign\x88\x01\x01\x12\x1b\n" +
+	"\x06squawk\x18\x03 \x01(\rH\x01R\x06squawk\x88\x01\x01\x12>\n" +
+	"\femitter_type\x18\x04 \x01(\x0e2\x1b.flightpath.AdsbEmitterTypeR\vemitterType\x12\x1f\n" +
+	"\blatitude\x18\x05 \x01(\x01H\x02R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\x06 \x01(\x01H\x03R\tlongitude\x88\x01\x01\x12\x1f\n" +
+	"\baltitude\x18\a \x01(\x02H\x04R\baltitude\x88\x01\x01\x12A\n" +
+	"\raltitude_type\x18\b \x01(\x0e2\x1c.flightpath.AdsbAltitudeTypeR\faltitudeType\x12\x1d\n" +
+	"\aheading\x18\t \x01(\x02H\x05R\aheading\x88\x01\x01\x12.\n" +
+	"\x10horizontal_speed\x18\n" +
+	" \x01(\x02H\x06R\x0fhorizontalSpeed\x88\x01\x01\x12*\n" +
+	"\x0evertical_speed\x18\v \x01(\x02H\aR\rverticalSpeed\x88\x01\x01\x12\x1c\n" +
+	"\tsimulated\x18\f \x01(\bR\tsimulated\x125\n" +
+	"\x17time_since_last_contact\x18\r \x01(\rR\x14timeSinceLastContactB\v\n" +
+	"\t_callsignB\t\n" +
+	"\a_squawkB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitudeB\v\n" +
+	"\t_altitudeB\n" +
+	"\n" +
+	"\b_headingB\x13\n" +
+	"\x11_horizontal_speedB\x11\n" +
+	"\x0f_vertical_speed\"\xe5\x01\n" +
+	"\x11TrafficSeparation\x12/\n" +
+	"\x13horizontal_distance\x18\x01 \x01(\x02R\x12horizontalDistance\x12\x18\n" +
+	"\abearing\x18\x02 \x01(\x02R\abearing\x124\n" +
+	"\x13vertical_separation\x18\x03 \x01(\x02H\x00R\x12verticalSeparation\x88\x01\x01\x12&\n" +
+	"\fclosing_rate\x18\x04 \x01(\x02H\x01R\vclosingRate\x88\x01\x01B\x16\n" +
+	"\x14_vertical_separationB\x0f\n" +
+	"\r_closing_rate\"\xb1\x03\n" +
+	"\vAdsbVehicle\x12!\n" +
+	"\ficao_address\x18\x01 \x01(\rR\vicaoAddress\x12\x10\n" +
+	"\x03lat\x18\x02 \x01(\x05R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x03 \x01(\x05R\x03lon\x12A\n" +
+	"\raltitude_type\x18\x04 \x01(\x0e2\x1c.flightpath.AdsbAltitudeTypeR\faltitudeType\x12\x1a\n" +
+	"\baltitude\x18\x05 \x01(\x05R\baltitude\x12\x18\n" +
+	"\aheading\x18\x06 \x01(\rR\aheading\x12!\n" +
+	"\fhor_velocity\x18\a \x01(\rR\vhorVelocity\x12!\n" +
+	"\fver_velocity\x18\b \x01(\x05R\vverVelocity\x12\x1a\n" +
+	"\bcallsign\x18\t \x01(\tR\bcallsign\x12>\n" +
+	"\femitter_type\x18\n" +
+	" \x01(\x0e2\x1b.flightpath.AdsbEmitterTypeR\vemitterType\x12\x12\n" +
+	"\x04tslc\x18\v \x01(\rR\x04tslc\x12\x14\n" +
+	"\x05flags\x18\f \x01(\rR\x05flags\x12\x16\n" +
+	"\x06squawk\x18\r \x01(\rR\x06squawk\"T\n" +
+	"\x1cSubscribeVehicleStateRequest\x12\x17\n" +
+	"\arate_hz\x18\x01 \x01(\x02R\x06rateHz\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\"\x9e\x01\n" +
+	"\x1dSubscribeVehicleStateResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12=\n" +
+	"\rvehicle_state\x18\x03 \x01(\v2\x18.flightpath.VehicleStateR\fvehicleState\"\xbb\x03\n" +
+	"\fVehicleState\x12&\n" +
+	"\x0flast_message_ms\x18\x01 \x01(\x03R\rlastMessageMs\x123\n" +
+	"\theartbeat\x18\x02 \x01(\v2\x15.flightpath.HeartbeatR\theartbeat\x12\x14\n" +
+	"\x05armed\x18\x03 \x01(\bR\x05armed\x127\n" +
+	"\vflight_mode\x18\x04 \x01(\v2\x16.flightpath.CustomModeR\n" +
+	"flightMode\x120\n" +
+	"\bposition\x18\x05 \x01(\v2\x14.flightpath.PositionR\bposition\x120\n" +
+	"\battitude\x18\x06 \x01(\v2\x14.flightpath.AttitudeR\battitude\x121\n" +
+	"\tbatteries\x18\a \x03(\v2\x13.flightpath.BatteryR\tbatteries\x12)\n" +
+	"\x03gps\x18\b \x01(\v2\x17.flightpath.GpsReceiverR\x03gps\x12=\n" +
+	"\flanded_state\x18\t \x01(\x0e2\x1a.flightpath.MavLandedStateR\vlandedState\"\xa4\x01\n" +
+	"\x06VfrHud\x12\x1a\n" +
+	"\bairspeed\x18\x01 \x01(\x02R\bairspeed\x12 \n" +
+	"\vgroundspeed\x18\x02 \x01(\x02R\vgroundspeed\x12\x18\n" +
+	"\aheading\x18\x03 \x01(\x05R\aheading\x12\x1a\n" +
+	"\bthrottle\x18\x04 \x01(\rR\bthrottle\x12\x10\n" +
+	"\x03alt\x18\x05 \x01(\x02R\x03alt\x12\x14\n" +
+	"\x05climb\x18\x06 \x01(\x02R\x05climb\"\xd0\x01\n" +
+	"\x11GlobalPositionInt\x12 \n" +
+	"\ftime_boot_ms\x18\x01 \x01(\rR\n" +
+	"timeBootMs\x12\x10\n" +
+	"\x03lat\x18\x02 \x01(\x05R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x03 \x01(\x05R\x03lon\x12\x10\n" +
+	"\x03alt\x18\x04 \x01(\x05R\x03alt\x12!\n" +
+	"\frelative_alt\x18\x05 \x01(\x05R\vrelativeAlt\x12\x0e\n" +
+	"\x02vx\x18\x06 \x01(\x05R\x02vx\x12\x0e\n" +
+	"\x02vy\x18\a \x01(\x05R\x02vy\x12\x0e\n" +
+	"\x02vz\x18\b \x01(\x05R\x02vz\x12\x10\n" +
+	"\x03hdg\x18\t \x01(\rR\x03hdg\"B\n" +
+	"\x1bSubscribeLandedStateRequest\x12#\n" +
+	"\revery_message\x18\x01 \x01(\bR\feveryMessage\"\xcd\x01\n" +
+	"\x1cSubscribeLandedStateResponse\x12!\n" +
+	"\ftimestamp_ms\x18\x01 \x01(\x03R\vtimestampMs\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\rR\bsystemId\x12!\n" +
+	"\fcomponent_id\x18\x03 \x01(\rR\vcomponentId\x12J\n" +
+	"\x12extended_sys_state\x18\x04 \x01(\v2\x1c.flightpath.ExtendedSysStateR\x10extendedSysState\"\x8a\x01\n" +
+	"\x10ExtendedSysState\x127\n" +
+	"\n" +
+	"vtol_state\x18\x01 \x01(\x0e2\x18.flightpath.MavVtolStateR\tvtolState\x12=\n" +
+	"\flanded_state\x18\x02 \x01(\x0e2\x1a.flightpath.MavLandedStateR\vlandedState*q\n" +
+	"\rBatterySource\x12\x1e\n" +
+	"\x1aBATTERY_SOURCE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dBATTERY_SOURCE_BATTERY_STATUS\x10\x01\x12\x1d\n" +
+	"\x19BATTERY_SOURCE_SYS_STATUS\x10\x02*}\n" +
+	"\x10AdsbAltitudeType\x12\"\n" +
+	"\x1eADSB_ALTITUDE_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fADSB_ALTITUDE_TYPE_PRESSURE_QNH\x10\x01\x12 \n" +
+	"\x1cADSB_ALTITUDE_TYPE_GEOMETRIC\x10\x02*\xd0\x05\n" +
+	"\x0fAdsbEmitterType\x12!\n" +
+	"\x1dADSB_EMITTER_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19ADSB_EMITTER_TYPE_NO_INFO\x10\x01\x12\x1b\n" +
+	"\x17ADSB_EMITTER_TYPE_LIGHT\x10\x02\x12\x1b\n" +
+	"\x17ADSB_EMITTER_TYPE_SMALL\x10\x03\x12\x1b\n" +
+	"\x17ADSB_EMITTER_TYPE_LARGE\x10\x04\x12'\n" +
+	"#ADSB_EMITTER_TYPE_HIGH_VORTEX_LARGE\x10\x05\x12\x1b\n" +
+	"\x17ADSB_EMITTER_TYPE_HEAVY\x10\x06\x12\"\n" +
+	"\x1eADSB_EMITTER_TYPE_HIGHLY_MANUV\x10\a\x12\x1f\n" +
+	"\x1bADSB_EMITTER_TYPE_ROTOCRAFT\x10\b\x12 \n" +
+	"\x1cADSB_EMITTER_TYPE_UNASSIGNED\x10\t\x12\x1c\n" +
+	"\x18ADSB_EMITTER_TYPE_GLIDER\x10\n" +
+	"\x12!\n" +
+	"\x1dADSB_EMITTER_TYPE_LIGHTER_AIR\x10\v\x12\x1f\n" +
+	"\x1bADSB_EMITTER_TYPE_PARACHUTE\x10\f\x12!\n" +
+	"\x1dADSB_EMITTER_TYPE_ULTRA_LIGHT\x10\r\x12!\n" +
+	"\x1dADSB_EMITTER_TYPE_UNASSIGNED2\x10\x0e\x12\x19\n" +
+	"\x15ADSB_EMITTER_TYPE_UAV\x10\x0f\x12\x1b\n" +
+	"\x17ADSB_EMITTER_TYPE_SPACE\x10\x10\x12!\n" +
+	"\x1dADSB_EMITTER_TYPE_UNASSGINED3\x10\x11\x12'\n" +
+	"#ADSB_EMITTER_TYPE_EMERGENCY_SURFACE\x10\x12\x12%\n" +
+	"!ADSB_EMITTER_TYPE_SERVICE_SURFACE\x10\x13\x12$\n" +
+	" ADSB_EMITTER_TYPE_POINT_OBSTACLE\x10\x14*\x8c\x02\n" +
 	"\n" +
 	"GpsFixType\x12\x1c\n" +
 	"\x18GPS_FIX_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
@@ -427,9 +10098,169 @@ const file_flightpath_telemetry_proto_rawDesc = "" +
 	"\x16GPS_FIX_TYPE_RTK_FLOAT\x10\x06\x12\x1a\n" +
 	"\x16GPS_FIX_TYPE_RTK_FIXED\x10\a\x12\x17\n" +
 	"\x13GPS_FIX_TYPE_STATIC\x10\b\x12\x14\n" +
-	"\x10GPS_FIX_TYPE_PPP\x10\t2p\n" +
+	"\x10GPS_FIX_TYPE_PPP\x10\t*\x9f\x11\n" +
+	"\x14MavSensorOrientation\x12&\n" +
+	"\"MAV_SENSOR_ORIENTATION_UNSPECIFIED\x10\x00\x12(\n" +
+	"$MAV_SENSOR_ORIENTATION_ROTATION_NONE\x10\x01\x12*\n" +
+	"&MAV_SENSOR_ORIENTATION_ROTATION_YAW_45\x10\x02\x12*\n" +
+	"&MAV_SENSOR_ORIENTATION_ROTATION_YAW_90\x10\x03\x12+\n" +
+	"'MAV_SENSOR_ORIENTATION_ROTATION_YAW_135\x10\x04\x12+\n" +
+	"'MAV_SENSOR_ORIENTATION_ROTATION_YAW_180\x10\x05\x12+\n" +
+	"'MAV_SENSOR_ORIENTATION_ROTATION_YAW_225\x10\x06\x12+\n" +
+	"'MAV_SENSOR_ORIENTATION_ROTATION_YAW_270\x10\a\x12+\n" +
+	"'MAV_SENSOR_ORIENTATION_ROTATION_YAW_315\x10\b\x12,\n" +
+	"(MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180\x10\t\x123\n" +
+	"/MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_45\x10\n" +
+	"\x123\n" +
+	"/MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_90\x10\v\x124\n" +
+	"0MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_135\x10\f\x12-\n" +
+	")MAV_SENSOR_ORIENTATION_ROTATION_PITCH_180\x10\r\x124\n" +
+	"0MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_225\x10\x0e\x124\n" +
+	"0MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_270\x10\x0f\x124\n" +
+	"0MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_315\x10\x10\x12+\n" +
+	"'MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90\x10\x11\x122\n" +
+	".MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_45\x10\x12\x122\n" +
+	".MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_90\x10\x13\x123\n" +
+	"/MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_135\x10\x14\x12,\n" +
+	"(MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270\x10\x15\x123\n" +
+	"/MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_YAW_45\x10\x16\x123\n" +
+	"/MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_YAW_90\x10\x17\x124\n" +
+	"0MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_YAW_135\x10\x18\x12,\n" +
+	"(MAV_SENSOR_ORIENTATION_ROTATION_PITCH_90\x10\x19\x12-\n" +
+	")MAV_SENSOR_ORIENTATION_ROTATION_PITCH_270\x10\x1a\x124\n" +
+	"0MAV_SENSOR_ORIENTATION_ROTATION_PITCH_180_YAW_90\x10\x1b\x125\n" +
+	"1MAV_SENSOR_ORIENTATION_ROTATION_PITCH_180_YAW_270\x10\x1c\x124\n" +
+	"0MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_90\x10\x1d\x125\n" +
+	"1MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_PITCH_90\x10\x1e\x125\n" +
+	"1MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_PITCH_90\x10\x1f\x125\n" +
+	"1MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_180\x10 \x126\n" +
+	"2MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_PITCH_180\x10!\x125\n" +
+	"1MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_270\x10\"\x126\n" +
+	"2MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_PITCH_270\x10#\x126\n" +
+	"2MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_PITCH_270\x10$\x12<\n" +
+	"8MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_180_YAW_90\x10%\x123\n" +
+	"/MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_270\x10&\x12<\n" +
+	"8MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_68_YAW_293\x10'\x12-\n" +
+	")MAV_SENSOR_ORIENTATION_ROTATION_PITCH_315\x10(\x125\n" +
+	"1MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_315\x10)\x12*\n" +
+	"&MAV_SENSOR_ORIENTATION_ROTATION_CUSTOM\x10e*\xf4\x01\n" +
+	"\vMavSeverity\x12\x1c\n" +
+	"\x18MAV_SEVERITY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16MAV_SEVERITY_EMERGENCY\x10\x01\x12\x16\n" +
+	"\x12MAV_SEVERITY_ALERT\x10\x02\x12\x19\n" +
+	"\x15MAV_SEVERITY_CRITICAL\x10\x03\x12\x16\n" +
+	"\x12MAV_SEVERITY_ERROR\x10\x04\x12\x18\n" +
+	"\x14MAV_SEVERITY_WARNING\x10\x05\x12\x17\n" +
+	"\x13MAV_SEVERITY_NOTICE\x10\x06\x12\x15\n" +
+	"\x11MAV_SEVERITY_INFO\x10\a\x12\x16\n" +
+	"\x12MAV_SEVERITY_DEBUG\x10\b*\xc4\x01\n" +
+	"\fMavVtolState\x12\x1e\n" +
+	"\x1aMAV_VTOL_STATE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18MAV_VTOL_STATE_UNDEFINED\x10\x01\x12#\n" +
+	"\x1fMAV_VTOL_STATE_TRANSITION_TO_FW\x10\x02\x12#\n" +
+	"\x1fMAV_VTOL_STATE_TRANSITION_TO_MC\x10\x03\x12\x15\n" +
+	"\x11MAV_VTOL_STATE_MC\x10\x04\x12\x15\n" +
+	"\x11MAV_VTOL_STATE_FW\x10\x05*\xcb\x01\n" +
+	"\x0eMavLandedState\x12 \n" +
+	"\x1cMAV_LANDED_STATE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aMAV_LANDED_STATE_UNDEFINED\x10\x01\x12\x1e\n" +
+	"\x1aMAV_LANDED_STATE_ON_GROUND\x10\x02\x12\x1b\n" +
+	"\x17MAV_LANDED_STATE_IN_AIR\x10\x03\x12\x1c\n" +
+	"\x18MAV_LANDED_STATE_TAKEOFF\x10\x04\x12\x1c\n" +
+	"\x18MAV_LANDED_STATE_LANDING\x10\x05*\xef\x02\n" +
+	"\x15MavBatteryChargeState\x12(\n" +
+	"$MAV_BATTERY_CHARGE_STATE_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"MAV_BATTERY_CHARGE_STATE_UNDEFINED\x10\x01\x12\x1f\n" +
+	"\x1bMAV_BATTERY_CHARGE_STATE_OK\x10\x02\x12 \n" +
+	"\x1cMAV_BATTERY_CHARGE_STATE_LOW\x10\x03\x12%\n" +
+	"!MAV_BATTERY_CHARGE_STATE_CRITICAL\x10\x04\x12&\n" +
+	"\"MAV_BATTERY_CHARGE_STATE_EMERGENCY\x10\x05\x12#\n" +
+	"\x1fMAV_BATTERY_CHARGE_STATE_FAILED\x10\x06\x12&\n" +
+	"\"MAV_BATTERY_CHARGE_STATE_UNHEALTHY\x10\a\x12%\n" +
+	"!MAV_BATTERY_CHARGE_STATE_CHARGING\x10\b*\xe4\x01\n" +
+	"\x12MavBatteryFunction\x12$\n" +
+	" MAV_BATTERY_FUNCTION_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cMAV_BATTERY_FUNCTION_UNKNOWN\x10\x01\x12\x1c\n" +
+	"\x18MAV_BATTERY_FUNCTION_ALL\x10\x02\x12#\n" +
+	"\x1fMAV_BATTERY_FUNCTION_PROPULSION\x10\x03\x12!\n" +
+	"\x1dMAV_BATTERY_FUNCTION_AVIONICS\x10\x04\x12 \n" +
+	"\x1cMAV_BATTERY_FUNCTION_PAYLOAD\x10\x05*\x96\x01\n" +
+	"\x0eMavBatteryMode\x12 \n" +
+	"\x1cMAV_BATTERY_MODE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18MAV_BATTERY_MODE_UNKNOWN\x10\x01\x12%\n" +
+	"!MAV_BATTERY_MODE_AUTO_DISCHARGING\x10\x02\x12\x1d\n" +
+	"\x19MAV_BATTERY_MODE_HOT_SWAP\x10\x03*\xbc\x01\n" +
+	"\x0eMavBatteryType\x12 \n" +
+	"\x1cMAV_BATTERY_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18MAV_BATTERY_TYPE_UNKNOWN\x10\x01\x12\x19\n" +
+	"\x15MAV_BATTERY_TYPE_LIPO\x10\x02\x12\x19\n" +
+	"\x15MAV_BATTERY_TYPE_LIFE\x10\x03\x12\x19\n" +
+	"\x15MAV_BATTERY_TYPE_LION\x10\x04\x12\x19\n" +
+	"\x15MAV_BATTERY_TYPE_NIMH\x10\x05*\xdd\x01\n" +
+	"\x11MavDistanceSensor\x12#\n" +
+	"\x1fMAV_DISTANCE_SENSOR_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19MAV_DISTANCE_SENSOR_LASER\x10\x01\x12\"\n" +
+	"\x1eMAV_DISTANCE_SENSOR_ULTRASOUND\x10\x02\x12 \n" +
+	"\x1cMAV_DISTANCE_SENSOR_INFRARED\x10\x03\x12\x1d\n" +
+	"\x19MAV_DISTANCE_SENSOR_RADAR\x10\x04\x12\x1f\n" +
+	"\x1bMAV_DISTANCE_SENSOR_UNKNOWN\x10\x05*\xc9\x02\n" +
+	"\x10MavEstimatorType\x12\"\n" +
+	"\x1eMAV_ESTIMATOR_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aMAV_ESTIMATOR_TYPE_UNKNOWN\x10\x01\x12\x1c\n" +
+	"\x18MAV_ESTIMATOR_TYPE_NAIVE\x10\x02\x12\x1d\n" +
+	"\x19MAV_ESTIMATOR_TYPE_VISION\x10\x03\x12\x1a\n" +
+	"\x16MAV_ESTIMATOR_TYPE_VIO\x10\x04\x12\x1a\n" +
+	"\x16MAV_ESTIMATOR_TYPE_GPS\x10\x05\x12\x1e\n" +
+	"\x1aMAV_ESTIMATOR_TYPE_GPS_INS\x10\x06\x12\x1c\n" +
+	"\x18MAV_ESTIMATOR_TYPE_MOCAP\x10\a\x12\x1c\n" +
+	"\x18MAV_ESTIMATOR_TYPE_LIDAR\x10\b\x12 \n" +
+	"\x1cMAV_ESTIMATOR_TYPE_AUTOPILOT\x10\t*\xd1\x03\n" +
+	"\bMavFrame\x12\x19\n" +
+	"\x15MAV_FRAME_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10MAV_FRAME_GLOBAL\x10\x01\x12\x17\n" +
+	"\x13MAV_FRAME_LOCAL_NED\x10\x02\x12\x15\n" +
+	"\x11MAV_FRAME_MISSION\x10\x03\x12!\n" +
+	"\x1dMAV_FRAME_GLOBAL_RELATIVE_ALT\x10\x04\x12\x17\n" +
+	"\x13MAV_FRAME_LOCAL_ENU\x10\x05\x12\x18\n" +
+	"\x14MAV_FRAME_GLOBAL_INT\x10\x06\x12%\n" +
+	"!MAV_FRAME_GLOBAL_RELATIVE_ALT_INT\x10\a\x12\x1e\n" +
+	"\x1aMAV_FRAME_LOCAL_OFFSET_NED\x10\b\x12\x16\n" +
+	"\x12MAV_FRAME_BODY_NED\x10\t\x12\x1d\n" +
+	"\x19MAV_FRAME_BODY_OFFSET_NED\x10\n" +
+	"\x12 \n" +
+	"\x1cMAV_FRAME_GLOBAL_TERRAIN_ALT\x10\v\x12$\n" +
+	" MAV_FRAME_GLOBAL_TERRAIN_ALT_INT\x10\f\x12\x16\n" +
+	"\x12MAV_FRAME_BODY_FRD\x10\r\x12\x17\n" +
+	"\x13MAV_FRAME_LOCAL_FRD\x10\x15\x12\x17\n" +
+	"\x13MAV_FRAME_LOCAL_FLU\x10\x16*\x9e\x01\n" +
+	"\x1bRtkBaselineCoordinateSystem\x12.\n" +
+	"*RTK_BASELINE_COORDINATE_SYSTEM_UNSPECIFIED\x10\x00\x12'\n" +
+	"#RTK_BASELINE_COORDINATE_SYSTEM_ECEF\x10\x01\x12&\n" +
+	"\"RTK_BASELINE_COORDINATE_SYSTEM_NED\x10\x022\xc7\x13\n" +
 	"\x10TelemetryService\x12\\\n" +
-	"\x0fSubscribeRawGps\x12\".flightpath.SubscribeRawGpsRequest\x1a#.flightpath.SubscribeRawGpsResponse0\x01B\xa0\x01\n" +
+	"\x0fSubscribeRawGps\x12\".flightpath.SubscribeRawGpsRequest\x1a#.flightpath.SubscribeRawGpsResponse0\x01\x12\x86\x01\n" +
+	"\x1dSubscribeOrbitExecutionStatus\x120.flightpath.SubscribeOrbitExecutionStatusRequest\x1a1.flightpath.SubscribeOrbitExecutionStatusResponse0\x01\x12b\n" +
+	"\x11SubscribeAttitude\x12$.flightpath.SubscribeAttitudeRequest\x1a%.flightpath.SubscribeAttitudeResponse0\x01\x12b\n" +
+	"\x11SubscribePosition\x12$.flightpath.SubscribePositionRequest\x1a%.flightpath.SubscribePositionResponse0\x01\x12q\n" +
+	"\x16SubscribeLocalPosition\x12).flightpath.SubscribeLocalPositionRequest\x1a*.flightpath.SubscribeLocalPositionResponse0\x01\x12_\n" +
+	"\x10SubscribeBattery\x12#.flightpath.SubscribeBatteryRequest\x1a$.flightpath.SubscribeBatteryResponse0\x01\x12q\n" +
+	"\x16SubscribeFlightMetrics\x12).flightpath.SubscribeFlightMetricsRequest\x1a*.flightpath.SubscribeFlightMetricsResponse0\x01\x12k\n" +
+	"\x14SubscribeLandedState\x12'.flightpath.SubscribeLandedStateRequest\x1a(.flightpath.SubscribeLandedStateResponse0\x01\x12h\n" +
+	"\x13SubscribeStatusText\x12&.flightpath.SubscribeStatusTextRequest\x1a'.flightpath.SubscribeStatusTextResponse0\x01\x12Z\n" +
+	"\x0fGetHomePosition\x12\".flightpath.GetHomePositionRequest\x1a#.flightpath.GetHomePositionResponse\x12n\n" +
+	"\x15SubscribeHomePosition\x12(.flightpath.SubscribeHomePositionRequest\x1a).flightpath.SubscribeHomePositionResponse0\x01\x12h\n" +
+	"\x13SubscribeRcChannels\x12&.flightpath.SubscribeRcChannelsRequest\x1a'.flightpath.SubscribeRcChannelsResponse0\x01\x12w\n" +
+	"\x18SubscribeActuatorOutputs\x12+.flightpath.SubscribeActuatorOutputsRequest\x1a,.flightpath.SubscribeActuatorOutputsResponse0\x01\x12w\n" +
+	"\x18SubscribeEstimatorStatus\x12+.flightpath.SubscribeEstimatorStatusRequest\x1a,.flightpath.SubscribeEstimatorStatusResponse0\x01\x12V\n" +
+	"\rSubscribeWind\x12 .flightpath.SubscribeWindRequest\x1a!.flightpath.SubscribeWindResponse0\x01\x12t\n" +
+	"\x17SubscribeDistanceSensor\x12*.flightpath.SubscribeDistanceSensorRequest\x1a+.flightpath.SubscribeDistanceSensorResponse0\x01\x12z\n" +
+	"\x19SubscribeObstacleDistance\x12,.flightpath.SubscribeObstacleDistanceRequest\x1a-.flightpath.SubscribeObstacleDistanceResponse0\x01\x12n\n" +
+	"\x15SubscribeGpsReceivers\x12(.flightpath.SubscribeGpsReceiversRequest\x1a).flightpath.SubscribeGpsReceiversResponse0\x01\x12e\n" +
+	"\x12SubscribeGpsStatus\x12%.flightpath.SubscribeGpsStatusRequest\x1a&.flightpath.SubscribeGpsStatusResponse0\x01\x12\\\n" +
+	"\x0fSubscribeGpsRtk\x12\".flightpath.SubscribeGpsRtkRequest\x1a#.flightpath.SubscribeGpsRtkResponse0\x01\x12n\n" +
+	"\x15SubscribeSystemStatus\x12(.flightpath.SubscribeSystemStatusRequest\x1a).flightpath.SubscribeSystemStatusResponse0\x01\x12_\n" +
+	"\x10SubscribeTraffic\x12#.flightpath.SubscribeTrafficRequest\x1a$.flightpath.SubscribeTrafficResponse0\x01\x12n\n" +
+	"\x15SubscribeVehicleState\x12(.flightpath.SubscribeVehicleStateRequest\x1a).flightpath.SubscribeVehicleStateResponse0\x01B\xa0\x01\n" +
 	"\x0ecom.flightpathB\x0eTelemetryProtoP\x01Z6github.com/flightpath-dev/flightpath/gen/go/flightpath\xa2\x02\x03FXX\xaa\x02\n" +
 	"Flightpath\xca\x02\n" +
 	"Flightpath\xe2\x02\x16Flightpath\\GPBMetadata\xea\x02\n" +
@@ -447,24 +10278,289 @@ func file_flightpath_telemetry_proto_rawDescGZIP() []byte {
 	return file_flightpath_telemetry_proto_rawDescData
 }
 
-var file_flightpath_telemetry_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_flightpath_telemetry_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_flightpath_telemetry_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_flightpath_telemetry_proto_msgTypes = make([]protoimpl.MessageInfo, 93)
 var file_flightpath_telemetry_proto_goTypes = []any{
-	(GpsFixType)(0),                 // 0: flightpath.GpsFixType
-	(*SubscribeRawGpsRequest)(nil),  // 1: flightpath.SubscribeRawGpsRequest
-	(*SubscribeRawGpsResponse)(nil), // 2: flightpath.SubscribeRawGpsResponse
-	(*GpsRawInt)(nil),               // 3: flightpath.GpsRawInt
+	(BatterySource)(0),                            // 0: flightpath.BatterySource
+	(AdsbAltitudeType)(0),                         // 1: flightpath.AdsbAltitudeType
+	(AdsbEmitterType)(0),                          // 2: flightpath.AdsbEmitterType
+	(GpsFixType)(0),                               // 3: flightpath.GpsFixType
+	(MavSensorOrientation)(0),                     // 4: flightpath.MavSensorOrientation
+	(MavSeverity)(0),                              // 5: flightpath.MavSeverity
+	(MavVtolState)(0),                             // 6: flightpath.MavVtolState
+	(MavLandedState)(0),                           // 7: flightpath.MavLandedState
+	(MavBatteryChargeState)(0),                    // 8: flightpath.MavBatteryChargeState
+	(MavBatteryFunction)(0),                       // 9: flightpath.MavBatteryFunction
+	(MavBatteryMode)(0),                           // 10: flightpath.MavBatteryMode
+	(MavBatteryType)(0),                           // 11: flightpath.MavBatteryType
+	(MavDistanceSensor)(0),                        // 12: flightpath.MavDistanceSensor
+	(MavEstimatorType)(0),                         // 13: flightpath.MavEstimatorType
+	(MavFrame)(0),                                 // 14: flightpath.MavFrame
+	(RtkBaselineCoordinateSystem)(0),              // 15: flightpath.RtkBaselineCoordinateSystem
+	(*SubscribeRawGpsRequest)(nil),                // 16: flightpath.SubscribeRawGpsRequest
+	(*SubscribeRawGpsResponse)(nil),               // 17: flightpath.SubscribeRawGpsResponse
+	(*GpsRawInt)(nil),                             // 18: flightpath.GpsRawInt
+	(*SubscribeOrbitExecutionStatusRequest)(nil),  // 19: flightpath.SubscribeOrbitExecutionStatusRequest
+	(*SubscribeOrbitExecutionStatusResponse)(nil), // 20: flightpath.SubscribeOrbitExecutionStatusResponse
+	(*OrbitExecutionStatus)(nil),                  // 21: flightpath.OrbitExecutionStatus
+	(*SubscribeAttitudeRequest)(nil),              // 22: flightpath.SubscribeAttitudeRequest
+	(*SubscribeAttitudeResponse)(nil),             // 23: flightpath.SubscribeAttitudeResponse
+	(*Attitude)(nil),                              // 24: flightpath.Attitude
+	(*AttitudeQuaternion)(nil),                    // 25: flightpath.AttitudeQuaternion
+	(*SubscribePositionRequest)(nil),              // 26: flightpath.SubscribePositionRequest
+	(*SubscribePositionResponse)(nil),             // 27: flightpath.SubscribePositionResponse
+	(*Position)(nil),                              // 28: flightpath.Position
+	(*SubscribeLocalPositionRequest)(nil),         // 29: flightpath.SubscribeLocalPositionRequest
+	(*SubscribeLocalPositionResponse)(nil),        // 30: flightpath.SubscribeLocalPositionResponse
+	(*LocalPositionNed)(nil),                      // 31: flightpath.LocalPositionNed
+	(*Odometry)(nil),                              // 32: flightpath.Odometry
+	(*Covariance)(nil),                            // 33: flightpath.Covariance
+	(*SubscribeBatteryRequest)(nil),               // 34: flightpath.SubscribeBatteryRequest
+	(*SubscribeBatteryResponse)(nil),              // 35: flightpath.SubscribeBatteryResponse
+	(*Battery)(nil),                               // 36: flightpath.Battery
+	(*BatteryFaults)(nil),                         // 37: flightpath.BatteryFaults
+	(*BatteryStatus)(nil),                         // 38: flightpath.BatteryStatus
+	(*SubscribeSystemStatusRequest)(nil),          // 39: flightpath.SubscribeSystemStatusRequest
+	(*SubscribeSystemStatusResponse)(nil),         // 40: flightpath.SubscribeSystemStatusResponse
+	(*SystemStatus)(nil),                          // 41: flightpath.SystemStatus
+	(*SensorStatus)(nil),                          // 42: flightpath.SensorStatus
+	(*SysStatusSensors)(nil),                      // 43: flightpath.SysStatusSensors
+	(*SysStatus)(nil),                             // 44: flightpath.SysStatus
+	(*SubscribeFlightMetricsRequest)(nil),         // 45: flightpath.SubscribeFlightMetricsRequest
+	(*SubscribeFlightMetricsResponse)(nil),        // 46: flightpath.SubscribeFlightMetricsResponse
+	(*SubscribeStatusTextRequest)(nil),            // 47: flightpath.SubscribeStatusTextRequest
+	(*SubscribeStatusTextResponse)(nil),           // 48: flightpath.SubscribeStatusTextResponse
+	(*StatusText)(nil),                            // 49: flightpath.StatusText
+	(*GetHomePositionRequest)(nil),                // 50: flightpath.GetHomePositionRequest
+	(*GetHomePositionResponse)(nil),               // 51: flightpath.GetHomePositionResponse
+	(*SubscribeHomePositionRequest)(nil),          // 52: flightpath.SubscribeHomePositionRequest
+	(*SubscribeHomePositionResponse)(nil),         // 53: flightpath.SubscribeHomePositionResponse
+	(*HomeLocation)(nil),                          // 54: flightpath.HomeLocation
+	(*HomePosition)(nil),                          // 55: flightpath.HomePosition
+	(*SubscribeRcChannelsRequest)(nil),            // 56: flightpath.SubscribeRcChannelsRequest
+	(*SubscribeRcChannelsResponse)(nil),           // 57: flightpath.SubscribeRcChannelsResponse
+	(*RcInput)(nil),                               // 58: flightpath.RcInput
+	(*SubscribeActuatorOutputsRequest)(nil),       // 59: flightpath.SubscribeActuatorOutputsRequest
+	(*SubscribeActuatorOutputsResponse)(nil),      // 60: flightpath.SubscribeActuatorOutputsResponse
+	(*ServoOutputs)(nil),                          // 61: flightpath.ServoOutputs
+	(*ChannelValue)(nil),                          // 62: flightpath.ChannelValue
+	(*ActuatorOutputs)(nil),                       // 63: flightpath.ActuatorOutputs
+	(*ActuatorValue)(nil),                         // 64: flightpath.ActuatorValue
+	(*RcChannels)(nil),                            // 65: flightpath.RcChannels
+	(*ServoOutputRaw)(nil),                        // 66: flightpath.ServoOutputRaw
+	(*ActuatorOutputStatus)(nil),                  // 67: flightpath.ActuatorOutputStatus
+	(*SubscribeEstimatorStatusRequest)(nil),       // 68: flightpath.SubscribeEstimatorStatusRequest
+	(*SubscribeEstimatorStatusResponse)(nil),      // 69: flightpath.SubscribeEstimatorStatusResponse
+	(*EstimatorStatus)(nil),                       // 70: flightpath.EstimatorStatus
+	(*EstimatorStatusFlags)(nil),                  // 71: flightpath.EstimatorStatusFlags
+	(*Vibration)(nil),                             // 72: flightpath.Vibration
+	(*SubscribeWindRequest)(nil),                  // 73: flightpath.SubscribeWindRequest
+	(*SubscribeWindResponse)(nil),                 // 74: flightpath.SubscribeWindResponse
+	(*Wind)(nil),                                  // 75: flightpath.Wind
+	(*WindCov)(nil),                               // 76: flightpath.WindCov
+	(*SubscribeDistanceSensorRequest)(nil),        // 77: flightpath.SubscribeDistanceSensorRequest
+	(*SubscribeDistanceSensorResponse)(nil),       // 78: flightpath.SubscribeDistanceSensorResponse
+	(*DistanceSensor)(nil),                        // 79: flightpath.DistanceSensor
+	(*SubscribeObstacleDistanceRequest)(nil),      // 80: flightpath.SubscribeObstacleDistanceRequest
+	(*SubscribeObstacleDistanceResponse)(nil),     // 81: flightpath.SubscribeObstacleDistanceResponse
+	(*ObstacleSector)(nil),                        // 82: flightpath.ObstacleSector
+	(*ObstacleDistance)(nil),                      // 83: flightpath.ObstacleDistance
+	(*SubscribeGpsReceiversRequest)(nil),          // 84: flightpath.SubscribeGpsReceiversRequest
+	(*SubscribeGpsReceiversResponse)(nil),         // 85: flightpath.SubscribeGpsReceiversResponse
+	(*GpsReceiver)(nil),                           // 86: flightpath.GpsReceiver
+	(*Gps2Raw)(nil),                               // 87: flightpath.Gps2Raw
+	(*SubscribeGpsStatusRequest)(nil),             // 88: flightpath.SubscribeGpsStatusRequest
+	(*SubscribeGpsStatusResponse)(nil),            // 89: flightpath.SubscribeGpsStatusResponse
+	(*GpsSatellite)(nil),                          // 90: flightpath.GpsSatellite
+	(*GpsStatus)(nil),                             // 91: flightpath.GpsStatus
+	(*SubscribeGpsRtkRequest)(nil),                // 92: flightpath.SubscribeGpsRtkRequest
+	(*SubscribeGpsRtkResponse)(nil),               // 93: flightpath.SubscribeGpsRtkResponse
+	(*RtkBaseline)(nil),                           // 94: flightpath.RtkBaseline
+	(*GpsRtk)(nil),                                // 95: flightpath.GpsRtk
+	(*SubscribeTrafficRequest)(nil),               // 96: flightpath.SubscribeTrafficRequest
+	(*SubscribeTrafficResponse)(nil),              // 97: flightpath.SubscribeTrafficResponse
+	(*TrafficTrack)(nil),                          // 98: flightpath.TrafficTrack
+	(*TrafficSeparation)(nil),                     // 99: flightpath.TrafficSeparation
+	(*AdsbVehicle)(nil),                           // 100: flightpath.AdsbVehicle
+	(*SubscribeVehicleStateRequest)(nil),          // 101: flightpath.SubscribeVehicleStateRequest
+	(*SubscribeVehicleStateResponse)(nil),         // 102: flightpath.SubscribeVehicleStateResponse
+	(*VehicleState)(nil),                          // 103: flightpath.VehicleState
+	(*VfrHud)(nil),                                // 104: flightpath.VfrHud
+	(*GlobalPositionInt)(nil),                     // 105: flightpath.GlobalPositionInt
+	(*SubscribeLandedStateRequest)(nil),           // 106: flightpath.SubscribeLandedStateRequest
+	(*SubscribeLandedStateResponse)(nil),          // 107: flightpath.SubscribeLandedStateResponse
+	(*ExtendedSysState)(nil),                      // 108: flightpath.ExtendedSysState
+	(*Heartbeat)(nil),                             // 109: flightpath.Heartbeat
+	(*CustomMode)(nil),                            // 110: flightpath.CustomMode
 }
 var file_flightpath_telemetry_proto_depIdxs = []int32{
-	3, // 0: flightpath.SubscribeRawGpsResponse.gps_raw_int:type_name -> flightpath.GpsRawInt
-	0, // 1: flightpath.GpsRawInt.fix_type:type_name -> flightpath.GpsFixType
-	1, // 2: flightpath.TelemetryService.SubscribeRawGps:input_type -> flightpath.SubscribeRawGpsRequest
-	2, // 3: flightpath.TelemetryService.SubscribeRawGps:output_type -> flightpath.SubscribeRawGpsResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	18,  // 0: flightpath.SubscribeRawGpsResponse.gps_raw_int:type_name -> flightpath.GpsRawInt
+	3,   // 1: flightpath.GpsRawInt.fix_type:type_name -> flightpath.GpsFixType
+	21,  // 2: flightpath.SubscribeOrbitExecutionStatusResponse.orbit_execution_status:type_name -> flightpath.OrbitExecutionStatus
+	14,  // 3: flightpath.OrbitExecutionStatus.frame:type_name -> flightpath.MavFrame
+	24,  // 4: flightpath.SubscribeAttitudeResponse.attitude:type_name -> flightpath.Attitude
+	25,  // 5: flightpath.SubscribeAttitudeResponse.attitude_quaternion:type_name -> flightpath.AttitudeQuaternion
+	28,  // 6: flightpath.SubscribePositionResponse.position:type_name -> flightpath.Position
+	105, // 7: flightpath.SubscribePositionResponse.global_position_int:type_name -> flightpath.GlobalPositionInt
+	31,  // 8: flightpath.SubscribeLocalPositionResponse.local_position_ned:type_name -> flightpath.LocalPositionNed
+	32,  // 9: flightpath.SubscribeLocalPositionResponse.odometry:type_name -> flightpath.Odometry
+	14,  // 10: flightpath.Odometry.frame_id:type_name -> flightpath.MavFrame
+	14,  // 11: flightpath.Odometry.child_frame_id:type_name -> flightpath.MavFrame
+	33,  // 12: flightpath.Odometry.pose_covariance:type_name -> flightpath.Covariance
+	33,  // 13: flightpath.Odometry.velocity_covariance:type_name -> flightpath.Covariance
+	13,  // 14: flightpath.Odometry.estimator_type:type_name -> flightpath.MavEstimatorType
+	36,  // 15: flightpath.SubscribeBatteryResponse.battery:type_name -> flightpath.Battery
+	0,   // 16: flightpath.Battery.source:type_name -> flightpath.BatterySource
+	9,   // 17: flightpath.Battery.function:type_name -> flightpath.MavBatteryFunction
+	11,  // 18: flightpath.Battery.type:type_name -> flightpath.MavBatteryType
+	8,   // 19: flightpath.Battery.charge_state:type_name -> flightpath.MavBatteryChargeState
+	10,  // 20: flightpath.Battery.mode:type_name -> flightpath.MavBatteryMode
+	37,  // 21: flightpath.Battery.faults:type_name -> flightpath.BatteryFaults
+	9,   // 22: flightpath.BatteryStatus.battery_function:type_name -> flightpath.MavBatteryFunction
+	11,  // 23: flightpath.BatteryStatus.type:type_name -> flightpath.MavBatteryType
+	8,   // 24: flightpath.BatteryStatus.charge_state:type_name -> flightpath.MavBatteryChargeState
+	10,  // 25: flightpath.BatteryStatus.mode:type_name -> flightpath.MavBatteryMode
+	41,  // 26: flightpath.SubscribeSystemStatusResponse.system_status:type_name -> flightpath.SystemStatus
+	44,  // 27: flightpath.SubscribeSystemStatusResponse.sys_status:type_name -> flightpath.SysStatus
+	43,  // 28: flightpath.SystemStatus.sensors:type_name -> flightpath.SysStatusSensors
+	42,  // 29: flightpath.SysStatusSensors.gyro:type_name -> flightpath.SensorStatus
+	42,  // 30: flightpath.SysStatusSensors.accel:type_name -> flightpath.SensorStatus
+	42,  // 31: flightpath.SysStatusSensors.mag:type_name -> flightpath.SensorStatus
+	42,  // 32: flightpath.SysStatusSensors.absolute_pressure:type_name -> flightpath.SensorStatus
+	42,  // 33: flightpath.SysStatusSensors.differential_pressure:type_name -> flightpath.SensorStatus
+	42,  // 34: flightpath.SysStatusSensors.gps:type_name -> flightpath.SensorStatus
+	42,  // 35: flightpath.SysStatusSensors.optical_flow:type_name -> flightpath.SensorStatus
+	42,  // 36: flightpath.SysStatusSensors.vision_position:type_name -> flightpath.SensorStatus
+	42,  // 37: flightpath.SysStatusSensors.laser_position:type_name -> flightpath.SensorStatus
+	42,  // 38: flightpath.SysStatusSensors.external_ground_truth:type_name -> flightpath.SensorStatus
+	42,  // 39: flightpath.SysStatusSensors.angular_rate_control:type_name -> flightpath.SensorStatus
+	42,  // 40: flightpath.SysStatusSensors.attitude_stabilization:type_name -> flightpath.SensorStatus
+	42,  // 41: flightpath.SysStatusSensors.yaw_position:type_name -> flightpath.SensorStatus
+	42,  // 42: flightpath.SysStatusSensors.z_altitude_control:type_name -> flightpath.SensorStatus
+	42,  // 43: flightpath.SysStatusSensors.xy_position_control:type_name -> flightpath.SensorStatus
+	42,  // 44: flightpath.SysStatusSensors.motor_outputs:type_name -> flightpath.SensorStatus
+	42,  // 45: flightpath.SysStatusSensors.rc_receiver:type_name -> flightpath.SensorStatus
+	42,  // 46: flightpath.SysStatusSensors.gyro2:type_name -> flightpath.SensorStatus
+	42,  // 47: flightpath.SysStatusSensors.accel2:type_name -> flightpath.SensorStatus
+	42,  // 48: flightpath.SysStatusSensors.mag2:type_name -> flightpath.SensorStatus
+	42,  // 49: flightpath.SysStatusSensors.geofence:type_name -> flightpath.SensorStatus
+	42,  // 50: flightpath.SysStatusSensors.ahrs:type_name -> flightpath.SensorStatus
+	42,  // 51: flightpath.SysStatusSensors.terrain:type_name -> flightpath.SensorStatus
+	42,  // 52: flightpath.SysStatusSensors.reverse_motor:type_name -> flightpath.SensorStatus
+	42,  // 53: flightpath.SysStatusSensors.logging:type_name -> flightpath.SensorStatus
+	42,  // 54: flightpath.SysStatusSensors.battery:type_name -> flightpath.SensorStatus
+	42,  // 55: flightpath.SysStatusSensors.proximity:type_name -> flightpath.SensorStatus
+	42,  // 56: flightpath.SysStatusSensors.satcom:type_name -> flightpath.SensorStatus
+	42,  // 57: flightpath.SysStatusSensors.prearm_check:type_name -> flightpath.SensorStatus
+	42,  // 58: flightpath.SysStatusSensors.obstacle_avoidance:type_name -> flightpath.SensorStatus
+	42,  // 59: flightpath.SysStatusSensors.propulsion:type_name -> flightpath.SensorStatus
+	42,  // 60: flightpath.SysStatusSensors.recovery_system:type_name -> flightpath.SensorStatus
+	104, // 61: flightpath.SubscribeFlightMetricsResponse.vfr_hud:type_name -> flightpath.VfrHud
+	5,   // 62: flightpath.SubscribeStatusTextRequest.min_severity:type_name -> flightpath.MavSeverity
+	5,   // 63: flightpath.SubscribeStatusTextResponse.severity:type_name -> flightpath.MavSeverity
+	5,   // 64: flightpath.StatusText.severity:type_name -> flightpath.MavSeverity
+	54,  // 65: flightpath.GetHomePositionResponse.location:type_name -> flightpath.HomeLocation
+	55,  // 66: flightpath.GetHomePositionResponse.home_position:type_name -> flightpath.HomePosition
+	54,  // 67: flightpath.SubscribeHomePositionResponse.location:type_name -> flightpath.HomeLocation
+	55,  // 68: flightpath.SubscribeHomePositionResponse.home_position:type_name -> flightpath.HomePosition
+	58,  // 69: flightpath.SubscribeRcChannelsResponse.rc_input:type_name -> flightpath.RcInput
+	62,  // 70: flightpath.RcInput.channels:type_name -> flightpath.ChannelValue
+	61,  // 71: flightpath.SubscribeActuatorOutputsResponse.servo_outputs:type_name -> flightpath.ServoOutputs
+	63,  // 72: flightpath.SubscribeActuatorOutputsResponse.actuator_outputs:type_name -> flightpath.ActuatorOutputs
+	62,  // 73: flightpath.ServoOutputs.channels:type_name -> flightpath.ChannelValue
+	64,  // 74: flightpath.ActuatorOutputs.actuators:type_name -> flightpath.ActuatorValue
+	70,  // 75: flightpath.SubscribeEstimatorStatusResponse.estimator_status:type_name -> flightpath.EstimatorStatus
+	72,  // 76: flightpath.SubscribeEstimatorStatusResponse.vibration:type_name -> flightpath.Vibration
+	71,  // 77: flightpath.EstimatorStatus.flags:type_name -> flightpath.EstimatorStatusFlags
+	75,  // 78: flightpath.SubscribeWindResponse.wind:type_name -> flightpath.Wind
+	76,  // 79: flightpath.SubscribeWindResponse.wind_cov:type_name -> flightpath.WindCov
+	79,  // 80: flightpath.SubscribeDistanceSensorResponse.distance_sensor:type_name -> flightpath.DistanceSensor
+	12,  // 81: flightpath.DistanceSensor.type:type_name -> flightpath.MavDistanceSensor
+	4,   // 82: flightpath.DistanceSensor.orientation:type_name -> flightpath.MavSensorOrientation
+	82,  // 83: flightpath.SubscribeObstacleDistanceResponse.sectors:type_name -> flightpath.ObstacleSector
+	83,  // 84: flightpath.SubscribeObstacleDistanceResponse.obstacle_distance:type_name -> flightpath.ObstacleDistance
+	12,  // 85: flightpath.ObstacleDistance.sensor_type:type_name -> flightpath.MavDistanceSensor
+	14,  // 86: flightpath.ObstacleDistance.frame:type_name -> flightpath.MavFrame
+	86,  // 87: flightpath.SubscribeGpsReceiversResponse.receiver:type_name -> flightpath.GpsReceiver
+	18,  // 88: flightpath.SubscribeGpsReceiversResponse.gps_raw_int:type_name -> flightpath.GpsRawInt
+	87,  // 89: flightpath.SubscribeGpsReceiversResponse.gps2_raw:type_name -> flightpath.Gps2Raw
+	3,   // 90: flightpath.GpsReceiver.fix_type:type_name -> flightpath.GpsFixType
+	3,   // 91: flightpath.Gps2Raw.fix_type:type_name -> flightpath.GpsFixType
+	90,  // 92: flightpath.SubscribeGpsStatusResponse.satellites:type_name -> flightpath.GpsSatellite
+	91,  // 93: flightpath.SubscribeGpsStatusResponse.gps_status:type_name -> flightpath.GpsStatus
+	94,  // 94: flightpath.SubscribeGpsRtkResponse.baseline:type_name -> flightpath.RtkBaseline
+	95,  // 95: flightpath.SubscribeGpsRtkResponse.gps_rtk:type_name -> flightpath.GpsRtk
+	15,  // 96: flightpath.RtkBaseline.coordinate_system:type_name -> flightpath.RtkBaselineCoordinateSystem
+	15,  // 97: flightpath.GpsRtk.baseline_coords_type:type_name -> flightpath.RtkBaselineCoordinateSystem
+	98,  // 98: flightpath.SubscribeTrafficResponse.track:type_name -> flightpath.TrafficTrack
+	99,  // 99: flightpath.SubscribeTrafficResponse.separation:type_name -> flightpath.TrafficSeparation
+	100, // 100: flightpath.SubscribeTrafficResponse.adsb_vehicle:type_name -> flightpath.AdsbVehicle
+	2,   // 101: flightpath.TrafficTrack.emitter_type:type_name -> flightpath.AdsbEmitterType
+	1,   // 102: flightpath.TrafficTrack.altitude_type:type_name -> flightpath.AdsbAltitudeType
+	1,   // 103: flightpath.AdsbVehicle.altitude_type:type_name -> flightpath.AdsbAltitudeType
+	2,   // 104: flightpath.AdsbVehicle.emitter_type:type_name -> flightpath.AdsbEmitterType
+	103, // 105: flightpath.SubscribeVehicleStateResponse.vehicle_state:type_name -> flightpath.VehicleState
+	109, // 106: flightpath.VehicleState.heartbeat:type_name -> flightpath.Heartbeat
+	110, // 107: flightpath.VehicleState.flight_mode:type_name -> flightpath.CustomMode
+	28,  // 108: flightpath.VehicleState.position:type_name -> flightpath.Position
+	24,  // 109: flightpath.VehicleState.attitude:type_name -> flightpath.Attitude
+	36,  // 110: flightpath.VehicleState.batteries:type_name -> flightpath.Battery
+	86,  // 111: flightpath.VehicleState.gps:type_name -> flightpath.GpsReceiver
+	7,   // 112: flightpath.VehicleState.landed_state:type_name -> flightpath.MavLandedState
+	108, // 113: flightpath.SubscribeLandedStateResponse.extended_sys_state:type_name -> flightpath.ExtendedSysState
+	6,   // 114: flightpath.ExtendedSysState.vtol_state:type_name -> flightpath.MavVtolState
+	7,   // 115: flightpath.ExtendedSysState.landed_state:type_name -> flightpath.MavLandedState
+	16,  // 116: flightpath.TelemetryService.SubscribeRawGps:input_type -> flightpath.SubscribeRawGpsRequest
+	19,  // 117: flightpath.TelemetryService.SubscribeOrbitExecutionStatus:input_type -> flightpath.SubscribeOrbitExecutionStatusRequest
+	22,  // 118: flightpath.TelemetryService.SubscribeAttitude:input_type -> flightpath.SubscribeAttitudeRequest
+	26,  // 119: flightpath.TelemetryService.SubscribePosition:input_type -> flightpath.SubscribePositionRequest
+	29,  // 120: flightpath.TelemetryService.SubscribeLocalPosition:input_type -> flightpath.SubscribeLocalPositionRequest
+	34,  // 121: flightpath.TelemetryService.SubscribeBattery:input_type -> flightpath.SubscribeBatteryRequest
+	45,  // 122: flightpath.TelemetryService.SubscribeFlightMetrics:input_type -> flightpath.SubscribeFlightMetricsRequest
+	106, // 123: flightpath.TelemetryService.SubscribeLandedState:input_type -> flightpath.SubscribeLandedStateRequest
+	47,  // 124: flightpath.TelemetryService.SubscribeStatusText:input_type -> flightpath.SubscribeStatusTextRequest
+	50,  // 125: flightpath.TelemetryService.GetHomePosition:input_type -> flightpath.GetHomePositionRequest
+	52,  // 126: flightpath.TelemetryService.SubscribeHomePosition:input_type -> flightpath.SubscribeHomePositionRequest
+	56,  // 127: flightpath.TelemetryService.SubscribeRcChannels:input_type -> flightpath.SubscribeRcChannelsRequest
+	59,  // 128: flightpath.TelemetryService.SubscribeActuatorOutputs:input_type -> flightpath.SubscribeActuatorOutputsRequest
+	68,  // 129: flightpath.TelemetryService.SubscribeEstimatorStatus:input_type -> flightpath.SubscribeEstimatorStatusRequest
+	73,  // 130: flightpath.TelemetryService.SubscribeWind:input_type -> flightpath.SubscribeWindRequest
+	77,  // 131: flightpath.TelemetryService.SubscribeDistanceSensor:input_type -> flightpath.SubscribeDistanceSensorRequest
+	80,  // 132: flightpath.TelemetryService.SubscribeObstacleDistance:input_type -> flightpath.SubscribeObstacleDistanceRequest
+	84,  // 133: flightpath.TelemetryService.SubscribeGpsReceivers:input_type -> flightpath.SubscribeGpsReceiversRequest
+	88,  // 134: flightpath.TelemetryService.SubscribeGpsStatus:input_type -> flightpath.SubscribeGpsStatusRequest
+	92,  // 135: flightpath.TelemetryService.SubscribeGpsRtk:input_type -> flightpath.SubscribeGpsRtkRequest
+	39,  // 136: flightpath.TelemetryService.SubscribeSystemStatus:input_type -> flightpath.SubscribeSystemStatusRequest
+	96,  // 137: flightpath.TelemetryService.SubscribeTraffic:input_type -> flightpath.SubscribeTrafficRequest
+	101, // 138: flightpath.TelemetryService.SubscribeVehicleState:input_type -> flightpath.SubscribeVehicleStateRequest
+	17,  // 139: flightpath.TelemetryService.SubscribeRawGps:output_type -> flightpath.SubscribeRawGpsResponse
+	20,  // 140: flightpath.TelemetryService.SubscribeOrbitExecutionStatus:output_type -> flightpath.SubscribeOrbitExecutionStatusResponse
+	23,  // 141: flightpath.TelemetryService.SubscribeAttitude:output_type -> flightpath.SubscribeAttitudeResponse
+	27,  // 142: flightpath.TelemetryService.SubscribePosition:output_type -> flightpath.SubscribePositionResponse
+	30,  // 143: flightpath.TelemetryService.SubscribeLocalPosition:output_type -> flightpath.SubscribeLocalPositionResponse
+	35,  // 144: flightpath.TelemetryService.SubscribeBattery:output_type -> flightpath.SubscribeBatteryResponse
+	46,  // 145: flightpath.TelemetryService.SubscribeFlightMetrics:output_type -> flightpath.SubscribeFlightMetricsResponse
+	107, // 146: flightpath.TelemetryService.SubscribeLandedState:output_type -> flightpath.SubscribeLandedStateResponse
+	48,  // 147: flightpath.TelemetryService.SubscribeStatusText:output_type -> flightpath.SubscribeStatusTextResponse
+	51,  // 148: flightpath.TelemetryService.GetHomePosition:output_type -> flightpath.GetHomePositionResponse
+	53,  // 149: flightpath.TelemetryService.SubscribeHomePosition:output_type -> flightpath.SubscribeHomePositionResponse
+	57,  // 150: flightpath.TelemetryService.SubscribeRcChannels:output_type -> flightpath.SubscribeRcChannelsResponse
+	60,  // 151: flightpath.TelemetryService.SubscribeActuatorOutputs:output_type -> flightpath.SubscribeActuatorOutputsResponse
+	69,  // 152: flightpath.TelemetryService.SubscribeEstimatorStatus:output_type -> flightpath.SubscribeEstimatorStatusResponse
+	74,  // 153: flightpath.TelemetryService.SubscribeWind:output_type -> flightpath.SubscribeWindResponse
+	78,  // 154: flightpath.TelemetryService.SubscribeDistanceSensor:output_type -> flightpath.SubscribeDistanceSensorResponse
+	81,  // 155: flightpath.TelemetryService.SubscribeObstacleDistance:output_type -> flightpath.SubscribeObstacleDistanceResponse
+	85,  // 156: flightpath.TelemetryService.SubscribeGpsReceivers:output_type -> flightpath.SubscribeGpsReceiversResponse
+	89,  // 157: flightpath.TelemetryService.SubscribeGpsStatus:output_type -> flightpath.SubscribeGpsStatusResponse
+	93,  // 158: flightpath.TelemetryService.SubscribeGpsRtk:output_type -> flightpath.SubscribeGpsRtkResponse
+	40,  // 159: flightpath.TelemetryService.SubscribeSystemStatus:output_type -> flightpath.SubscribeSystemStatusResponse
+	97,  // 160: flightpath.TelemetryService.SubscribeTraffic:output_type -> flightpath.SubscribeTrafficResponse
+	102, // 161: flightpath.TelemetryService.SubscribeVehicleState:output_type -> flightpath.SubscribeVehicleStateResponse
+	139, // [139:162] is the sub-list for method output_type
+	116, // [116:139] is the sub-list for method input_type
+	116, // [116:116] is the sub-list for extension type_name
+	116, // [116:116] is the sub-list for extension extendee
+	0,   // [0:116] is the sub-list for field type_name
 }
 
 func init() { file_flightpath_telemetry_proto_init() }
@@ -472,13 +10568,22 @@ func file_flightpath_telemetry_proto_init() {
 	if File_flightpath_telemetry_proto != nil {
 		return
 	}
+	file_flightpath_connection_proto_init()
+	file_flightpath_telemetry_proto_msgTypes[12].OneofWrappers = []any{}
+	file_flightpath_telemetry_proto_msgTypes[20].OneofWrappers = []any{}
+	file_flightpath_telemetry_proto_msgTypes[42].OneofWrappers = []any{}
+	file_flightpath_telemetry_proto_msgTypes[59].OneofWrappers = []any{}
+	file_flightpath_telemetry_proto_msgTypes[66].OneofWrappers = []any{}
+	file_flightpath_telemetry_proto_msgTypes[70].OneofWrappers = []any{}
+	file_flightpath_telemetry_proto_msgTypes[82].OneofWrappers = []any{}
+	file_flightpath_telemetry_proto_msgTypes[83].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_flightpath_telemetry_proto_rawDesc), len(file_flightpath_telemetry_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      16,
+			NumMessages:   93,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
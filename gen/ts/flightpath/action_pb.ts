@@ -0,0 +1,1837 @@
+// @generated by protoc-gen-es v2.10.2 with parameter "target=ts,import_extension=.js"
+// @generated from file flightpath/action.proto (package flightpath, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { CustomMode, MainMode, SubMode } from "./connection_pb.js";
+import { file_flightpath_connection } from "./connection_pb.js";
+import type { MavFrame } from "./telemetry_pb.js";
+import { file_flightpath_telemetry } from "./telemetry_pb.js";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file flightpath/action.proto.
+ */
+export const file_flightpath_action: GenFile = /*@__PURE__*/
+  fileDesc("ChdmbGlnaHRwYXRoL2FjdGlvbi5wcm90bxIKZmxpZ2h0cGF0aCI1CgpBcm1SZXF1ZXN0EhEKCXN5c3RlbV9pZBgBIAEoDRIUCgxjb21wb25lbnRfaWQYAiABKA0iDQoLQXJtUmVzcG9uc2UiRwoNRGlzYXJtUmVxdWVzdBIRCglzeXN0ZW1faWQYASABKA0SFAoMY29tcG9uZW50X2lkGAIgASgNEg0KBWZvcmNlGAMgASgIIhAKDkRpc2FybVJlc3BvbnNlIksKDlRha2VvZmZSZXF1ZXN0EhEKCXN5c3RlbV9pZBgBIAEoDRIUCgxjb21wb25lbnRfaWQYAiABKA0SEAoIYWx0aXR1ZGUYAyABKAIiagoPVGFrZW9mZlJlc3BvbnNlEhQKDHRpbWVzdGFtcF9tcxgBIAEoAxImCgVwaGFzZRgCIAEoDjIXLmZsaWdodHBhdGguRmxpZ2h0UGhhc2USGQoRcmVsYXRpdmVfYWx0aXR1ZGUYAyABKAIiNgoLTGFuZFJlcXVlc3QSEQoJc3lzdGVtX2lkGAEgASgNEhQKDGNvbXBvbmVudF9pZBgCIAEoDSJnCgxMYW5kUmVzcG9uc2USFAoMdGltZXN0YW1wX21zGAEgASgDEiYKBXBoYXNlGAIgASgOMhcuZmxpZ2h0cGF0aC5GbGlnaHRQaGFzZRIZChFyZWxhdGl2ZV9hbHRpdHVkZRgDIAEoAiJAChVSZXR1cm5Ub0xhdW5jaFJlcXVlc3QSEQoJc3lzdGVtX2lkGAEgASgNEhQKDGNvbXBvbmVudF9pZBgCIAEoDSJxChZSZXR1cm5Ub0xhdW5jaFJlc3BvbnNlEhQKDHRpbWVzdGFtcF9tcxgBIAEoAxImCgVwaGFzZRgCIAEoDjIXLmZsaWdodHBhdGguRmxpZ2h0UGhhc2USGQoRcmVsYXRpdmVfYWx0aXR1ZGUYAyABKAIijwEKFFNldEZsaWdodE1vZGVSZXF1ZXN0EhEKCXN5c3RlbV9pZBgBIAEoDRIUCgxjb21wb25lbnRfaWQYAiABKA0SJwoJbWFpbl9tb2RlGAMgASgOMhQuZmxpZ2h0cGF0aC5NYWluTW9kZRIlCghzdWJfbW9kZRgEIAEoDjITLmZsaWdodHBhdGguU3ViTW9kZSJEChVTZXRGbGlnaHRNb2RlUmVzcG9uc2USKwoLY3VzdG9tX21vZGUYASABKAsyFi5mbGlnaHRwYXRoLkN1c3RvbU1vZGUiwAEKE0dvdG9Mb2NhdGlvblJlcXVlc3QSEQoJc3lzdGVtX2lkGAEgASgNEhQKDGNvbXBvbmVudF9pZBgCIAEoDRIQCghsYXRpdHVkZRgDIAEoARIRCglsb25naXR1ZGUYBCABKAESEAoIYWx0aXR1ZGUYBSABKAISFAoMZ3JvdW5kX3NwZWVkGAYgASgCEhAKA3lhdxgHIAEoAkgAiAEBEhkKEWFjY2VwdGFuY2VfcmFkaXVzGAggASgCQgYKBF95YXciqwEKFEdvdG9Mb2NhdGlvblJlc3BvbnNlEhQKDHRpbWVzdGFtcF9tcxgBIAEoAxIQCghkaXN0YW5jZRgCIAEoAhIbChNob3Jpem9udGFsX2Rpc3RhbmNlGAMgASgCEhkKEXZlcnRpY2FsX2Rpc3RhbmNlGAQgASgCEhUKDWNsb3Npbmdfc3BlZWQYBSABKAISCwoDZXRhGAYgASgCEg8KB2Fycml2ZWQYByABKAgihAIKDkRvT3JiaXRSZXF1ZXN0EhEKCXN5c3RlbV9pZBgBIAEoDRIUCgxjb21wb25lbnRfaWQYAiABKA0SEAoIbGF0aXR1ZGUYAyABKAESEQoJbG9uZ2l0dWRlGAQgASgBEhUKCGFsdGl0dWRlGAUgASgCSACIAQESDgoGcmFkaXVzGAYgASgCEhkKEWNvdW50ZXJfY2xvY2t3aXNlGAcgASgIEhAKCHZlbG9jaXR5GAggASgCEjQKDXlhd19iZWhhdmlvdXIYCSABKA4yHS5mbGlnaHRwYXRoLk9yYml0WWF3QmVoYXZpb3VyEg0KBXR1cm5zGAogASgCQgsKCV9hbHRpdHVkZSIRCg9Eb09yYml0UmVzcG9uc2UiQQoWUmVib290QXV0b3BpbG90UmVxdWVzdBIRCglzeXN0ZW1faWQYASABKA0SFAoMY29tcG9uZW50X2lkGAIgASgNIl8KF1JlYm9vdEF1dG9waWxvdFJlc3BvbnNlEhQKDHRpbWVzdGFtcF9tcxgBIAEoAxIuCgVwaGFzZRgCIAEoDjIfLmZsaWdodHBhdGguUmVib290U2h1dGRvd25QaGFzZSJDChhTaHV0ZG93bkF1dG9waWxvdFJlcXVlc3QSEQoJc3lzdGVtX2lkGAEgASgNEhQKDGNvbXBvbmVudF9pZBgCIAEoDSJhChlTaHV0ZG93bkF1dG9waWxvdFJlc3BvbnNlEhQKDHRpbWVzdGFtcF9tcxgBIAEoAxIuCgVwaGFzZRgCIAEoDjIfLmZsaWdodHBhdGguUmVib290U2h1dGRvd25QaGFzZSKSAgoSU2VuZENvbW1hbmRSZXF1ZXN0EhEKCXN5c3RlbV9pZBgBIAEoDRIUCgxjb21wb25lbnRfaWQYAiABKA0SDwoHY29tbWFuZBgDIAEoDRIOCgZwYXJhbTEYBCABKAISDgoGcGFyYW0yGAUgASgCEg4KBnBhcmFtMxgGIAEoAhIOCgZwYXJhbTQYByABKAISDgoGcGFyYW01GAggASgCEg4KBnBhcmFtNhgJIAEoAhIOCgZwYXJhbTcYCiABKAISFwoPdXNlX2NvbW1hbmRfaW50GAsgASgIEiMKBWZyYW1lGAwgASgOMhQuZmxpZ2h0cGF0aC5NYXZGcmFtZRIJCgF4GA0gASgFEgkKAXkYDiABKAUiQgoTU2VuZENvbW1hbmRSZXNwb25zZRIrCgtjb21tYW5kX2FjaxgBIAEoCzIWLmZsaWdodHBhdGguQ29tbWFuZEFjayKeAQoKQ29tbWFuZEFjaxIPCgdjb21tYW5kGAEgASgNEiUKBnJlc3VsdBgCIAEoDjIVLmZsaWdodHBhdGguTWF2UmVzdWx0EhAKCHByb2dyZXNzGAMgASgNEhUKDXJlc3VsdF9wYXJhbTIYBCABKAUSFQoNdGFyZ2V0X3N5c3RlbRgFIAEoDRIYChB0YXJnZXRfY29tcG9uZW50GAYgASgNIpYBChJDaGFuZ2VTcGVlZFJlcXVlc3QSEQoJc3lzdGVtX2lkGAEgASgNEhQKDGNvbXBvbmVudF9pZBgCIAEoDRIpCgpzcGVlZF90eXBlGAMgASgOMhUuZmxpZ2h0cGF0aC5TcGVlZFR5cGUSDQoFc3BlZWQYBCABKAISHQoVd2FpdF9mb3JfY29uZmlybWF0aW9uGAUgASgIIm0KE0NoYW5nZVNwZWVkUmVzcG9uc2USKwoLY29tbWFuZF9hY2sYASABKAsyFi5mbGlnaHRwYXRoLkNvbW1hbmRBY2sSEQoJY29uZmlybWVkGAIgASgIEhYKDm9ic2VydmVkX3NwZWVkGAMgASgCIrwBCg1TZXRZYXdSZXF1ZXN0EhEKCXN5c3RlbV9pZBgBIAEoDRIUCgxjb21wb25lbnRfaWQYAiABKA0SDQoFYW5nbGUYAyABKAISFQoNYW5ndWxhcl9zcGVlZBgEIAEoAhIrCglkaXJlY3Rpb24YBSABKA4yGC5mbGlnaHRwYXRoLllhd0RpcmVjdGlvbhIQCghyZWxhdGl2ZRgGIAEoCBIdChV3YWl0X2Zvcl9jb25maXJtYXRpb24YByABKAgiagoOU2V0WWF3UmVzcG9uc2USKwoLY29tbWFuZF9hY2sYASABKAsyFi5mbGlnaHRwYXRoLkNvbW1hbmRBY2sSEQoJY29uZmlybWVkGAIgASgIEhgKEG9ic2VydmVkX2hlYWRpbmcYAyABKAIikQEKFVNldFJvaUxvY2F0aW9uUmVxdWVzdBIRCglzeXN0ZW1faWQYASABKA0SFAoMY29tcG9uZW50X2lkGAIgASgNEhAKCGxhdGl0dWRlGAMgASgBEhEKCWxvbmdpdHVkZRgEIAEoARIQCghhbHRpdHVkZRgFIAEoAhIYChBnaW1iYWxfZGV2aWNlX2lkGAYgASgNIkUKFlNldFJvaUxvY2F0aW9uUmVzcG9uc2USKwoLY29tbWFuZF9hY2sYASABKAsyFi5mbGlnaHRwYXRoLkNvbW1hbmRBY2siVAoPQ2xlYXJSb2lSZXF1ZXN0EhEKCXN5c3RlbV9pZBgBIAEoDRIUCgxjb21wb25lbnRfaWQYAiABKA0SGAoQZ2ltYmFsX2RldmljZV9pZBgDIAEoDSI/ChBDbGVhclJvaVJlc3BvbnNlEisKC2NvbW1hbmRfYWNrGAEgASgLMhYuZmxpZ2h0cGF0aC5Db21tYW5kQWNrIpYBChZTZXRIb21lUG9zaXRpb25SZXF1ZXN0EhEKCXN5c3RlbV9pZBgBIAEoDRIUCgxjb21wb25lbnRfaWQYAiABKA0SHAoUdXNlX2N1cnJlbnRfbG9jYXRpb24YAyABKAgSEAoIbGF0aXR1ZGUYBCABKAESEQoJbG9uZ2l0dWRlGAUgASgBEhAKCGFsdGl0dWRlGAYgASgCIkYKF1NldEhvbWVQb3NpdGlvblJlc3BvbnNlEisKC2NvbW1hbmRfYWNrGAEgASgLMhYuZmxpZ2h0cGF0aC5Db21tYW5kQWNrKsECChFPcmJpdFlhd0JlaGF2aW91chIjCh9PUkJJVF9ZQVdfQkVIQVZJT1VSX1VOU1BFQ0lGSUVEEAASMwovT1JCSVRfWUFXX0JFSEFWSU9VUl9IT0xEX0ZST05UX1RPX0NJUkNMRV9DRU5URVIQARIsCihPUkJJVF9ZQVdfQkVIQVZJT1VSX0hPTERfSU5JVElBTF9IRUFESU5HEAISJAogT1JCSVRfWUFXX0JFSEFWSU9VUl9VTkNPTlRST0xMRUQQAxI0CjBPUkJJVF9ZQVdfQkVIQVZJT1VSX0hPTERfRlJPTlRfVEFOR0VOVF9UT19DSVJDTEUQBBIlCiFPUkJJVF9ZQVdfQkVIQVZJT1VSX1JDX0NPTlRST0xMRUQQBRIhCh1PUkJJVF9ZQVdfQkVIQVZJT1VSX1VOQ0hBTkdFRBAGKrgBChNSZWJvb3RTaHV0ZG93blBoYXNlEiUKIVJFQk9PVF9TSFVURE9XTl9QSEFTRV9VTlNQRUNJRklFRBAAEiIKHlJFQk9PVF9TSFVURE9XTl9QSEFTRV9BQ0NFUFRFRBABEigKJFJFQk9PVF9TSFVURE9XTl9QSEFTRV9IRUFSVEJFQVRfTE9TVBACEiwKKFJFQk9PVF9TSFVURE9XTl9QSEFTRV9IRUFSVEJFQVRfUkVTVE9SRUQQAyr1AgoJTWF2UmVzdWx0EhoKFk1BVl9SRVNVTFRfVU5TUEVDSUZJRUQQABIXChNNQVZfUkVTVUxUX0FDQ0VQVEVEEAESIwofTUFWX1JFU1VMVF9URU1QT1JBUklMWV9SRUpFQ1RFRBACEhUKEU1BVl9SRVNVTFRfREVOSUVEEAMSGgoWTUFWX1JFU1VMVF9VTlNVUFBPUlRFRBAEEhUKEU1BVl9SRVNVTFRfRkFJTEVEEAUSGgoWTUFWX1JFU1VMVF9JTl9QUk9HUkVTUxAGEhgKFE1BVl9SRVNVTFRfQ0FOQ0VMTEVEEAcSIAocTUFWX1JFU1VMVF9DT01NQU5EX0xPTkdfT05MWRAIEh8KG01BVl9SRVNVTFRfQ09NTUFORF9JTlRfT05MWRAJEiwKKE1BVl9SRVNVTFRfQ09NTUFORF9VTlNVUFBPUlRFRF9NQVZfRlJBTUUQChIdChlNQVZfUkVTVUxUX05PVF9JTl9DT05UUk9MEAsqlgEKCVNwZWVkVHlwZRIaChZTUEVFRF9UWVBFX1VOU1BFQ0lGSUVEEAASFwoTU1BFRURfVFlQRV9BSVJTUEVFRBABEhoKFlNQRUVEX1RZUEVfR1JPVU5EU1BFRUQQAhIaChZTUEVFRF9UWVBFX0NMSU1CX1NQRUVEEAMSHAoYU1BFRURfVFlQRV9ERVNDRU5UX1NQRUVEEAQqbwoMWWF3RGlyZWN0aW9uEh0KGVlBV19ESVJFQ1RJT05fVU5TUEVDSUZJRUQQABIbChdZQVdfRElSRUNUSU9OX0NMT0NLV0lTRRABEiMKH1lBV19ESVJFQ1RJT05fQ09VTlRFUl9DTE9DS1dJU0UQAirpAQoLRmxpZ2h0UGhhc2USHAoYRkxJR0hUX1BIQVNFX1VOU1BFQ0lGSUVEEAASGQoVRkxJR0hUX1BIQVNFX0FDQ0VQVEVEEAESGQoVRkxJR0hUX1BIQVNFX0NMSU1CSU5HEAISGQoVRkxJR0hUX1BIQVNFX0hPVkVSSU5HEAMSGgoWRkxJR0hUX1BIQVNFX1JFVFVSTklORxAEEhsKF0ZMSUdIVF9QSEFTRV9ERVNDRU5ESU5HEAUSFwoTRkxJR0hUX1BIQVNFX0xBTkRFRBAGEhkKFUZMSUdIVF9QSEFTRV9ESVNBUk1FRBAHMvQJCg1BY3Rpb25TZXJ2aWNlEjYKA0FybRIWLmZsaWdodHBhdGguQXJtUmVxdWVzdBoXLmZsaWdodHBhdGguQXJtUmVzcG9uc2USPwoGRGlzYXJtEhkuZmxpZ2h0cGF0aC5EaXNhcm1SZXF1ZXN0GhouZmxpZ2h0cGF0aC5EaXNhcm1SZXNwb25zZRJECgdUYWtlb2ZmEhouZmxpZ2h0cGF0aC5UYWtlb2ZmUmVxdWVzdBobLmZsaWdodHBhdGguVGFrZW9mZlJlc3BvbnNlMAESOwoETGFuZBIXLmZsaWdodHBhdGguTGFuZFJlcXVlc3QaGC5mbGlnaHRwYXRoLkxhbmRSZXNwb25zZTABElkKDlJldHVyblRvTGF1bmNoEiEuZmxpZ2h0cGF0aC5SZXR1cm5Ub0xhdW5jaFJlcXVlc3QaIi5mbGlnaHRwYXRoLlJldHVyblRvTGF1bmNoUmVzcG9uc2UwARJUCg1TZXRGbGlnaHRNb2RlEiAuZmxpZ2h0cGF0aC5TZXRGbGlnaHRNb2RlUmVxdWVzdBohLmZsaWdodHBhdGguU2V0RmxpZ2h0TW9kZVJlc3BvbnNlElMKDEdvdG9Mb2NhdGlvbhIfLmZsaWdodHBhdGguR290b0xvY2F0aW9uUmVxdWVzdBogLmZsaWdodHBhdGguR290b0xvY2F0aW9uUmVzcG9uc2UwARJCCgdEb09yYml0EhouZmxpZ2h0cGF0aC5Eb09yYml0UmVxdWVzdBobLmZsaWdodHBhdGguRG9PcmJpdFJlc3BvbnNlElwKD1JlYm9vdEF1dG9waWxvdBIiLmZsaWdodHBhdGguUmVib290QXV0b3BpbG90UmVxdWVzdBojLmZsaWdodHBhdGguUmVib290QXV0b3BpbG90UmVzcG9uc2UwARJiChFTaHV0ZG93bkF1dG9waWxvdBIkLmZsaWdodHBhdGguU2h1dGRvd25BdXRvcGlsb3RSZXF1ZXN0GiUuZmxpZ2h0cGF0aC5TaHV0ZG93bkF1dG9waWxvdFJlc3BvbnNlMAESTgoLU2VuZENvbW1hbmQSHi5mbGlnaHRwYXRoLlNlbmRDb21tYW5kUmVxdWVzdBofLmZsaWdodHBhdGguU2VuZENvbW1hbmRSZXNwb25zZRJOCgtDaGFuZ2VTcGVlZBIeLmZsaWdodHBhdGguQ2hhbmdlU3BlZWRSZXF1ZXN0Gh8uZmxpZ2h0cGF0aC5DaGFuZ2VTcGVlZFJlc3BvbnNlEj8KBlNldFlhdxIZLmZsaWdodHBhdGguU2V0WWF3UmVxdWVzdBoaLmZsaWdodHBhdGguU2V0WWF3UmVzcG9uc2USVwoOU2V0Um9pTG9jYXRpb24SIS5mbGlnaHRwYXRoLlNldFJvaUxvY2F0aW9uUmVxdWVzdBoiLmZsaWdodHBhdGguU2V0Um9pTG9jYXRpb25SZXNwb25zZRJFCghDbGVhclJvaRIbLmZsaWdodHBhdGguQ2xlYXJSb2lSZXF1ZXN0GhwuZmxpZ2h0cGF0aC5DbGVhclJvaVJlc3BvbnNlEloKD1NldEhvbWVQb3NpdGlvbhIiLmZsaWdodHBhdGguU2V0SG9tZVBvc2l0aW9uUmVxdWVzdBojLmZsaWdodHBhdGguU2V0SG9tZVBvc2l0aW9uUmVzcG9uc2VCqAEKDmNvbS5mbGlnaHRwYXRoQgtBY3Rpb25Qcm90b1ABWkFnaXRodWIuY29tL2ZsaWdodHBhdGgtZGV2L2ZsaWdodHBhdGgvZ2VuL2dvL2ZsaWdodHBhdGg7ZmxpZ2h0cGF0aKICA0ZYWKoCCkZsaWdodHBhdGjKAgpGbGlnaHRwYXRo4gIWRmxpZ2h0cGF0aFxHUEJNZXRhZGF0YeoCCkZsaWdodHBhdGhiBnByb3RvMw", [file_flightpath_connection, file_flightpath_telemetry]);
+
+/**
+ * ArmRequest is the request message for Arm
+ *
+ * @generated from message flightpath.ArmRequest
+ */
+export type ArmRequest = Message<"flightpath.ArmRequest"> & {
+  /**
+   * System ID of the drone to arm. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 1;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the autopilot to arm. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 2;
+   */
+  componentId: number;
+};
+
+/**
+ * Describes the message flightpath.ArmRequest.
+ * Use `create(ArmRequestSchema)` to create a new message.
+ */
+export const ArmRequestSchema: GenMessage<ArmRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 0);
+
+/**
+ * ArmResponse is the response message for Arm
+ *
+ * @generated from message flightpath.ArmResponse
+ */
+export type ArmResponse = Message<"flightpath.ArmResponse"> & {
+};
+
+/**
+ * Describes the message flightpath.ArmResponse.
+ * Use `create(ArmResponseSchema)` to create a new message.
+ */
+export const ArmResponseSchema: GenMessage<ArmResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 1);
+
+/**
+ * DisarmRequest is the request message for Disarm
+ *
+ * @generated from message flightpath.DisarmRequest
+ */
+export type DisarmRequest = Message<"flightpath.DisarmRequest"> & {
+  /**
+   * System ID of the drone to disarm. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 1;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the autopilot to disarm. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 2;
+   */
+  componentId: number;
+
+  /**
+   * Force disarm, bypassing the autopilot's landed checks (param2 = 21196).
+   * WARNING: Force disarming a flying drone will cause it to fall out of the sky.
+   *
+   * @generated from field: bool force = 3;
+   */
+  force: boolean;
+};
+
+/**
+ * Describes the message flightpath.DisarmRequest.
+ * Use `create(DisarmRequestSchema)` to create a new message.
+ */
+export const DisarmRequestSchema: GenMessage<DisarmRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 2);
+
+/**
+ * DisarmResponse is the response message for Disarm
+ *
+ * @generated from message flightpath.DisarmResponse
+ */
+export type DisarmResponse = Message<"flightpath.DisarmResponse"> & {
+};
+
+/**
+ * Describes the message flightpath.DisarmResponse.
+ * Use `create(DisarmResponseSchema)` to create a new message.
+ */
+export const DisarmResponseSchema: GenMessage<DisarmResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 3);
+
+/**
+ * TakeoffRequest is the request message for Takeoff
+ *
+ * @generated from message flightpath.TakeoffRequest
+ */
+export type TakeoffRequest = Message<"flightpath.TakeoffRequest"> & {
+  /**
+   * System ID of the drone. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 1;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 2;
+   */
+  componentId: number;
+
+  /**
+   * Takeoff altitude above home (m). If not set, the autopilot's default takeoff altitude is used
+   * (PX4 only, other autopilots require an altitude).
+   *
+   * @generated from field: float altitude = 3;
+   */
+  altitude: number;
+};
+
+/**
+ * Describes the message flightpath.TakeoffRequest.
+ * Use `create(TakeoffRequestSchema)` to create a new message.
+ */
+export const TakeoffRequestSchema: GenMessage<TakeoffRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 4);
+
+/**
+ * TakeoffResponse contains a flight phase update for Takeoff
+ *
+ * @generated from message flightpath.TakeoffResponse
+ */
+export type TakeoffResponse = Message<"flightpath.TakeoffResponse"> & {
+  /**
+   * Timestamp when this update was generated (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * Current flight phase
+   *
+   * @generated from field: flightpath.FlightPhase phase = 2;
+   */
+  phase: FlightPhase;
+
+  /**
+   * Altitude above home (m)
+   *
+   * @generated from field: float relative_altitude = 3;
+   */
+  relativeAltitude: number;
+};
+
+/**
+ * Describes the message flightpath.TakeoffResponse.
+ * Use `create(TakeoffResponseSchema)` to create a new message.
+ */
+export const TakeoffResponseSchema: GenMessage<TakeoffResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 5);
+
+/**
+ * LandRequest is the request message for Land
+ *
+ * @generated from message flightpath.LandRequest
+ */
+export type LandRequest = Message<"flightpath.LandRequest"> & {
+  /**
+   * System ID of the drone. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 1;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 2;
+   */
+  componentId: number;
+};
+
+/**
+ * Describes the message flightpath.LandRequest.
+ * Use `create(LandRequestSchema)` to create a new message.
+ */
+export const LandRequestSchema: GenMessage<LandRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 6);
+
+/**
+ * LandResponse contains a flight phase update for Land
+ *
+ * @generated from message flightpath.LandResponse
+ */
+export type LandResponse = Message<"flightpath.LandResponse"> & {
+  /**
+   * Timestamp when this update was generated (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * Current flight phase
+   *
+   * @generated from field: flightpath.FlightPhase phase = 2;
+   */
+  phase: FlightPhase;
+
+  /**
+   * Altitude above home (m)
+   *
+   * @generated from field: float relative_altitude = 3;
+   */
+  relativeAltitude: number;
+};
+
+/**
+ * Describes the message flightpath.LandResponse.
+ * Use `create(LandResponseSchema)` to create a new message.
+ */
+export const LandResponseSchema: GenMessage<LandResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 7);
+
+/**
+ * ReturnToLaunchRequest is the request message for ReturnToLaunch
+ *
+ * @generated from message flightpath.ReturnToLaunchRequest
+ */
+export type ReturnToLaunchRequest = Message<"flightpath.ReturnToLaunchRequest"> & {
+  /**
+   * System ID of the drone. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 1;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 2;
+   */
+  componentId: number;
+};
+
+/**
+ * Describes the message flightpath.ReturnToLaunchRequest.
+ * Use `create(ReturnToLaunchRequestSchema)` to create a new message.
+ */
+export const ReturnToLaunchRequestSchema: GenMessage<ReturnToLaunchRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 8);
+
+/**
+ * ReturnToLaunchResponse contains a flight phase update for ReturnToLaunch
+ *
+ * @generated from message flightpath.ReturnToLaunchResponse
+ */
+export type ReturnToLaunchResponse = Message<"flightpath.ReturnToLaunchResponse"> & {
+  /**
+   * Timestamp when this update was generated (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * Current flight phase
+   *
+   * @generated from field: flightpath.FlightPhase phase = 2;
+   */
+  phase: FlightPhase;
+
+  /**
+   * Altitude above home (m)
+   *
+   * @generated from field: float relative_altitude = 3;
+   */
+  relativeAltitude: number;
+};
+
+/**
+ * Describes the message flightpath.ReturnToLaunchResponse.
+ * Use `create(ReturnToLaunchResponseSchema)` to create a new message.
+ */
+export const ReturnToLaunchResponseSchema: GenMessage<ReturnToLaunchResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 9);
+
+/**
+ * SetFlightModeRequest is the request message for SetFlightMode
+ *
+ * @generated from message flightpath.SetFlightModeRequest
+ */
+export type SetFlightModeRequest = Message<"flightpath.SetFlightModeRequest"> & {
+  /**
+   * System ID of the drone. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 1;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 2;
+   */
+  componentId: number;
+
+  /**
+   * Main flight mode (required)
+   *
+   * @generated from field: flightpath.MainMode main_mode = 3;
+   */
+  mainMode: MainMode;
+
+  /**
+   * Sub mode. If not set, the default sub mode of the main mode is used
+   * (e.g. AUTO_MISSION for AUTO, POSCTL_POSCTL for POSCTL).
+   *
+   * @generated from field: flightpath.SubMode sub_mode = 4;
+   */
+  subMode: SubMode;
+};
+
+/**
+ * Describes the message flightpath.SetFlightModeRequest.
+ * Use `create(SetFlightModeRequestSchema)` to create a new message.
+ */
+export const SetFlightModeRequestSchema: GenMessage<SetFlightModeRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 10);
+
+/**
+ * SetFlightModeResponse is the response message for SetFlightMode
+ *
+ * @generated from message flightpath.SetFlightModeResponse
+ */
+export type SetFlightModeResponse = Message<"flightpath.SetFlightModeResponse"> & {
+  /**
+   * Flight mode reported by the drone's HEARTBEAT after the change
+   *
+   * @generated from field: flightpath.CustomMode custom_mode = 1;
+   */
+  customMode?: CustomMode;
+};
+
+/**
+ * Describes the message flightpath.SetFlightModeResponse.
+ * Use `create(SetFlightModeResponseSchema)` to create a new message.
+ */
+export const SetFlightModeResponseSchema: GenMessage<SetFlightModeResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 11);
+
+/**
+ * GotoLocationRequest is the request message for GotoLocation
+ *
+ * @generated from message flightpath.GotoLocationRequest
+ */
+export type GotoLocationRequest = Message<"flightpath.GotoLocationRequest"> & {
+  /**
+   * System ID of the drone. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 1;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 2;
+   */
+  componentId: number;
+
+  /**
+   * Target latitude (WGS84) in degrees
+   *
+   * @generated from field: double latitude = 3;
+   */
+  latitude: number;
+
+  /**
+   * Target longitude (WGS84) in degrees
+   *
+   * @generated from field: double longitude = 4;
+   */
+  longitude: number;
+
+  /**
+   * Target altitude above home (m)
+   *
+   * @generated from field: float altitude = 5;
+   */
+  altitude: number;
+
+  /**
+   * Ground speed (m/s). If not set, the autopilot's default speed is used.
+   *
+   * @generated from field: float ground_speed = 6;
+   */
+  groundSpeed: number;
+
+  /**
+   * Yaw heading (degrees, 0 = north, clockwise). If not set, the autopilot's yaw behaviour is used.
+   *
+   * @generated from field: optional float yaw = 7;
+   */
+  yaw?: number;
+
+  /**
+   * Distance to the target (m) below which the drone is considered arrived. Defaults to 2 m if not set.
+   *
+   * @generated from field: float acceptance_radius = 8;
+   */
+  acceptanceRadius: number;
+};
+
+/**
+ * Describes the message flightpath.GotoLocationRequest.
+ * Use `create(GotoLocationRequestSchema)` to create a new message.
+ */
+export const GotoLocationRequestSchema: GenMessage<GotoLocationRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 12);
+
+/**
+ * GotoLocationResponse contains a progress update for GotoLocation
+ *
+ * @generated from message flightpath.GotoLocationResponse
+ */
+export type GotoLocationResponse = Message<"flightpath.GotoLocationResponse"> & {
+  /**
+   * Timestamp when this update was generated (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * Distance to the target (m)
+   *
+   * @generated from field: float distance = 2;
+   */
+  distance: number;
+
+  /**
+   * Horizontal distance to the target (m)
+   *
+   * @generated from field: float horizontal_distance = 3;
+   */
+  horizontalDistance: number;
+
+  /**
+   * Altitude difference to the target (m, positive if the target is above the drone)
+   *
+   * @generated from field: float vertical_distance = 4;
+   */
+  verticalDistance: number;
+
+  /**
+   * Speed towards the target (m/s, negative if moving away)
+   *
+   * @generated from field: float closing_speed = 5;
+   */
+  closingSpeed: number;
+
+  /**
+   * Estimated time to arrival (s). Set to -1 when the drone is not moving towards the target.
+   *
+   * @generated from field: float eta = 6;
+   */
+  eta: number;
+
+  /**
+   * True in the last update, once the drone is within the acceptance radius
+   *
+   * @generated from field: bool arrived = 7;
+   */
+  arrived: boolean;
+};
+
+/**
+ * Describes the message flightpath.GotoLocationResponse.
+ * Use `create(GotoLocationResponseSchema)` to create a new message.
+ */
+export const GotoLocationResponseSchema: GenMessage<GotoLocationResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 13);
+
+/**
+ * DoOrbitRequest is the request message for DoOrbit
+ *
+ * @generated from message flightpath.DoOrbitRequest
+ */
+export type DoOrbitRequest = Message<"flightpath.DoOrbitRequest"> & {
+  /**
+   * System ID of the drone. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 1;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 2;
+   */
+  componentId: number;
+
+  /**
+   * Latitude (WGS84) of the orbit center in degrees
+   *
+   * @generated from field: double latitude = 3;
+   */
+  latitude: number;
+
+  /**
+   * Longitude (WGS84) of the orbit center in degrees
+   *
+   * @generated from field: double longitude = 4;
+   */
+  longitude: number;
+
+  /**
+   * Altitude of the orbit center above home (m). If not set, the current altitude is kept.
+   *
+   * @generated from field: optional float altitude = 5;
+   */
+  altitude?: number;
+
+  /**
+   * Orbit radius (m), must be positive
+   *
+   * @generated from field: float radius = 6;
+   */
+  radius: number;
+
+  /**
+   * Orbit counter-clockwise (seen from above) instead of clockwise
+   *
+   * @generated from field: bool counter_clockwise = 7;
+   */
+  counterClockwise: boolean;
+
+  /**
+   * Tangential velocity (m/s). If not set, the autopilot's default velocity is used.
+   *
+   * @generated from field: float velocity = 8;
+   */
+  velocity: number;
+
+  /**
+   * Yaw behaviour during the orbit. If not set, the current (or autopilot default) behaviour is used.
+   *
+   * @generated from field: flightpath.OrbitYawBehaviour yaw_behaviour = 9;
+   */
+  yawBehaviour: OrbitYawBehaviour;
+
+  /**
+   * Number of turns before the orbit ends. If not set, the drone orbits until it is given another command.
+   *
+   * @generated from field: float turns = 10;
+   */
+  turns: number;
+};
+
+/**
+ * Describes the message flightpath.DoOrbitRequest.
+ * Use `create(DoOrbitRequestSchema)` to create a new message.
+ */
+export const DoOrbitRequestSchema: GenMessage<DoOrbitRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 14);
+
+/**
+ * DoOrbitResponse is the response message for DoOrbit
+ *
+ * @generated from message flightpath.DoOrbitResponse
+ */
+export type DoOrbitResponse = Message<"flightpath.DoOrbitResponse"> & {
+};
+
+/**
+ * Describes the message flightpath.DoOrbitResponse.
+ * Use `create(DoOrbitResponseSchema)` to create a new message.
+ */
+export const DoOrbitResponseSchema: GenMessage<DoOrbitResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 15);
+
+/**
+ * RebootAutopilotRequest is the request message for RebootAutopilot
+ *
+ * @generated from message flightpath.RebootAutopilotRequest
+ */
+export type RebootAutopilotRequest = Message<"flightpath.RebootAutopilotRequest"> & {
+  /**
+   * System ID of the drone. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 1;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 2;
+   */
+  componentId: number;
+};
+
+/**
+ * Describes the message flightpath.RebootAutopilotRequest.
+ * Use `create(RebootAutopilotRequestSchema)` to create a new message.
+ */
+export const RebootAutopilotRequestSchema: GenMessage<RebootAutopilotRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 16);
+
+/**
+ * RebootAutopilotResponse contains a status update for RebootAutopilot
+ *
+ * @generated from message flightpath.RebootAutopilotResponse
+ */
+export type RebootAutopilotResponse = Message<"flightpath.RebootAutopilotResponse"> & {
+  /**
+   * Timestamp when this update was generated (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * Current reboot phase
+   *
+   * @generated from field: flightpath.RebootShutdownPhase phase = 2;
+   */
+  phase: RebootShutdownPhase;
+};
+
+/**
+ * Describes the message flightpath.RebootAutopilotResponse.
+ * Use `create(RebootAutopilotResponseSchema)` to create a new message.
+ */
+export const RebootAutopilotResponseSchema: GenMessage<RebootAutopilotResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 17);
+
+/**
+ * ShutdownAutopilotRequest is the request message for ShutdownAutopilot
+ *
+ * @generated from message flightpath.ShutdownAutopilotRequest
+ */
+export type ShutdownAutopilotRequest = Message<"flightpath.ShutdownAutopilotRequest"> & {
+  /**
+   * System ID of the drone. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 1;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 2;
+   */
+  componentId: number;
+};
+
+/**
+ * Describes the message flightpath.ShutdownAutopilotRequest.
+ * Use `create(ShutdownAutopilotRequestSchema)` to create a new message.
+ */
+export const ShutdownAutopilotRequestSchema: GenMessage<ShutdownAutopilotRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 18);
+
+/**
+ * ShutdownAutopilotResponse contains a status update for ShutdownAutopilot
+ *
+ * @generated from message flightpath.ShutdownAutopilotResponse
+ */
+export type ShutdownAutopilotResponse = Message<"flightpath.ShutdownAutopilotResponse"> & {
+  /**
+   * Timestamp when this update was generated (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * Current shutdown phase
+   *
+   * @generated from field: flightpath.RebootShutdownPhase phase = 2;
+   */
+  phase: RebootShutdownPhase;
+};
+
+/**
+ * Describes the message flightpath.ShutdownAutopilotResponse.
+ * Use `create(ShutdownAutopilotResponseSchema)` to create a new message.
+ */
+export const ShutdownAutopilotResponseSchema: GenMessage<ShutdownAutopilotResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 19);
+
+/**
+ * SendCommandRequest is the request message for SendCommand
+ *
+ * @generated from message flightpath.SendCommandRequest
+ */
+export type SendCommandRequest = Message<"flightpath.SendCommandRequest"> & {
+  /**
+   * System ID of the target. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 1;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the target. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 2;
+   */
+  componentId: number;
+
+  /**
+   * Command ID (MAV_CMD), must be part of the common dialect
+   *
+   * @generated from field: uint32 command = 3;
+   */
+  command: number;
+
+  /**
+   * Parameter 1 (for the specific command)
+   *
+   * @generated from field: float param1 = 4;
+   */
+  param1: number;
+
+  /**
+   * Parameter 2 (for the specific command)
+   *
+   * @generated from field: float param2 = 5;
+   */
+  param2: number;
+
+  /**
+   * Parameter 3 (for the specific command)
+   *
+   * @generated from field: float param3 = 6;
+   */
+  param3: number;
+
+  /**
+   * Parameter 4 (for the specific command)
+   *
+   * @generated from field: float param4 = 7;
+   */
+  param4: number;
+
+  /**
+   * Parameter 5 (for the specific command). Ignored for COMMAND_INT, which uses x instead.
+   *
+   * @generated from field: float param5 = 8;
+   */
+  param5: number;
+
+  /**
+   * Parameter 6 (for the specific command). Ignored for COMMAND_INT, which uses y instead.
+   *
+   * @generated from field: float param6 = 9;
+   */
+  param6: number;
+
+  /**
+   * Parameter 7 (for the specific command). Sent as z for COMMAND_INT.
+   *
+   * @generated from field: float param7 = 10;
+   */
+  param7: number;
+
+  /**
+   * Send the command as COMMAND_INT instead of COMMAND_LONG
+   *
+   * @generated from field: bool use_command_int = 11;
+   */
+  useCommandInt: boolean;
+
+  /**
+   * COMMAND_INT only: coordinate system of the command (required)
+   *
+   * @generated from field: flightpath.MavFrame frame = 12;
+   */
+  frame: MavFrame;
+
+  /**
+   * COMMAND_INT only: local x position in meters * 1e4, or latitude in degrees * 1e7
+   *
+   * @generated from field: int32 x = 13;
+   */
+  x: number;
+
+  /**
+   * COMMAND_INT only: local y position in meters * 1e4, or longitude in degrees * 1e7
+   *
+   * @generated from field: int32 y = 14;
+   */
+  y: number;
+};
+
+/**
+ * Describes the message flightpath.SendCommandRequest.
+ * Use `create(SendCommandRequestSchema)` to create a new message.
+ */
+export const SendCommandRequestSchema: GenMessage<SendCommandRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 20);
+
+/**
+ * SendCommandResponse is the response message for SendCommand
+ *
+ * @generated from message flightpath.SendCommandResponse
+ */
+export type SendCommandResponse = Message<"flightpath.SendCommandResponse"> & {
+  /**
+   * Final COMMAND_ACK received for the command
+   *
+   * @generated from field: flightpath.CommandAck command_ack = 1;
+   */
+  commandAck?: CommandAck;
+};
+
+/**
+ * Describes the message flightpath.SendCommandResponse.
+ * Use `create(SendCommandResponseSchema)` to create a new message.
+ */
+export const SendCommandResponseSchema: GenMessage<SendCommandResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 21);
+
+/**
+ * CommandAck represents the COMMAND_ACK MAVLink message
+ * Report status of a command. Includes feedback whether the command was executed.
+ *
+ * @generated from message flightpath.CommandAck
+ */
+export type CommandAck = Message<"flightpath.CommandAck"> & {
+  /**
+   * Command ID (of acknowledged command)
+   *
+   * @generated from field: uint32 command = 1;
+   */
+  command: number;
+
+  /**
+   * Result of command
+   *
+   * @generated from field: flightpath.MavResult result = 2;
+   */
+  result: MavResult;
+
+  /**
+   * The progress percentage when result is MAV_RESULT_IN_PROGRESS. Values: [0-100], or UINT8_MAX if the progress is unknown.
+   *
+   * @generated from field: uint32 progress = 3;
+   */
+  progress: number;
+
+  /**
+   * Additional result information. Can be set with a command-specific enum containing command-specific error reasons for why the command might be denied.
+   *
+   * @generated from field: int32 result_param2 = 4;
+   */
+  resultParam2: number;
+
+  /**
+   * System ID of the target recipient (the system that sent the command)
+   *
+   * @generated from field: uint32 target_system = 5;
+   */
+  targetSystem: number;
+
+  /**
+   * Component ID of the target recipient (the component that sent the command)
+   *
+   * @generated from field: uint32 target_component = 6;
+   */
+  targetComponent: number;
+};
+
+/**
+ * Describes the message flightpath.CommandAck.
+ * Use `create(CommandAckSchema)` to create a new message.
+ */
+export const CommandAckSchema: GenMessage<CommandAck> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 22);
+
+/**
+ * ChangeSpeedRequest is the request message for ChangeSpeed
+ *
+ * @generated from message flightpath.ChangeSpeedRequest
+ */
+export type ChangeSpeedRequest = Message<"flightpath.ChangeSpeedRequest"> & {
+  /**
+   * System ID of the drone. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 1;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 2;
+   */
+  componentId: number;
+
+  /**
+   * Speed to change. Defaults to SPEED_TYPE_GROUNDSPEED if not set.
+   *
+   * @generated from field: flightpath.SpeedType speed_type = 3;
+   */
+  speedType: SpeedType;
+
+  /**
+   * Target speed (m/s), in (0, 100]
+   *
+   * @generated from field: float speed = 4;
+   */
+  speed: number;
+
+  /**
+   * Wait (up to 10 s) for VFR_HUD to report the target speed before returning.
+   * Note that the drone only reaches the target speed while it is moving.
+   *
+   * @generated from field: bool wait_for_confirmation = 5;
+   */
+  waitForConfirmation: boolean;
+};
+
+/**
+ * Describes the message flightpath.ChangeSpeedRequest.
+ * Use `create(ChangeSpeedRequestSchema)` to create a new message.
+ */
+export const ChangeSpeedRequestSchema: GenMessage<ChangeSpeedRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 23);
+
+/**
+ * ChangeSpeedResponse is the response message for ChangeSpeed
+ *
+ * @generated from message flightpath.ChangeSpeedResponse
+ */
+export type ChangeSpeedResponse = Message<"flightpath.ChangeSpeedResponse"> & {
+  /**
+   * COMMAND_ACK received for the command
+   *
+   * @generated from field: flightpath.CommandAck command_ack = 1;
+   */
+  commandAck?: CommandAck;
+
+  /**
+   * True if VFR_HUD reported the target speed (only when wait_for_confirmation is set)
+   *
+   * @generated from field: bool confirmed = 2;
+   */
+  confirmed: boolean;
+
+  /**
+   * Last speed reported by VFR_HUD for the requested speed type (m/s, only when wait_for_confirmation is set)
+   *
+   * @generated from field: float observed_speed = 3;
+   */
+  observedSpeed: number;
+};
+
+/**
+ * Describes the message flightpath.ChangeSpeedResponse.
+ * Use `create(ChangeSpeedResponseSchema)` to create a new message.
+ */
+export const ChangeSpeedResponseSchema: GenMessage<ChangeSpeedResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 24);
+
+/**
+ * SetYawRequest is the request message for SetYaw
+ *
+ * @generated from message flightpath.SetYawRequest
+ */
+export type SetYawRequest = Message<"flightpath.SetYawRequest"> & {
+  /**
+   * System ID of the drone. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 1;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 2;
+   */
+  componentId: number;
+
+  /**
+   * Target heading (deg, 0 = north, clockwise), in [0, 360).
+   * If relative is set, the angle to turn by instead, in the given direction.
+   *
+   * @generated from field: float angle = 3;
+   */
+  angle: number;
+
+  /**
+   * Angular speed (deg/s), in [0, 360]. If not set, the autopilot's default rate is used.
+   *
+   * @generated from field: float angular_speed = 4;
+   */
+  angularSpeed: number;
+
+  /**
+   * Direction of the turn. Required if relative is set.
+   *
+   * @generated from field: flightpath.YawDirection direction = 5;
+   */
+  direction: YawDirection;
+
+  /**
+   * Turn by angle relative to the current heading instead of turning to an absolute heading
+   *
+   * @generated from field: bool relative = 6;
+   */
+  relative: boolean;
+
+  /**
+   * Wait (up to 10 s) for VFR_HUD to report the target heading before returning (absolute headings only)
+   *
+   * @generated from field: bool wait_for_confirmation = 7;
+   */
+  waitForConfirmation: boolean;
+};
+
+/**
+ * Describes the message flightpath.SetYawRequest.
+ * Use `create(SetYawRequestSchema)` to create a new message.
+ */
+export const SetYawRequestSchema: GenMessage<SetYawRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 25);
+
+/**
+ * SetYawResponse is the response message for SetYaw
+ *
+ * @generated from message flightpath.SetYawResponse
+ */
+export type SetYawResponse = Message<"flightpath.SetYawResponse"> & {
+  /**
+   * COMMAND_ACK received for the command
+   *
+   * @generated from field: flightpath.CommandAck command_ack = 1;
+   */
+  commandAck?: CommandAck;
+
+  /**
+   * True if VFR_HUD reported the target heading (only when wait_for_confirmation is set)
+   *
+   * @generated from field: bool confirmed = 2;
+   */
+  confirmed: boolean;
+
+  /**
+   * Last heading reported by VFR_HUD (deg, only when wait_for_confirmation is set)
+   *
+   * @generated from field: float observed_heading = 3;
+   */
+  observedHeading: number;
+};
+
+/**
+ * Describes the message flightpath.SetYawResponse.
+ * Use `create(SetYawResponseSchema)` to create a new message.
+ */
+export const SetYawResponseSchema: GenMessage<SetYawResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 26);
+
+/**
+ * SetRoiLocationRequest is the request message for SetRoiLocation
+ *
+ * @generated from message flightpath.SetRoiLocationRequest
+ */
+export type SetRoiLocationRequest = Message<"flightpath.SetRoiLocationRequest"> & {
+  /**
+   * System ID of the drone. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 1;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 2;
+   */
+  componentId: number;
+
+  /**
+   * Latitude (WGS84) of the region of interest in degrees
+   *
+   * @generated from field: double latitude = 3;
+   */
+  latitude: number;
+
+  /**
+   * Longitude (WGS84) of the region of interest in degrees
+   *
+   * @generated from field: double longitude = 4;
+   */
+  longitude: number;
+
+  /**
+   * Altitude of the region of interest above home (m)
+   *
+   * @generated from field: float altitude = 5;
+   */
+  altitude: number;
+
+  /**
+   * Gimbal device ID to point. If not set, all gimbals are pointed.
+   *
+   * @generated from field: uint32 gimbal_device_id = 6;
+   */
+  gimbalDeviceId: number;
+};
+
+/**
+ * Describes the message flightpath.SetRoiLocationRequest.
+ * Use `create(SetRoiLocationRequestSchema)` to create a new message.
+ */
+export const SetRoiLocationRequestSchema: GenMessage<SetRoiLocationRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 27);
+
+/**
+ * SetRoiLocationResponse is the response message for SetRoiLocation
+ *
+ * @generated from message flightpath.SetRoiLocationResponse
+ */
+export type SetRoiLocationResponse = Message<"flightpath.SetRoiLocationResponse"> & {
+  /**
+   * COMMAND_ACK received for the command
+   *
+   * @generated from field: flightpath.CommandAck command_ack = 1;
+   */
+  commandAck?: CommandAck;
+};
+
+/**
+ * Describes the message flightpath.SetRoiLocationResponse.
+ * Use `create(SetRoiLocationResponseSchema)` to create a new message.
+ */
+export const SetRoiLocationResponseSchema: GenMessage<SetRoiLocationResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 28);
+
+/**
+ * ClearRoiRequest is the request message for ClearRoi
+ *
+ * @generated from message flightpath.ClearRoiRequest
+ */
+export type ClearRoiRequest = Message<"flightpath.ClearRoiRequest"> & {
+  /**
+   * System ID of the drone. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 1;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 2;
+   */
+  componentId: number;
+
+  /**
+   * Gimbal device ID to release. If not set, all gimbals are released.
+   *
+   * @generated from field: uint32 gimbal_device_id = 3;
+   */
+  gimbalDeviceId: number;
+};
+
+/**
+ * Describes the message flightpath.ClearRoiRequest.
+ * Use `create(ClearRoiRequestSchema)` to create a new message.
+ */
+export const ClearRoiRequestSchema: GenMessage<ClearRoiRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 29);
+
+/**
+ * ClearRoiResponse is the response message for ClearRoi
+ *
+ * @generated from message flightpath.ClearRoiResponse
+ */
+export type ClearRoiResponse = Message<"flightpath.ClearRoiResponse"> & {
+  /**
+   * COMMAND_ACK received for the command
+   *
+   * @generated from field: flightpath.CommandAck command_ack = 1;
+   */
+  commandAck?: CommandAck;
+};
+
+/**
+ * Describes the message flightpath.ClearRoiResponse.
+ * Use `create(ClearRoiResponseSchema)` to create a new message.
+ */
+export const ClearRoiResponseSchema: GenMessage<ClearRoiResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 30);
+
+/**
+ * SetHomePositionRequest is the request message for SetHomePosition
+ *
+ * @generated from message flightpath.SetHomePositionRequest
+ */
+export type SetHomePositionRequest = Message<"flightpath.SetHomePositionRequest"> & {
+  /**
+   * System ID of the drone. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 1;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 2;
+   */
+  componentId: number;
+
+  /**
+   * Use the current location of the drone as home (latitude, longitude and altitude are ignored)
+   *
+   * @generated from field: bool use_current_location = 3;
+   */
+  useCurrentLocation: boolean;
+
+  /**
+   * Latitude (WGS84) of the new home in degrees
+   *
+   * @generated from field: double latitude = 4;
+   */
+  latitude: number;
+
+  /**
+   * Longitude (WGS84) of the new home in degrees
+   *
+   * @generated from field: double longitude = 5;
+   */
+  longitude: number;
+
+  /**
+   * Altitude (MSL) of the new home (m)
+   *
+   * @generated from field: float altitude = 6;
+   */
+  altitude: number;
+};
+
+/**
+ * Describes the message flightpath.SetHomePositionRequest.
+ * Use `create(SetHomePositionRequestSchema)` to create a new message.
+ */
+export const SetHomePositionRequestSchema: GenMessage<SetHomePositionRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 31);
+
+/**
+ * SetHomePositionResponse is the response message for SetHomePosition
+ *
+ * @generated from message flightpath.SetHomePositionResponse
+ */
+export type SetHomePositionResponse = Message<"flightpath.SetHomePositionResponse"> & {
+  /**
+   * COMMAND_ACK received for the command
+   *
+   * @generated from field: flightpath.CommandAck command_ack = 1;
+   */
+  commandAck?: CommandAck;
+};
+
+/**
+ * Describes the message flightpath.SetHomePositionResponse.
+ * Use `create(SetHomePositionResponseSchema)` to create a new message.
+ */
+export const SetHomePositionResponseSchema: GenMessage<SetHomePositionResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_action, 32);
+
+/**
+ * OrbitYawBehaviour represents yaw behaviours from MAVLink ORBIT_YAW_BEHAVIOUR enum
+ * All values are incremented by 1 to accommodate ORBIT_YAW_BEHAVIOUR_UNSPECIFIED
+ *
+ * @generated from enum flightpath.OrbitYawBehaviour
+ */
+export enum OrbitYawBehaviour {
+  /**
+   * @generated from enum value: ORBIT_YAW_BEHAVIOUR_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Vehicle front points to the center (default)
+   *
+   * @generated from enum value: ORBIT_YAW_BEHAVIOUR_HOLD_FRONT_TO_CIRCLE_CENTER = 1;
+   */
+  HOLD_FRONT_TO_CIRCLE_CENTER = 1,
+
+  /**
+   * Vehicle front holds heading when message received
+   *
+   * @generated from enum value: ORBIT_YAW_BEHAVIOUR_HOLD_INITIAL_HEADING = 2;
+   */
+  HOLD_INITIAL_HEADING = 2,
+
+  /**
+   * Yaw uncontrolled
+   *
+   * @generated from enum value: ORBIT_YAW_BEHAVIOUR_UNCONTROLLED = 3;
+   */
+  UNCONTROLLED = 3,
+
+  /**
+   * Vehicle front follows flight path (tangential to circle)
+   *
+   * @generated from enum value: ORBIT_YAW_BEHAVIOUR_HOLD_FRONT_TANGENT_TO_CIRCLE = 4;
+   */
+  HOLD_FRONT_TANGENT_TO_CIRCLE = 4,
+
+  /**
+   * Yaw controlled by RC input
+   *
+   * @generated from enum value: ORBIT_YAW_BEHAVIOUR_RC_CONTROLLED = 5;
+   */
+  RC_CONTROLLED = 5,
+
+  /**
+   * Vehicle uses current yaw behaviour (unchanged)
+   *
+   * @generated from enum value: ORBIT_YAW_BEHAVIOUR_UNCHANGED = 6;
+   */
+  UNCHANGED = 6,
+}
+
+/**
+ * Describes the enum flightpath.OrbitYawBehaviour.
+ */
+export const OrbitYawBehaviourSchema: GenEnum<OrbitYawBehaviour> = /*@__PURE__*/
+  enumDesc(file_flightpath_action, 0);
+
+/**
+ * RebootShutdownPhase represents the phases of an autopilot reboot or shutdown
+ *
+ * @generated from enum flightpath.RebootShutdownPhase
+ */
+export enum RebootShutdownPhase {
+  /**
+   * @generated from enum value: REBOOT_SHUTDOWN_PHASE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The command was accepted by the autopilot
+   *
+   * @generated from enum value: REBOOT_SHUTDOWN_PHASE_ACCEPTED = 1;
+   */
+  ACCEPTED = 1,
+
+  /**
+   * The drone's heartbeats have stopped
+   *
+   * @generated from enum value: REBOOT_SHUTDOWN_PHASE_HEARTBEAT_LOST = 2;
+   */
+  HEARTBEAT_LOST = 2,
+
+  /**
+   * The drone's heartbeats are received again (reboot only)
+   *
+   * @generated from enum value: REBOOT_SHUTDOWN_PHASE_HEARTBEAT_RESTORED = 3;
+   */
+  HEARTBEAT_RESTORED = 3,
+}
+
+/**
+ * Describes the enum flightpath.RebootShutdownPhase.
+ */
+export const RebootShutdownPhaseSchema: GenEnum<RebootShutdownPhase> = /*@__PURE__*/
+  enumDesc(file_flightpath_action, 1);
+
+/**
+ * MavResult represents command results from MAVLink MAV_RESULT enum
+ * All values are incremented by 1 to accommodate MAV_RESULT_UNSPECIFIED
+ *
+ * @generated from enum flightpath.MavResult
+ */
+export enum MavResult {
+  /**
+   * @generated from enum value: MAV_RESULT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Command is valid (is supported and has valid parameters), and was executed
+   *
+   * @generated from enum value: MAV_RESULT_ACCEPTED = 1;
+   */
+  ACCEPTED = 1,
+
+  /**
+   * Command is valid, but cannot be executed at this time
+   *
+   * @generated from enum value: MAV_RESULT_TEMPORARILY_REJECTED = 2;
+   */
+  TEMPORARILY_REJECTED = 2,
+
+  /**
+   * Command is invalid (is supported but has invalid parameters)
+   *
+   * @generated from enum value: MAV_RESULT_DENIED = 3;
+   */
+  DENIED = 3,
+
+  /**
+   * Command is not supported (unknown)
+   *
+   * @generated from enum value: MAV_RESULT_UNSUPPORTED = 4;
+   */
+  UNSUPPORTED = 4,
+
+  /**
+   * Command is valid, but execution has failed
+   *
+   * @generated from enum value: MAV_RESULT_FAILED = 5;
+   */
+  FAILED = 5,
+
+  /**
+   * Command is valid and is being executed
+   *
+   * @generated from enum value: MAV_RESULT_IN_PROGRESS = 6;
+   */
+  IN_PROGRESS = 6,
+
+  /**
+   * Command has been cancelled (as a result of receiving a COMMAND_CANCEL message)
+   *
+   * @generated from enum value: MAV_RESULT_CANCELLED = 7;
+   */
+  CANCELLED = 7,
+
+  /**
+   * Command is only accepted when sent as a COMMAND_LONG
+   *
+   * @generated from enum value: MAV_RESULT_COMMAND_LONG_ONLY = 8;
+   */
+  COMMAND_LONG_ONLY = 8,
+
+  /**
+   * Command is only accepted when sent as a COMMAND_INT
+   *
+   * @generated from enum value: MAV_RESULT_COMMAND_INT_ONLY = 9;
+   */
+  COMMAND_INT_ONLY = 9,
+
+  /**
+   * Command is invalid because a frame is required and the specified frame is not supported
+   *
+   * @generated from enum value: MAV_RESULT_COMMAND_UNSUPPORTED_MAV_FRAME = 10;
+   */
+  COMMAND_UNSUPPORTED_MAV_FRAME = 10,
+
+  /**
+   * Command has been rejected because source system is not in control of the target system/component
+   *
+   * @generated from enum value: MAV_RESULT_NOT_IN_CONTROL = 11;
+   */
+  NOT_IN_CONTROL = 11,
+}
+
+/**
+ * Describes the enum flightpath.MavResult.
+ */
+export const MavResultSchema: GenEnum<MavResult> = /*@__PURE__*/
+  enumDesc(file_flightpath_action, 2);
+
+/**
+ * SpeedType represents speed types from MAVLink SPEED_TYPE enum
+ * All values are incremented by 1 to accommodate SPEED_TYPE_UNSPECIFIED
+ *
+ * @generated from enum flightpath.SpeedType
+ */
+export enum SpeedType {
+  /**
+   * @generated from enum value: SPEED_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Airspeed
+   *
+   * @generated from enum value: SPEED_TYPE_AIRSPEED = 1;
+   */
+  AIRSPEED = 1,
+
+  /**
+   * Groundspeed
+   *
+   * @generated from enum value: SPEED_TYPE_GROUNDSPEED = 2;
+   */
+  GROUNDSPEED = 2,
+
+  /**
+   * Climb speed
+   *
+   * @generated from enum value: SPEED_TYPE_CLIMB_SPEED = 3;
+   */
+  CLIMB_SPEED = 3,
+
+  /**
+   * Descent speed
+   *
+   * @generated from enum value: SPEED_TYPE_DESCENT_SPEED = 4;
+   */
+  DESCENT_SPEED = 4,
+}
+
+/**
+ * Describes the enum flightpath.SpeedType.
+ */
+export const SpeedTypeSchema: GenEnum<SpeedType> = /*@__PURE__*/
+  enumDesc(file_flightpath_action, 3);
+
+/**
+ * YawDirection represents the direction of a yaw turn
+ *
+ * @generated from enum flightpath.YawDirection
+ */
+export enum YawDirection {
+  /**
+   * Shortest direction
+   *
+   * @generated from enum value: YAW_DIRECTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Clockwise (seen from above)
+   *
+   * @generated from enum value: YAW_DIRECTION_CLOCKWISE = 1;
+   */
+  CLOCKWISE = 1,
+
+  /**
+   * Counter-clockwise (seen from above)
+   *
+   * @generated from enum value: YAW_DIRECTION_COUNTER_CLOCKWISE = 2;
+   */
+  COUNTER_CLOCKWISE = 2,
+}
+
+/**
+ * Describes the enum flightpath.YawDirection.
+ */
+export const YawDirectionSchema: GenEnum<YawDirection> = /*@__PURE__*/
+  enumDesc(file_flightpath_action, 4);
+
+/**
+ * FlightPhase represents the phases of a takeoff, landing or return-to-launch manoeuvre.
+ * Derived from EXTENDED_SYS_STATE (landed state), GLOBAL_POSITION_INT (altitude) and the
+ * HEARTBEAT flight mode.
+ *
+ * @generated from enum flightpath.FlightPhase
+ */
+export enum FlightPhase {
+  /**
+   * @generated from enum value: FLIGHT_PHASE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The command was accepted by the autopilot
+   *
+   * @generated from enum value: FLIGHT_PHASE_ACCEPTED = 1;
+   */
+  ACCEPTED = 1,
+
+  /**
+   * Climbing to the takeoff altitude
+   *
+   * @generated from enum value: FLIGHT_PHASE_CLIMBING = 2;
+   */
+  CLIMBING = 2,
+
+  /**
+   * Hovering (takeoff complete, or return-to-launch finished without landing)
+   *
+   * @generated from enum value: FLIGHT_PHASE_HOVERING = 3;
+   */
+  HOVERING = 3,
+
+  /**
+   * Flying back to the launch position
+   *
+   * @generated from enum value: FLIGHT_PHASE_RETURNING = 4;
+   */
+  RETURNING = 4,
+
+  /**
+   * Descending to land
+   *
+   * @generated from enum value: FLIGHT_PHASE_DESCENDING = 5;
+   */
+  DESCENDING = 5,
+
+  /**
+   * On the ground, still armed
+   *
+   * @generated from enum value: FLIGHT_PHASE_LANDED = 6;
+   */
+  LANDED = 6,
+
+  /**
+   * On the ground and disarmed
+   *
+   * @generated from enum value: FLIGHT_PHASE_DISARMED = 7;
+   */
+  DISARMED = 7,
+}
+
+/**
+ * Describes the enum flightpath.FlightPhase.
+ */
+export const FlightPhaseSchema: GenEnum<FlightPhase> = /*@__PURE__*/
+  enumDesc(file_flightpath_action, 5);
+
+/**
+ * Drone actions (arm, disarm, etc.)
+ *
+ * @generated from service flightpath.ActionService
+ */
+export const ActionService: GenService<{
+  /**
+   * Arm the drone (MAV_CMD_COMPONENT_ARM_DISARM with param1 = 1).
+   * Returns once the drone has acknowledged the command with COMMAND_ACK.
+   *
+   * @generated from rpc flightpath.ActionService.Arm
+   */
+  arm: {
+    methodKind: "unary";
+    input: typeof ArmRequestSchema;
+    output: typeof ArmResponseSchema;
+  },
+  /**
+   * Disarm the drone (MAV_CMD_COMPONENT_ARM_DISARM with param1 = 0).
+   * Returns once the drone has acknowledged the command with COMMAND_ACK.
+   *
+   * @generated from rpc flightpath.ActionService.Disarm
+   */
+  disarm: {
+    methodKind: "unary";
+    input: typeof DisarmRequestSchema;
+    output: typeof DisarmResponseSchema;
+  },
+  /**
+   * Take off to the requested altitude (MAV_CMD_NAV_TAKEOFF).
+   * Streams flight phase updates until the drone hovers at the takeoff altitude.
+   *
+   * @generated from rpc flightpath.ActionService.Takeoff
+   */
+  takeoff: {
+    methodKind: "server_streaming";
+    input: typeof TakeoffRequestSchema;
+    output: typeof TakeoffResponseSchema;
+  },
+  /**
+   * Land at the current position (MAV_CMD_NAV_LAND).
+   * Streams flight phase updates until the drone has landed and disarmed.
+   *
+   * @generated from rpc flightpath.ActionService.Land
+   */
+  land: {
+    methodKind: "server_streaming";
+    input: typeof LandRequestSchema;
+    output: typeof LandResponseSchema;
+  },
+  /**
+   * Return to the launch position and land (MAV_CMD_NAV_RETURN_TO_LAUNCH).
+   * Streams flight phase updates until the drone has landed and disarmed
+   * (or hovers above home if the autopilot is configured not to land).
+   *
+   * @generated from rpc flightpath.ActionService.ReturnToLaunch
+   */
+  returnToLaunch: {
+    methodKind: "server_streaming";
+    input: typeof ReturnToLaunchRequestSchema;
+    output: typeof ReturnToLaunchResponseSchema;
+  },
+  /**
+   * Change the flight mode (MAV_CMD_DO_SET_MODE).
+   * The mode is encoded into the autopilot's custom_mode (PX4 or ArduPilot).
+   * Returns once a HEARTBEAT from the drone reports the requested mode.
+   *
+   * @generated from rpc flightpath.ActionService.SetFlightMode
+   */
+  setFlightMode: {
+    methodKind: "unary";
+    input: typeof SetFlightModeRequestSchema;
+    output: typeof SetFlightModeResponseSchema;
+  },
+  /**
+   * Fly to a WGS84 location (MAV_CMD_DO_REPOSITION sent as COMMAND_INT).
+   * Streams the distance and estimated time to the target until the drone is within the
+   * acceptance radius. Fails if the drone does not switch to guided/hold mode shortly after
+   * accepting the command, or leaves it on the way.
+   *
+   * @generated from rpc flightpath.ActionService.GotoLocation
+   */
+  gotoLocation: {
+    methodKind: "server_streaming";
+    input: typeof GotoLocationRequestSchema;
+    output: typeof GotoLocationResponseSchema;
+  },
+  /**
+   * Start orbiting around a WGS84 location (MAV_CMD_DO_ORBIT sent as COMMAND_INT).
+   * Returns once the drone has acknowledged the command. Use TelemetryService.SubscribeOrbitExecutionStatus
+   * to follow the orbit.
+   *
+   * @generated from rpc flightpath.ActionService.DoOrbit
+   */
+  doOrbit: {
+    methodKind: "unary";
+    input: typeof DoOrbitRequestSchema;
+    output: typeof DoOrbitResponseSchema;
+  },
+  /**
+   * Reboot the autopilot (MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN with param1 = 1). Refused while armed.
+   * Streams updates as the drone's heartbeats disappear and reappear, and completes once the
+   * drone is heard again.
+   *
+   * @generated from rpc flightpath.ActionService.RebootAutopilot
+   */
+  rebootAutopilot: {
+    methodKind: "server_streaming";
+    input: typeof RebootAutopilotRequestSchema;
+    output: typeof RebootAutopilotResponseSchema;
+  },
+  /**
+   * Shut down the autopilot (MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN with param1 = 2). Refused while armed.
+   * Streams updates until the drone's heartbeats have disappeared.
+   *
+   * @generated from rpc flightpath.ActionService.ShutdownAutopilot
+   */
+  shutdownAutopilot: {
+    methodKind: "server_streaming";
+    input: typeof ShutdownAutopilotRequestSchema;
+    output: typeof ShutdownAutopilotResponseSchema;
+  },
+  /**
+   * Send any MAV_CMD of the common dialect as COMMAND_LONG or COMMAND_INT.
+   * The command is retransmitted until acknowledged, like the commands of the other RPCs.
+   * Returns the final COMMAND_ACK, whatever its result (a rejection is not an RPC error).
+   *
+   * @generated from rpc flightpath.ActionService.SendCommand
+   */
+  sendCommand: {
+    methodKind: "unary";
+    input: typeof SendCommandRequestSchema;
+    output: typeof SendCommandResponseSchema;
+  },
+  /**
+   * Change the target speed (MAV_CMD_DO_CHANGE_SPEED).
+   * Optionally waits for VFR_HUD to report the new speed.
+   *
+   * @generated from rpc flightpath.ActionService.ChangeSpeed
+   */
+  changeSpeed: {
+    methodKind: "unary";
+    input: typeof ChangeSpeedRequestSchema;
+    output: typeof ChangeSpeedResponseSchema;
+  },
+  /**
+   * Turn to a heading (MAV_CMD_CONDITION_YAW).
+   * Optionally waits for VFR_HUD to report the new heading (absolute headings only).
+   *
+   * @generated from rpc flightpath.ActionService.SetYaw
+   */
+  setYaw: {
+    methodKind: "unary";
+    input: typeof SetYawRequestSchema;
+    output: typeof SetYawResponseSchema;
+  },
+  /**
+   * Point the vehicle and its cameras/gimbals at a location (MAV_CMD_DO_SET_ROI_LOCATION sent as COMMAND_INT).
+   *
+   * @generated from rpc flightpath.ActionService.SetRoiLocation
+   */
+  setRoiLocation: {
+    methodKind: "unary";
+    input: typeof SetRoiLocationRequestSchema;
+    output: typeof SetRoiLocationResponseSchema;
+  },
+  /**
+   * Cancel the region of interest (MAV_CMD_DO_SET_ROI_NONE).
+   *
+   * @generated from rpc flightpath.ActionService.ClearRoi
+   */
+  clearRoi: {
+    methodKind: "unary";
+    input: typeof ClearRoiRequestSchema;
+    output: typeof ClearRoiResponseSchema;
+  },
+  /**
+   * Set the home position to the current location or to a WGS84 location (MAV_CMD_DO_SET_HOME
+   * sent as COMMAND_INT). Use TelemetryService.SubscribeHomePosition to follow the change.
+   *
+   * @generated from rpc flightpath.ActionService.SetHomePosition
+   */
+  setHomePosition: {
+    methodKind: "unary";
+    input: typeof SetHomePositionRequestSchema;
+    output: typeof SetHomePositionResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_flightpath_action, 0);
+
@@ -192,7 +192,11 @@ export const BaseModeSchema: GenMessage<BaseMode> = /*@__PURE__*/
   messageDesc(file_flightpath_connection, 3);
 
 /**
- * CustomMode represents flight mode as platform-agnostic abstractions
+ * CustomMode represents flight mode as platform-agnostic abstractions.
+ * PX4 custom_mode is decoded into its main mode and the sub mode of that main mode
+ * (e.g. AUTO/AUTO_TAKEOFF, POSCTL/POSCTL_ORBIT). ArduPilot (Copter and Plane) mode numbers are
+ * mapped to the equivalent main/sub mode. Modes of other autopilots, and ArduPilot modes without
+ * an equivalent, are reported as unspecified.
  *
  * @generated from message flightpath.CustomMode
  */
@@ -205,7 +209,7 @@ export type CustomMode = Message<"flightpath.CustomMode"> & {
   mainMode: MainMode;
 
   /**
-   * Sub mode (context-dependent based on main mode)
+   * Sub mode (context-dependent based on main mode, unspecified if the main mode has none)
    *
    * @generated from field: flightpath.SubMode sub_mode = 2;
    */
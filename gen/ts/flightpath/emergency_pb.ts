@@ -0,0 +1,230 @@
+// @generated by protoc-gen-es v2.10.2 with parameter "target=ts,import_extension=.js"
+// @generated from file flightpath/emergency.proto (package flightpath, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file flightpath/emergency.proto.
+ */
+export const file_flightpath_emergency: GenFile = /*@__PURE__*/
+  fileDesc("ChpmbGlnaHRwYXRoL2VtZXJnZW5jeS5wcm90bxIKZmxpZ2h0cGF0aCJyChpSZXF1ZXN0Q29uZmlybWF0aW9uUmVxdWVzdBIrCgZhY3Rpb24YASABKA4yGy5mbGlnaHRwYXRoLkVtZXJnZW5jeUFjdGlvbhIRCglzeXN0ZW1faWQYAiABKA0SFAoMY29tcG9uZW50X2lkGAMgASgNIkMKG1JlcXVlc3RDb25maXJtYXRpb25SZXNwb25zZRINCgV0b2tlbhgBIAEoCRIVCg1leHBpcmVzX2F0X21zGAIgASgDIhwKC0tpbGxSZXF1ZXN0Eg0KBXRva2VuGAEgASgJIg4KDEtpbGxSZXNwb25zZSIpChhGbGlnaHRUZXJtaW5hdGlvblJlcXVlc3QSDQoFdG9rZW4YASABKAkiGwoZRmxpZ2h0VGVybWluYXRpb25SZXNwb25zZSp3Cg9FbWVyZ2VuY3lBY3Rpb24SIAocRU1FUkdFTkNZX0FDVElPTl9VTlNQRUNJRklFRBAAEhkKFUVNRVJHRU5DWV9BQ1RJT05fS0lMTBABEicKI0VNRVJHRU5DWV9BQ1RJT05fRkxJR0hUX1RFUk1JTkFUSU9OEAIylwIKEEVtZXJnZW5jeVNlcnZpY2USZgoTUmVxdWVzdENvbmZpcm1hdGlvbhImLmZsaWdodHBhdGguUmVxdWVzdENvbmZpcm1hdGlvblJlcXVlc3QaJy5mbGlnaHRwYXRoLlJlcXVlc3RDb25maXJtYXRpb25SZXNwb25zZRI5CgRLaWxsEhcuZmxpZ2h0cGF0aC5LaWxsUmVxdWVzdBoYLmZsaWdodHBhdGguS2lsbFJlc3BvbnNlEmAKEUZsaWdodFRlcm1pbmF0aW9uEiQuZmxpZ2h0cGF0aC5GbGlnaHRUZXJtaW5hdGlvblJlcXVlc3QaJS5mbGlnaHRwYXRoLkZsaWdodFRlcm1pbmF0aW9uUmVzcG9uc2VCqwEKDmNvbS5mbGlnaHRwYXRoQg5FbWVyZ2VuY3lQcm90b1ABWkFnaXRodWIuY29tL2ZsaWdodHBhdGgtZGV2L2ZsaWdodHBhdGgvZ2VuL2dvL2ZsaWdodHBhdGg7ZmxpZ2h0cGF0aKICA0ZYWKoCCkZsaWdodHBhdGjKAgpGbGlnaHRwYXRo4gIWRmxpZ2h0cGF0aFxHUEJNZXRhZGF0YeoCCkZsaWdodHBhdGhiBnByb3RvMw");
+
+/**
+ * RequestConfirmationRequest is the request message for RequestConfirmation
+ *
+ * @generated from message flightpath.RequestConfirmationRequest
+ */
+export type RequestConfirmationRequest = Message<"flightpath.RequestConfirmationRequest"> & {
+  /**
+   * Emergency action to confirm
+   *
+   * @generated from field: flightpath.EmergencyAction action = 1;
+   */
+  action: EmergencyAction;
+
+  /**
+   * System ID of the drone. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+};
+
+/**
+ * Describes the message flightpath.RequestConfirmationRequest.
+ * Use `create(RequestConfirmationRequestSchema)` to create a new message.
+ */
+export const RequestConfirmationRequestSchema: GenMessage<RequestConfirmationRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_emergency, 0);
+
+/**
+ * RequestConfirmationResponse is the response message for RequestConfirmation
+ *
+ * @generated from message flightpath.RequestConfirmationResponse
+ */
+export type RequestConfirmationResponse = Message<"flightpath.RequestConfirmationResponse"> & {
+  /**
+   * Confirmation token to present to the action RPC
+   *
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * Time after which the token is no longer accepted (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 expires_at_ms = 2;
+   */
+  expiresAtMs: bigint;
+};
+
+/**
+ * Describes the message flightpath.RequestConfirmationResponse.
+ * Use `create(RequestConfirmationResponseSchema)` to create a new message.
+ */
+export const RequestConfirmationResponseSchema: GenMessage<RequestConfirmationResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_emergency, 1);
+
+/**
+ * KillRequest is the request message for Kill
+ *
+ * @generated from message flightpath.KillRequest
+ */
+export type KillRequest = Message<"flightpath.KillRequest"> & {
+  /**
+   * Confirmation token obtained from RequestConfirmation (EMERGENCY_ACTION_KILL).
+   * The drone targeted is the one the token was requested for.
+   *
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message flightpath.KillRequest.
+ * Use `create(KillRequestSchema)` to create a new message.
+ */
+export const KillRequestSchema: GenMessage<KillRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_emergency, 2);
+
+/**
+ * KillResponse is the response message for Kill
+ *
+ * @generated from message flightpath.KillResponse
+ */
+export type KillResponse = Message<"flightpath.KillResponse"> & {
+};
+
+/**
+ * Describes the message flightpath.KillResponse.
+ * Use `create(KillResponseSchema)` to create a new message.
+ */
+export const KillResponseSchema: GenMessage<KillResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_emergency, 3);
+
+/**
+ * FlightTerminationRequest is the request message for FlightTermination
+ *
+ * @generated from message flightpath.FlightTerminationRequest
+ */
+export type FlightTerminationRequest = Message<"flightpath.FlightTerminationRequest"> & {
+  /**
+   * Confirmation token obtained from RequestConfirmation (EMERGENCY_ACTION_FLIGHT_TERMINATION).
+   * The drone targeted is the one the token was requested for.
+   *
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message flightpath.FlightTerminationRequest.
+ * Use `create(FlightTerminationRequestSchema)` to create a new message.
+ */
+export const FlightTerminationRequestSchema: GenMessage<FlightTerminationRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_emergency, 4);
+
+/**
+ * FlightTerminationResponse is the response message for FlightTermination
+ *
+ * @generated from message flightpath.FlightTerminationResponse
+ */
+export type FlightTerminationResponse = Message<"flightpath.FlightTerminationResponse"> & {
+};
+
+/**
+ * Describes the message flightpath.FlightTerminationResponse.
+ * Use `create(FlightTerminationResponseSchema)` to create a new message.
+ */
+export const FlightTerminationResponseSchema: GenMessage<FlightTerminationResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_emergency, 5);
+
+/**
+ * EmergencyAction represents the actions of the EmergencyService
+ *
+ * @generated from enum flightpath.EmergencyAction
+ */
+export enum EmergencyAction {
+  /**
+   * @generated from enum value: EMERGENCY_ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Kill the motors (see Kill)
+   *
+   * @generated from enum value: EMERGENCY_ACTION_KILL = 1;
+   */
+  KILL = 1,
+
+  /**
+   * Terminate the flight (see FlightTermination)
+   *
+   * @generated from enum value: EMERGENCY_ACTION_FLIGHT_TERMINATION = 2;
+   */
+  FLIGHT_TERMINATION = 2,
+}
+
+/**
+ * Describes the enum flightpath.EmergencyAction.
+ */
+export const EmergencyActionSchema: GenEnum<EmergencyAction> = /*@__PURE__*/
+  enumDesc(file_flightpath_emergency, 0);
+
+/**
+ * Emergency actions (kill, flight termination).
+ * These actions can make the drone fall out of the sky, so they require two steps:
+ * RequestConfirmation returns a short-lived token, which must be presented to the action RPC.
+ *
+ * @generated from service flightpath.EmergencyService
+ */
+export const EmergencyService: GenService<{
+  /**
+   * Request a confirmation token for an emergency action on a drone.
+   * The token is valid for a single use, for a few seconds only, and only for the client
+   * (host and user agent) that requested it.
+   *
+   * @generated from rpc flightpath.EmergencyService.RequestConfirmation
+   */
+  requestConfirmation: {
+    methodKind: "unary";
+    input: typeof RequestConfirmationRequestSchema;
+    output: typeof RequestConfirmationResponseSchema;
+  },
+  /**
+   * Kill the drone's motors immediately, even in flight
+   * (MAV_CMD_COMPONENT_ARM_DISARM with param1 = 0 and param2 = 21196).
+   * Requires a token obtained with EMERGENCY_ACTION_KILL.
+   *
+   * @generated from rpc flightpath.EmergencyService.Kill
+   */
+  kill: {
+    methodKind: "unary";
+    input: typeof KillRequestSchema;
+    output: typeof KillResponseSchema;
+  },
+  /**
+   * Terminate the flight (MAV_CMD_DO_FLIGHTTERMINATION). Depending on the autopilot configuration,
+   * this cuts the motors and/or deploys a parachute.
+   * Requires a token obtained with EMERGENCY_ACTION_FLIGHT_TERMINATION.
+   *
+   * @generated from rpc flightpath.EmergencyService.FlightTermination
+   */
+  flightTermination: {
+    methodKind: "unary";
+    input: typeof FlightTerminationRequestSchema;
+    output: typeof FlightTerminationResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_flightpath_emergency, 0);
+
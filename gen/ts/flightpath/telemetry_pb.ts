@@ -4,13 +4,15 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { CustomMode, Heartbeat } from "./connection_pb.js";
+import { file_flightpath_connection } from "./connection_pb.js";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file flightpath/telemetry.proto.
  */
 export const file_flightpath_telemetry: GenFile = /*@__PURE__*/
-  fileDesc("ChpmbGlnaHRwYXRoL3RlbGVtZXRyeS5wcm90bxIKZmxpZ2h0cGF0aCIYChZTdWJzY3JpYmVSYXdHcHNSZXF1ZXN0IoQBChdTdWJzY3JpYmVSYXdHcHNSZXNwb25zZRIUCgx0aW1lc3RhbXBfbXMYASABKAMSEQoJc3lzdGVtX2lkGAIgASgNEhQKDGNvbXBvbmVudF9pZBgDIAEoDRIqCgtncHNfcmF3X2ludBgEIAEoCzIVLmZsaWdodHBhdGguR3BzUmF3SW50IqMCCglHcHNSYXdJbnQSEQoJdGltZV91c2VjGAEgASgEEigKCGZpeF90eXBlGAIgASgOMhYuZmxpZ2h0cGF0aC5HcHNGaXhUeXBlEgsKA2xhdBgDIAEoBRILCgNsb24YBCABKAUSCwoDYWx0GAUgASgFEgsKA2VwaBgGIAEoDRILCgNlcHYYByABKA0SCwoDdmVsGAggASgNEgsKA2NvZxgJIAEoDRIaChJzYXRlbGxpdGVzX3Zpc2libGUYCiABKA0SFQoNYWx0X2VsbGlwc29pZBgLIAEoBRINCgVoX2FjYxgMIAEoDRINCgV2X2FjYxgNIAEoDRIPCgd2ZWxfYWNjGA4gASgNEg8KB2hkZ19hY2MYDyABKA0SCwoDeWF3GBAgASgNKowCCgpHcHNGaXhUeXBlEhwKGEdQU19GSVhfVFlQRV9VTlNQRUNJRklFRBAAEhcKE0dQU19GSVhfVFlQRV9OT19HUFMQARIXChNHUFNfRklYX1RZUEVfTk9fRklYEAISFwoTR1BTX0ZJWF9UWVBFXzJEX0ZJWBADEhcKE0dQU19GSVhfVFlQRV8zRF9GSVgQBBIVChFHUFNfRklYX1RZUEVfREdQUxAFEhoKFkdQU19GSVhfVFlQRV9SVEtfRkxPQVQQBhIaChZHUFNfRklYX1RZUEVfUlRLX0ZJWEVEEAcSFwoTR1BTX0ZJWF9UWVBFX1NUQVRJQxAIEhQKEEdQU19GSVhfVFlQRV9QUFAQCTJwChBUZWxlbWV0cnlTZXJ2aWNlElwKD1N1YnNjcmliZVJhd0dwcxIiLmZsaWdodHBhdGguU3Vic2NyaWJlUmF3R3BzUmVxdWVzdBojLmZsaWdodHBhdGguU3Vic2NyaWJlUmF3R3BzUmVzcG9uc2UwAUKrAQoOY29tLmZsaWdodHBhdGhCDlRlbGVtZXRyeVByb3RvUAFaQWdpdGh1Yi5jb20vZmxpZ2h0cGF0aC1kZXYvZmxpZ2h0cGF0aC9nZW4vZ28vZmxpZ2h0cGF0aDtmbGlnaHRwYXRoogIDRlhYqgIKRmxpZ2h0cGF0aMoCCkZsaWdodHBhdGjiAhZGbGlnaHRwYXRoXEdQQk1ldGFkYXRh6gIKRmxpZ2h0cGF0aGIGcHJvdG8z");
+  fileDesc("ChpmbGlnaHRwYXRoL3RlbGVtZXRyeS5wcm90bxIKZmxpZ2h0cGF0aCIYChZTdWJzY3JpYmVSYXdHcHNSZXF1ZXN0IoQBChdTdWJzY3JpYmVSYXdHcHNSZXNwb25zZRIUCgx0aW1lc3RhbXBfbXMYASABKAMSEQoJc3lzdGVtX2lkGAIgASgNEhQKDGNvbXBvbmVudF9pZBgDIAEoDRIqCgtncHNfcmF3X2ludBgEIAEoCzIVLmZsaWdodHBhdGguR3BzUmF3SW50IqMCCglHcHNSYXdJbnQSEQoJdGltZV91c2VjGAEgASgEEigKCGZpeF90eXBlGAIgASgOMhYuZmxpZ2h0cGF0aC5HcHNGaXhUeXBlEgsKA2xhdBgDIAEoBRILCgNsb24YBCABKAUSCwoDYWx0GAUgASgFEgsKA2VwaBgGIAEoDRILCgNlcHYYByABKA0SCwoDdmVsGAggASgNEgsKA2NvZxgJIAEoDRIaChJzYXRlbGxpdGVzX3Zpc2libGUYCiABKA0SFQoNYWx0X2VsbGlwc29pZBgLIAEoBRINCgVoX2FjYxgMIAEoDRINCgV2X2FjYxgNIAEoDRIPCgd2ZWxfYWNjGA4gASgNEg8KB2hkZ19hY2MYDyABKA0SCwoDeWF3GBAgASgNIiYKJFN1YnNjcmliZU9yYml0RXhlY3V0aW9uU3RhdHVzUmVxdWVzdCK4AQolU3Vic2NyaWJlT3JiaXRFeGVjdXRpb25TdGF0dXNSZXNwb25zZRIUCgx0aW1lc3RhbXBfbXMYASABKAMSEQoJc3lzdGVtX2lkGAIgASgNEhQKDGNvbXBvbmVudF9pZBgDIAEoDRIOCgZhY3RpdmUYBCABKAgSQAoWb3JiaXRfZXhlY3V0aW9uX3N0YXR1cxgFIAEoCzIgLmZsaWdodHBhdGguT3JiaXRFeGVjdXRpb25TdGF0dXMifwoUT3JiaXRFeGVjdXRpb25TdGF0dXMSEQoJdGltZV91c2VjGAEgASgEEg4KBnJhZGl1cxgCIAEoAhIjCgVmcmFtZRgDIAEoDjIULmZsaWdodHBhdGguTWF2RnJhbWUSCQoBeBgEIAEoBRIJCgF5GAUgASgFEgkKAXoYBiABKAIiGgoYU3Vic2NyaWJlQXR0aXR1ZGVSZXF1ZXN0Ir8BChlTdWJzY3JpYmVBdHRpdHVkZVJlc3BvbnNlEhQKDHRpbWVzdGFtcF9tcxgBIAEoAxIRCglzeXN0ZW1faWQYAiABKA0SFAoMY29tcG9uZW50X2lkGAMgASgNEiYKCGF0dGl0dWRlGAQgASgLMhQuZmxpZ2h0cGF0aC5BdHRpdHVkZRI7ChNhdHRpdHVkZV9xdWF0ZXJuaW9uGAUgASgLMh4uZmxpZ2h0cGF0aC5BdHRpdHVkZVF1YXRlcm5pb24iuQEKCEF0dGl0dWRlEhQKDHRpbWVfYm9vdF9tcxgBIAEoDRIMCgRyb2xsGAIgASgCEg0KBXBpdGNoGAMgASgCEgsKA3lhdxgEIAEoAhIQCghyb2xsX2RlZxgFIAEoAhIRCglwaXRjaF9kZWcYBiABKAISDwoHeWF3X2RlZxgHIAEoAhIRCglyb2xsc3BlZWQYCCABKAISEgoKcGl0Y2hzcGVlZBgJIAEoAhIQCgh5YXdzcGVlZBgKIAEoAiKqAQoSQXR0aXR1ZGVRdWF0ZXJuaW9uEhQKDHRpbWVfYm9vdF9tcxgBIAEoDRIKCgJxMRgCIAEoAhIKCgJxMhgDIAEoAhIKCgJxMxgEIAEoAhIKCgJxNBgFIAEoAhIRCglyb2xsc3BlZWQYBiABKAISEgoKcGl0Y2hzcGVlZBgHIAEoAhIQCgh5YXdzcGVlZBgIIAEoAhIVCg1yZXByX29mZnNldF9xGAkgAygCIhoKGFN1YnNjcmliZVBvc2l0aW9uUmVxdWVzdCK+AQoZU3Vic2NyaWJlUG9zaXRpb25SZXNwb25zZRIUCgx0aW1lc3RhbXBfbXMYASABKAMSEQoJc3lzdGVtX2lkGAIgASgNEhQKDGNvbXBvbmVudF9pZBgDIAEoDRImCghwb3NpdGlvbhgEIAEoCzIULmZsaWdodHBhdGguUG9zaXRpb24SOgoTZ2xvYmFsX3Bvc2l0aW9uX2ludBgFIAEoCzIdLmZsaWdodHBhdGguR2xvYmFsUG9zaXRpb25JbnQizQEKCFBvc2l0aW9uEhAKCGxhdGl0dWRlGAEgASgBEhEKCWxvbmdpdHVkZRgCIAEoARIZChFhYnNvbHV0ZV9hbHRpdHVkZRgDIAEoAhIZChFyZWxhdGl2ZV9hbHRpdHVkZRgEIAEoAhIWCg52ZWxvY2l0eV9ub3J0aBgFIAEoAhIVCg12ZWxvY2l0eV9lYXN0GAYgASgCEhUKDXZlbG9jaXR5X2Rvd24YByABKAISFAoHaGVhZGluZxgIIAEoAkgAiAEBQgoKCF9oZWFkaW5nIh8KHVN1YnNjcmliZUxvY2FsUG9zaXRpb25SZXF1ZXN0IsEBCh5TdWJzY3JpYmVMb2NhbFBvc2l0aW9uUmVzcG9uc2USFAoMdGltZXN0YW1wX21zGAEgASgDEhEKCXN5c3RlbV9pZBgCIAEoDRIUCgxjb21wb25lbnRfaWQYAyABKA0SOAoSbG9jYWxfcG9zaXRpb25fbmVkGAQgASgLMhwuZmxpZ2h0cGF0aC5Mb2NhbFBvc2l0aW9uTmVkEiYKCG9kb21ldHJ5GAUgASgLMhQuZmxpZ2h0cGF0aC5PZG9tZXRyeSJtChBMb2NhbFBvc2l0aW9uTmVkEhQKDHRpbWVfYm9vdF9tcxgBIAEoDRIJCgF4GAIgASgCEgkKAXkYAyABKAISCQoBehgEIAEoAhIKCgJ2eBgFIAEoAhIKCgJ2eRgGIAEoAhIKCgJ2ehgHIAEoAiLAAwoIT2RvbWV0cnkSEQoJdGltZV91c2VjGAEgASgEEiYKCGZyYW1lX2lkGAIgASgOMhQuZmxpZ2h0cGF0aC5NYXZGcmFtZRIsCg5jaGlsZF9mcmFtZV9pZBgDIAEoDjIULmZsaWdodHBhdGguTWF2RnJhbWUSCQoBeBgEIAEoAhIJCgF5GAUgASgCEgkKAXoYBiABKAISCQoBcRgHIAMoAhIKCgJ2eBgIIAEoAhIKCgJ2eRgJIAEoAhIKCgJ2ehgKIAEoAhIRCglyb2xsc3BlZWQYCyABKAISEgoKcGl0Y2hzcGVlZBgMIAEoAhIQCgh5YXdzcGVlZBgNIAEoAhIvCg9wb3NlX2NvdmFyaWFuY2UYDiABKAsyFi5mbGlnaHRwYXRoLkNvdmFyaWFuY2USMwoTdmVsb2NpdHlfY292YXJpYW5jZRgPIAEoCzIWLmZsaWdodHBhdGguQ292YXJpYW5jZRIVCg1yZXNldF9jb3VudGVyGBAgASgNEjQKDmVzdGltYXRvcl90eXBlGBEgASgOMhwuZmxpZ2h0cGF0aC5NYXZFc3RpbWF0b3JUeXBlEg8KB3F1YWxpdHkYEiABKAUiPgoKQ292YXJpYW5jZRINCgVrbm93bhgBIAEoCBIRCgl2YXJpYW5jZXMYAiADKAISDgoGbWF0cml4GAMgAygCIhkKF1N1YnNjcmliZUJhdHRlcnlSZXF1ZXN0In8KGFN1YnNjcmliZUJhdHRlcnlSZXNwb25zZRIUCgx0aW1lc3RhbXBfbXMYASABKAMSEQoJc3lzdGVtX2lkGAIgASgNEhQKDGNvbXBvbmVudF9pZBgDIAEoDRIkCgdiYXR0ZXJ5GAQgASgLMhMuZmxpZ2h0cGF0aC5CYXR0ZXJ5IvkECgdCYXR0ZXJ5EgoKAmlkGAEgASgNEikKBnNvdXJjZRgCIAEoDjIZLmZsaWdodHBhdGguQmF0dGVyeVNvdXJjZRIwCghmdW5jdGlvbhgDIAEoDjIeLmZsaWdodHBhdGguTWF2QmF0dGVyeUZ1bmN0aW9uEigKBHR5cGUYBCABKA4yGi5mbGlnaHRwYXRoLk1hdkJhdHRlcnlUeXBlEhQKB3ZvbHRhZ2UYBSABKAJIAIgBARIVCg1jZWxsX3ZvbHRhZ2VzGAYgAygCEhQKB2N1cnJlbnQYByABKAJIAYgBARIcCg9jb25zdW1lZF9jaGFyZ2UYCCABKAJIAogBARIcCg9jb25zdW1lZF9lbmVyZ3kYCSABKAJIA4gBARIYCgt0ZW1wZXJhdHVyZRgKIAEoAkgEiAEBEh4KEXJlbWFpbmluZ19wZXJjZW50GAsgASgCSAWIAQESGwoOdGltZV9yZW1haW5pbmcYDCABKA1IBogBARI3CgxjaGFyZ2Vfc3RhdGUYDSABKA4yIS5mbGlnaHRwYXRoLk1hdkJhdHRlcnlDaGFyZ2VTdGF0ZRIoCgRtb2RlGA4gASgOMhouZmxpZ2h0cGF0aC5NYXZCYXR0ZXJ5TW9kZRIpCgZmYXVsdHMYDyABKAsyGS5mbGlnaHRwYXRoLkJhdHRlcnlGYXVsdHNCCgoIX3ZvbHRhZ2VCCgoIX2N1cnJlbnRCEgoQX2NvbnN1bWVkX2NoYXJnZUISChBfY29uc3VtZWRfZW5lcmd5Qg4KDF90ZW1wZXJhdHVyZUIUChJfcmVtYWluaW5nX3BlcmNlbnRCEQoPX3RpbWVfcmVtYWluaW5nIvwBCg1CYXR0ZXJ5RmF1bHRzEhYKDmRlZXBfZGlzY2hhcmdlGAEgASgIEg4KBnNwaWtlcxgCIAEoCBIRCgljZWxsX2ZhaWwYAyABKAgSFAoMb3Zlcl9jdXJyZW50GAQgASgIEhgKEG92ZXJfdGVtcGVyYXR1cmUYBSABKAgSGQoRdW5kZXJfdGVtcGVyYXR1cmUYBiABKAgSHAoUaW5jb21wYXRpYmxlX3ZvbHRhZ2UYByABKAgSHQoVaW5jb21wYXRpYmxlX2Zpcm13YXJlGAggASgIEigKIGluY29tcGF0aWJsZV9jZWxsc19jb25maWd1cmF0aW9uGAkgASgIIrUDCg1CYXR0ZXJ5U3RhdHVzEgoKAmlkGAEgASgNEjgKEGJhdHRlcnlfZnVuY3Rpb24YAiABKA4yHi5mbGlnaHRwYXRoLk1hdkJhdHRlcnlGdW5jdGlvbhIoCgR0eXBlGAMgASgOMhouZmxpZ2h0cGF0aC5NYXZCYXR0ZXJ5VHlwZRITCgt0ZW1wZXJhdHVyZRgEIAEoBRIQCgh2b2x0YWdlcxgFIAMoDRIXCg9jdXJyZW50X2JhdHRlcnkYBiABKAUSGAoQY3VycmVudF9jb25zdW1lZBgHIAEoBRIXCg9lbmVyZ3lfY29uc3VtZWQYCCABKAUSGQoRYmF0dGVyeV9yZW1haW5pbmcYCSABKAUSFgoOdGltZV9yZW1haW5pbmcYCiABKAUSNwoMY2hhcmdlX3N0YXRlGAsgASgOMiEuZmxpZ2h0cGF0aC5NYXZCYXR0ZXJ5Q2hhcmdlU3RhdGUSFAoMdm9sdGFnZXNfZXh0GAwgAygNEigKBG1vZGUYDSABKA4yGi5mbGlnaHRwYXRoLk1hdkJhdHRlcnlNb2RlEhUKDWZhdWx0X2JpdG1hc2sYDiABKA0iHgocU3Vic2NyaWJlU3lzdGVtU3RhdHVzUmVxdWVzdCK6AQodU3Vic2NyaWJlU3lzdGVtU3RhdHVzUmVzcG9uc2USFAoMdGltZXN0YW1wX21zGAEgASgDEhEKCXN5c3RlbV9pZBgCIAEoDRIUCgxjb21wb25lbnRfaWQYAyABKA0SLwoNc3lzdGVtX3N0YXR1cxgEIAEoCzIYLmZsaWdodHBhdGguU3lzdGVtU3RhdHVzEikKCnN5c19zdGF0dXMYBSABKAsyFS5mbGlnaHRwYXRoLlN5c1N0YXR1cyJ8CgxTeXN0ZW1TdGF0dXMSLQoHc2Vuc29ycxgBIAEoCzIcLmZsaWdodHBhdGguU3lzU3RhdHVzU2Vuc29ycxIQCghjcHVfbG9hZBgCIAEoAhIWCg5kcm9wX3JhdGVfY29tbRgDIAEoAhITCgtlcnJvcnNfY29tbRgEIAEoDSJBCgxTZW5zb3JTdGF0dXMSDwoHcHJlc2VudBgBIAEoCBIPCgdlbmFibGVkGAIgASgIEg8KB2hlYWx0aHkYAyABKAgi+wsKEFN5c1N0YXR1c1NlbnNvcnMSJgoEZ3lybxgBIAEoCzIYLmZsaWdodHBhdGguU2Vuc29yU3RhdHVzEicKBWFjY2VsGAIgASgLMhguZmxpZ2h0cGF0aC5TZW5zb3JTdGF0dXMSJQoDbWFnGAMgASgLMhguZmxpZ2h0cGF0aC5TZW5zb3JTdGF0dXMSMwoRYWJzb2x1dGVfcHJlc3N1cmUYBCABKAsyGC5mbGlnaHRwYXRoLlNlbnNvclN0YXR1cxI3ChVkaWZmZXJlbnRpYWxfcHJlc3N1cmUYBSABKAsyGC5mbGlnaHRwYXRoLlNlbnNvclN0YXR1cxIlCgNncHMYBiABKAsyGC5mbGlnaHRwYXRoLlNlbnNvclN0YXR1cxIuCgxvcHRpY2FsX2Zsb3cYByABKAsyGC5mbGlnaHRwYXRoLlNlbnNvclN0YXR1cxIxCg92aXNpb25fcG9zaXRpb24YCCABKAsyGC5mbGlnaHRwYXRoLlNlbnNvclN0YXR1cxIwCg5sYXNlcl9wb3NpdGlvbhgJIAEoCzIYLmZsaWdodHBhdGguU2Vuc29yU3RhdHVzEjcKFWV4dGVybmFsX2dyb3VuZF90cnV0aBgKIAEoCzIYLmZsaWdodHBhdGguU2Vuc29yU3RhdHVzEjYKFGFuZ3VsYXJfcmF0ZV9jb250cm9sGAsgASgLMhguZmxpZ2h0cGF0aC5TZW5zb3JTdGF0dXMSOAoWYXR0aXR1ZGVfc3RhYmlsaXphdGlvbhgMIAEoCzIYLmZsaWdodHBhdGguU2Vuc29yU3RhdHVzEi4KDHlhd19wb3NpdGlvbhgNIAEoCzIYLmZsaWdodHBhdGguU2Vuc29yU3RhdHVzEjQKEnpfYWx0aXR1ZGVfY29udHJvbBgOIAEoCzIYLmZsaWdodHBhdGguU2Vuc29yU3RhdHVzEjUKE3h5X3Bvc2l0aW9uX2NvbnRyb2wYDyABKAsyGC5mbGlnaHRwYXRoLlNlbnNvclN0YXR1cxIvCg1tb3Rvcl9vdXRwdXRzGBAgASgLMhguZmxpZ2h0cGF0aC5TZW5zb3JTdGF0dXMSLQoLcmNfcmVjZWl2ZXIYESABKAsyGC5mbGlnaHRwYXRoLlNlbnNvclN0YXR1cxInCgVneXJvMhgSIAEoCzIYLmZsaWdodHBhdGguU2Vuc29yU3RhdHVzEigKBmFjY2VsMhgTIAEoCzIYLmZsaWdodHBhdGguU2Vuc29yU3RhdHVzEiYKBG1hZzIYFCABKAsyGC5mbGlnaHRwYXRoLlNlbnNvclN0YXR1cxIqCghnZW9mZW5jZRgVIAEoCzIYLmZsaWdodHBhdGguU2Vuc29yU3RhdHVzEiYKBGFocnMYFiABKAsyGC5mbGlnaHRwYXRoLlNlbnNvclN0YXR1cxIpCgd0ZXJyYWluGBcgASgLMhguZmxpZ2h0cGF0aC5TZW5zb3JTdGF0dXMSLwoNcmV2ZXJzZV9tb3RvchgYIAEoCzIYLmZsaWdodHBhdGguU2Vuc29yU3RhdHVzEikKB2xvZ2dpbmcYGSABKAsyGC5mbGlnaHRwYXRoLlNlbnNvclN0YXR1cxIpCgdiYXR0ZXJ5GBogASgLMhguZmxpZ2h0cGF0aC5TZW5zb3JTdGF0dXMSKwoJcHJveGltaXR5GBsgASgLMhguZmxpZ2h0cGF0aC5TZW5zb3JTdGF0dXMSKAoGc2F0Y29tGBwgASgLMhguZmxpZ2h0cGF0aC5TZW5zb3JTdGF0dXMSLgoMcHJlYXJtX2NoZWNrGB0gASgLMhguZmxpZ2h0cGF0aC5TZW5zb3JTdGF0dXMSNAoSb2JzdGFjbGVfYXZvaWRhbmNlGB4gASgLMhguZmxpZ2h0cGF0aC5TZW5zb3JTdGF0dXMSLAoKcHJvcHVsc2lvbhgfIAEoCzIYLmZsaWdodHBhdGguU2Vuc29yU3RhdHVzEjEKD3JlY292ZXJ5X3N5c3RlbRggIAEoCzIYLmZsaWdodHBhdGguU2Vuc29yU3RhdHVzIv4DCglTeXNTdGF0dXMSJwofb25ib2FyZF9jb250cm9sX3NlbnNvcnNfcHJlc2VudBgBIAEoDRInCh9vbmJvYXJkX2NvbnRyb2xfc2Vuc29yc19lbmFibGVkGAIgASgNEiYKHm9uYm9hcmRfY29udHJvbF9zZW5zb3JzX2hlYWx0aBgDIAEoDRIMCgRsb2FkGAQgASgNEhcKD3ZvbHRhZ2VfYmF0dGVyeRgFIAEoDRIXCg9jdXJyZW50X2JhdHRlcnkYBiABKAUSGQoRYmF0dGVyeV9yZW1haW5pbmcYByABKAUSFgoOZHJvcF9yYXRlX2NvbW0YCCABKA0SEwoLZXJyb3JzX2NvbW0YCSABKA0SFQoNZXJyb3JzX2NvdW50MRgKIAEoDRIVCg1lcnJvcnNfY291bnQyGAsgASgNEhUKDWVycm9yc19jb3VudDMYDCABKA0SFQoNZXJyb3JzX2NvdW50NBgNIAEoDRIwCihvbmJvYXJkX2NvbnRyb2xfc2Vuc29yc19wcmVzZW50X2V4dGVuZGVkGA4gASgNEjAKKG9uYm9hcmRfY29udHJvbF9zZW5zb3JzX2VuYWJsZWRfZXh0ZW5kZWQYDyABKA0SLwonb25ib2FyZF9jb250cm9sX3NlbnNvcnNfaGVhbHRoX2V4dGVuZGVkGBAgASgNIh8KHVN1YnNjcmliZUZsaWdodE1ldHJpY3NSZXF1ZXN0IoQBCh5TdWJzY3JpYmVGbGlnaHRNZXRyaWNzUmVzcG9uc2USFAoMdGltZXN0YW1wX21zGAEgASgDEhEKCXN5c3RlbV9pZBgCIAEoDRIUCgxjb21wb25lbnRfaWQYAyABKA0SIwoHdmZyX2h1ZBgEIAEoCzISLmZsaWdodHBhdGguVmZySHVkIksKGlN1YnNjcmliZVN0YXR1c1RleHRSZXF1ZXN0Ei0KDG1pbl9zZXZlcml0eRgBIAEoDjIXLmZsaWdodHBhdGguTWF2U2V2ZXJpdHkiqQEKG1N1YnNjcmliZVN0YXR1c1RleHRSZXNwb25zZRIUCgx0aW1lc3RhbXBfbXMYASABKAMSEQoJc3lzdGVtX2lkGAIgASgNEhQKDGNvbXBvbmVudF9pZBgDIAEoDRIpCghzZXZlcml0eRgEIAEoDjIXLmZsaWdodHBhdGguTWF2U2V2ZXJpdHkSDAoEdGV4dBgFIAEoCRISCgppbmNvbXBsZXRlGAYgASgIImQKClN0YXR1c1RleHQSKQoIc2V2ZXJpdHkYASABKA4yFy5mbGlnaHRwYXRoLk1hdlNldmVyaXR5EgwKBHRleHQYAiABKAkSCgoCaWQYAyABKA0SEQoJY2h1bmtfc2VxGAQgASgNIkEKFkdldEhvbWVQb3NpdGlvblJlcXVlc3QSEQoJc3lzdGVtX2lkGAEgASgNEhQKDGNvbXBvbmVudF9pZBgCIAEoDSJ2ChdHZXRIb21lUG9zaXRpb25SZXNwb25zZRIqCghsb2NhdGlvbhgBIAEoCzIYLmZsaWdodHBhdGguSG9tZUxvY2F0aW9uEi8KDWhvbWVfcG9zaXRpb24YAiABKAsyGC5mbGlnaHRwYXRoLkhvbWVQb3NpdGlvbiIeChxTdWJzY3JpYmVIb21lUG9zaXRpb25SZXF1ZXN0IrsBCh1TdWJzY3JpYmVIb21lUG9zaXRpb25SZXNwb25zZRIUCgx0aW1lc3RhbXBfbXMYASABKAMSEQoJc3lzdGVtX2lkGAIgASgNEhQKDGNvbXBvbmVudF9pZBgDIAEoDRIqCghsb2NhdGlvbhgEIAEoCzIYLmZsaWdodHBhdGguSG9tZUxvY2F0aW9uEi8KDWhvbWVfcG9zaXRpb24YBSABKAsyGC5mbGlnaHRwYXRoLkhvbWVQb3NpdGlvbiJOCgxIb21lTG9jYXRpb24SEAoIbGF0aXR1ZGUYASABKAESEQoJbG9uZ2l0dWRlGAIgASgBEhkKEWFic29sdXRlX2FsdGl0dWRlGAMgASgCIsABCgxIb21lUG9zaXRpb24SEAoIbGF0aXR1ZGUYASABKAUSEQoJbG9uZ2l0dWRlGAIgASgFEhAKCGFsdGl0dWRlGAMgASgFEgkKAXgYBCABKAISCQoBeRgFIAEoAhIJCgF6GAYgASgCEgkKAXEYByADKAISEgoKYXBwcm9hY2hfeBgIIAEoAhISCgphcHByb2FjaF95GAkgASgCEhIKCmFwcHJvYWNoX3oYCiABKAISEQoJdGltZV91c2VjGAsgASgEIhwKGlN1YnNjcmliZVJjQ2hhbm5lbHNSZXF1ZXN0IoMBChtTdWJzY3JpYmVSY0NoYW5uZWxzUmVzcG9uc2USFAoMdGltZXN0YW1wX21zGAEgASgDEhEKCXN5c3RlbV9pZBgCIAEoDRIUCgxjb21wb25lbnRfaWQYAyABKA0SJQoIcmNfaW5wdXQYBCABKAsyEy5mbGlnaHRwYXRoLlJjSW5wdXQifgoHUmNJbnB1dBIUCgx0aW1lX2Jvb3RfbXMYASABKA0SFQoNY2hhbm5lbF9jb3VudBgCIAEoDRIqCghjaGFubmVscxgDIAMoCzIYLmZsaWdodHBhdGguQ2hhbm5lbFZhbHVlEhEKBHJzc2kYBCABKA1IAIgBAUIHCgVfcnNzaSIhCh9TdWJzY3JpYmVBY3R1YXRvck91dHB1dHNSZXF1ZXN0IskBCiBTdWJzY3JpYmVBY3R1YXRvck91dHB1dHNSZXNwb25zZRIUCgx0aW1lc3RhbXBfbXMYASABKAMSEQoJc3lzdGVtX2lkGAIgASgNEhQKDGNvbXBvbmVudF9pZBgDIAEoDRIvCg1zZXJ2b19vdXRwdXRzGAQgASgLMhguZmxpZ2h0cGF0aC5TZXJ2b091dHB1dHMSNQoQYWN0dWF0b3Jfb3V0cHV0cxgFIAEoCzIbLmZsaWdodHBhdGguQWN0dWF0b3JPdXRwdXRzIkkKDFNlcnZvT3V0cHV0cxINCgVwb3J0cxgBIAMoDRIqCghjaGFubmVscxgCIAMoCzIYLmZsaWdodHBhdGguQ2hhbm5lbFZhbHVlIi4KDENoYW5uZWxWYWx1ZRIPCgdjaGFubmVsGAEgASgNEg0KBXZhbHVlGAIgASgNIlIKD0FjdHVhdG9yT3V0cHV0cxIRCgl0aW1lX3VzZWMYASABKAQSLAoJYWN0dWF0b3JzGAIgAygLMhkuZmxpZ2h0cGF0aC5BY3R1YXRvclZhbHVlIjAKDUFjdHVhdG9yVmFsdWUSEAoIYWN0dWF0b3IYASABKA0SDQoFdmFsdWUYAiABKAIiVQoKUmNDaGFubmVscxIUCgx0aW1lX2Jvb3RfbXMYASABKA0SEQoJY2hhbmNvdW50GAIgASgNEhAKCGNoYW5fcmF3GAMgAygNEgwKBHJzc2kYBCABKA0iRAoOU2Vydm9PdXRwdXRSYXcSEQoJdGltZV91c2VjGAEgASgNEgwKBHBvcnQYAiABKA0SEQoJc2Vydm9fcmF3GAMgAygNIksKFEFjdHVhdG9yT3V0cHV0U3RhdHVzEhEKCXRpbWVfdXNlYxgBIAEoBBIOCgZhY3RpdmUYAiABKA0SEAoIYWN0dWF0b3IYAyADKAIiIQofU3Vic2NyaWJlRXN0aW1hdG9yU3RhdHVzUmVxdWVzdCLdAQogU3Vic2NyaWJlRXN0aW1hdG9yU3RhdHVzUmVzcG9uc2USFAoMdGltZXN0YW1wX21zGAEgASgDEhEKCXN5c3RlbV9pZBgCIAEoDRIUCgxjb21wb25lbnRfaWQYAyABKA0SNQoQZXN0aW1hdG9yX3N0YXR1cxgEIAEoCzIbLmZsaWdodHBhdGguRXN0aW1hdG9yU3RhdHVzEigKCXZpYnJhdGlvbhgFIAEoCzIVLmZsaWdodHBhdGguVmlicmF0aW9uEhkKEWVzdGltYXRvcl9oZWFsdGh5GAYgASgIIooCCg9Fc3RpbWF0b3JTdGF0dXMSEQoJdGltZV91c2VjGAEgASgEEi8KBWZsYWdzGAIgASgLMiAuZmxpZ2h0cGF0aC5Fc3RpbWF0b3JTdGF0dXNGbGFncxIRCgl2ZWxfcmF0aW8YAyABKAISFwoPcG9zX2hvcml6X3JhdGlvGAQgASgCEhYKDnBvc192ZXJ0X3JhdGlvGAUgASgCEhEKCW1hZ19yYXRpbxgGIAEoAhISCgpoYWdsX3JhdGlvGAcgASgCEhEKCXRhc19yYXRpbxgIIAEoAhIaChJwb3NfaG9yaXpfYWNjdXJhY3kYCSABKAISGQoRcG9zX3ZlcnRfYWNjdXJhY3kYCiABKAIiqgIKFEVzdGltYXRvclN0YXR1c0ZsYWdzEhAKCGF0dGl0dWRlGAEgASgIEhYKDnZlbG9jaXR5X2hvcml6GAIgASgIEhUKDXZlbG9jaXR5X3ZlcnQYAyABKAgSFQoNcG9zX2hvcml6X3JlbBgEIAEoCBIVCg1wb3NfaG9yaXpfYWJzGAUgASgIEhQKDHBvc192ZXJ0X2FicxgGIAEoCBIUCgxwb3NfdmVydF9hZ2wYByABKAgSFgoOY29uc3RfcG9zX21vZGUYCCABKAgSGgoScHJlZF9wb3NfaG9yaXpfcmVsGAkgASgIEhoKEnByZWRfcG9zX2hvcml6X2FicxgKIAEoCBISCgpncHNfZ2xpdGNoGAsgASgIEhMKC2FjY2VsX2Vycm9yGAwgASgIIpYBCglWaWJyYXRpb24SEQoJdGltZV91c2VjGAEgASgEEhMKC3ZpYnJhdGlvbl94GAIgASgCEhMKC3ZpYnJhdGlvbl95GAMgASgCEhMKC3ZpYnJhdGlvbl96GAQgASgCEhEKCWNsaXBwaW5nMBgFIAEoDRIRCgljbGlwcGluZzEYBiABKA0SEQoJY2xpcHBpbmcyGAcgASgNIhYKFFN1YnNjcmliZVdpbmRSZXF1ZXN0Ip0BChVTdWJzY3JpYmVXaW5kUmVzcG9uc2USFAoMdGltZXN0YW1wX21zGAEgASgDEhEKCXN5c3RlbV9pZBgCIAEoDRIUCgxjb21wb25lbnRfaWQYAyABKA0SHgoEd2luZBgEIAEoCzIQLmZsaWdodHBhdGguV2luZBIlCgh3aW5kX2NvdhgFIAEoCzITLmZsaWdodHBhdGguV2luZENvdiJQCgRXaW5kEg0KBXNwZWVkGAEgASgCEhEKCWRpcmVjdGlvbhgCIAEoAhIXCgpzcGVlZF9kb3duGAMgASgCSACIAQFCDQoLX3NwZWVkX2Rvd24isgEKB1dpbmRDb3YSEQoJdGltZV91c2VjGAEgASgEEg4KBndpbmRfeBgCIAEoAhIOCgZ3aW5kX3kYAyABKAISDgoGd2luZF96GAQgASgCEhEKCXZhcl9ob3JpehgFIAEoAhIQCgh2YXJfdmVydBgGIAEoAhIQCgh3aW5kX2FsdBgHIAEoAhIWCg5ob3Jpel9hY2N1cmFjeRgIIAEoAhIVCg12ZXJ0X2FjY3VyYWN5GAkgASgCIiAKHlN1YnNjcmliZURpc3RhbmNlU2Vuc29yUmVxdWVzdCKVAQofU3Vic2NyaWJlRGlzdGFuY2VTZW5zb3JSZXNwb25zZRIUCgx0aW1lc3RhbXBfbXMYASABKAMSEQoJc3lzdGVtX2lkGAIgASgNEhQKDGNvbXBvbmVudF9pZBgDIAEoDRIzCg9kaXN0YW5jZV9zZW5zb3IYBCABKAsyGi5mbGlnaHRwYXRoLkRpc3RhbmNlU2Vuc29yIsoCCg5EaXN0YW5jZVNlbnNvchIUCgx0aW1lX2Jvb3RfbXMYASABKA0SFAoMbWluX2Rpc3RhbmNlGAIgASgNEhQKDG1heF9kaXN0YW5jZRgDIAEoDRIYChBjdXJyZW50X2Rpc3RhbmNlGAQgASgNEisKBHR5cGUYBSABKA4yHS5mbGlnaHRwYXRoLk1hdkRpc3RhbmNlU2Vuc29yEgoKAmlkGAYgASgNEjUKC29yaWVudGF0aW9uGAcgASgOMiAuZmxpZ2h0cGF0aC5NYXZTZW5zb3JPcmllbnRhdGlvbhISCgpjb3ZhcmlhbmNlGAggASgNEhYKDmhvcml6b250YWxfZm92GAkgASgCEhQKDHZlcnRpY2FsX2ZvdhgKIAEoAhISCgpxdWF0ZXJuaW9uGAsgAygCEhYKDnNpZ25hbF9xdWFsaXR5GAwgASgNIiIKIFN1YnNjcmliZU9ic3RhY2xlRGlzdGFuY2VSZXF1ZXN0IsgBCiFTdWJzY3JpYmVPYnN0YWNsZURpc3RhbmNlUmVzcG9uc2USFAoMdGltZXN0YW1wX21zGAEgASgDEhEKCXN5c3RlbV9pZBgCIAEoDRIUCgxjb21wb25lbnRfaWQYAyABKA0SKwoHc2VjdG9ycxgEIAMoCzIaLmZsaWdodHBhdGguT2JzdGFjbGVTZWN0b3ISNwoRb2JzdGFjbGVfZGlzdGFuY2UYBSABKAsyHC5mbGlnaHRwYXRoLk9ic3RhY2xlRGlzdGFuY2UiQwoOT2JzdGFjbGVTZWN0b3ISDQoFYW5nbGUYASABKAISFQoIZGlzdGFuY2UYAiABKAJIAIgBAUILCglfZGlzdGFuY2Ui+wEKEE9ic3RhY2xlRGlzdGFuY2USEQoJdGltZV91c2VjGAEgASgEEjIKC3NlbnNvcl90eXBlGAIgASgOMh0uZmxpZ2h0cGF0aC5NYXZEaXN0YW5jZVNlbnNvchIRCglkaXN0YW5jZXMYAyADKA0SEQoJaW5jcmVtZW50GAQgASgNEhQKDG1pbl9kaXN0YW5jZRgFIAEoDRIUCgxtYXhfZGlzdGFuY2UYBiABKA0SEwoLaW5jcmVtZW50X2YYByABKAISFAoMYW5nbGVfb2Zmc2V0GAggASgCEiMKBWZyYW1lGAkgASgOMhQuZmxpZ2h0cGF0aC5NYXZGcmFtZSIeChxTdWJzY3JpYmVHcHNSZWNlaXZlcnNSZXF1ZXN0IvIBCh1TdWJzY3JpYmVHcHNSZWNlaXZlcnNSZXNwb25zZRIUCgx0aW1lc3RhbXBfbXMYASABKAMSEQoJc3lzdGVtX2lkGAIgASgNEhQKDGNvbXBvbmVudF9pZBgDIAEoDRIUCgxncHNfaW5zdGFuY2UYBCABKA0SKQoIcmVjZWl2ZXIYBSABKAsyFy5mbGlnaHRwYXRoLkdwc1JlY2VpdmVyEioKC2dwc19yYXdfaW50GAYgASgLMhUuZmxpZ2h0cGF0aC5HcHNSYXdJbnQSJQoIZ3BzMl9yYXcYByABKAsyEy5mbGlnaHRwYXRoLkdwczJSYXci5AMKC0dwc1JlY2VpdmVyEigKCGZpeF90eXBlGAEgASgOMhYuZmxpZ2h0cGF0aC5HcHNGaXhUeXBlEhAKCGxhdGl0dWRlGAIgASgBEhEKCWxvbmdpdHVkZRgDIAEoARIQCghhbHRpdHVkZRgEIAEoAhIRCgRoZG9wGAUgASgCSACIAQESEQoEdmRvcBgGIAEoAkgBiAEBEhkKDGdyb3VuZF9zcGVlZBgHIAEoAkgCiAEBEhMKBmNvdXJzZRgIIAEoAkgDiAEBEh8KEnNhdGVsbGl0ZXNfdmlzaWJsZRgJIAEoDUgEiAEBEiAKE2hvcml6b250YWxfYWNjdXJhY3kYCiABKAJIBYgBARIeChF2ZXJ0aWNhbF9hY2N1cmFjeRgLIAEoAkgGiAEBEhsKDnNwZWVkX2FjY3VyYWN5GAwgASgCSAeIAQESEAoDeWF3GA0gASgCSAiIAQFCBwoFX2hkb3BCBwoFX3Zkb3BCDwoNX2dyb3VuZF9zcGVlZEIJCgdfY291cnNlQhUKE19zYXRlbGxpdGVzX3Zpc2libGVCFgoUX2hvcml6b250YWxfYWNjdXJhY3lCFAoSX3ZlcnRpY2FsX2FjY3VyYWN5QhEKD19zcGVlZF9hY2N1cmFjeUIGCgRfeWF3IscCCgdHcHMyUmF3EhEKCXRpbWVfdXNlYxgBIAEoBBIoCghmaXhfdHlwZRgCIAEoDjIWLmZsaWdodHBhdGguR3BzRml4VHlwZRILCgNsYXQYAyABKAUSCwoDbG9uGAQgASgFEgsKA2FsdBgFIAEoBRILCgNlcGgYBiABKA0SCwoDZXB2GAcgASgNEgsKA3ZlbBgIIAEoDRILCgNjb2cYCSABKA0SGgoSc2F0ZWxsaXRlc192aXNpYmxlGAogASgNEhIKCmRncHNfbnVtY2gYCyABKA0SEAoIZGdwc19hZ2UYDCABKA0SCwoDeWF3GA0gASgNEhUKDWFsdF9lbGxpcHNvaWQYDiABKAUSDQoFaF9hY2MYDyABKA0SDQoFdl9hY2MYECABKA0SDwoHdmVsX2FjYxgRIAEoDRIPCgdoZGdfYWNjGBIgASgNIhsKGVN1YnNjcmliZUdwc1N0YXR1c1JlcXVlc3QitAEKGlN1YnNjcmliZUdwc1N0YXR1c1Jlc3BvbnNlEhQKDHRpbWVzdGFtcF9tcxgBIAEoAxIRCglzeXN0ZW1faWQYAiABKA0SFAoMY29tcG9uZW50X2lkGAMgASgNEiwKCnNhdGVsbGl0ZXMYBCADKAsyGC5mbGlnaHRwYXRoLkdwc1NhdGVsbGl0ZRIpCgpncHNfc3RhdHVzGAUgASgLMhUuZmxpZ2h0cGF0aC5HcHNTdGF0dXMiWgoMR3BzU2F0ZWxsaXRlEgsKA3BybhgBIAEoDRIMCgR1c2VkGAIgASgIEhEKCWVsZXZhdGlvbhgDIAEoDRIPCgdhemltdXRoGAQgASgCEgsKA3NuchgFIAEoDSKlAQoJR3BzU3RhdHVzEhoKEnNhdGVsbGl0ZXNfdmlzaWJsZRgBIAEoDRIVCg1zYXRlbGxpdGVfcHJuGAIgAygNEhYKDnNhdGVsbGl0ZV91c2VkGAMgAygNEhsKE3NhdGVsbGl0ZV9lbGV2YXRpb24YBCADKA0SGQoRc2F0ZWxsaXRlX2F6aW11dGgYBSADKA0SFQoNc2F0ZWxsaXRlX3NuchgGIAMoDSIYChZTdWJzY3JpYmVHcHNSdGtSZXF1ZXN0Ir4BChdTdWJzY3JpYmVHcHNSdGtSZXNwb25zZRIUCgx0aW1lc3RhbXBfbXMYASABKAMSEQoJc3lzdGVtX2lkGAIgASgNEhQKDGNvbXBvbmVudF9pZBgDIAEoDRIUCgxncHNfaW5zdGFuY2UYBCABKA0SKQoIYmFzZWxpbmUYBSABKAsyFy5mbGlnaHRwYXRoLlJ0a0Jhc2VsaW5lEiMKB2dwc19ydGsYBiABKAsyEi5mbGlnaHRwYXRoLkdwc1J0ayKCAQoLUnRrQmFzZWxpbmUSQgoRY29vcmRpbmF0ZV9zeXN0ZW0YASABKA4yJy5mbGlnaHRwYXRoLlJ0a0Jhc2VsaW5lQ29vcmRpbmF0ZVN5c3RlbRIJCgFhGAIgASgCEgkKAWIYAyABKAISCQoBYxgEIAEoAhIOCgZsZW5ndGgYBSABKAIiyAIKBkdwc1J0axIdChV0aW1lX2xhc3RfYmFzZWxpbmVfbXMYASABKA0SFwoPcnRrX3JlY2VpdmVyX2lkGAIgASgNEgoKAnduGAMgASgNEgsKA3RvdxgEIAEoDRISCgpydGtfaGVhbHRoGAUgASgNEhAKCHJ0a19yYXRlGAYgASgNEg0KBW5zYXRzGAcgASgNEkUKFGJhc2VsaW5lX2Nvb3Jkc190eXBlGAggASgOMicuZmxpZ2h0cGF0aC5SdGtCYXNlbGluZUNvb3JkaW5hdGVTeXN0ZW0SFQoNYmFzZWxpbmVfYV9tbRgJIAEoBRIVCg1iYXNlbGluZV9iX21tGAogASgFEhUKDWJhc2VsaW5lX2NfbW0YCyABKAUSEAoIYWNjdXJhY3kYDCABKA0SGgoSaWFyX251bV9oeXBvdGhlc2VzGA0gASgFIjcKF1N1YnNjcmliZVRyYWZmaWNSZXF1ZXN0EhwKFGluY2x1ZGVfYWxsX2VtaXR0ZXJzGAEgASgIIvUBChhTdWJzY3JpYmVUcmFmZmljUmVzcG9uc2USFAoMdGltZXN0YW1wX21zGAEgASgDEhEKCXN5c3RlbV9pZBgCIAEoDRIUCgxjb21wb25lbnRfaWQYAyABKA0SJwoFdHJhY2sYBCABKAsyGC5mbGlnaHRwYXRoLlRyYWZmaWNUcmFjaxIxCgpzZXBhcmF0aW9uGAUgASgLMh0uZmxpZ2h0cGF0aC5UcmFmZmljU2VwYXJhdGlvbhIPCgdleHBpcmVkGAYgASgIEi0KDGFkc2JfdmVoaWNsZRgHIAEoCzIXLmZsaWdodHBhdGguQWRzYlZlaGljbGUi+AMKDFRyYWZmaWNUcmFjaxIUCgxpY2FvX2FkZHJlc3MYASABKA0SFQoIY2FsbHNpZ24YAiABKAlIAIgBARITCgZzcXVhd2sYAyABKA1IAYgBARIxCgxlbWl0dGVyX3R5cGUYBCABKA4yGy5mbGlnaHRwYXRoLkFkc2JFbWl0dGVyVHlwZRIVCghsYXRpdHVkZRgFIAEoAUgCiAEBEhYKCWxvbmdpdHVkZRgGIAEoAUgDiAEBEhUKCGFsdGl0dWRlGAcgASgCSASIAQESMwoNYWx0aXR1ZGVfdHlwZRgIIAEoDjIcLmZsaWdodHBhdGguQWRzYkFsdGl0dWRlVHlwZRIUCgdoZWFkaW5nGAkgASgCSAWIAQESHQoQaG9yaXpvbnRhbF9zcGVlZBgKIAEoAkgGiAEBEhsKDnZlcnRpY2FsX3NwZWVkGAsgASgCSAeIAQESEQoJc2ltdWxhdGVkGAwgASgIEh8KF3RpbWVfc2luY2VfbGFzdF9jb250YWN0GA0gASgNQgsKCV9jYWxsc2lnbkIJCgdfc3F1YXdrQgsKCV9sYXRpdHVkZUIMCgpfbG9uZ2l0dWRlQgsKCV9hbHRpdHVkZUIKCghfaGVhZGluZ0ITChFfaG9yaXpvbnRhbF9zcGVlZEIRCg9fdmVydGljYWxfc3BlZWQipwEKEVRyYWZmaWNTZXBhcmF0aW9uEhsKE2hvcml6b250YWxfZGlzdGFuY2UYASABKAISDwoHYmVhcmluZxgCIAEoAhIgChN2ZXJ0aWNhbF9zZXBhcmF0aW9uGAMgASgCSACIAQESGQoMY2xvc2luZ19yYXRlGAQgASgCSAGIAQFCFgoUX3ZlcnRpY2FsX3NlcGFyYXRpb25CDwoNX2Nsb3NpbmdfcmF0ZSKzAgoLQWRzYlZlaGljbGUSFAoMaWNhb19hZGRyZXNzGAEgASgNEgsKA2xhdBgCIAEoBRILCgNsb24YAyABKAUSMwoNYWx0aXR1ZGVfdHlwZRgEIAEoDjIcLmZsaWdodHBhdGguQWRzYkFsdGl0dWRlVHlwZRIQCghhbHRpdHVkZRgFIAEoBRIPCgdoZWFkaW5nGAYgASgNEhQKDGhvcl92ZWxvY2l0eRgHIAEoDRIUCgx2ZXJfdmVsb2NpdHkYCCABKAUSEAoIY2FsbHNpZ24YCSABKAkSMQoMZW1pdHRlcl90eXBlGAogASgOMhsuZmxpZ2h0cGF0aC5BZHNiRW1pdHRlclR5cGUSDAoEdHNsYxgLIAEoDRINCgVmbGFncxgMIAEoDRIOCgZzcXVhd2sYDSABKA0iQgocU3Vic2NyaWJlVmVoaWNsZVN0YXRlUmVxdWVzdBIPCgdyYXRlX2h6GAEgASgCEhEKCXN5c3RlbV9pZBgCIAEoDSJ5Ch1TdWJzY3JpYmVWZWhpY2xlU3RhdGVSZXNwb25zZRIUCgx0aW1lc3RhbXBfbXMYASABKAMSEQoJc3lzdGVtX2lkGAIgASgNEi8KDXZlaGljbGVfc3RhdGUYAyABKAsyGC5mbGlnaHRwYXRoLlZlaGljbGVTdGF0ZSLdAgoMVmVoaWNsZVN0YXRlEhcKD2xhc3RfbWVzc2FnZV9tcxgBIAEoAxIoCgloZWFydGJlYXQYAiABKAsyFS5mbGlnaHRwYXRoLkhlYXJ0YmVhdBINCgVhcm1lZBgDIAEoCBIrCgtmbGlnaHRfbW9kZRgEIAEoCzIWLmZsaWdodHBhdGguQ3VzdG9tTW9kZRImCghwb3NpdGlvbhgFIAEoCzIULmZsaWdodHBhdGguUG9zaXRpb24SJgoIYXR0aXR1ZGUYBiABKAsyFC5mbGlnaHRwYXRoLkF0dGl0dWRlEiYKCWJhdHRlcmllcxgHIAMoCzITLmZsaWdodHBhdGguQmF0dGVyeRIkCgNncHMYCCABKAsyFy5mbGlnaHRwYXRoLkdwc1JlY2VpdmVyEjAKDGxhbmRlZF9zdGF0ZRgJIAEoDjIaLmZsaWdodHBhdGguTWF2TGFuZGVkU3RhdGUibgoGVmZySHVkEhAKCGFpcnNwZWVkGAEgASgCEhMKC2dyb3VuZHNwZWVkGAIgASgCEg8KB2hlYWRpbmcYAyABKAUSEAoIdGhyb3R0bGUYBCABKA0SCwoDYWx0GAUgASgCEg0KBWNsaW1iGAYgASgCIpcBChFHbG9iYWxQb3NpdGlvbkludBIUCgx0aW1lX2Jvb3RfbXMYASABKA0SCwoDbGF0GAIgASgFEgsKA2xvbhgDIAEoBRILCgNhbHQYBCABKAUSFAoMcmVsYXRpdmVfYWx0GAUgASgFEgoKAnZ4GAYgASgFEgoKAnZ5GAcgASgFEgoKAnZ6GAggASgFEgsKA2hkZxgJIAEoDSI0ChtTdWJzY3JpYmVMYW5kZWRTdGF0ZVJlcXVlc3QSFQoNZXZlcnlfbWVzc2FnZRgBIAEoCCKXAQocU3Vic2NyaWJlTGFuZGVkU3RhdGVSZXNwb25zZRIUCgx0aW1lc3RhbXBfbXMYASABKAMSEQoJc3lzdGVtX2lkGAIgASgNEhQKDGNvbXBvbmVudF9pZBgDIAEoDRI4ChJleHRlbmRlZF9zeXNfc3RhdGUYBCABKAsyHC5mbGlnaHRwYXRoLkV4dGVuZGVkU3lzU3RhdGUicgoQRXh0ZW5kZWRTeXNTdGF0ZRIsCgp2dG9sX3N0YXRlGAEgASgOMhguZmxpZ2h0cGF0aC5NYXZWdG9sU3RhdGUSMAoMbGFuZGVkX3N0YXRlGAIgASgOMhouZmxpZ2h0cGF0aC5NYXZMYW5kZWRTdGF0ZSpxCg1CYXR0ZXJ5U291cmNlEh4KGkJBVFRFUllfU09VUkNFX1VOU1BFQ0lGSUVEEAASIQodQkFUVEVSWV9TT1VSQ0VfQkFUVEVSWV9TVEFUVVMQARIdChlCQVRURVJZX1NPVVJDRV9TWVNfU1RBVFVTEAIqfQoQQWRzYkFsdGl0dWRlVHlwZRIiCh5BRFNCX0FMVElUVURFX1RZUEVfVU5TUEVDSUZJRUQQABIjCh9BRFNCX0FMVElUVURFX1RZUEVfUFJFU1NVUkVfUU5IEAESIAocQURTQl9BTFRJVFVERV9UWVBFX0dFT01FVFJJQxACKtAFCg9BZHNiRW1pdHRlclR5cGUSIQodQURTQl9FTUlUVEVSX1RZUEVfVU5TUEVDSUZJRUQQABIdChlBRFNCX0VNSVRURVJfVFlQRV9OT19JTkZPEAESGwoXQURTQl9FTUlUVEVSX1RZUEVfTElHSFQQAhIbChdBRFNCX0VNSVRURVJfVFlQRV9TTUFMTBADEhsKF0FEU0JfRU1JVFRFUl9UWVBFX0xBUkdFEAQSJwojQURTQl9FTUlUVEVSX1RZUEVfSElHSF9WT1JURVhfTEFSR0UQBRIbChdBRFNCX0VNSVRURVJfVFlQRV9IRUFWWRAGEiIKHkFEU0JfRU1JVFRFUl9UWVBFX0hJR0hMWV9NQU5VVhAHEh8KG0FEU0JfRU1JVFRFUl9UWVBFX1JPVE9DUkFGVBAIEiAKHEFEU0JfRU1JVFRFUl9UWVBFX1VOQVNTSUdORUQQCRIcChhBRFNCX0VNSVRURVJfVFlQRV9HTElERVIQChIhCh1BRFNCX0VNSVRURVJfVFlQRV9MSUdIVEVSX0FJUhALEh8KG0FEU0JfRU1JVFRFUl9UWVBFX1BBUkFDSFVURRAMEiEKHUFEU0JfRU1JVFRFUl9UWVBFX1VMVFJBX0xJR0hUEA0SIQodQURTQl9FTUlUVEVSX1RZUEVfVU5BU1NJR05FRDIQDhIZChVBRFNCX0VNSVRURVJfVFlQRV9VQVYQDxIbChdBRFNCX0VNSVRURVJfVFlQRV9TUEFDRRAQEiEKHUFEU0JfRU1JVFRFUl9UWVBFX1VOQVNTR0lORUQzEBESJwojQURTQl9FTUlUVEVSX1RZUEVfRU1FUkdFTkNZX1NVUkZBQ0UQEhIlCiFBRFNCX0VNSVRURVJfVFlQRV9TRVJWSUNFX1NVUkZBQ0UQExIkCiBBRFNCX0VNSVRURVJfVFlQRV9QT0lOVF9PQlNUQUNMRRAUKowCCgpHcHNGaXhUeXBlEhwKGEdQU19GSVhfVFlQRV9VTlNQRUNJRklFRBAAEhcKE0dQU19GSVhfVFlQRV9OT19HUFMQARIXChNHUFNfRklYX1RZUEVfTk9fRklYEAISFwoTR1BTX0ZJWF9UWVBFXzJEX0ZJWBADEhcKE0dQU19GSVhfVFlQRV8zRF9GSVgQBBIVChFHUFNfRklYX1RZUEVfREdQUxAFEhoKFkdQU19GSVhfVFlQRV9SVEtfRkxPQVQQBhIaChZHUFNfRklYX1RZUEVfUlRLX0ZJWEVEEAcSFwoTR1BTX0ZJWF9UWVBFX1NUQVRJQxAIEhQKEEdQU19GSVhfVFlQRV9QUFAQCSqfEQoUTWF2U2Vuc29yT3JpZW50YXRpb24SJgoiTUFWX1NFTlNPUl9PUklFTlRBVElPTl9VTlNQRUNJRklFRBAAEigKJE1BVl9TRU5TT1JfT1JJRU5UQVRJT05fUk9UQVRJT05fTk9ORRABEioKJk1BVl9TRU5TT1JfT1JJRU5UQVRJT05fUk9UQVRJT05fWUFXXzQ1EAISKgomTUFWX1NFTlNPUl9PUklFTlRBVElPTl9ST1RBVElPTl9ZQVdfOTAQAxIrCidNQVZfU0VOU09SX09SSUVOVEFUSU9OX1JPVEFUSU9OX1lBV18xMzUQBBIrCidNQVZfU0VOU09SX09SSUVOVEFUSU9OX1JPVEFUSU9OX1lBV18xODAQBRIrCidNQVZfU0VOU09SX09SSUVOVEFUSU9OX1JPVEFUSU9OX1lBV18yMjUQBhIrCidNQVZfU0VOU09SX09SSUVOVEFUSU9OX1JPVEFUSU9OX1lBV18yNzAQBxIrCidNQVZfU0VOU09SX09SSUVOVEFUSU9OX1JPVEFUSU9OX1lBV18zMTUQCBIsCihNQVZfU0VOU09SX09SSUVOVEFUSU9OX1JPVEFUSU9OX1JPTExfMTgwEAkSMwovTUFWX1NFTlNPUl9PUklFTlRBVElPTl9ST1RBVElPTl9ST0xMXzE4MF9ZQVdfNDUQChIzCi9NQVZfU0VOU09SX09SSUVOVEFUSU9OX1JPVEFUSU9OX1JPTExfMTgwX1lBV185MBALEjQKME1BVl9TRU5TT1JfT1JJRU5UQVRJT05fUk9UQVRJT05fUk9MTF8xODBfWUFXXzEzNRAMEi0KKU1BVl9TRU5TT1JfT1JJRU5UQVRJT05fUk9UQVRJT05fUElUQ0hfMTgwEA0SNAowTUFWX1NFTlNPUl9PUklFTlRBVElPTl9ST1RBVElPTl9ST0xMXzE4MF9ZQVdfMjI1EA4SNAowTUFWX1NFTlNPUl9PUklFTlRBVElPTl9ST1RBVElPTl9ST0xMXzE4MF9ZQVdfMjcwEA8SNAowTUFWX1NFTlNPUl9PUklFTlRBVElPTl9ST1RBVElPTl9ST0xMXzE4MF9ZQVdfMzE1EBASKwonTUFWX1NFTlNPUl9PUklFTlRBVElPTl9ST1RBVElPTl9ST0xMXzkwEBESMgouTUFWX1NFTlNPUl9PUklFTlRBVElPTl9ST1RBVElPTl9ST0xMXzkwX1lBV180NRASEjIKLk1BVl9TRU5TT1JfT1JJRU5UQVRJT05fUk9UQVRJT05fUk9MTF85MF9ZQVdfOTAQExIzCi9NQVZfU0VOU09SX09SSUVOVEFUSU9OX1JPVEFUSU9OX1JPTExfOTBfWUFXXzEzNRAUEiwKKE1BVl9TRU5TT1JfT1JJRU5UQVRJT05fUk9UQVRJT05fUk9MTF8yNzAQFRIzCi9NQVZfU0VOU09SX09SSUVOVEFUSU9OX1JPVEFUSU9OX1JPTExfMjcwX1lBV180NRAWEjMKL01BVl9TRU5TT1JfT1JJRU5UQVRJT05fUk9UQVRJT05fUk9MTF8yNzBfWUFXXzkwEBcSNAowTUFWX1NFTlNPUl9PUklFTlRBVElPTl9ST1RBVElPTl9ST0xMXzI3MF9ZQVdfMTM1EBgSLAooTUFWX1NFTlNPUl9PUklFTlRBVElPTl9ST1RBVElPTl9QSVRDSF85MBAZEi0KKU1BVl9TRU5TT1JfT1JJRU5UQVRJT05fUk9UQVRJT05fUElUQ0hfMjcwEBoSNAowTUFWX1NFTlNPUl9PUklFTlRBVElPTl9ST1RBVElPTl9QSVRDSF8xODBfWUFXXzkwEBsSNQoxTUFWX1NFTlNPUl9PUklFTlRBVElPTl9ST1RBVElPTl9QSVRDSF8xODBfWUFXXzI3MBAcEjQKME1BVl9TRU5TT1JfT1JJRU5UQVRJT05fUk9UQVRJT05fUk9MTF85MF9QSVRDSF85MBAdEjUKMU1BVl9TRU5TT1JfT1JJRU5UQVRJT05fUk9UQVRJT05fUk9MTF8xODBfUElUQ0hfOTAQHhI1CjFNQVZfU0VOU09SX09SSUVOVEFUSU9OX1JPVEFUSU9OX1JPTExfMjcwX1BJVENIXzkwEB8SNQoxTUFWX1NFTlNPUl9PUklFTlRBVElPTl9ST1RBVElPTl9ST0xMXzkwX1BJVENIXzE4MBAgEjYKMk1BVl9TRU5TT1JfT1JJRU5UQVRJT05fUk9UQVRJT05fUk9MTF8yNzBfUElUQ0hfMTgwECESNQoxTUFWX1NFTlNPUl9PUklFTlRBVElPTl9ST1RBVElPTl9ST0xMXzkwX1BJVENIXzI3MBAiEjYKMk1BVl9TRU5TT1JfT1JJRU5UQVRJT05fUk9UQVRJT05fUk9MTF8xODBfUElUQ0hfMjcwECMSNgoyTUFWX1NFTlNPUl9PUklFTlRBVElPTl9ST1RBVElPTl9ST0xMXzI3MF9QSVRDSF8yNzAQJBI8CjhNQVZfU0VOU09SX09SSUVOVEFUSU9OX1JPVEFUSU9OX1JPTExfOTBfUElUQ0hfMTgwX1lBV185MBAlEjMKL01BVl9TRU5TT1JfT1JJRU5UQVRJT05fUk9UQVRJT05fUk9MTF85MF9ZQVdfMjcwECYSPAo4TUFWX1NFTlNPUl9PUklFTlRBVElPTl9ST1RBVElPTl9ST0xMXzkwX1BJVENIXzY4X1lBV18yOTMQJxItCilNQVZfU0VOU09SX09SSUVOVEFUSU9OX1JPVEFUSU9OX1BJVENIXzMxNRAoEjUKMU1BVl9TRU5TT1JfT1JJRU5UQVRJT05fUk9UQVRJT05fUk9MTF85MF9QSVRDSF8zMTUQKRIqCiZNQVZfU0VOU09SX09SSUVOVEFUSU9OX1JPVEFUSU9OX0NVU1RPTRBlKvQBCgtNYXZTZXZlcml0eRIcChhNQVZfU0VWRVJJVFlfVU5TUEVDSUZJRUQQABIaChZNQVZfU0VWRVJJVFlfRU1FUkdFTkNZEAESFgoSTUFWX1NFVkVSSVRZX0FMRVJUEAISGQoVTUFWX1NFVkVSSVRZX0NSSVRJQ0FMEAMSFgoSTUFWX1NFVkVSSVRZX0VSUk9SEAQSGAoUTUFWX1NFVkVSSVRZX1dBUk5JTkcQBRIXChNNQVZfU0VWRVJJVFlfTk9USUNFEAYSFQoRTUFWX1NFVkVSSVRZX0lORk8QBxIWChJNQVZfU0VWRVJJVFlfREVCVUcQCCrEAQoMTWF2VnRvbFN0YXRlEh4KGk1BVl9WVE9MX1NUQVRFX1VOU1BFQ0lGSUVEEAASHAoYTUFWX1ZUT0xfU1RBVEVfVU5ERUZJTkVEEAESIwofTUFWX1ZUT0xfU1RBVEVfVFJBTlNJVElPTl9UT19GVxACEiMKH01BVl9WVE9MX1NUQVRFX1RSQU5TSVRJT05fVE9fTUMQAxIVChFNQVZfVlRPTF9TVEFURV9NQxAEEhUKEU1BVl9WVE9MX1NUQVRFX0ZXEAUqywEKDk1hdkxhbmRlZFN0YXRlEiAKHE1BVl9MQU5ERURfU1RBVEVfVU5TUEVDSUZJRUQQABIeChpNQVZfTEFOREVEX1NUQVRFX1VOREVGSU5FRBABEh4KGk1BVl9MQU5ERURfU1RBVEVfT05fR1JPVU5EEAISGwoXTUFWX0xBTkRFRF9TVEFURV9JTl9BSVIQAxIcChhNQVZfTEFOREVEX1NUQVRFX1RBS0VPRkYQBBIcChhNQVZfTEFOREVEX1NUQVRFX0xBTkRJTkcQBSrvAgoVTWF2QmF0dGVyeUNoYXJnZVN0YXRlEigKJE1BVl9CQVRURVJZX0NIQVJHRV9TVEFURV9VTlNQRUNJRklFRBAAEiYKIk1BVl9CQVRURVJZX0NIQVJHRV9TVEFURV9VTkRFRklORUQQARIfChtNQVZfQkFUVEVSWV9DSEFSR0VfU1RBVEVfT0sQAhIgChxNQVZfQkFUVEVSWV9DSEFSR0VfU1RBVEVfTE9XEAMSJQohTUFWX0JBVFRFUllfQ0hBUkdFX1NUQVRFX0NSSVRJQ0FMEAQSJgoiTUFWX0JBVFRFUllfQ0hBUkdFX1NUQVRFX0VNRVJHRU5DWRAFEiMKH01BVl9CQVRURVJZX0NIQVJHRV9TVEFURV9GQUlMRUQQBhImCiJNQVZfQkFUVEVSWV9DSEFSR0VfU1RBVEVfVU5IRUFMVEhZEAcSJQohTUFWX0JBVFRFUllfQ0hBUkdFX1NUQVRFX0NIQVJHSU5HEAgq5AEKEk1hdkJhdHRlcnlGdW5jdGlvbhIkCiBNQVZfQkFUVEVSWV9GVU5DVElPTl9VTlNQRUNJRklFRBAAEiAKHE1BVl9CQVRURVJZX0ZVTkNUSU9OX1VOS05PV04QARIcChhNQVZfQkFUVEVSWV9GVU5DVElPTl9BTEwQAhIjCh9NQVZfQkFUVEVSWV9GVU5DVElPTl9QUk9QVUxTSU9OEAMSIQodTUFWX0JBVFRFUllfRlVOQ1RJT05fQVZJT05JQ1MQBBIgChxNQVZfQkFUVEVSWV9GVU5DVElPTl9QQVlMT0FEEAUqlgEKDk1hdkJhdHRlcnlNb2RlEiAKHE1BVl9CQVRURVJZX01PREVfVU5TUEVDSUZJRUQQABIcChhNQVZfQkFUVEVSWV9NT0RFX1VOS05PV04QARIlCiFNQVZfQkFUVEVSWV9NT0RFX0FVVE9fRElTQ0hBUkdJTkcQAhIdChlNQVZfQkFUVEVSWV9NT0RFX0hPVF9TV0FQEAMqvAEKDk1hdkJhdHRlcnlUeXBlEiAKHE1BVl9CQVRURVJZX1RZUEVfVU5TUEVDSUZJRUQQABIcChhNQVZfQkFUVEVSWV9UWVBFX1VOS05PV04QARIZChVNQVZfQkFUVEVSWV9UWVBFX0xJUE8QAhIZChVNQVZfQkFUVEVSWV9UWVBFX0xJRkUQAxIZChVNQVZfQkFUVEVSWV9UWVBFX0xJT04QBBIZChVNQVZfQkFUVEVSWV9UWVBFX05JTUgQBSrdAQoRTWF2RGlzdGFuY2VTZW5zb3ISIwofTUFWX0RJU1RBTkNFX1NFTlNPUl9VTlNQRUNJRklFRBAAEh0KGU1BVl9ESVNUQU5DRV9TRU5TT1JfTEFTRVIQARIiCh5NQVZfRElTVEFOQ0VfU0VOU09SX1VMVFJBU09VTkQQAhIgChxNQVZfRElTVEFOQ0VfU0VOU09SX0lORlJBUkVEEAMSHQoZTUFWX0RJU1RBTkNFX1NFTlNPUl9SQURBUhAEEh8KG01BVl9ESVNUQU5DRV9TRU5TT1JfVU5LTk9XThAFKskCChBNYXZFc3RpbWF0b3JUeXBlEiIKHk1BVl9FU1RJTUFUT1JfVFlQRV9VTlNQRUNJRklFRBAAEh4KGk1BVl9FU1RJTUFUT1JfVFlQRV9VTktOT1dOEAESHAoYTUFWX0VTVElNQVRPUl9UWVBFX05BSVZFEAISHQoZTUFWX0VTVElNQVRPUl9UWVBFX1ZJU0lPThADEhoKFk1BVl9FU1RJTUFUT1JfVFlQRV9WSU8QBBIaChZNQVZfRVNUSU1BVE9SX1RZUEVfR1BTEAUSHgoaTUFWX0VTVElNQVRPUl9UWVBFX0dQU19JTlMQBhIcChhNQVZfRVNUSU1BVE9SX1RZUEVfTU9DQVAQBxIcChhNQVZfRVNUSU1BVE9SX1RZUEVfTElEQVIQCBIgChxNQVZfRVNUSU1BVE9SX1RZUEVfQVVUT1BJTE9UEAkq0QMKCE1hdkZyYW1lEhkKFU1BVl9GUkFNRV9VTlNQRUNJRklFRBAAEhQKEE1BVl9GUkFNRV9HTE9CQUwQARIXChNNQVZfRlJBTUVfTE9DQUxfTkVEEAISFQoRTUFWX0ZSQU1FX01JU1NJT04QAxIhCh1NQVZfRlJBTUVfR0xPQkFMX1JFTEFUSVZFX0FMVBAEEhcKE01BVl9GUkFNRV9MT0NBTF9FTlUQBRIYChRNQVZfRlJBTUVfR0xPQkFMX0lOVBAGEiUKIU1BVl9GUkFNRV9HTE9CQUxfUkVMQVRJVkVfQUxUX0lOVBAHEh4KGk1BVl9GUkFNRV9MT0NBTF9PRkZTRVRfTkVEEAgSFgoSTUFWX0ZSQU1FX0JPRFlfTkVEEAkSHQoZTUFWX0ZSQU1FX0JPRFlfT0ZGU0VUX05FRBAKEiAKHE1BVl9GUkFNRV9HTE9CQUxfVEVSUkFJTl9BTFQQCxIkCiBNQVZfRlJBTUVfR0xPQkFMX1RFUlJBSU5fQUxUX0lOVBAMEhYKEk1BVl9GUkFNRV9CT0RZX0ZSRBANEhcKE01BVl9GUkFNRV9MT0NBTF9GUkQQFRIXChNNQVZfRlJBTUVfTE9DQUxfRkxVEBYqngEKG1J0a0Jhc2VsaW5lQ29vcmRpbmF0ZVN5c3RlbRIuCipSVEtfQkFTRUxJTkVfQ09PUkRJTkFURV9TWVNURU1fVU5TUEVDSUZJRUQQABInCiNSVEtfQkFTRUxJTkVfQ09PUkRJTkFURV9TWVNURU1fRUNFRhABEiYKIlJUS19CQVNFTElORV9DT09SRElOQVRFX1NZU1RFTV9ORUQQAjLHEwoQVGVsZW1ldHJ5U2VydmljZRJcCg9TdWJzY3JpYmVSYXdHcHMSIi5mbGlnaHRwYXRoLlN1YnNjcmliZVJhd0dwc1JlcXVlc3QaIy5mbGlnaHRwYXRoLlN1YnNjcmliZVJhd0dwc1Jlc3BvbnNlMAEShgEKHVN1YnNjcmliZU9yYml0RXhlY3V0aW9uU3RhdHVzEjAuZmxpZ2h0cGF0aC5TdWJzY3JpYmVPcmJpdEV4ZWN1dGlvblN0YXR1c1JlcXVlc3QaMS5mbGlnaHRwYXRoLlN1YnNjcmliZU9yYml0RXhlY3V0aW9uU3RhdHVzUmVzcG9uc2UwARJiChFTdWJzY3JpYmVBdHRpdHVkZRIkLmZsaWdodHBhdGguU3Vic2NyaWJlQXR0aXR1ZGVSZXF1ZXN0GiUuZmxpZ2h0cGF0aC5TdWJzY3JpYmVBdHRpdHVkZVJlc3BvbnNlMAESYgoRU3Vic2NyaWJlUG9zaXRpb24SJC5mbGlnaHRwYXRoLlN1YnNjcmliZVBvc2l0aW9uUmVxdWVzdBolLmZsaWdodHBhdGguU3Vic2NyaWJlUG9zaXRpb25SZXNwb25zZTABEnEKFlN1YnNjcmliZUxvY2FsUG9zaXRpb24SKS5mbGlnaHRwYXRoLlN1YnNjcmliZUxvY2FsUG9zaXRpb25SZXF1ZXN0GiouZmxpZ2h0cGF0aC5TdWJzY3JpYmVMb2NhbFBvc2l0aW9uUmVzcG9uc2UwARJfChBTdWJzY3JpYmVCYXR0ZXJ5EiMuZmxpZ2h0cGF0aC5TdWJzY3JpYmVCYXR0ZXJ5UmVxdWVzdBokLmZsaWdodHBhdGguU3Vic2NyaWJlQmF0dGVyeVJlc3BvbnNlMAEScQoWU3Vic2NyaWJlRmxpZ2h0TWV0cmljcxIpLmZsaWdodHBhdGguU3Vic2NyaWJlRmxpZ2h0TWV0cmljc1JlcXVlc3QaKi5mbGlnaHRwYXRoLlN1YnNjcmliZUZsaWdodE1ldHJpY3NSZXNwb25zZTABEmsKFFN1YnNjcmliZUxhbmRlZFN0YXRlEicuZmxpZ2h0cGF0aC5TdWJzY3JpYmVMYW5kZWRTdGF0ZVJlcXVlc3QaKC5mbGlnaHRwYXRoLlN1YnNjcmliZUxhbmRlZFN0YXRlUmVzcG9uc2UwARJoChNTdWJzY3JpYmVTdGF0dXNUZXh0EiYuZmxpZ2h0cGF0aC5TdWJzY3JpYmVTdGF0dXNUZXh0UmVxdWVzdBonLmZsaWdodHBhdGguU3Vic2NyaWJlU3RhdHVzVGV4dFJlc3BvbnNlMAESWgoPR2V0SG9tZVBvc2l0aW9uEiIuZmxpZ2h0cGF0aC5HZXRIb21lUG9zaXRpb25SZXF1ZXN0GiMuZmxpZ2h0cGF0aC5HZXRIb21lUG9zaXRpb25SZXNwb25zZRJuChVTdWJzY3JpYmVIb21lUG9zaXRpb24SKC5mbGlnaHRwYXRoLlN1YnNjcmliZUhvbWVQb3NpdGlvblJlcXVlc3QaKS5mbGlnaHRwYXRoLlN1YnNjcmliZUhvbWVQb3NpdGlvblJlc3BvbnNlMAESaAoTU3Vic2NyaWJlUmNDaGFubmVscxImLmZsaWdodHBhdGguU3Vic2NyaWJlUmNDaGFubmVsc1JlcXVlc3QaJy5mbGlnaHRwYXRoLlN1YnNjcmliZVJjQ2hhbm5lbHNSZXNwb25zZTABEncKGFN1YnNjcmliZUFjdHVhdG9yT3V0cHV0cxIrLmZsaWdodHBhdGguU3Vic2NyaWJlQWN0dWF0b3JPdXRwdXRzUmVxdWVzdBosLmZsaWdodHBhdGguU3Vic2NyaWJlQWN0dWF0b3JPdXRwdXRzUmVzcG9uc2UwARJ3ChhTdWJzY3JpYmVFc3RpbWF0b3JTdGF0dXMSKy5mbGlnaHRwYXRoLlN1YnNjcmliZUVzdGltYXRvclN0YXR1c1JlcXVlc3QaLC5mbGlnaHRwYXRoLlN1YnNjcmliZUVzdGltYXRvclN0YXR1c1Jlc3BvbnNlMAESVgoNU3Vic2NyaWJlV2luZBIgLmZsaWdodHBhdGguU3Vic2NyaWJlV2luZFJlcXVlc3QaIS5mbGlnaHRwYXRoLlN1YnNjcmliZVdpbmRSZXNwb25zZTABEnQKF1N1YnNjcmliZURpc3RhbmNlU2Vuc29yEiouZmxpZ2h0cGF0aC5TdWJzY3JpYmVEaXN0YW5jZVNlbnNvclJlcXVlc3QaKy5mbGlnaHRwYXRoLlN1YnNjcmliZURpc3RhbmNlU2Vuc29yUmVzcG9uc2UwARJ6ChlTdWJzY3JpYmVPYnN0YWNsZURpc3RhbmNlEiwuZmxpZ2h0cGF0aC5TdWJzY3JpYmVPYnN0YWNsZURpc3RhbmNlUmVxdWVzdBotLmZsaWdodHBhdGguU3Vic2NyaWJlT2JzdGFjbGVEaXN0YW5jZVJlc3BvbnNlMAESbgoVU3Vic2NyaWJlR3BzUmVjZWl2ZXJzEiguZmxpZ2h0cGF0aC5TdWJzY3JpYmVHcHNSZWNlaXZlcnNSZXF1ZXN0GikuZmxpZ2h0cGF0aC5TdWJzY3JpYmVHcHNSZWNlaXZlcnNSZXNwb25zZTABEmUKElN1YnNjcmliZUdwc1N0YXR1cxIlLmZsaWdodHBhdGguU3Vic2NyaWJlR3BzU3RhdHVzUmVxdWVzdBomLmZsaWdodHBhdGguU3Vic2NyaWJlR3BzU3RhdHVzUmVzcG9uc2UwARJcCg9TdWJzY3JpYmVHcHNSdGsSIi5mbGlnaHRwYXRoLlN1YnNjcmliZUdwc1J0a1JlcXVlc3QaIy5mbGlnaHRwYXRoLlN1YnNjcmliZUdwc1J0a1Jlc3BvbnNlMAESbgoVU3Vic2NyaWJlU3lzdGVtU3RhdHVzEiguZmxpZ2h0cGF0aC5TdWJzY3JpYmVTeXN0ZW1TdGF0dXNSZXF1ZXN0GikuZmxpZ2h0cGF0aC5TdWJzY3JpYmVTeXN0ZW1TdGF0dXNSZXNwb25zZTABEl8KEFN1YnNjcmliZVRyYWZmaWMSIy5mbGlnaHRwYXRoLlN1YnNjcmliZVRyYWZmaWNSZXF1ZXN0GiQuZmxpZ2h0cGF0aC5TdWJzY3JpYmVUcmFmZmljUmVzcG9uc2UwARJuChVTdWJzY3JpYmVWZWhpY2xlU3RhdGUSKC5mbGlnaHRwYXRoLlN1YnNjcmliZVZlaGljbGVTdGF0ZVJlcXVlc3QaKS5mbGlnaHRwYXRoLlN1YnNjcmliZVZlaGljbGVTdGF0ZVJlc3BvbnNlMAFCqwEKDmNvbS5mbGlnaHRwYXRoQg5UZWxlbWV0cnlQcm90b1ABWkFnaXRodWIuY29tL2ZsaWdodHBhdGgtZGV2L2ZsaWdodHBhdGgvZ2VuL2dvL2ZsaWdodHBhdGg7ZmxpZ2h0cGF0aKICA0ZYWKoCCkZsaWdodHBhdGjKAgpGbGlnaHRwYXRo4gIWRmxpZ2h0cGF0aFxHUEJNZXRhZGF0YeoCCkZsaWdodHBhdGhiBnByb3RvMw", [file_flightpath_connection]);
 
 /**
  * SubscribeRawGpsRequest is the request message for SubscribeRawGps
@@ -197,69 +199,5979 @@ export type GpsRawInt = Message<"flightpath.GpsRawInt"> & {
 export const GpsRawIntSchema: GenMessage<GpsRawInt> = /*@__PURE__*/
   messageDesc(file_flightpath_telemetry, 2);
 
+/**
+ * SubscribeOrbitExecutionStatusRequest is the request message for SubscribeOrbitExecutionStatus
+ *
+ * @generated from message flightpath.SubscribeOrbitExecutionStatusRequest
+ */
+export type SubscribeOrbitExecutionStatusRequest = Message<"flightpath.SubscribeOrbitExecutionStatusRequest"> & {
+};
+
+/**
+ * Describes the message flightpath.SubscribeOrbitExecutionStatusRequest.
+ * Use `create(SubscribeOrbitExecutionStatusRequestSchema)` to create a new message.
+ */
+export const SubscribeOrbitExecutionStatusRequestSchema: GenMessage<SubscribeOrbitExecutionStatusRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 3);
+
+/**
+ * SubscribeOrbitExecutionStatusResponse contains ORBIT_EXECUTION_STATUS message data
+ *
+ * @generated from message flightpath.SubscribeOrbitExecutionStatusResponse
+ */
+export type SubscribeOrbitExecutionStatusResponse = Message<"flightpath.SubscribeOrbitExecutionStatusResponse"> & {
+  /**
+   * Timestamp when this orbit status was captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the orbit status
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the orbit status
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * True while the drone reports an orbit in progress.
+   * False once ORBIT_EXECUTION_STATUS has stopped (orbit finished or aborted), in which case
+   * orbit_execution_status holds the last reported orbit.
+   *
+   * @generated from field: bool active = 4;
+   */
+  active: boolean;
+
+  /**
+   * ORBIT_EXECUTION_STATUS message data
+   *
+   * @generated from field: flightpath.OrbitExecutionStatus orbit_execution_status = 5;
+   */
+  orbitExecutionStatus?: OrbitExecutionStatus;
+};
+
+/**
+ * Describes the message flightpath.SubscribeOrbitExecutionStatusResponse.
+ * Use `create(SubscribeOrbitExecutionStatusResponseSchema)` to create a new message.
+ */
+export const SubscribeOrbitExecutionStatusResponseSchema: GenMessage<SubscribeOrbitExecutionStatusResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 4);
+
+/**
+ * OrbitExecutionStatus represents the ORBIT_EXECUTION_STATUS MAVLink message
+ * Vehicle status report that is sent out while orbit execution is in progress (see MAV_CMD_DO_ORBIT).
+ *
+ * @generated from message flightpath.OrbitExecutionStatus
+ */
+export type OrbitExecutionStatus = Message<"flightpath.OrbitExecutionStatus"> & {
+  /**
+   * Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
+   *
+   * @generated from field: uint64 time_usec = 1;
+   */
+  timeUsec: bigint;
+
+  /**
+   * Radius of the orbit circle. Positive values orbit clockwise, negative values orbit counter-clockwise. (m)
+   *
+   * @generated from field: float radius = 2;
+   */
+  radius: number;
+
+  /**
+   * The coordinate system of the fields: x, y, z
+   *
+   * @generated from field: flightpath.MavFrame frame = 3;
+   */
+  frame: MavFrame;
+
+  /**
+   * X coordinate of center point. Coordinate system depends on frame field: local = x position in meters * 1e4, global = latitude in degrees * 1e7.
+   *
+   * @generated from field: int32 x = 4;
+   */
+  x: number;
+
+  /**
+   * Y coordinate of center point. Coordinate system depends on frame field: local = y position in meters * 1e4, global = longitude in degrees * 1e7.
+   *
+   * @generated from field: int32 y = 5;
+   */
+  y: number;
+
+  /**
+   * Altitude of center point. Coordinate system depends on frame field. (m)
+   *
+   * @generated from field: float z = 6;
+   */
+  z: number;
+};
+
+/**
+ * Describes the message flightpath.OrbitExecutionStatus.
+ * Use `create(OrbitExecutionStatusSchema)` to create a new message.
+ */
+export const OrbitExecutionStatusSchema: GenMessage<OrbitExecutionStatus> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 5);
+
+/**
+ * SubscribeAttitudeRequest is the request message for SubscribeAttitude
+ *
+ * @generated from message flightpath.SubscribeAttitudeRequest
+ */
+export type SubscribeAttitudeRequest = Message<"flightpath.SubscribeAttitudeRequest"> & {
+};
+
+/**
+ * Describes the message flightpath.SubscribeAttitudeRequest.
+ * Use `create(SubscribeAttitudeRequestSchema)` to create a new message.
+ */
+export const SubscribeAttitudeRequestSchema: GenMessage<SubscribeAttitudeRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 6);
+
+/**
+ * SubscribeAttitudeResponse contains ATTITUDE and ATTITUDE_QUATERNION message data
+ *
+ * @generated from message flightpath.SubscribeAttitudeResponse
+ */
+export type SubscribeAttitudeResponse = Message<"flightpath.SubscribeAttitudeResponse"> & {
+  /**
+   * Timestamp when this attitude was captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the attitude
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the attitude
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * Latest ATTITUDE message data (not set until the first ATTITUDE is received)
+   *
+   * @generated from field: flightpath.Attitude attitude = 4;
+   */
+  attitude?: Attitude;
+
+  /**
+   * Latest ATTITUDE_QUATERNION message data (not set until the first ATTITUDE_QUATERNION is received)
+   *
+   * @generated from field: flightpath.AttitudeQuaternion attitude_quaternion = 5;
+   */
+  attitudeQuaternion?: AttitudeQuaternion;
+};
+
+/**
+ * Describes the message flightpath.SubscribeAttitudeResponse.
+ * Use `create(SubscribeAttitudeResponseSchema)` to create a new message.
+ */
+export const SubscribeAttitudeResponseSchema: GenMessage<SubscribeAttitudeResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 7);
+
+/**
+ * Attitude represents the ATTITUDE MAVLink message
+ * The attitude in the aeronautical frame (right-handed, Z-down, Y-right, X-front, ZYX, intrinsic).
+ * Euler angles are provided both in radians (as sent by the drone) and in degrees.
+ *
+ * @generated from message flightpath.Attitude
+ */
+export type Attitude = Message<"flightpath.Attitude"> & {
+  /**
+   * Timestamp (time since system boot) (ms)
+   *
+   * @generated from field: uint32 time_boot_ms = 1;
+   */
+  timeBootMs: number;
+
+  /**
+   * Roll angle (-pi..+pi) (rad)
+   *
+   * @generated from field: float roll = 2;
+   */
+  roll: number;
+
+  /**
+   * Pitch angle (-pi..+pi) (rad)
+   *
+   * @generated from field: float pitch = 3;
+   */
+  pitch: number;
+
+  /**
+   * Yaw angle (-pi..+pi) (rad)
+   *
+   * @generated from field: float yaw = 4;
+   */
+  yaw: number;
+
+  /**
+   * Roll angle (-180..+180) (deg)
+   *
+   * @generated from field: float roll_deg = 5;
+   */
+  rollDeg: number;
+
+  /**
+   * Pitch angle (-180..+180) (deg)
+   *
+   * @generated from field: float pitch_deg = 6;
+   */
+  pitchDeg: number;
+
+  /**
+   * Yaw angle (-180..+180) (deg)
+   *
+   * @generated from field: float yaw_deg = 7;
+   */
+  yawDeg: number;
+
+  /**
+   * Roll angular speed (rad/s)
+   *
+   * @generated from field: float rollspeed = 8;
+   */
+  rollspeed: number;
+
+  /**
+   * Pitch angular speed (rad/s)
+   *
+   * @generated from field: float pitchspeed = 9;
+   */
+  pitchspeed: number;
+
+  /**
+   * Yaw angular speed (rad/s)
+   *
+   * @generated from field: float yawspeed = 10;
+   */
+  yawspeed: number;
+};
+
+/**
+ * Describes the message flightpath.Attitude.
+ * Use `create(AttitudeSchema)` to create a new message.
+ */
+export const AttitudeSchema: GenMessage<Attitude> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 8);
+
+/**
+ * AttitudeQuaternion represents the ATTITUDE_QUATERNION MAVLink message
+ * The attitude in the aeronautical frame (right-handed, Z-down, X-front, Y-right), expressed as quaternion.
+ * Quaternion order is w, x, y, z and a zero rotation would be expressed as (1 0 0 0).
+ *
+ * @generated from message flightpath.AttitudeQuaternion
+ */
+export type AttitudeQuaternion = Message<"flightpath.AttitudeQuaternion"> & {
+  /**
+   * Timestamp (time since system boot) (ms)
+   *
+   * @generated from field: uint32 time_boot_ms = 1;
+   */
+  timeBootMs: number;
+
+  /**
+   * Quaternion component 1, w (1 in null-rotation)
+   *
+   * @generated from field: float q1 = 2;
+   */
+  q1: number;
+
+  /**
+   * Quaternion component 2, x (0 in null-rotation)
+   *
+   * @generated from field: float q2 = 3;
+   */
+  q2: number;
+
+  /**
+   * Quaternion component 3, y (0 in null-rotation)
+   *
+   * @generated from field: float q3 = 4;
+   */
+  q3: number;
+
+  /**
+   * Quaternion component 4, z (0 in null-rotation)
+   *
+   * @generated from field: float q4 = 5;
+   */
+  q4: number;
+
+  /**
+   * Roll angular speed (rad/s)
+   *
+   * @generated from field: float rollspeed = 6;
+   */
+  rollspeed: number;
+
+  /**
+   * Pitch angular speed (rad/s)
+   *
+   * @generated from field: float pitchspeed = 7;
+   */
+  pitchspeed: number;
+
+  /**
+   * Yaw angular speed (rad/s)
+   *
+   * @generated from field: float yawspeed = 8;
+   */
+  yawspeed: number;
+
+  /**
+   * Rotation offset by which the attitude quaternion and angular speed vector should be rotated for user display (quaternion with [w, x, y, z] order, zero-rotation is [1, 0, 0, 0], [0, 0, 0, 0] if not supported).
+   * For example, tailsitter VTOLs rotate their reference attitude by 90 degrees between hover mode and fixed wing mode.
+   *
+   * @generated from field: repeated float repr_offset_q = 9;
+   */
+  reprOffsetQ: number[];
+};
+
+/**
+ * Describes the message flightpath.AttitudeQuaternion.
+ * Use `create(AttitudeQuaternionSchema)` to create a new message.
+ */
+export const AttitudeQuaternionSchema: GenMessage<AttitudeQuaternion> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 9);
+
+/**
+ * SubscribePositionRequest is the request message for SubscribePosition
+ *
+ * @generated from message flightpath.SubscribePositionRequest
+ */
+export type SubscribePositionRequest = Message<"flightpath.SubscribePositionRequest"> & {
+};
+
+/**
+ * Describes the message flightpath.SubscribePositionRequest.
+ * Use `create(SubscribePositionRequestSchema)` to create a new message.
+ */
+export const SubscribePositionRequestSchema: GenMessage<SubscribePositionRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 10);
+
+/**
+ * SubscribePositionResponse contains GLOBAL_POSITION_INT message data
+ *
+ * @generated from message flightpath.SubscribePositionResponse
+ */
+export type SubscribePositionResponse = Message<"flightpath.SubscribePositionResponse"> & {
+  /**
+   * Timestamp when this position was captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the position
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the position
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * Position in normalized units
+   *
+   * @generated from field: flightpath.Position position = 4;
+   */
+  position?: Position;
+
+  /**
+   * GLOBAL_POSITION_INT message data (raw integer fields, for lossless logging)
+   *
+   * @generated from field: flightpath.GlobalPositionInt global_position_int = 5;
+   */
+  globalPositionInt?: GlobalPositionInt;
+};
+
+/**
+ * Describes the message flightpath.SubscribePositionResponse.
+ * Use `create(SubscribePositionResponseSchema)` to create a new message.
+ */
+export const SubscribePositionResponseSchema: GenMessage<SubscribePositionResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 11);
+
+/**
+ * Position is the fused global position estimate from GLOBAL_POSITION_INT in normalized units
+ *
+ * @generated from message flightpath.Position
+ */
+export type Position = Message<"flightpath.Position"> & {
+  /**
+   * Latitude (WGS84) (deg)
+   *
+   * @generated from field: double latitude = 1;
+   */
+  latitude: number;
+
+  /**
+   * Longitude (WGS84) (deg)
+   *
+   * @generated from field: double longitude = 2;
+   */
+  longitude: number;
+
+  /**
+   * Altitude (MSL) (m)
+   *
+   * @generated from field: float absolute_altitude = 3;
+   */
+  absoluteAltitude: number;
+
+  /**
+   * Altitude above home (m)
+   *
+   * @generated from field: float relative_altitude = 4;
+   */
+  relativeAltitude: number;
+
+  /**
+   * Velocity towards north (m/s)
+   *
+   * @generated from field: float velocity_north = 5;
+   */
+  velocityNorth: number;
+
+  /**
+   * Velocity towards east (m/s)
+   *
+   * @generated from field: float velocity_east = 6;
+   */
+  velocityEast: number;
+
+  /**
+   * Velocity downwards (m/s)
+   *
+   * @generated from field: float velocity_down = 7;
+   */
+  velocityDown: number;
+
+  /**
+   * Vehicle heading (yaw angle) in 0.0..359.99 degrees. Not set if unknown.
+   *
+   * @generated from field: optional float heading = 8;
+   */
+  heading?: number;
+};
+
+/**
+ * Describes the message flightpath.Position.
+ * Use `create(PositionSchema)` to create a new message.
+ */
+export const PositionSchema: GenMessage<Position> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 12);
+
+/**
+ * SubscribeLocalPositionRequest is the request message for SubscribeLocalPosition
+ *
+ * @generated from message flightpath.SubscribeLocalPositionRequest
+ */
+export type SubscribeLocalPositionRequest = Message<"flightpath.SubscribeLocalPositionRequest"> & {
+};
+
+/**
+ * Describes the message flightpath.SubscribeLocalPositionRequest.
+ * Use `create(SubscribeLocalPositionRequestSchema)` to create a new message.
+ */
+export const SubscribeLocalPositionRequestSchema: GenMessage<SubscribeLocalPositionRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 13);
+
+/**
+ * SubscribeLocalPositionResponse contains LOCAL_POSITION_NED and ODOMETRY message data
+ *
+ * @generated from message flightpath.SubscribeLocalPositionResponse
+ */
+export type SubscribeLocalPositionResponse = Message<"flightpath.SubscribeLocalPositionResponse"> & {
+  /**
+   * Timestamp when this local position was captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the local position
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the local position
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * Latest LOCAL_POSITION_NED message data (not set until the first LOCAL_POSITION_NED is received)
+   *
+   * @generated from field: flightpath.LocalPositionNed local_position_ned = 4;
+   */
+  localPositionNed?: LocalPositionNed;
+
+  /**
+   * Latest ODOMETRY message data (not set until the first ODOMETRY is received)
+   *
+   * @generated from field: flightpath.Odometry odometry = 5;
+   */
+  odometry?: Odometry;
+};
+
+/**
+ * Describes the message flightpath.SubscribeLocalPositionResponse.
+ * Use `create(SubscribeLocalPositionResponseSchema)` to create a new message.
+ */
+export const SubscribeLocalPositionResponseSchema: GenMessage<SubscribeLocalPositionResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 14);
+
+/**
+ * LocalPositionNed represents the LOCAL_POSITION_NED MAVLink message
+ * The filtered local position (e.g. fused computer vision and accelerometers).
+ * Coordinate frame is right-handed, Z-axis down (aeronautical frame, NED / north-east-down convention).
+ *
+ * @generated from message flightpath.LocalPositionNed
+ */
+export type LocalPositionNed = Message<"flightpath.LocalPositionNed"> & {
+  /**
+   * Timestamp (time since system boot) (ms)
+   *
+   * @generated from field: uint32 time_boot_ms = 1;
+   */
+  timeBootMs: number;
+
+  /**
+   * X Position (m)
+   *
+   * @generated from field: float x = 2;
+   */
+  x: number;
+
+  /**
+   * Y Position (m)
+   *
+   * @generated from field: float y = 3;
+   */
+  y: number;
+
+  /**
+   * Z Position (m)
+   *
+   * @generated from field: float z = 4;
+   */
+  z: number;
+
+  /**
+   * X Speed (m/s)
+   *
+   * @generated from field: float vx = 5;
+   */
+  vx: number;
+
+  /**
+   * Y Speed (m/s)
+   *
+   * @generated from field: float vy = 6;
+   */
+  vy: number;
+
+  /**
+   * Z Speed (m/s)
+   *
+   * @generated from field: float vz = 7;
+   */
+  vz: number;
+};
+
+/**
+ * Describes the message flightpath.LocalPositionNed.
+ * Use `create(LocalPositionNedSchema)` to create a new message.
+ */
+export const LocalPositionNedSchema: GenMessage<LocalPositionNed> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 15);
+
+/**
+ * Odometry represents the ODOMETRY MAVLink message
+ * Odometry message to communicate odometry information with an external interface.
+ * Fits ROS REP 147 standard for aerial vehicles (http://www.ros.org/reps/rep-0147.html).
+ *
+ * @generated from message flightpath.Odometry
+ */
+export type Odometry = Message<"flightpath.Odometry"> & {
+  /**
+   * Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
+   *
+   * @generated from field: uint64 time_usec = 1;
+   */
+  timeUsec: bigint;
+
+  /**
+   * Coordinate frame of reference for the pose data
+   *
+   * @generated from field: flightpath.MavFrame frame_id = 2;
+   */
+  frameId: MavFrame;
+
+  /**
+   * Coordinate frame of reference for the velocity in free space (twist) data
+   *
+   * @generated from field: flightpath.MavFrame child_frame_id = 3;
+   */
+  childFrameId: MavFrame;
+
+  /**
+   * X Position (m)
+   *
+   * @generated from field: float x = 4;
+   */
+  x: number;
+
+  /**
+   * Y Position (m)
+   *
+   * @generated from field: float y = 5;
+   */
+  y: number;
+
+  /**
+   * Z Position (m)
+   *
+   * @generated from field: float z = 6;
+   */
+  z: number;
+
+  /**
+   * Quaternion components, w, x, y, z (1 0 0 0 is the null-rotation)
+   *
+   * @generated from field: repeated float q = 7;
+   */
+  q: number[];
+
+  /**
+   * X linear speed (m/s)
+   *
+   * @generated from field: float vx = 8;
+   */
+  vx: number;
+
+  /**
+   * Y linear speed (m/s)
+   *
+   * @generated from field: float vy = 9;
+   */
+  vy: number;
+
+  /**
+   * Z linear speed (m/s)
+   *
+   * @generated from field: float vz = 10;
+   */
+  vz: number;
+
+  /**
+   * Roll angular speed (rad/s)
+   *
+   * @generated from field: float rollspeed = 11;
+   */
+  rollspeed: number;
+
+  /**
+   * Pitch angular speed (rad/s)
+   *
+   * @generated from field: float pitchspeed = 12;
+   */
+  pitchspeed: number;
+
+  /**
+   * Yaw angular speed (rad/s)
+   *
+   * @generated from field: float yawspeed = 13;
+   */
+  yawspeed: number;
+
+  /**
+   * Pose covariance (states: x, y, z, roll, pitch, yaw)
+   *
+   * @generated from field: flightpath.Covariance pose_covariance = 14;
+   */
+  poseCovariance?: Covariance;
+
+  /**
+   * Velocity covariance (states: vx, vy, vz, rollspeed, pitchspeed, yawspeed)
+   *
+   * @generated from field: flightpath.Covariance velocity_covariance = 15;
+   */
+  velocityCovariance?: Covariance;
+
+  /**
+   * Estimate reset counter. This should be incremented when the estimate resets in any of the dimensions (position, velocity, attitude, angular speed).
+   *
+   * @generated from field: uint32 reset_counter = 16;
+   */
+  resetCounter: number;
+
+  /**
+   * Type of estimator that is providing the odometry
+   *
+   * @generated from field: flightpath.MavEstimatorType estimator_type = 17;
+   */
+  estimatorType: MavEstimatorType;
+
+  /**
+   * Odometry quality metric as a percentage. -1 = odometry has failed, 0 = unknown/unset quality, 1 = worst quality, 100 = best quality
+   *
+   * @generated from field: int32 quality = 18;
+   */
+  quality: number;
+};
+
+/**
+ * Describes the message flightpath.Odometry.
+ * Use `create(OdometrySchema)` to create a new message.
+ */
+export const OdometrySchema: GenMessage<Odometry> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 16);
+
+/**
+ * Covariance is a 6x6 cross-covariance matrix decoded from the upper right triangle sent in MAVLink
+ * messages (21 values, row-major).
+ * MAVLink marks an unknown matrix by setting its first element to NaN. Individual unknown
+ * elements are also sent as NaN, and are kept as NaN here.
+ *
+ * @generated from message flightpath.Covariance
+ */
+export type Covariance = Message<"flightpath.Covariance"> & {
+  /**
+   * False if the drone does not provide the covariance (first element NaN), in which case
+   * variances and matrix are empty
+   *
+   * @generated from field: bool known = 1;
+   */
+  known: boolean;
+
+  /**
+   * Variances of the six states (diagonal of the matrix)
+   *
+   * @generated from field: repeated float variances = 2;
+   */
+  variances: number[];
+
+  /**
+   * Full symmetric matrix in row-major order (36 values)
+   *
+   * @generated from field: repeated float matrix = 3;
+   */
+  matrix: number[];
+};
+
+/**
+ * Describes the message flightpath.Covariance.
+ * Use `create(CovarianceSchema)` to create a new message.
+ */
+export const CovarianceSchema: GenMessage<Covariance> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 17);
+
+/**
+ * SubscribeBatteryRequest is the request message for SubscribeBattery
+ *
+ * @generated from message flightpath.SubscribeBatteryRequest
+ */
+export type SubscribeBatteryRequest = Message<"flightpath.SubscribeBatteryRequest"> & {
+};
+
+/**
+ * Describes the message flightpath.SubscribeBatteryRequest.
+ * Use `create(SubscribeBatteryRequestSchema)` to create a new message.
+ */
+export const SubscribeBatteryRequestSchema: GenMessage<SubscribeBatteryRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 18);
+
+/**
+ * SubscribeBatteryResponse contains the state of a single battery
+ *
+ * @generated from message flightpath.SubscribeBatteryResponse
+ */
+export type SubscribeBatteryResponse = Message<"flightpath.SubscribeBatteryResponse"> & {
+  /**
+   * Timestamp when this battery state was captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the battery state
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the battery state
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * Battery state in normalized units
+   *
+   * @generated from field: flightpath.Battery battery = 4;
+   */
+  battery?: Battery;
+};
+
+/**
+ * Describes the message flightpath.SubscribeBatteryResponse.
+ * Use `create(SubscribeBatteryResponseSchema)` to create a new message.
+ */
+export const SubscribeBatteryResponseSchema: GenMessage<SubscribeBatteryResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 19);
+
+/**
+ * Battery is the state of a single battery in normalized units.
+ * Values the drone does not report are not set.
+ *
+ * @generated from message flightpath.Battery
+ */
+export type Battery = Message<"flightpath.Battery"> & {
+  /**
+   * Battery ID (the BATTERY_STATUS id, always 0 for SYS_STATUS)
+   *
+   * @generated from field: uint32 id = 1;
+   */
+  id: number;
+
+  /**
+   * Message the battery state was decoded from
+   *
+   * @generated from field: flightpath.BatterySource source = 2;
+   */
+  source: BatterySource;
+
+  /**
+   * Function of the battery
+   *
+   * @generated from field: flightpath.MavBatteryFunction function = 3;
+   */
+  function: MavBatteryFunction;
+
+  /**
+   * Type (chemistry) of the battery
+   *
+   * @generated from field: flightpath.MavBatteryType type = 4;
+   */
+  type: MavBatteryType;
+
+  /**
+   * Total battery voltage (V)
+   *
+   * @generated from field: optional float voltage = 5;
+   */
+  voltage?: number;
+
+  /**
+   * Voltage of every cell, including extended cells 11 to 14 (V).
+   * Empty if the drone only reports the total voltage.
+   *
+   * @generated from field: repeated float cell_voltages = 6;
+   */
+  cellVoltages: number[];
+
+  /**
+   * Battery current, positive when discharging (A)
+   *
+   * @generated from field: optional float current = 7;
+   */
+  current?: number;
+
+  /**
+   * Consumed charge (mAh)
+   *
+   * @generated from field: optional float consumed_charge = 8;
+   */
+  consumedCharge?: number;
+
+  /**
+   * Consumed energy (J)
+   *
+   * @generated from field: optional float consumed_energy = 9;
+   */
+  consumedEnergy?: number;
+
+  /**
+   * Temperature of the battery (degC)
+   *
+   * @generated from field: optional float temperature = 10;
+   */
+  temperature?: number;
+
+  /**
+   * Remaining battery energy (0-100) (%)
+   *
+   * @generated from field: optional float remaining_percent = 11;
+   */
+  remainingPercent?: number;
+
+  /**
+   * Remaining battery time (s)
+   *
+   * @generated from field: optional uint32 time_remaining = 12;
+   */
+  timeRemaining?: number;
+
+  /**
+   * State for extent of discharge
+   *
+   * @generated from field: flightpath.MavBatteryChargeState charge_state = 13;
+   */
+  chargeState: MavBatteryChargeState;
+
+  /**
+   * Battery mode
+   *
+   * @generated from field: flightpath.MavBatteryMode mode = 14;
+   */
+  mode: MavBatteryMode;
+
+  /**
+   * Fault/health indications
+   *
+   * @generated from field: flightpath.BatteryFaults faults = 15;
+   */
+  faults?: BatteryFaults;
+};
+
+/**
+ * Describes the message flightpath.Battery.
+ * Use `create(BatterySchema)` to create a new message.
+ */
+export const BatterySchema: GenMessage<Battery> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 20);
+
+/**
+ * BatteryFaults represents the MAV_BATTERY_FAULT bitfield as structured boolean flags.
+ * Bits are ordered from least significant (bit 0) to most significant (bit 8),
+ * matching https://mavlink.io/en/messages/common.html#MAV_BATTERY_FAULT.
+ *
+ * @generated from message flightpath.BatteryFaults
+ */
+export type BatteryFaults = Message<"flightpath.BatteryFaults"> & {
+  /**
+   * Bit 0 (1): Battery has deep discharged
+   *
+   * @generated from field: bool deep_discharge = 1;
+   */
+  deepDischarge: boolean;
+
+  /**
+   * Bit 1 (2): Voltage spikes
+   *
+   * @generated from field: bool spikes = 2;
+   */
+  spikes: boolean;
+
+  /**
+   * Bit 2 (4): One or more cells have failed
+   *
+   * @generated from field: bool cell_fail = 3;
+   */
+  cellFail: boolean;
+
+  /**
+   * Bit 3 (8): Over-current fault
+   *
+   * @generated from field: bool over_current = 4;
+   */
+  overCurrent: boolean;
+
+  /**
+   * Bit 4 (16): Over-temperature fault
+   *
+   * @generated from field: bool over_temperature = 5;
+   */
+  overTemperature: boolean;
+
+  /**
+   * Bit 5 (32): Under-temperature fault
+   *
+   * @generated from field: bool under_temperature = 6;
+   */
+  underTemperature: boolean;
+
+  /**
+   * Bit 6 (64): Vehicle voltage is not compatible with this battery
+   *
+   * @generated from field: bool incompatible_voltage = 7;
+   */
+  incompatibleVoltage: boolean;
+
+  /**
+   * Bit 7 (128): Battery firmware is not compatible with current autopilot firmware
+   *
+   * @generated from field: bool incompatible_firmware = 8;
+   */
+  incompatibleFirmware: boolean;
+
+  /**
+   * Bit 8 (256): Battery is not compatible due to cell configuration
+   *
+   * @generated from field: bool incompatible_cells_configuration = 9;
+   */
+  incompatibleCellsConfiguration: boolean;
+};
+
+/**
+ * Describes the message flightpath.BatteryFaults.
+ * Use `create(BatteryFaultsSchema)` to create a new message.
+ */
+export const BatteryFaultsSchema: GenMessage<BatteryFaults> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 21);
+
+/**
+ * BatteryStatus represents the BATTERY_STATUS MAVLink message
+ * Battery information. Updates GCS with flight controller battery status.
+ *
+ * @generated from message flightpath.BatteryStatus
+ */
+export type BatteryStatus = Message<"flightpath.BatteryStatus"> & {
+  /**
+   * Battery ID
+   *
+   * @generated from field: uint32 id = 1;
+   */
+  id: number;
+
+  /**
+   * Function of the battery
+   *
+   * @generated from field: flightpath.MavBatteryFunction battery_function = 2;
+   */
+  batteryFunction: MavBatteryFunction;
+
+  /**
+   * Type (chemistry) of the battery
+   *
+   * @generated from field: flightpath.MavBatteryType type = 3;
+   */
+  type: MavBatteryType;
+
+  /**
+   * Temperature of the battery. INT16_MAX for unknown temperature. (cdegC)
+   *
+   * @generated from field: int32 temperature = 4;
+   */
+  temperature: number;
+
+  /**
+   * Battery voltage of cells 1 to 10 (see voltages_ext for cells 11-14). Cells in this field above the valid cell count for this battery should have the UINT16_MAX value. If individual cell voltages are unknown or not measured for this battery, then the overall battery voltage should be filled in cell 0, with all others set to UINT16_MAX. (mV)
+   *
+   * @generated from field: repeated uint32 voltages = 5;
+   */
+  voltages: number[];
+
+  /**
+   * Battery current, -1: autopilot does not measure the current (cA)
+   *
+   * @generated from field: int32 current_battery = 6;
+   */
+  currentBattery: number;
+
+  /**
+   * Consumed charge, -1: autopilot does not provide consumption estimate (mAh)
+   *
+   * @generated from field: int32 current_consumed = 7;
+   */
+  currentConsumed: number;
+
+  /**
+   * Consumed energy, -1: autopilot does not provide energy consumption estimate (hJ)
+   *
+   * @generated from field: int32 energy_consumed = 8;
+   */
+  energyConsumed: number;
+
+  /**
+   * Remaining battery energy. Values: [0-100], -1: autopilot does not estimate the remaining battery. (%)
+   *
+   * @generated from field: int32 battery_remaining = 9;
+   */
+  batteryRemaining: number;
+
+  /**
+   * Remaining battery time, 0: autopilot does not provide remaining battery time estimate (s)
+   *
+   * @generated from field: int32 time_remaining = 10;
+   */
+  timeRemaining: number;
+
+  /**
+   * State for extent of discharge, provided by autopilot for warning or external reactions
+   *
+   * @generated from field: flightpath.MavBatteryChargeState charge_state = 11;
+   */
+  chargeState: MavBatteryChargeState;
+
+  /**
+   * Battery voltages for cells 11 to 14. Cells above the valid cell count for this battery should have a value of 0, where zero indicates not supported. If the measured value is 0 then 1 should be sent instead. (mV)
+   *
+   * @generated from field: repeated uint32 voltages_ext = 12;
+   */
+  voltagesExt: number[];
+
+  /**
+   * Battery mode. Default (0) is that battery mode reporting is not supported or battery is in normal-use mode.
+   *
+   * @generated from field: flightpath.MavBatteryMode mode = 13;
+   */
+  mode: MavBatteryMode;
+
+  /**
+   * Fault/health indications (MAV_BATTERY_FAULT bitmask). These should be set when charge_state is MAV_BATTERY_CHARGE_STATE_FAILED or MAV_BATTERY_CHARGE_STATE_UNHEALTHY (if not, fault reporting is not supported).
+   *
+   * @generated from field: uint32 fault_bitmask = 14;
+   */
+  faultBitmask: number;
+};
+
+/**
+ * Describes the message flightpath.BatteryStatus.
+ * Use `create(BatteryStatusSchema)` to create a new message.
+ */
+export const BatteryStatusSchema: GenMessage<BatteryStatus> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 22);
+
+/**
+ * SubscribeSystemStatusRequest is the request message for SubscribeSystemStatus
+ *
+ * @generated from message flightpath.SubscribeSystemStatusRequest
+ */
+export type SubscribeSystemStatusRequest = Message<"flightpath.SubscribeSystemStatusRequest"> & {
+};
+
+/**
+ * Describes the message flightpath.SubscribeSystemStatusRequest.
+ * Use `create(SubscribeSystemStatusRequestSchema)` to create a new message.
+ */
+export const SubscribeSystemStatusRequestSchema: GenMessage<SubscribeSystemStatusRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 23);
+
+/**
+ * SubscribeSystemStatusResponse contains SYS_STATUS message data
+ *
+ * @generated from message flightpath.SubscribeSystemStatusResponse
+ */
+export type SubscribeSystemStatusResponse = Message<"flightpath.SubscribeSystemStatusResponse"> & {
+  /**
+   * Timestamp when this system status was captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the system status
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the system status
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * System status with the sensor bitmaps decoded
+   *
+   * @generated from field: flightpath.SystemStatus system_status = 4;
+   */
+  systemStatus?: SystemStatus;
+
+  /**
+   * SYS_STATUS message data
+   *
+   * @generated from field: flightpath.SysStatus sys_status = 5;
+   */
+  sysStatus?: SysStatus;
+};
+
+/**
+ * Describes the message flightpath.SubscribeSystemStatusResponse.
+ * Use `create(SubscribeSystemStatusResponseSchema)` to create a new message.
+ */
+export const SubscribeSystemStatusResponseSchema: GenMessage<SubscribeSystemStatusResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 24);
+
+/**
+ * SystemStatus is the decoded system status of a drone
+ *
+ * @generated from message flightpath.SystemStatus
+ */
+export type SystemStatus = Message<"flightpath.SystemStatus"> & {
+  /**
+   * State of the onboard controllers and sensors
+   *
+   * @generated from field: flightpath.SysStatusSensors sensors = 1;
+   */
+  sensors?: SysStatusSensors;
+
+  /**
+   * Maximum usage of the mainloop time (%)
+   *
+   * @generated from field: float cpu_load = 2;
+   */
+  cpuLoad: number;
+
+  /**
+   * Communication drop rate (UART, I2C, SPI, CAN), dropped packets on all links (%)
+   *
+   * @generated from field: float drop_rate_comm = 3;
+   */
+  dropRateComm: number;
+
+  /**
+   * Communication errors (UART, I2C, SPI, CAN), dropped packets on all links
+   *
+   * @generated from field: uint32 errors_comm = 4;
+   */
+  errorsComm: number;
+};
+
+/**
+ * Describes the message flightpath.SystemStatus.
+ * Use `create(SystemStatusSchema)` to create a new message.
+ */
+export const SystemStatusSchema: GenMessage<SystemStatus> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 25);
+
+/**
+ * SensorStatus is the state of an onboard controller or sensor
+ *
+ * @generated from message flightpath.SensorStatus
+ */
+export type SensorStatus = Message<"flightpath.SensorStatus"> & {
+  /**
+   * Whether the controller or sensor is present
+   *
+   * @generated from field: bool present = 1;
+   */
+  present: boolean;
+
+  /**
+   * Whether the controller or sensor is enabled
+   *
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+
+  /**
+   * Whether the controller or sensor is operational (false: error)
+   *
+   * @generated from field: bool healthy = 3;
+   */
+  healthy: boolean;
+};
+
+/**
+ * Describes the message flightpath.SensorStatus.
+ * Use `create(SensorStatusSchema)` to create a new message.
+ */
+export const SensorStatusSchema: GenMessage<SensorStatus> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 26);
+
+/**
+ * SysStatusSensors represents the MAVLink MAV_SYS_STATUS_SENSOR and MAV_SYS_STATUS_SENSOR_EXTENDED
+ * bitmaps of SYS_STATUS as structured data
+ *
+ * @generated from message flightpath.SysStatusSensors
+ */
+export type SysStatusSensors = Message<"flightpath.SysStatusSensors"> & {
+  /**
+   * Bit 0 (0x01): 3D gyro
+   *
+   * @generated from field: flightpath.SensorStatus gyro = 1;
+   */
+  gyro?: SensorStatus;
+
+  /**
+   * Bit 1 (0x02): 3D accelerometer
+   *
+   * @generated from field: flightpath.SensorStatus accel = 2;
+   */
+  accel?: SensorStatus;
+
+  /**
+   * Bit 2 (0x04): 3D magnetometer
+   *
+   * @generated from field: flightpath.SensorStatus mag = 3;
+   */
+  mag?: SensorStatus;
+
+  /**
+   * Bit 3 (0x08): Absolute pressure
+   *
+   * @generated from field: flightpath.SensorStatus absolute_pressure = 4;
+   */
+  absolutePressure?: SensorStatus;
+
+  /**
+   * Bit 4 (0x10): Differential pressure
+   *
+   * @generated from field: flightpath.SensorStatus differential_pressure = 5;
+   */
+  differentialPressure?: SensorStatus;
+
+  /**
+   * Bit 5 (0x20): GPS
+   *
+   * @generated from field: flightpath.SensorStatus gps = 6;
+   */
+  gps?: SensorStatus;
+
+  /**
+   * Bit 6 (0x40): Optical flow
+   *
+   * @generated from field: flightpath.SensorStatus optical_flow = 7;
+   */
+  opticalFlow?: SensorStatus;
+
+  /**
+   * Bit 7 (0x80): Computer vision position
+   *
+   * @generated from field: flightpath.SensorStatus vision_position = 8;
+   */
+  visionPosition?: SensorStatus;
+
+  /**
+   * Bit 8 (0x100): Laser based position
+   *
+   * @generated from field: flightpath.SensorStatus laser_position = 9;
+   */
+  laserPosition?: SensorStatus;
+
+  /**
+   * Bit 9 (0x200): External ground truth (Vicon or Leica)
+   *
+   * @generated from field: flightpath.SensorStatus external_ground_truth = 10;
+   */
+  externalGroundTruth?: SensorStatus;
+
+  /**
+   * Bit 10 (0x400): 3D angular rate control
+   *
+   * @generated from field: flightpath.SensorStatus angular_rate_control = 11;
+   */
+  angularRateControl?: SensorStatus;
+
+  /**
+   * Bit 11 (0x800): Attitude stabilization
+   *
+   * @generated from field: flightpath.SensorStatus attitude_stabilization = 12;
+   */
+  attitudeStabilization?: SensorStatus;
+
+  /**
+   * Bit 12 (0x1000): Yaw position
+   *
+   * @generated from field: flightpath.SensorStatus yaw_position = 13;
+   */
+  yawPosition?: SensorStatus;
+
+  /**
+   * Bit 13 (0x2000): Z/altitude control
+   *
+   * @generated from field: flightpath.SensorStatus z_altitude_control = 14;
+   */
+  zAltitudeControl?: SensorStatus;
+
+  /**
+   * Bit 14 (0x4000): X/Y position control
+   *
+   * @generated from field: flightpath.SensorStatus xy_position_control = 15;
+   */
+  xyPositionControl?: SensorStatus;
+
+  /**
+   * Bit 15 (0x8000): Motor outputs / control
+   *
+   * @generated from field: flightpath.SensorStatus motor_outputs = 16;
+   */
+  motorOutputs?: SensorStatus;
+
+  /**
+   * Bit 16 (0x10000): RC receiver
+   *
+   * @generated from field: flightpath.SensorStatus rc_receiver = 17;
+   */
+  rcReceiver?: SensorStatus;
+
+  /**
+   * Bit 17 (0x20000): 2nd 3D gyro
+   *
+   * @generated from field: flightpath.SensorStatus gyro2 = 18;
+   */
+  gyro2?: SensorStatus;
+
+  /**
+   * Bit 18 (0x40000): 2nd 3D accelerometer
+   *
+   * @generated from field: flightpath.SensorStatus accel2 = 19;
+   */
+  accel2?: SensorStatus;
+
+  /**
+   * Bit 19 (0x80000): 2nd 3D magnetometer
+   *
+   * @generated from field: flightpath.SensorStatus mag2 = 20;
+   */
+  mag2?: SensorStatus;
+
+  /**
+   * Bit 20 (0x100000): Geofence
+   *
+   * @generated from field: flightpath.SensorStatus geofence = 21;
+   */
+  geofence?: SensorStatus;
+
+  /**
+   * Bit 21 (0x200000): AHRS subsystem health
+   *
+   * @generated from field: flightpath.SensorStatus ahrs = 22;
+   */
+  ahrs?: SensorStatus;
+
+  /**
+   * Bit 22 (0x400000): Terrain subsystem health
+   *
+   * @generated from field: flightpath.SensorStatus terrain = 23;
+   */
+  terrain?: SensorStatus;
+
+  /**
+   * Bit 23 (0x800000): Motors are reversed
+   *
+   * @generated from field: flightpath.SensorStatus reverse_motor = 24;
+   */
+  reverseMotor?: SensorStatus;
+
+  /**
+   * Bit 24 (0x1000000): Logging
+   *
+   * @generated from field: flightpath.SensorStatus logging = 25;
+   */
+  logging?: SensorStatus;
+
+  /**
+   * Bit 25 (0x2000000): Battery
+   *
+   * @generated from field: flightpath.SensorStatus battery = 26;
+   */
+  battery?: SensorStatus;
+
+  /**
+   * Bit 26 (0x4000000): Proximity
+   *
+   * @generated from field: flightpath.SensorStatus proximity = 27;
+   */
+  proximity?: SensorStatus;
+
+  /**
+   * Bit 27 (0x8000000): Satellite communication
+   *
+   * @generated from field: flightpath.SensorStatus satcom = 28;
+   */
+  satcom?: SensorStatus;
+
+  /**
+   * Bit 28 (0x10000000): Pre-arm check status. Always healthy when armed.
+   *
+   * @generated from field: flightpath.SensorStatus prearm_check = 29;
+   */
+  prearmCheck?: SensorStatus;
+
+  /**
+   * Bit 29 (0x20000000): Avoidance/collision prevention
+   *
+   * @generated from field: flightpath.SensorStatus obstacle_avoidance = 30;
+   */
+  obstacleAvoidance?: SensorStatus;
+
+  /**
+   * Bit 30 (0x40000000): Propulsion (actuator, esc, motor or propellor)
+   *
+   * @generated from field: flightpath.SensorStatus propulsion = 31;
+   */
+  propulsion?: SensorStatus;
+
+  /**
+   * Extended bit 0 (0x01): Recovery system (parachute, balloon, retracts etc)
+   *
+   * @generated from field: flightpath.SensorStatus recovery_system = 32;
+   */
+  recoverySystem?: SensorStatus;
+};
+
+/**
+ * Describes the message flightpath.SysStatusSensors.
+ * Use `create(SysStatusSensorsSchema)` to create a new message.
+ */
+export const SysStatusSensorsSchema: GenMessage<SysStatusSensors> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 27);
+
+/**
+ * SysStatus represents the SYS_STATUS MAVLink message
+ * The general system state.
+ *
+ * @generated from message flightpath.SysStatus
+ */
+export type SysStatus = Message<"flightpath.SysStatus"> & {
+  /**
+   * Bitmap showing which onboard controllers and sensors are present (MAV_SYS_STATUS_SENSOR). Value of 0: not present. Value of 1: present.
+   *
+   * @generated from field: uint32 onboard_control_sensors_present = 1;
+   */
+  onboardControlSensorsPresent: number;
+
+  /**
+   * Bitmap showing which onboard controllers and sensors are enabled (MAV_SYS_STATUS_SENSOR). Value of 0: not enabled. Value of 1: enabled.
+   *
+   * @generated from field: uint32 onboard_control_sensors_enabled = 2;
+   */
+  onboardControlSensorsEnabled: number;
+
+  /**
+   * Bitmap showing which onboard controllers and sensors have an error (or are operational) (MAV_SYS_STATUS_SENSOR). Value of 0: error. Value of 1: healthy.
+   *
+   * @generated from field: uint32 onboard_control_sensors_health = 3;
+   */
+  onboardControlSensorsHealth: number;
+
+  /**
+   * Maximum usage in percent of the mainloop time. Values: [0-1000] - should always be below 1000 (d%)
+   *
+   * @generated from field: uint32 load = 4;
+   */
+  load: number;
+
+  /**
+   * Battery voltage, UINT16_MAX: Voltage not sent by autopilot (mV)
+   *
+   * @generated from field: uint32 voltage_battery = 5;
+   */
+  voltageBattery: number;
+
+  /**
+   * Battery current, -1: Current not sent by autopilot (cA)
+   *
+   * @generated from field: int32 current_battery = 6;
+   */
+  currentBattery: number;
+
+  /**
+   * Battery energy remaining, -1: Battery remaining energy not sent by autopilot (%)
+   *
+   * @generated from field: int32 battery_remaining = 7;
+   */
+  batteryRemaining: number;
+
+  /**
+   * Communication drop rate, (UART, I2C, SPI, CAN), dropped packets on all links (packets that were corrupted on reception on the MAV) (c%)
+   *
+   * @generated from field: uint32 drop_rate_comm = 8;
+   */
+  dropRateComm: number;
+
+  /**
+   * Communication errors (UART, I2C, SPI, CAN), dropped packets on all links (packets that were corrupted on reception on the MAV)
+   *
+   * @generated from field: uint32 errors_comm = 9;
+   */
+  errorsComm: number;
+
+  /**
+   * Autopilot-specific errors
+   *
+   * @generated from field: uint32 errors_count1 = 10;
+   */
+  errorsCount1: number;
+
+  /**
+   * Autopilot-specific errors
+   *
+   * @generated from field: uint32 errors_count2 = 11;
+   */
+  errorsCount2: number;
+
+  /**
+   * Autopilot-specific errors
+   *
+   * @generated from field: uint32 errors_count3 = 12;
+   */
+  errorsCount3: number;
+
+  /**
+   * Autopilot-specific errors
+   *
+   * @generated from field: uint32 errors_count4 = 13;
+   */
+  errorsCount4: number;
+
+  /**
+   * Bitmap showing which onboard controllers and sensors are present (MAV_SYS_STATUS_SENSOR_EXTENDED). Value of 0: not present. Value of 1: present.
+   *
+   * @generated from field: uint32 onboard_control_sensors_present_extended = 14;
+   */
+  onboardControlSensorsPresentExtended: number;
+
+  /**
+   * Bitmap showing which onboard controllers and sensors are enabled (MAV_SYS_STATUS_SENSOR_EXTENDED). Value of 0: not enabled. Value of 1: enabled.
+   *
+   * @generated from field: uint32 onboard_control_sensors_enabled_extended = 15;
+   */
+  onboardControlSensorsEnabledExtended: number;
+
+  /**
+   * Bitmap showing which onboard controllers and sensors have an error (or are operational) (MAV_SYS_STATUS_SENSOR_EXTENDED). Value of 0: error. Value of 1: healthy.
+   *
+   * @generated from field: uint32 onboard_control_sensors_health_extended = 16;
+   */
+  onboardControlSensorsHealthExtended: number;
+};
+
+/**
+ * Describes the message flightpath.SysStatus.
+ * Use `create(SysStatusSchema)` to create a new message.
+ */
+export const SysStatusSchema: GenMessage<SysStatus> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 28);
+
+/**
+ * SubscribeFlightMetricsRequest is the request message for SubscribeFlightMetrics
+ *
+ * @generated from message flightpath.SubscribeFlightMetricsRequest
+ */
+export type SubscribeFlightMetricsRequest = Message<"flightpath.SubscribeFlightMetricsRequest"> & {
+};
+
+/**
+ * Describes the message flightpath.SubscribeFlightMetricsRequest.
+ * Use `create(SubscribeFlightMetricsRequestSchema)` to create a new message.
+ */
+export const SubscribeFlightMetricsRequestSchema: GenMessage<SubscribeFlightMetricsRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 29);
+
+/**
+ * SubscribeFlightMetricsResponse contains VFR_HUD message data
+ *
+ * @generated from message flightpath.SubscribeFlightMetricsResponse
+ */
+export type SubscribeFlightMetricsResponse = Message<"flightpath.SubscribeFlightMetricsResponse"> & {
+  /**
+   * Timestamp when these flight metrics were captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the flight metrics
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the flight metrics
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * VFR_HUD message data
+   *
+   * @generated from field: flightpath.VfrHud vfr_hud = 4;
+   */
+  vfrHud?: VfrHud;
+};
+
+/**
+ * Describes the message flightpath.SubscribeFlightMetricsResponse.
+ * Use `create(SubscribeFlightMetricsResponseSchema)` to create a new message.
+ */
+export const SubscribeFlightMetricsResponseSchema: GenMessage<SubscribeFlightMetricsResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 30);
+
+/**
+ * SubscribeStatusTextRequest is the request message for SubscribeStatusText
+ *
+ * @generated from message flightpath.SubscribeStatusTextRequest
+ */
+export type SubscribeStatusTextRequest = Message<"flightpath.SubscribeStatusTextRequest"> & {
+  /**
+   * Only send messages at least as severe as this severity (all messages if unspecified)
+   *
+   * @generated from field: flightpath.MavSeverity min_severity = 1;
+   */
+  minSeverity: MavSeverity;
+};
+
+/**
+ * Describes the message flightpath.SubscribeStatusTextRequest.
+ * Use `create(SubscribeStatusTextRequestSchema)` to create a new message.
+ */
+export const SubscribeStatusTextRequestSchema: GenMessage<SubscribeStatusTextRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 31);
+
+/**
+ * SubscribeStatusTextResponse contains a (reassembled) STATUSTEXT message
+ *
+ * @generated from message flightpath.SubscribeStatusTextResponse
+ */
+export type SubscribeStatusTextResponse = Message<"flightpath.SubscribeStatusTextResponse"> & {
+  /**
+   * Timestamp when this message was captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the message
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the message
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * Severity of the message
+   *
+   * @generated from field: flightpath.MavSeverity severity = 4;
+   */
+  severity: MavSeverity;
+
+  /**
+   * Full text of the message (all chunks joined)
+   *
+   * @generated from field: string text = 5;
+   */
+  text: string;
+
+  /**
+   * True if some chunks of the message were not received in time (text only holds the received ones)
+   *
+   * @generated from field: bool incomplete = 6;
+   */
+  incomplete: boolean;
+};
+
+/**
+ * Describes the message flightpath.SubscribeStatusTextResponse.
+ * Use `create(SubscribeStatusTextResponseSchema)` to create a new message.
+ */
+export const SubscribeStatusTextResponseSchema: GenMessage<SubscribeStatusTextResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 32);
+
+/**
+ * StatusText represents the STATUSTEXT MAVLink message
+ * Status text message. These messages are printed in yellow in the COMM console of QGroundControl.
+ * Long messages are split into chunks that share the same id.
+ *
+ * @generated from message flightpath.StatusText
+ */
+export type StatusText = Message<"flightpath.StatusText"> & {
+  /**
+   * Severity of status. Relies on the definitions within RFC-5424.
+   *
+   * @generated from field: flightpath.MavSeverity severity = 1;
+   */
+  severity: MavSeverity;
+
+  /**
+   * Status text message, without null termination character
+   *
+   * @generated from field: string text = 2;
+   */
+  text: string;
+
+  /**
+   * Unique (opaque) identifier for this statustext message. May be used to reassemble a logical long-statustext message from a sequence of chunks. A value of zero indicates this is the only chunk in the sequence and the message can be emitted immediately.
+   *
+   * @generated from field: uint32 id = 3;
+   */
+  id: number;
+
+  /**
+   * This chunk's sequence number; indexing is from zero. Any null character in the text field is taken to mean this was the last chunk.
+   *
+   * @generated from field: uint32 chunk_seq = 4;
+   */
+  chunkSeq: number;
+};
+
+/**
+ * Describes the message flightpath.StatusText.
+ * Use `create(StatusTextSchema)` to create a new message.
+ */
+export const StatusTextSchema: GenMessage<StatusText> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 33);
+
+/**
+ * GetHomePositionRequest is the request message for GetHomePosition
+ *
+ * @generated from message flightpath.GetHomePositionRequest
+ */
+export type GetHomePositionRequest = Message<"flightpath.GetHomePositionRequest"> & {
+  /**
+   * System ID of the drone. Defaults to 1 if not set.
+   *
+   * @generated from field: uint32 system_id = 1;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the autopilot. Defaults to MAV_COMP_ID_AUTOPILOT1 (1) if not set.
+   *
+   * @generated from field: uint32 component_id = 2;
+   */
+  componentId: number;
+};
+
+/**
+ * Describes the message flightpath.GetHomePositionRequest.
+ * Use `create(GetHomePositionRequestSchema)` to create a new message.
+ */
+export const GetHomePositionRequestSchema: GenMessage<GetHomePositionRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 34);
+
+/**
+ * GetHomePositionResponse contains HOME_POSITION message data
+ *
+ * @generated from message flightpath.GetHomePositionResponse
+ */
+export type GetHomePositionResponse = Message<"flightpath.GetHomePositionResponse"> & {
+  /**
+   * Home location in normalized units
+   *
+   * @generated from field: flightpath.HomeLocation location = 1;
+   */
+  location?: HomeLocation;
+
+  /**
+   * HOME_POSITION message data
+   *
+   * @generated from field: flightpath.HomePosition home_position = 2;
+   */
+  homePosition?: HomePosition;
+};
+
+/**
+ * Describes the message flightpath.GetHomePositionResponse.
+ * Use `create(GetHomePositionResponseSchema)` to create a new message.
+ */
+export const GetHomePositionResponseSchema: GenMessage<GetHomePositionResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 35);
+
+/**
+ * SubscribeHomePositionRequest is the request message for SubscribeHomePosition
+ *
+ * @generated from message flightpath.SubscribeHomePositionRequest
+ */
+export type SubscribeHomePositionRequest = Message<"flightpath.SubscribeHomePositionRequest"> & {
+};
+
+/**
+ * Describes the message flightpath.SubscribeHomePositionRequest.
+ * Use `create(SubscribeHomePositionRequestSchema)` to create a new message.
+ */
+export const SubscribeHomePositionRequestSchema: GenMessage<SubscribeHomePositionRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 36);
+
+/**
+ * SubscribeHomePositionResponse contains HOME_POSITION message data
+ *
+ * @generated from message flightpath.SubscribeHomePositionResponse
+ */
+export type SubscribeHomePositionResponse = Message<"flightpath.SubscribeHomePositionResponse"> & {
+  /**
+   * Timestamp when this home position was captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the home position
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the home position
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * Home location in normalized units
+   *
+   * @generated from field: flightpath.HomeLocation location = 4;
+   */
+  location?: HomeLocation;
+
+  /**
+   * HOME_POSITION message data
+   *
+   * @generated from field: flightpath.HomePosition home_position = 5;
+   */
+  homePosition?: HomePosition;
+};
+
+/**
+ * Describes the message flightpath.SubscribeHomePositionResponse.
+ * Use `create(SubscribeHomePositionResponseSchema)` to create a new message.
+ */
+export const SubscribeHomePositionResponseSchema: GenMessage<SubscribeHomePositionResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 37);
+
+/**
+ * HomeLocation is the global home location from HOME_POSITION in normalized units
+ *
+ * @generated from message flightpath.HomeLocation
+ */
+export type HomeLocation = Message<"flightpath.HomeLocation"> & {
+  /**
+   * Latitude (WGS84) (deg)
+   *
+   * @generated from field: double latitude = 1;
+   */
+  latitude: number;
+
+  /**
+   * Longitude (WGS84) (deg)
+   *
+   * @generated from field: double longitude = 2;
+   */
+  longitude: number;
+
+  /**
+   * Altitude (MSL) (m)
+   *
+   * @generated from field: float absolute_altitude = 3;
+   */
+  absoluteAltitude: number;
+};
+
+/**
+ * Describes the message flightpath.HomeLocation.
+ * Use `create(HomeLocationSchema)` to create a new message.
+ */
+export const HomeLocationSchema: GenMessage<HomeLocation> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 38);
+
+/**
+ * HomePosition represents the HOME_POSITION MAVLink message
+ * Contains the home position. The home position is the default position that the system will
+ * return to and land on.
+ *
+ * @generated from message flightpath.HomePosition
+ */
+export type HomePosition = Message<"flightpath.HomePosition"> & {
+  /**
+   * Latitude (WGS84) in degrees * 1E7
+   *
+   * @generated from field: int32 latitude = 1;
+   */
+  latitude: number;
+
+  /**
+   * Longitude (WGS84) in degrees * 1E7
+   *
+   * @generated from field: int32 longitude = 2;
+   */
+  longitude: number;
+
+  /**
+   * Altitude (MSL). Positive for up. (mm)
+   *
+   * @generated from field: int32 altitude = 3;
+   */
+  altitude: number;
+
+  /**
+   * Local X position of this position in the local coordinate frame (NED) (m)
+   *
+   * @generated from field: float x = 4;
+   */
+  x: number;
+
+  /**
+   * Local Y position of this position in the local coordinate frame (NED) (m)
+   *
+   * @generated from field: float y = 5;
+   */
+  y: number;
+
+  /**
+   * Local Z position of this position in the local coordinate frame (NED: positive "down") (m)
+   *
+   * @generated from field: float z = 6;
+   */
+  z: number;
+
+  /**
+   * Quaternion indicating world-to-surface-normal and heading transformation of the takeoff position. Used to indicate the heading and slope of the ground.
+   *
+   * @generated from field: repeated float q = 7;
+   */
+  q: number[];
+
+  /**
+   * Local X position of the end of the approach vector (m)
+   *
+   * @generated from field: float approach_x = 8;
+   */
+  approachX: number;
+
+  /**
+   * Local Y position of the end of the approach vector (m)
+   *
+   * @generated from field: float approach_y = 9;
+   */
+  approachY: number;
+
+  /**
+   * Local Z position of the end of the approach vector (m)
+   *
+   * @generated from field: float approach_z = 10;
+   */
+  approachZ: number;
+
+  /**
+   * Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
+   *
+   * @generated from field: uint64 time_usec = 11;
+   */
+  timeUsec: bigint;
+};
+
+/**
+ * Describes the message flightpath.HomePosition.
+ * Use `create(HomePositionSchema)` to create a new message.
+ */
+export const HomePositionSchema: GenMessage<HomePosition> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 39);
+
+/**
+ * SubscribeRcChannelsRequest is the request message for SubscribeRcChannels
+ *
+ * @generated from message flightpath.SubscribeRcChannelsRequest
+ */
+export type SubscribeRcChannelsRequest = Message<"flightpath.SubscribeRcChannelsRequest"> & {
+};
+
+/**
+ * Describes the message flightpath.SubscribeRcChannelsRequest.
+ * Use `create(SubscribeRcChannelsRequestSchema)` to create a new message.
+ */
+export const SubscribeRcChannelsRequestSchema: GenMessage<SubscribeRcChannelsRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 40);
+
+/**
+ * SubscribeRcChannelsResponse contains RC_CHANNELS message data
+ *
+ * @generated from message flightpath.SubscribeRcChannelsResponse
+ */
+export type SubscribeRcChannelsResponse = Message<"flightpath.SubscribeRcChannelsResponse"> & {
+  /**
+   * Timestamp when this RC input was captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the RC input
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the RC input
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * RC input with unused channels left out
+   *
+   * @generated from field: flightpath.RcInput rc_input = 4;
+   */
+  rcInput?: RcInput;
+};
+
+/**
+ * Describes the message flightpath.SubscribeRcChannelsResponse.
+ * Use `create(SubscribeRcChannelsResponseSchema)` to create a new message.
+ */
+export const SubscribeRcChannelsResponseSchema: GenMessage<SubscribeRcChannelsResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 41);
+
+/**
+ * RcInput is the RC input from RC_CHANNELS with unused channels left out
+ *
+ * @generated from message flightpath.RcInput
+ */
+export type RcInput = Message<"flightpath.RcInput"> & {
+  /**
+   * Timestamp (time since system boot) (ms)
+   *
+   * @generated from field: uint32 time_boot_ms = 1;
+   */
+  timeBootMs: number;
+
+  /**
+   * Total number of RC channels being received. This can be larger than 18, indicating that more channels are available but not given in RC_CHANNELS. 0 when no RC channels are available.
+   *
+   * @generated from field: uint32 channel_count = 2;
+   */
+  channelCount: number;
+
+  /**
+   * Values of the used channels (us). Channels reported as unused (UINT16_MAX) are left out.
+   *
+   * @generated from field: repeated flightpath.ChannelValue channels = 3;
+   */
+  channels: ChannelValue[];
+
+  /**
+   * Receive signal strength indicator in device-dependent units/scale [0-254]. Not set if invalid/unknown.
+   *
+   * @generated from field: optional uint32 rssi = 4;
+   */
+  rssi?: number;
+};
+
+/**
+ * Describes the message flightpath.RcInput.
+ * Use `create(RcInputSchema)` to create a new message.
+ */
+export const RcInputSchema: GenMessage<RcInput> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 42);
+
+/**
+ * SubscribeActuatorOutputsRequest is the request message for SubscribeActuatorOutputs
+ *
+ * @generated from message flightpath.SubscribeActuatorOutputsRequest
+ */
+export type SubscribeActuatorOutputsRequest = Message<"flightpath.SubscribeActuatorOutputsRequest"> & {
+};
+
+/**
+ * Describes the message flightpath.SubscribeActuatorOutputsRequest.
+ * Use `create(SubscribeActuatorOutputsRequestSchema)` to create a new message.
+ */
+export const SubscribeActuatorOutputsRequestSchema: GenMessage<SubscribeActuatorOutputsRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 43);
+
+/**
+ * SubscribeActuatorOutputsResponse contains SERVO_OUTPUT_RAW and ACTUATOR_OUTPUT_STATUS message data
+ *
+ * @generated from message flightpath.SubscribeActuatorOutputsResponse
+ */
+export type SubscribeActuatorOutputsResponse = Message<"flightpath.SubscribeActuatorOutputsResponse"> & {
+  /**
+   * Timestamp when these outputs were captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the outputs
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the outputs
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * Latest servo outputs of all ports (not set until the first SERVO_OUTPUT_RAW is received)
+   *
+   * @generated from field: flightpath.ServoOutputs servo_outputs = 4;
+   */
+  servoOutputs?: ServoOutputs;
+
+  /**
+   * Latest actuator outputs (not set until the first ACTUATOR_OUTPUT_STATUS is received)
+   *
+   * @generated from field: flightpath.ActuatorOutputs actuator_outputs = 5;
+   */
+  actuatorOutputs?: ActuatorOutputs;
+};
+
+/**
+ * Describes the message flightpath.SubscribeActuatorOutputsResponse.
+ * Use `create(SubscribeActuatorOutputsResponseSchema)` to create a new message.
+ */
+export const SubscribeActuatorOutputsResponseSchema: GenMessage<SubscribeActuatorOutputsResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 44);
+
+/**
+ * ServoOutputs are the servo outputs from the SERVO_OUTPUT_RAW messages of all ports, grouped
+ * into a single channel list (port 0 holds channels 1-16, port 1 channels 17-32, etc.)
+ *
+ * @generated from message flightpath.ServoOutputs
+ */
+export type ServoOutputs = Message<"flightpath.ServoOutputs"> & {
+  /**
+   * Ports a SERVO_OUTPUT_RAW has been received for
+   *
+   * @generated from field: repeated uint32 ports = 1;
+   */
+  ports: number[];
+
+  /**
+   * Values of the used channels in channel order (us). Channels reported as unused are left out.
+   *
+   * @generated from field: repeated flightpath.ChannelValue channels = 2;
+   */
+  channels: ChannelValue[];
+};
+
+/**
+ * Describes the message flightpath.ServoOutputs.
+ * Use `create(ServoOutputsSchema)` to create a new message.
+ */
+export const ServoOutputsSchema: GenMessage<ServoOutputs> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 45);
+
+/**
+ * ChannelValue is the value of a single RC or servo channel
+ *
+ * @generated from message flightpath.ChannelValue
+ */
+export type ChannelValue = Message<"flightpath.ChannelValue"> & {
+  /**
+   * Channel number (1-based)
+   *
+   * @generated from field: uint32 channel = 1;
+   */
+  channel: number;
+
+  /**
+   * Channel value (us)
+   *
+   * @generated from field: uint32 value = 2;
+   */
+  value: number;
+};
+
+/**
+ * Describes the message flightpath.ChannelValue.
+ * Use `create(ChannelValueSchema)` to create a new message.
+ */
+export const ChannelValueSchema: GenMessage<ChannelValue> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 46);
+
+/**
+ * ActuatorOutputs are the actuator outputs from ACTUATOR_OUTPUT_STATUS with unused actuators left out
+ *
+ * @generated from message flightpath.ActuatorOutputs
+ */
+export type ActuatorOutputs = Message<"flightpath.ActuatorOutputs"> & {
+  /**
+   * Timestamp (since system boot) (us)
+   *
+   * @generated from field: uint64 time_usec = 1;
+   */
+  timeUsec: bigint;
+
+  /**
+   * Values of the used actuators
+   *
+   * @generated from field: repeated flightpath.ActuatorValue actuators = 2;
+   */
+  actuators: ActuatorValue[];
+};
+
+/**
+ * Describes the message flightpath.ActuatorOutputs.
+ * Use `create(ActuatorOutputsSchema)` to create a new message.
+ */
+export const ActuatorOutputsSchema: GenMessage<ActuatorOutputs> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 47);
+
+/**
+ * ActuatorValue is the value of a single actuator output
+ *
+ * @generated from message flightpath.ActuatorValue
+ */
+export type ActuatorValue = Message<"flightpath.ActuatorValue"> & {
+  /**
+   * Actuator number (1-based)
+   *
+   * @generated from field: uint32 actuator = 1;
+   */
+  actuator: number;
+
+  /**
+   * Servo / motor output value
+   *
+   * @generated from field: float value = 2;
+   */
+  value: number;
+};
+
+/**
+ * Describes the message flightpath.ActuatorValue.
+ * Use `create(ActuatorValueSchema)` to create a new message.
+ */
+export const ActuatorValueSchema: GenMessage<ActuatorValue> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 48);
+
+/**
+ * RcChannels represents the RC_CHANNELS MAVLink message
+ * The PPM values of the RC channels received. The standard PPM modulation is as follows: 1000 microseconds: 0%, 2000 microseconds: 100%.
+ *
+ * @generated from message flightpath.RcChannels
+ */
+export type RcChannels = Message<"flightpath.RcChannels"> & {
+  /**
+   * Timestamp (time since system boot) (ms)
+   *
+   * @generated from field: uint32 time_boot_ms = 1;
+   */
+  timeBootMs: number;
+
+  /**
+   * Total number of RC channels being received. This can be larger than 18, indicating that more channels are available but not given in this message. This value should be 0 when no RC channels are available.
+   *
+   * @generated from field: uint32 chancount = 2;
+   */
+  chancount: number;
+
+  /**
+   * RC channel 1 to 18 values. A channel should be disregarded if set to UINT16_MAX. (us)
+   *
+   * @generated from field: repeated uint32 chan_raw = 3;
+   */
+  chanRaw: number[];
+
+  /**
+   * Receive signal strength indicator in device-dependent units/scale. Values: [0-254], UINT8_MAX: invalid/unknown.
+   *
+   * @generated from field: uint32 rssi = 4;
+   */
+  rssi: number;
+};
+
+/**
+ * Describes the message flightpath.RcChannels.
+ * Use `create(RcChannelsSchema)` to create a new message.
+ */
+export const RcChannelsSchema: GenMessage<RcChannels> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 49);
+
+/**
+ * ServoOutputRaw represents the SERVO_OUTPUT_RAW MAVLink message
+ * Superseded by ACTUATOR_OUTPUT_STATUS. The RAW values of the servo outputs.
+ *
+ * @generated from message flightpath.ServoOutputRaw
+ */
+export type ServoOutputRaw = Message<"flightpath.ServoOutputRaw"> & {
+  /**
+   * Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number. (us)
+   *
+   * @generated from field: uint32 time_usec = 1;
+   */
+  timeUsec: number;
+
+  /**
+   * Servo output port (set of 16 outputs = 1 port). Flight stacks running on Pixhawk should use: 0 = MAIN, 1 = AUX.
+   *
+   * @generated from field: uint32 port = 2;
+   */
+  port: number;
+
+  /**
+   * Servo output 1 to 16 values (us)
+   *
+   * @generated from field: repeated uint32 servo_raw = 3;
+   */
+  servoRaw: number[];
+};
+
+/**
+ * Describes the message flightpath.ServoOutputRaw.
+ * Use `create(ServoOutputRawSchema)` to create a new message.
+ */
+export const ServoOutputRawSchema: GenMessage<ServoOutputRaw> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 50);
+
+/**
+ * ActuatorOutputStatus represents the ACTUATOR_OUTPUT_STATUS MAVLink message
+ * The raw values of the actuator outputs (e.g. on Pixhawk, from MAIN, AUX ports).
+ *
+ * @generated from message flightpath.ActuatorOutputStatus
+ */
+export type ActuatorOutputStatus = Message<"flightpath.ActuatorOutputStatus"> & {
+  /**
+   * Timestamp (since system boot) (us)
+   *
+   * @generated from field: uint64 time_usec = 1;
+   */
+  timeUsec: bigint;
+
+  /**
+   * Active outputs
+   *
+   * @generated from field: uint32 active = 2;
+   */
+  active: number;
+
+  /**
+   * Servo / motor output array values. Zero values indicate unused channels.
+   *
+   * @generated from field: repeated float actuator = 3;
+   */
+  actuator: number[];
+};
+
+/**
+ * Describes the message flightpath.ActuatorOutputStatus.
+ * Use `create(ActuatorOutputStatusSchema)` to create a new message.
+ */
+export const ActuatorOutputStatusSchema: GenMessage<ActuatorOutputStatus> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 51);
+
+/**
+ * SubscribeEstimatorStatusRequest is the request message for SubscribeEstimatorStatus
+ *
+ * @generated from message flightpath.SubscribeEstimatorStatusRequest
+ */
+export type SubscribeEstimatorStatusRequest = Message<"flightpath.SubscribeEstimatorStatusRequest"> & {
+};
+
+/**
+ * Describes the message flightpath.SubscribeEstimatorStatusRequest.
+ * Use `create(SubscribeEstimatorStatusRequestSchema)` to create a new message.
+ */
+export const SubscribeEstimatorStatusRequestSchema: GenMessage<SubscribeEstimatorStatusRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 52);
+
+/**
+ * SubscribeEstimatorStatusResponse contains ESTIMATOR_STATUS and VIBRATION message data
+ *
+ * @generated from message flightpath.SubscribeEstimatorStatusResponse
+ */
+export type SubscribeEstimatorStatusResponse = Message<"flightpath.SubscribeEstimatorStatusResponse"> & {
+  /**
+   * Timestamp when this estimator status was captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the estimator status
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the estimator status
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * Latest ESTIMATOR_STATUS message data (not set until the first ESTIMATOR_STATUS is received)
+   *
+   * @generated from field: flightpath.EstimatorStatus estimator_status = 4;
+   */
+  estimatorStatus?: EstimatorStatus;
+
+  /**
+   * Latest VIBRATION message data (not set until the first VIBRATION is received)
+   *
+   * @generated from field: flightpath.Vibration vibration = 5;
+   */
+  vibration?: Vibration;
+
+  /**
+   * True if the estimator reports a valid attitude, velocity and position without GPS glitch or
+   * accelerometer error, all innovation test ratios are within the configured maximum, and the
+   * vibration levels (if known) are within the configured maximum.
+   * False until ESTIMATOR_STATUS is received.
+   *
+   * @generated from field: bool estimator_healthy = 6;
+   */
+  estimatorHealthy: boolean;
+};
+
+/**
+ * Describes the message flightpath.SubscribeEstimatorStatusResponse.
+ * Use `create(SubscribeEstimatorStatusResponseSchema)` to create a new message.
+ */
+export const SubscribeEstimatorStatusResponseSchema: GenMessage<SubscribeEstimatorStatusResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 53);
+
+/**
+ * EstimatorStatus represents the ESTIMATOR_STATUS MAVLink message
+ * Estimator status message including flags, innovation test ratios and estimated accuracies.
+ * The innovation test ratios show the magnitude of the sensor innovation divided by the innovation check threshold. Under normal operation the innovation test ratios should be below 0.5 with occasional values up to 1.0. Values greater than 1.0 should be rare under normal operation and indicate that a measurement has been rejected by the filter.
+ *
+ * @generated from message flightpath.EstimatorStatus
+ */
+export type EstimatorStatus = Message<"flightpath.EstimatorStatus"> & {
+  /**
+   * Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number. (us)
+   *
+   * @generated from field: uint64 time_usec = 1;
+   */
+  timeUsec: bigint;
+
+  /**
+   * Which EKF outputs are valid
+   *
+   * @generated from field: flightpath.EstimatorStatusFlags flags = 2;
+   */
+  flags?: EstimatorStatusFlags;
+
+  /**
+   * Velocity innovation test ratio
+   *
+   * @generated from field: float vel_ratio = 3;
+   */
+  velRatio: number;
+
+  /**
+   * Horizontal position innovation test ratio
+   *
+   * @generated from field: float pos_horiz_ratio = 4;
+   */
+  posHorizRatio: number;
+
+  /**
+   * Vertical position innovation test ratio
+   *
+   * @generated from field: float pos_vert_ratio = 5;
+   */
+  posVertRatio: number;
+
+  /**
+   * Magnetometer innovation test ratio
+   *
+   * @generated from field: float mag_ratio = 6;
+   */
+  magRatio: number;
+
+  /**
+   * Height above terrain innovation test ratio
+   *
+   * @generated from field: float hagl_ratio = 7;
+   */
+  haglRatio: number;
+
+  /**
+   * True airspeed innovation test ratio
+   *
+   * @generated from field: float tas_ratio = 8;
+   */
+  tasRatio: number;
+
+  /**
+   * Horizontal position 1-STD accuracy relative to the EKF local origin (m)
+   *
+   * @generated from field: float pos_horiz_accuracy = 9;
+   */
+  posHorizAccuracy: number;
+
+  /**
+   * Vertical position 1-STD accuracy relative to the EKF local origin (m)
+   *
+   * @generated from field: float pos_vert_accuracy = 10;
+   */
+  posVertAccuracy: number;
+};
+
+/**
+ * Describes the message flightpath.EstimatorStatus.
+ * Use `create(EstimatorStatusSchema)` to create a new message.
+ */
+export const EstimatorStatusSchema: GenMessage<EstimatorStatus> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 54);
+
+/**
+ * EstimatorStatusFlags represents the ESTIMATOR_STATUS_FLAGS bitfield as structured boolean flags.
+ * Bits are ordered from least significant (bit 0) to most significant (bit 11),
+ * matching https://mavlink.io/en/messages/common.html#ESTIMATOR_STATUS_FLAGS.
+ *
+ * @generated from message flightpath.EstimatorStatusFlags
+ */
+export type EstimatorStatusFlags = Message<"flightpath.EstimatorStatusFlags"> & {
+  /**
+   * Bit 0 (1): True if the attitude estimate is good
+   *
+   * @generated from field: bool attitude = 1;
+   */
+  attitude: boolean;
+
+  /**
+   * Bit 1 (2): True if the horizontal velocity estimate is good
+   *
+   * @generated from field: bool velocity_horiz = 2;
+   */
+  velocityHoriz: boolean;
+
+  /**
+   * Bit 2 (4): True if the vertical velocity estimate is good
+   *
+   * @generated from field: bool velocity_vert = 3;
+   */
+  velocityVert: boolean;
+
+  /**
+   * Bit 3 (8): True if the horizontal position (relative) estimate is good
+   *
+   * @generated from field: bool pos_horiz_rel = 4;
+   */
+  posHorizRel: boolean;
+
+  /**
+   * Bit 4 (16): True if the horizontal position (absolute) estimate is good
+   *
+   * @generated from field: bool pos_horiz_abs = 5;
+   */
+  posHorizAbs: boolean;
+
+  /**
+   * Bit 5 (32): True if the vertical position (absolute) estimate is good
+   *
+   * @generated from field: bool pos_vert_abs = 6;
+   */
+  posVertAbs: boolean;
+
+  /**
+   * Bit 6 (64): True if the vertical position (above ground) estimate is good
+   *
+   * @generated from field: bool pos_vert_agl = 7;
+   */
+  posVertAgl: boolean;
+
+  /**
+   * Bit 7 (128): True if the EKF is in a constant position mode and is not using external measurements (eg GPS or optical flow)
+   *
+   * @generated from field: bool const_pos_mode = 8;
+   */
+  constPosMode: boolean;
+
+  /**
+   * Bit 8 (256): True if the EKF has sufficient data to enter a mode that will provide a (relative) position estimate
+   *
+   * @generated from field: bool pred_pos_horiz_rel = 9;
+   */
+  predPosHorizRel: boolean;
+
+  /**
+   * Bit 9 (512): True if the EKF has sufficient data to enter a mode that will provide a (absolute) position estimate
+   *
+   * @generated from field: bool pred_pos_horiz_abs = 10;
+   */
+  predPosHorizAbs: boolean;
+
+  /**
+   * Bit 10 (1024): True if the EKF has detected a GPS glitch
+   *
+   * @generated from field: bool gps_glitch = 11;
+   */
+  gpsGlitch: boolean;
+
+  /**
+   * Bit 11 (2048): True if the EKF has detected bad accelerometer data
+   *
+   * @generated from field: bool accel_error = 12;
+   */
+  accelError: boolean;
+};
+
+/**
+ * Describes the message flightpath.EstimatorStatusFlags.
+ * Use `create(EstimatorStatusFlagsSchema)` to create a new message.
+ */
+export const EstimatorStatusFlagsSchema: GenMessage<EstimatorStatusFlags> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 55);
+
+/**
+ * Vibration represents the VIBRATION MAVLink message
+ * Vibration levels and accelerometer clipping.
+ *
+ * @generated from message flightpath.Vibration
+ */
+export type Vibration = Message<"flightpath.Vibration"> & {
+  /**
+   * Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number. (us)
+   *
+   * @generated from field: uint64 time_usec = 1;
+   */
+  timeUsec: bigint;
+
+  /**
+   * Vibration levels on X-axis
+   *
+   * @generated from field: float vibration_x = 2;
+   */
+  vibrationX: number;
+
+  /**
+   * Vibration levels on Y-axis
+   *
+   * @generated from field: float vibration_y = 3;
+   */
+  vibrationY: number;
+
+  /**
+   * Vibration levels on Z-axis
+   *
+   * @generated from field: float vibration_z = 4;
+   */
+  vibrationZ: number;
+
+  /**
+   * First accelerometer clipping count
+   *
+   * @generated from field: uint32 clipping0 = 5;
+   */
+  clipping0: number;
+
+  /**
+   * Second accelerometer clipping count
+   *
+   * @generated from field: uint32 clipping1 = 6;
+   */
+  clipping1: number;
+
+  /**
+   * Third accelerometer clipping count
+   *
+   * @generated from field: uint32 clipping2 = 7;
+   */
+  clipping2: number;
+};
+
+/**
+ * Describes the message flightpath.Vibration.
+ * Use `create(VibrationSchema)` to create a new message.
+ */
+export const VibrationSchema: GenMessage<Vibration> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 56);
+
+/**
+ * SubscribeWindRequest is the request message for SubscribeWind
+ *
+ * @generated from message flightpath.SubscribeWindRequest
+ */
+export type SubscribeWindRequest = Message<"flightpath.SubscribeWindRequest"> & {
+};
+
+/**
+ * Describes the message flightpath.SubscribeWindRequest.
+ * Use `create(SubscribeWindRequestSchema)` to create a new message.
+ */
+export const SubscribeWindRequestSchema: GenMessage<SubscribeWindRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 57);
+
+/**
+ * SubscribeWindResponse contains WIND_COV message data
+ *
+ * @generated from message flightpath.SubscribeWindResponse
+ */
+export type SubscribeWindResponse = Message<"flightpath.SubscribeWindResponse"> & {
+  /**
+   * Timestamp when this wind estimate was captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the wind estimate
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the wind estimate
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * Wind as speed and direction. Not set if the horizontal wind is unknown.
+   *
+   * @generated from field: flightpath.Wind wind = 4;
+   */
+  wind?: Wind;
+
+  /**
+   * WIND_COV message data
+   *
+   * @generated from field: flightpath.WindCov wind_cov = 5;
+   */
+  windCov?: WindCov;
+};
+
+/**
+ * Describes the message flightpath.SubscribeWindResponse.
+ * Use `create(SubscribeWindResponseSchema)` to create a new message.
+ */
+export const SubscribeWindResponseSchema: GenMessage<SubscribeWindResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 58);
+
+/**
+ * Wind is the horizontal wind from WIND_COV as speed and direction
+ *
+ * @generated from message flightpath.Wind
+ */
+export type Wind = Message<"flightpath.Wind"> & {
+  /**
+   * Horizontal wind speed (m/s)
+   *
+   * @generated from field: float speed = 1;
+   */
+  speed: number;
+
+  /**
+   * Direction the wind is blowing from, clockwise from north (0..360) (deg)
+   *
+   * @generated from field: float direction = 2;
+   */
+  direction: number;
+
+  /**
+   * Vertical wind speed, positive down. Not set if unknown. (m/s)
+   *
+   * @generated from field: optional float speed_down = 3;
+   */
+  speedDown?: number;
+};
+
+/**
+ * Describes the message flightpath.Wind.
+ * Use `create(WindSchema)` to create a new message.
+ */
+export const WindSchema: GenMessage<Wind> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 59);
+
+/**
+ * WindCov represents the WIND_COV MAVLink message
+ * Wind estimate from vehicle. Note that despite the name, this message does not actually contain any covariances but instead variability and accuracy fields in terms of standard deviation (1-STD).
+ *
+ * @generated from message flightpath.WindCov
+ */
+export type WindCov = Message<"flightpath.WindCov"> & {
+  /**
+   * Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number. (us)
+   *
+   * @generated from field: uint64 time_usec = 1;
+   */
+  timeUsec: bigint;
+
+  /**
+   * Wind in North (NED) direction (NAN if unknown) (m/s)
+   *
+   * @generated from field: float wind_x = 2;
+   */
+  windX: number;
+
+  /**
+   * Wind in East (NED) direction (NAN if unknown) (m/s)
+   *
+   * @generated from field: float wind_y = 3;
+   */
+  windY: number;
+
+  /**
+   * Wind in down (NED) direction (NAN if unknown) (m/s)
+   *
+   * @generated from field: float wind_z = 4;
+   */
+  windZ: number;
+
+  /**
+   * Variability of wind in XY, 1-STD estimated from a 1 Hz lowpassed wind estimate (NAN if unknown) (m/s)
+   *
+   * @generated from field: float var_horiz = 5;
+   */
+  varHoriz: number;
+
+  /**
+   * Variability of wind in Z, 1-STD estimated from a 1 Hz lowpassed wind estimate (NAN if unknown) (m/s)
+   *
+   * @generated from field: float var_vert = 6;
+   */
+  varVert: number;
+
+  /**
+   * Altitude (MSL) that this measurement was taken at (NAN if unknown) (m)
+   *
+   * @generated from field: float wind_alt = 7;
+   */
+  windAlt: number;
+
+  /**
+   * Horizontal speed 1-STD accuracy (0 if unknown) (m/s)
+   *
+   * @generated from field: float horiz_accuracy = 8;
+   */
+  horizAccuracy: number;
+
+  /**
+   * Vertical speed 1-STD accuracy (0 if unknown) (m/s)
+   *
+   * @generated from field: float vert_accuracy = 9;
+   */
+  vertAccuracy: number;
+};
+
+/**
+ * Describes the message flightpath.WindCov.
+ * Use `create(WindCovSchema)` to create a new message.
+ */
+export const WindCovSchema: GenMessage<WindCov> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 60);
+
+/**
+ * SubscribeDistanceSensorRequest is the request message for SubscribeDistanceSensor
+ *
+ * @generated from message flightpath.SubscribeDistanceSensorRequest
+ */
+export type SubscribeDistanceSensorRequest = Message<"flightpath.SubscribeDistanceSensorRequest"> & {
+};
+
+/**
+ * Describes the message flightpath.SubscribeDistanceSensorRequest.
+ * Use `create(SubscribeDistanceSensorRequestSchema)` to create a new message.
+ */
+export const SubscribeDistanceSensorRequestSchema: GenMessage<SubscribeDistanceSensorRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 61);
+
+/**
+ * SubscribeDistanceSensorResponse contains DISTANCE_SENSOR message data
+ *
+ * @generated from message flightpath.SubscribeDistanceSensorResponse
+ */
+export type SubscribeDistanceSensorResponse = Message<"flightpath.SubscribeDistanceSensorResponse"> & {
+  /**
+   * Timestamp when this reading was captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the reading
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the reading
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * DISTANCE_SENSOR message data (sensors are identified by distance_sensor.id)
+   *
+   * @generated from field: flightpath.DistanceSensor distance_sensor = 4;
+   */
+  distanceSensor?: DistanceSensor;
+};
+
+/**
+ * Describes the message flightpath.SubscribeDistanceSensorResponse.
+ * Use `create(SubscribeDistanceSensorResponseSchema)` to create a new message.
+ */
+export const SubscribeDistanceSensorResponseSchema: GenMessage<SubscribeDistanceSensorResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 62);
+
+/**
+ * DistanceSensor represents the DISTANCE_SENSOR MAVLink message
+ * Distance sensor information for an onboard rangefinder.
+ *
+ * @generated from message flightpath.DistanceSensor
+ */
+export type DistanceSensor = Message<"flightpath.DistanceSensor"> & {
+  /**
+   * Timestamp (time since system boot) (ms)
+   *
+   * @generated from field: uint32 time_boot_ms = 1;
+   */
+  timeBootMs: number;
+
+  /**
+   * Minimum distance the sensor can measure (cm)
+   *
+   * @generated from field: uint32 min_distance = 2;
+   */
+  minDistance: number;
+
+  /**
+   * Maximum distance the sensor can measure (cm)
+   *
+   * @generated from field: uint32 max_distance = 3;
+   */
+  maxDistance: number;
+
+  /**
+   * Current distance reading (cm)
+   *
+   * @generated from field: uint32 current_distance = 4;
+   */
+  currentDistance: number;
+
+  /**
+   * Type of distance sensor
+   *
+   * @generated from field: flightpath.MavDistanceSensor type = 5;
+   */
+  type: MavDistanceSensor;
+
+  /**
+   * Onboard ID of the sensor
+   *
+   * @generated from field: uint32 id = 6;
+   */
+  id: number;
+
+  /**
+   * Direction the sensor faces. downward-facing: ROTATION_PITCH_270, upward-facing: ROTATION_PITCH_90, backward-facing: ROTATION_PITCH_180, forward-facing: ROTATION_NONE, left-facing: ROTATION_YAW_90, right-facing: ROTATION_YAW_270
+   *
+   * @generated from field: flightpath.MavSensorOrientation orientation = 7;
+   */
+  orientation: MavSensorOrientation;
+
+  /**
+   * Measurement variance. Max standard deviation is 6cm. UINT8_MAX if unknown. (cm^2)
+   *
+   * @generated from field: uint32 covariance = 8;
+   */
+  covariance: number;
+
+  /**
+   * Horizontal Field of View (angle) where the distance measurement is valid and the field of view is known. Otherwise this is set to 0. (rad)
+   *
+   * @generated from field: float horizontal_fov = 9;
+   */
+  horizontalFov: number;
+
+  /**
+   * Vertical Field of View (angle) where the distance measurement is valid and the field of view is known. Otherwise this is set to 0. (rad)
+   *
+   * @generated from field: float vertical_fov = 10;
+   */
+  verticalFov: number;
+
+  /**
+   * Quaternion of the sensor orientation in vehicle body frame (w, x, y, z order, zero-rotation is 1, 0, 0, 0). Zero-rotation is along the vehicle body x-axis. This field is required if the orientation is set to MAV_SENSOR_ROTATION_CUSTOM. Set it to 0 if invalid.
+   *
+   * @generated from field: repeated float quaternion = 11;
+   */
+  quaternion: number[];
+
+  /**
+   * Signal quality of the sensor. Specific to each sensor type, representing the relation of the signal strength with the target reflectivity, distance, size or aspect, but normalised as a percentage. 0 = unknown/unset signal quality, 1 = invalid signal, 100 = perfect signal. (%)
+   *
+   * @generated from field: uint32 signal_quality = 12;
+   */
+  signalQuality: number;
+};
+
+/**
+ * Describes the message flightpath.DistanceSensor.
+ * Use `create(DistanceSensorSchema)` to create a new message.
+ */
+export const DistanceSensorSchema: GenMessage<DistanceSensor> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 63);
+
+/**
+ * SubscribeObstacleDistanceRequest is the request message for SubscribeObstacleDistance
+ *
+ * @generated from message flightpath.SubscribeObstacleDistanceRequest
+ */
+export type SubscribeObstacleDistanceRequest = Message<"flightpath.SubscribeObstacleDistanceRequest"> & {
+};
+
+/**
+ * Describes the message flightpath.SubscribeObstacleDistanceRequest.
+ * Use `create(SubscribeObstacleDistanceRequestSchema)` to create a new message.
+ */
+export const SubscribeObstacleDistanceRequestSchema: GenMessage<SubscribeObstacleDistanceRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 64);
+
+/**
+ * SubscribeObstacleDistanceResponse contains OBSTACLE_DISTANCE message data
+ *
+ * @generated from message flightpath.SubscribeObstacleDistanceResponse
+ */
+export type SubscribeObstacleDistanceResponse = Message<"flightpath.SubscribeObstacleDistanceResponse"> & {
+  /**
+   * Timestamp when these obstacles were captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the obstacles
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the obstacles
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * Distance to obstacles per direction, with angles resolved. Directions marked as unknown/not
+   * used (UINT16_MAX) are left out.
+   *
+   * @generated from field: repeated flightpath.ObstacleSector sectors = 4;
+   */
+  sectors: ObstacleSector[];
+
+  /**
+   * OBSTACLE_DISTANCE message data
+   *
+   * @generated from field: flightpath.ObstacleDistance obstacle_distance = 5;
+   */
+  obstacleDistance?: ObstacleDistance;
+};
+
+/**
+ * Describes the message flightpath.SubscribeObstacleDistanceResponse.
+ * Use `create(SubscribeObstacleDistanceResponseSchema)` to create a new message.
+ */
+export const SubscribeObstacleDistanceResponseSchema: GenMessage<SubscribeObstacleDistanceResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 65);
+
+/**
+ * ObstacleSector is the distance to the nearest obstacle in one direction of an OBSTACLE_DISTANCE message
+ *
+ * @generated from message flightpath.ObstacleSector
+ */
+export type ObstacleSector = Message<"flightpath.ObstacleSector"> & {
+  /**
+   * Direction of the sector, clockwise from north (obstacle_distance.frame MAV_FRAME_GLOBAL) or
+   * from the vehicle front (MAV_FRAME_BODY_FRD) (0..360) (deg)
+   *
+   * @generated from field: float angle = 1;
+   */
+  angle: number;
+
+  /**
+   * Distance to the obstacle. Not set if no obstacle is present within the sensor range. (m)
+   *
+   * @generated from field: optional float distance = 2;
+   */
+  distance?: number;
+};
+
+/**
+ * Describes the message flightpath.ObstacleSector.
+ * Use `create(ObstacleSectorSchema)` to create a new message.
+ */
+export const ObstacleSectorSchema: GenMessage<ObstacleSector> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 66);
+
+/**
+ * ObstacleDistance represents the OBSTACLE_DISTANCE MAVLink message
+ * Obstacle distances in front of the sensor, starting from the left in increment degrees to the right
+ *
+ * @generated from message flightpath.ObstacleDistance
+ */
+export type ObstacleDistance = Message<"flightpath.ObstacleDistance"> & {
+  /**
+   * Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number. (us)
+   *
+   * @generated from field: uint64 time_usec = 1;
+   */
+  timeUsec: bigint;
+
+  /**
+   * Class id of the distance sensor type
+   *
+   * @generated from field: flightpath.MavDistanceSensor sensor_type = 2;
+   */
+  sensorType: MavDistanceSensor;
+
+  /**
+   * Distance of obstacles around the vehicle with index 0 corresponding to north + angle_offset, unless otherwise specified in the frame. A value of 0 is valid and means that the obstacle is practically touching the sensor. A value of max_distance +1 means no obstacle is present. A value of UINT16_MAX for unknown/not used. In a array element, one unit corresponds to 1cm. (cm)
+   *
+   * @generated from field: repeated uint32 distances = 3;
+   */
+  distances: number[];
+
+  /**
+   * Angular width in degrees of each array element. Increment direction is clockwise. This field is ignored if increment_f is non-zero. (deg)
+   *
+   * @generated from field: uint32 increment = 4;
+   */
+  increment: number;
+
+  /**
+   * Minimum distance the sensor can measure (cm)
+   *
+   * @generated from field: uint32 min_distance = 5;
+   */
+  minDistance: number;
+
+  /**
+   * Maximum distance the sensor can measure (cm)
+   *
+   * @generated from field: uint32 max_distance = 6;
+   */
+  maxDistance: number;
+
+  /**
+   * Angular width in degrees of each array element as a float. If non-zero then this value is used instead of the uint8_t increment field. Positive is clockwise direction, negative is counter-clockwise. (deg)
+   *
+   * @generated from field: float increment_f = 7;
+   */
+  incrementF: number;
+
+  /**
+   * Relative angle offset of the 0-index element in the distances array. Value of 0 corresponds to forward. Positive is clockwise direction, negative is counter-clockwise. (deg)
+   *
+   * @generated from field: float angle_offset = 8;
+   */
+  angleOffset: number;
+
+  /**
+   * Coordinate frame of reference for the yaw rotation and offset of the sensor data. Defaults to MAV_FRAME_GLOBAL, which is north aligned. For body-mounted sensors use MAV_FRAME_BODY_FRD, which is vehicle front aligned.
+   *
+   * @generated from field: flightpath.MavFrame frame = 9;
+   */
+  frame: MavFrame;
+};
+
+/**
+ * Describes the message flightpath.ObstacleDistance.
+ * Use `create(ObstacleDistanceSchema)` to create a new message.
+ */
+export const ObstacleDistanceSchema: GenMessage<ObstacleDistance> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 67);
+
+/**
+ * SubscribeGpsReceiversRequest is the request message for SubscribeGpsReceivers
+ *
+ * @generated from message flightpath.SubscribeGpsReceiversRequest
+ */
+export type SubscribeGpsReceiversRequest = Message<"flightpath.SubscribeGpsReceiversRequest"> & {
+};
+
+/**
+ * Describes the message flightpath.SubscribeGpsReceiversRequest.
+ * Use `create(SubscribeGpsReceiversRequestSchema)` to create a new message.
+ */
+export const SubscribeGpsReceiversRequestSchema: GenMessage<SubscribeGpsReceiversRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 68);
+
+/**
+ * SubscribeGpsReceiversResponse contains GPS_RAW_INT or GPS2_RAW message data
+ *
+ * @generated from message flightpath.SubscribeGpsReceiversResponse
+ */
+export type SubscribeGpsReceiversResponse = Message<"flightpath.SubscribeGpsReceiversResponse"> & {
+  /**
+   * Timestamp when this GPS data was captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the GPS data
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the GPS data
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * Instance of the GPS receiver (0 = GPS_RAW_INT, 1 = GPS2_RAW)
+   *
+   * @generated from field: uint32 gps_instance = 4;
+   */
+  gpsInstance: number;
+
+  /**
+   * GPS reading with unknown values left unset, for comparing receivers
+   *
+   * @generated from field: flightpath.GpsReceiver receiver = 5;
+   */
+  receiver?: GpsReceiver;
+
+  /**
+   * GPS_RAW_INT message data (first receiver only)
+   *
+   * @generated from field: flightpath.GpsRawInt gps_raw_int = 6;
+   */
+  gpsRawInt?: GpsRawInt;
+
+  /**
+   * GPS2_RAW message data (second receiver only)
+   *
+   * @generated from field: flightpath.Gps2Raw gps2_raw = 7;
+   */
+  gps2Raw?: Gps2Raw;
+};
+
+/**
+ * Describes the message flightpath.SubscribeGpsReceiversResponse.
+ * Use `create(SubscribeGpsReceiversResponseSchema)` to create a new message.
+ */
+export const SubscribeGpsReceiversResponseSchema: GenMessage<SubscribeGpsReceiversResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 69);
+
+/**
+ * GpsReceiver is a GPS reading in SI units, independent of the MAVLink message it came from
+ *
+ * @generated from message flightpath.GpsReceiver
+ */
+export type GpsReceiver = Message<"flightpath.GpsReceiver"> & {
+  /**
+   * GPS fix type
+   *
+   * @generated from field: flightpath.GpsFixType fix_type = 1;
+   */
+  fixType: GpsFixType;
+
+  /**
+   * Latitude (WGS84) (deg)
+   *
+   * @generated from field: double latitude = 2;
+   */
+  latitude: number;
+
+  /**
+   * Longitude (WGS84) (deg)
+   *
+   * @generated from field: double longitude = 3;
+   */
+  longitude: number;
+
+  /**
+   * Altitude (MSL), positive for up (m)
+   *
+   * @generated from field: float altitude = 4;
+   */
+  altitude: number;
+
+  /**
+   * Horizontal dilution of position. Not set if unknown.
+   *
+   * @generated from field: optional float hdop = 5;
+   */
+  hdop?: number;
+
+  /**
+   * Vertical dilution of position. Not set if unknown.
+   *
+   * @generated from field: optional float vdop = 6;
+   */
+  vdop?: number;
+
+  /**
+   * Ground speed. Not set if unknown. (m/s)
+   *
+   * @generated from field: optional float ground_speed = 7;
+   */
+  groundSpeed?: number;
+
+  /**
+   * Course over ground (direction of movement, not heading) (0..360). Not set if unknown. (deg)
+   *
+   * @generated from field: optional float course = 8;
+   */
+  course?: number;
+
+  /**
+   * Number of satellites visible. Not set if unknown.
+   *
+   * @generated from field: optional uint32 satellites_visible = 9;
+   */
+  satellitesVisible?: number;
+
+  /**
+   * Position uncertainty. Not set if not reported. (m)
+   *
+   * @generated from field: optional float horizontal_accuracy = 10;
+   */
+  horizontalAccuracy?: number;
+
+  /**
+   * Altitude uncertainty. Not set if not reported. (m)
+   *
+   * @generated from field: optional float vertical_accuracy = 11;
+   */
+  verticalAccuracy?: number;
+
+  /**
+   * Speed uncertainty. Not set if not reported. (m/s)
+   *
+   * @generated from field: optional float speed_accuracy = 12;
+   */
+  speedAccuracy?: number;
+
+  /**
+   * Yaw in earth frame from north (0..360). Not set if the receiver does not provide yaw or is currently unable to. (deg)
+   *
+   * @generated from field: optional float yaw = 13;
+   */
+  yaw?: number;
+};
+
+/**
+ * Describes the message flightpath.GpsReceiver.
+ * Use `create(GpsReceiverSchema)` to create a new message.
+ */
+export const GpsReceiverSchema: GenMessage<GpsReceiver> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 70);
+
+/**
+ * Gps2Raw represents the GPS2_RAW MAVLink message
+ * Second GPS data.
+ *
+ * @generated from message flightpath.Gps2Raw
+ */
+export type Gps2Raw = Message<"flightpath.Gps2Raw"> & {
+  /**
+   * Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number. (us)
+   *
+   * @generated from field: uint64 time_usec = 1;
+   */
+  timeUsec: bigint;
+
+  /**
+   * GPS fix type
+   *
+   * @generated from field: flightpath.GpsFixType fix_type = 2;
+   */
+  fixType: GpsFixType;
+
+  /**
+   * Latitude (WGS84) (degE7)
+   *
+   * @generated from field: int32 lat = 3;
+   */
+  lat: number;
+
+  /**
+   * Longitude (WGS84) (degE7)
+   *
+   * @generated from field: int32 lon = 4;
+   */
+  lon: number;
+
+  /**
+   * Altitude (MSL). Positive for up. (mm)
+   *
+   * @generated from field: int32 alt = 5;
+   */
+  alt: number;
+
+  /**
+   * GPS HDOP horizontal dilution of position (unitless * 100). If unknown, set to: UINT16_MAX
+   *
+   * @generated from field: uint32 eph = 6;
+   */
+  eph: number;
+
+  /**
+   * GPS VDOP vertical dilution of position (unitless * 100). If unknown, set to: UINT16_MAX
+   *
+   * @generated from field: uint32 epv = 7;
+   */
+  epv: number;
+
+  /**
+   * GPS ground speed. If unknown, set to: UINT16_MAX (cm/s)
+   *
+   * @generated from field: uint32 vel = 8;
+   */
+  vel: number;
+
+  /**
+   * Course over ground (NOT heading, but direction of movement): 0.0..359.99 degrees. If unknown, set to: UINT16_MAX (cdeg)
+   *
+   * @generated from field: uint32 cog = 9;
+   */
+  cog: number;
+
+  /**
+   * Number of satellites visible. If unknown, set to UINT8_MAX
+   *
+   * @generated from field: uint32 satellites_visible = 10;
+   */
+  satellitesVisible: number;
+
+  /**
+   * Number of DGPS satellites
+   *
+   * @generated from field: uint32 dgps_numch = 11;
+   */
+  dgpsNumch: number;
+
+  /**
+   * Age of DGPS info (ms)
+   *
+   * @generated from field: uint32 dgps_age = 12;
+   */
+  dgpsAge: number;
+
+  /**
+   * Yaw in earth frame from north. Use 0 if this GPS does not provide yaw. Use UINT16_MAX if this GPS is configured to provide yaw and is currently unable to provide it. Use 36000 for north. (cdeg)
+   *
+   * @generated from field: uint32 yaw = 13;
+   */
+  yaw: number;
+
+  /**
+   * Altitude (above WGS84, EGM96 ellipsoid). Positive for up. (mm)
+   *
+   * @generated from field: int32 alt_ellipsoid = 14;
+   */
+  altEllipsoid: number;
+
+  /**
+   * Position uncertainty (mm)
+   *
+   * @generated from field: uint32 h_acc = 15;
+   */
+  hAcc: number;
+
+  /**
+   * Altitude uncertainty (mm)
+   *
+   * @generated from field: uint32 v_acc = 16;
+   */
+  vAcc: number;
+
+  /**
+   * Speed uncertainty (mm/s)
+   *
+   * @generated from field: uint32 vel_acc = 17;
+   */
+  velAcc: number;
+
+  /**
+   * Heading / track uncertainty (degE5)
+   *
+   * @generated from field: uint32 hdg_acc = 18;
+   */
+  hdgAcc: number;
+};
+
+/**
+ * Describes the message flightpath.Gps2Raw.
+ * Use `create(Gps2RawSchema)` to create a new message.
+ */
+export const Gps2RawSchema: GenMessage<Gps2Raw> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 71);
+
+/**
+ * SubscribeGpsStatusRequest is the request message for SubscribeGpsStatus
+ *
+ * @generated from message flightpath.SubscribeGpsStatusRequest
+ */
+export type SubscribeGpsStatusRequest = Message<"flightpath.SubscribeGpsStatusRequest"> & {
+};
+
+/**
+ * Describes the message flightpath.SubscribeGpsStatusRequest.
+ * Use `create(SubscribeGpsStatusRequestSchema)` to create a new message.
+ */
+export const SubscribeGpsStatusRequestSchema: GenMessage<SubscribeGpsStatusRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 72);
+
+/**
+ * SubscribeGpsStatusResponse contains GPS_STATUS message data
+ *
+ * @generated from message flightpath.SubscribeGpsStatusResponse
+ */
+export type SubscribeGpsStatusResponse = Message<"flightpath.SubscribeGpsStatusResponse"> & {
+  /**
+   * Timestamp when this GPS status was captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the GPS status
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the GPS status
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * Visible satellites (at most 20)
+   *
+   * @generated from field: repeated flightpath.GpsSatellite satellites = 4;
+   */
+  satellites: GpsSatellite[];
+
+  /**
+   * GPS_STATUS message data
+   *
+   * @generated from field: flightpath.GpsStatus gps_status = 5;
+   */
+  gpsStatus?: GpsStatus;
+};
+
+/**
+ * Describes the message flightpath.SubscribeGpsStatusResponse.
+ * Use `create(SubscribeGpsStatusResponseSchema)` to create a new message.
+ */
+export const SubscribeGpsStatusResponseSchema: GenMessage<SubscribeGpsStatusResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 73);
+
+/**
+ * GpsSatellite is a satellite seen by a GPS receiver
+ *
+ * @generated from message flightpath.GpsSatellite
+ */
+export type GpsSatellite = Message<"flightpath.GpsSatellite"> & {
+  /**
+   * Global satellite ID
+   *
+   * @generated from field: uint32 prn = 1;
+   */
+  prn: number;
+
+  /**
+   * Whether the satellite is used for localization
+   *
+   * @generated from field: bool used = 2;
+   */
+  used: boolean;
+
+  /**
+   * Elevation of the satellite (deg)
+   *
+   * @generated from field: uint32 elevation = 3;
+   */
+  elevation: number;
+
+  /**
+   * Direction of the satellite (0..360) (deg)
+   *
+   * @generated from field: float azimuth = 4;
+   */
+  azimuth: number;
+
+  /**
+   * Signal to noise ratio of the satellite (dB)
+   *
+   * @generated from field: uint32 snr = 5;
+   */
+  snr: number;
+};
+
+/**
+ * Describes the message flightpath.GpsSatellite.
+ * Use `create(GpsSatelliteSchema)` to create a new message.
+ */
+export const GpsSatelliteSchema: GenMessage<GpsSatellite> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 74);
+
+/**
+ * GpsStatus represents the GPS_STATUS MAVLink message
+ * The positioning status, as reported by GPS. This message is intended to display status information about each satellite visible to the receiver. This message can contain information for up to 20 satellites.
+ *
+ * @generated from message flightpath.GpsStatus
+ */
+export type GpsStatus = Message<"flightpath.GpsStatus"> & {
+  /**
+   * Number of satellites visible
+   *
+   * @generated from field: uint32 satellites_visible = 1;
+   */
+  satellitesVisible: number;
+
+  /**
+   * Global satellite ID
+   *
+   * @generated from field: repeated uint32 satellite_prn = 2;
+   */
+  satellitePrn: number[];
+
+  /**
+   * 0: Satellite not used, 1: used for localization
+   *
+   * @generated from field: repeated uint32 satellite_used = 3;
+   */
+  satelliteUsed: number[];
+
+  /**
+   * Elevation (0: right on top of receiver, 90: on the horizon) of satellite (deg)
+   *
+   * @generated from field: repeated uint32 satellite_elevation = 4;
+   */
+  satelliteElevation: number[];
+
+  /**
+   * Direction of satellite, 0: 0 deg, 255: 360 deg.
+   *
+   * @generated from field: repeated uint32 satellite_azimuth = 5;
+   */
+  satelliteAzimuth: number[];
+
+  /**
+   * Signal to noise ratio of satellite (dB)
+   *
+   * @generated from field: repeated uint32 satellite_snr = 6;
+   */
+  satelliteSnr: number[];
+};
+
+/**
+ * Describes the message flightpath.GpsStatus.
+ * Use `create(GpsStatusSchema)` to create a new message.
+ */
+export const GpsStatusSchema: GenMessage<GpsStatus> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 75);
+
+/**
+ * SubscribeGpsRtkRequest is the request message for SubscribeGpsRtk
+ *
+ * @generated from message flightpath.SubscribeGpsRtkRequest
+ */
+export type SubscribeGpsRtkRequest = Message<"flightpath.SubscribeGpsRtkRequest"> & {
+};
+
+/**
+ * Describes the message flightpath.SubscribeGpsRtkRequest.
+ * Use `create(SubscribeGpsRtkRequestSchema)` to create a new message.
+ */
+export const SubscribeGpsRtkRequestSchema: GenMessage<SubscribeGpsRtkRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 76);
+
+/**
+ * SubscribeGpsRtkResponse contains GPS_RTK or GPS2_RTK message data
+ *
+ * @generated from message flightpath.SubscribeGpsRtkResponse
+ */
+export type SubscribeGpsRtkResponse = Message<"flightpath.SubscribeGpsRtkResponse"> & {
+  /**
+   * Timestamp when this RTK status was captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the RTK status
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the RTK status
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * Instance of the GPS receiver (0 = GPS_RTK, 1 = GPS2_RTK)
+   *
+   * @generated from field: uint32 gps_instance = 4;
+   */
+  gpsInstance: number;
+
+  /**
+   * RTK baseline in meters
+   *
+   * @generated from field: flightpath.RtkBaseline baseline = 5;
+   */
+  baseline?: RtkBaseline;
+
+  /**
+   * GPS_RTK or GPS2_RTK message data (both messages have the same fields)
+   *
+   * @generated from field: flightpath.GpsRtk gps_rtk = 6;
+   */
+  gpsRtk?: GpsRtk;
+};
+
+/**
+ * Describes the message flightpath.SubscribeGpsRtkResponse.
+ * Use `create(SubscribeGpsRtkResponseSchema)` to create a new message.
+ */
+export const SubscribeGpsRtkResponseSchema: GenMessage<SubscribeGpsRtkResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 77);
+
+/**
+ * RtkBaseline is the RTK baseline (vector from the base station to the receiver) in meters
+ *
+ * @generated from message flightpath.RtkBaseline
+ */
+export type RtkBaseline = Message<"flightpath.RtkBaseline"> & {
+  /**
+   * Coordinate system of the baseline
+   *
+   * @generated from field: flightpath.RtkBaselineCoordinateSystem coordinate_system = 1;
+   */
+  coordinateSystem: RtkBaselineCoordinateSystem;
+
+  /**
+   * ECEF x or NED north component (m)
+   *
+   * @generated from field: float a = 2;
+   */
+  a: number;
+
+  /**
+   * ECEF y or NED east component (m)
+   *
+   * @generated from field: float b = 3;
+   */
+  b: number;
+
+  /**
+   * ECEF z or NED down component (m)
+   *
+   * @generated from field: float c = 4;
+   */
+  c: number;
+
+  /**
+   * Length of the baseline (m)
+   *
+   * @generated from field: float length = 5;
+   */
+  length: number;
+};
+
+/**
+ * Describes the message flightpath.RtkBaseline.
+ * Use `create(RtkBaselineSchema)` to create a new message.
+ */
+export const RtkBaselineSchema: GenMessage<RtkBaseline> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 78);
+
+/**
+ * GpsRtk represents the GPS_RTK and GPS2_RTK MAVLink messages
+ * RTK GPS data. Gives information on the relative baseline calculation the GPS is reporting
+ *
+ * @generated from message flightpath.GpsRtk
+ */
+export type GpsRtk = Message<"flightpath.GpsRtk"> & {
+  /**
+   * Time since boot of last baseline message received. (ms)
+   *
+   * @generated from field: uint32 time_last_baseline_ms = 1;
+   */
+  timeLastBaselineMs: number;
+
+  /**
+   * Identification of connected RTK receiver.
+   *
+   * @generated from field: uint32 rtk_receiver_id = 2;
+   */
+  rtkReceiverId: number;
+
+  /**
+   * GPS Week Number of last baseline
+   *
+   * @generated from field: uint32 wn = 3;
+   */
+  wn: number;
+
+  /**
+   * GPS Time of Week of last baseline (ms)
+   *
+   * @generated from field: uint32 tow = 4;
+   */
+  tow: number;
+
+  /**
+   * GPS-specific health report for RTK data.
+   *
+   * @generated from field: uint32 rtk_health = 5;
+   */
+  rtkHealth: number;
+
+  /**
+   * Rate of baseline messages being received by GPS (Hz)
+   *
+   * @generated from field: uint32 rtk_rate = 6;
+   */
+  rtkRate: number;
+
+  /**
+   * Current number of sats used for RTK calculation.
+   *
+   * @generated from field: uint32 nsats = 7;
+   */
+  nsats: number;
+
+  /**
+   * Coordinate system of baseline
+   *
+   * @generated from field: flightpath.RtkBaselineCoordinateSystem baseline_coords_type = 8;
+   */
+  baselineCoordsType: RtkBaselineCoordinateSystem;
+
+  /**
+   * Current baseline in ECEF x or NED north component. (mm)
+   *
+   * @generated from field: int32 baseline_a_mm = 9;
+   */
+  baselineAMm: number;
+
+  /**
+   * Current baseline in ECEF y or NED east component. (mm)
+   *
+   * @generated from field: int32 baseline_b_mm = 10;
+   */
+  baselineBMm: number;
+
+  /**
+   * Current baseline in ECEF z or NED down component. (mm)
+   *
+   * @generated from field: int32 baseline_c_mm = 11;
+   */
+  baselineCMm: number;
+
+  /**
+   * Current estimate of baseline accuracy.
+   *
+   * @generated from field: uint32 accuracy = 12;
+   */
+  accuracy: number;
+
+  /**
+   * Current number of integer ambiguity hypotheses.
+   *
+   * @generated from field: int32 iar_num_hypotheses = 13;
+   */
+  iarNumHypotheses: number;
+};
+
+/**
+ * Describes the message flightpath.GpsRtk.
+ * Use `create(GpsRtkSchema)` to create a new message.
+ */
+export const GpsRtkSchema: GenMessage<GpsRtk> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 79);
+
+/**
+ * SubscribeTrafficRequest is the request message for SubscribeTraffic
+ *
+ * @generated from message flightpath.SubscribeTrafficRequest
+ */
+export type SubscribeTrafficRequest = Message<"flightpath.SubscribeTrafficRequest"> & {
+  /**
+   * Also send unmanned aircraft, space vehicles, surface vehicles and point obstacles
+   * (by default only manned aircraft and emitters of unknown type are sent)
+   *
+   * @generated from field: bool include_all_emitters = 1;
+   */
+  includeAllEmitters: boolean;
+};
+
+/**
+ * Describes the message flightpath.SubscribeTrafficRequest.
+ * Use `create(SubscribeTrafficRequestSchema)` to create a new message.
+ */
+export const SubscribeTrafficRequestSchema: GenMessage<SubscribeTrafficRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 80);
+
+/**
+ * SubscribeTrafficResponse contains ADSB_VEHICLE message data
+ *
+ * @generated from message flightpath.SubscribeTrafficResponse
+ */
+export type SubscribeTrafficResponse = Message<"flightpath.SubscribeTrafficResponse"> & {
+  /**
+   * Timestamp when this track was captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the track
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the track
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * Aircraft track with unknown values left unset
+   *
+   * @generated from field: flightpath.TrafficTrack track = 4;
+   */
+  track?: TrafficTrack;
+
+  /**
+   * Separation from the drone (the latest position of the system sending the track). Not set if
+   * the position of the drone or of the aircraft is unknown.
+   *
+   * @generated from field: flightpath.TrafficSeparation separation = 5;
+   */
+  separation?: TrafficSeparation;
+
+  /**
+   * Whether the aircraft has not been reported for a while and its track has been dropped
+   * (the last reported track is sent)
+   *
+   * @generated from field: bool expired = 6;
+   */
+  expired: boolean;
+
+  /**
+   * ADSB_VEHICLE message data
+   *
+   * @generated from field: flightpath.AdsbVehicle adsb_vehicle = 7;
+   */
+  adsbVehicle?: AdsbVehicle;
+};
+
+/**
+ * Describes the message flightpath.SubscribeTrafficResponse.
+ * Use `create(SubscribeTrafficResponseSchema)` to create a new message.
+ */
+export const SubscribeTrafficResponseSchema: GenMessage<SubscribeTrafficResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 81);
+
+/**
+ * TrafficTrack is an aircraft reported by ADS-B in SI units.
+ * Values flagged as invalid are not set.
+ *
+ * @generated from message flightpath.TrafficTrack
+ */
+export type TrafficTrack = Message<"flightpath.TrafficTrack"> & {
+  /**
+   * ICAO address
+   *
+   * @generated from field: uint32 icao_address = 1;
+   */
+  icaoAddress: number;
+
+  /**
+   * Callsign
+   *
+   * @generated from field: optional string callsign = 2;
+   */
+  callsign?: string;
+
+  /**
+   * Squawk code
+   *
+   * @generated from field: optional uint32 squawk = 3;
+   */
+  squawk?: number;
+
+  /**
+   * Emitter type
+   *
+   * @generated from field: flightpath.AdsbEmitterType emitter_type = 4;
+   */
+  emitterType: AdsbEmitterType;
+
+  /**
+   * Latitude (deg)
+   *
+   * @generated from field: optional double latitude = 5;
+   */
+  latitude?: number;
+
+  /**
+   * Longitude (deg)
+   *
+   * @generated from field: optional double longitude = 6;
+   */
+  longitude?: number;
+
+  /**
+   * Altitude (ASL) (m)
+   *
+   * @generated from field: optional float altitude = 7;
+   */
+  altitude?: number;
+
+  /**
+   * Whether the altitude is barometric (QNH) or geometric
+   *
+   * @generated from field: flightpath.AdsbAltitudeType altitude_type = 8;
+   */
+  altitudeType: AdsbAltitudeType;
+
+  /**
+   * Course over ground (0..360) (deg)
+   *
+   * @generated from field: optional float heading = 9;
+   */
+  heading?: number;
+
+  /**
+   * Horizontal speed (m/s)
+   *
+   * @generated from field: optional float horizontal_speed = 10;
+   */
+  horizontalSpeed?: number;
+
+  /**
+   * Vertical speed, positive up (m/s)
+   *
+   * @generated from field: optional float vertical_speed = 11;
+   */
+  verticalSpeed?: number;
+
+  /**
+   * Whether the track is simulated
+   *
+   * @generated from field: bool simulated = 12;
+   */
+  simulated: boolean;
+
+  /**
+   * Time since last communication with the aircraft (s)
+   *
+   * @generated from field: uint32 time_since_last_contact = 13;
+   */
+  timeSinceLastContact: number;
+};
+
+/**
+ * Describes the message flightpath.TrafficTrack.
+ * Use `create(TrafficTrackSchema)` to create a new message.
+ */
+export const TrafficTrackSchema: GenMessage<TrafficTrack> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 82);
+
+/**
+ * TrafficSeparation is the separation between the drone and an aircraft
+ *
+ * @generated from message flightpath.TrafficSeparation
+ */
+export type TrafficSeparation = Message<"flightpath.TrafficSeparation"> & {
+  /**
+   * Horizontal distance to the aircraft (m)
+   *
+   * @generated from field: float horizontal_distance = 1;
+   */
+  horizontalDistance: number;
+
+  /**
+   * Direction of the aircraft from the drone, clockwise from north (0..360) (deg)
+   *
+   * @generated from field: float bearing = 2;
+   */
+  bearing: number;
+
+  /**
+   * Altitude of the aircraft above the drone (negative if below). Not set if the aircraft altitude
+   * is unknown. (m)
+   *
+   * @generated from field: optional float vertical_separation = 3;
+   */
+  verticalSeparation?: number;
+
+  /**
+   * Rate at which the distance to the aircraft decreases (negative if diverging). Not set if the
+   * aircraft velocity is unknown. (m/s)
+   *
+   * @generated from field: optional float closing_rate = 4;
+   */
+  closingRate?: number;
+};
+
+/**
+ * Describes the message flightpath.TrafficSeparation.
+ * Use `create(TrafficSeparationSchema)` to create a new message.
+ */
+export const TrafficSeparationSchema: GenMessage<TrafficSeparation> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 83);
+
+/**
+ * AdsbVehicle represents the ADSB_VEHICLE MAVLink message
+ * The location and information of an ADSB vehicle
+ *
+ * @generated from message flightpath.AdsbVehicle
+ */
+export type AdsbVehicle = Message<"flightpath.AdsbVehicle"> & {
+  /**
+   * ICAO address
+   *
+   * @generated from field: uint32 icao_address = 1;
+   */
+  icaoAddress: number;
+
+  /**
+   * Latitude (degE7)
+   *
+   * @generated from field: int32 lat = 2;
+   */
+  lat: number;
+
+  /**
+   * Longitude (degE7)
+   *
+   * @generated from field: int32 lon = 3;
+   */
+  lon: number;
+
+  /**
+   * ADSB altitude type.
+   *
+   * @generated from field: flightpath.AdsbAltitudeType altitude_type = 4;
+   */
+  altitudeType: AdsbAltitudeType;
+
+  /**
+   * Altitude(ASL) (mm)
+   *
+   * @generated from field: int32 altitude = 5;
+   */
+  altitude: number;
+
+  /**
+   * Course over ground (cdeg)
+   *
+   * @generated from field: uint32 heading = 6;
+   */
+  heading: number;
+
+  /**
+   * The horizontal velocity (cm/s)
+   *
+   * @generated from field: uint32 hor_velocity = 7;
+   */
+  horVelocity: number;
+
+  /**
+   * The vertical velocity. Positive is up (cm/s)
+   *
+   * @generated from field: int32 ver_velocity = 8;
+   */
+  verVelocity: number;
+
+  /**
+   * The callsign, 8+null
+   *
+   * @generated from field: string callsign = 9;
+   */
+  callsign: string;
+
+  /**
+   * ADSB emitter type.
+   *
+   * @generated from field: flightpath.AdsbEmitterType emitter_type = 10;
+   */
+  emitterType: AdsbEmitterType;
+
+  /**
+   * Time since last communication in seconds (s)
+   *
+   * @generated from field: uint32 tslc = 11;
+   */
+  tslc: number;
+
+  /**
+   * Bitmap to indicate various statuses including valid data fields (ADSB_FLAGS)
+   *
+   * @generated from field: uint32 flags = 12;
+   */
+  flags: number;
+
+  /**
+   * Squawk code. Note that the code is in decimal: e.g. 7700 (general emergency) is encoded as binary 0b0001_1110_0001_0100, not(!) as 0b0000_111_111_000_000
+   *
+   * @generated from field: uint32 squawk = 13;
+   */
+  squawk: number;
+};
+
+/**
+ * Describes the message flightpath.AdsbVehicle.
+ * Use `create(AdsbVehicleSchema)` to create a new message.
+ */
+export const AdsbVehicleSchema: GenMessage<AdsbVehicle> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 84);
+
+/**
+ * SubscribeVehicleStateRequest is the request message for SubscribeVehicleState
+ *
+ * @generated from message flightpath.SubscribeVehicleStateRequest
+ */
+export type SubscribeVehicleStateRequest = Message<"flightpath.SubscribeVehicleStateRequest"> & {
+  /**
+   * Rate at which snapshots are sent (0 = 1 Hz, at most 50 Hz) (Hz)
+   *
+   * @generated from field: float rate_hz = 1;
+   */
+  rateHz: number;
+
+  /**
+   * Only send the state of this system (0 = all systems)
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+};
+
+/**
+ * Describes the message flightpath.SubscribeVehicleStateRequest.
+ * Use `create(SubscribeVehicleStateRequestSchema)` to create a new message.
+ */
+export const SubscribeVehicleStateRequestSchema: GenMessage<SubscribeVehicleStateRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 85);
+
+/**
+ * SubscribeVehicleStateResponse contains the state of a single drone
+ *
+ * @generated from message flightpath.SubscribeVehicleStateResponse
+ */
+export type SubscribeVehicleStateResponse = Message<"flightpath.SubscribeVehicleStateResponse"> & {
+  /**
+   * Timestamp when this snapshot was taken (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the drone
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * State of the drone
+   *
+   * @generated from field: flightpath.VehicleState vehicle_state = 3;
+   */
+  vehicleState?: VehicleState;
+};
+
+/**
+ * Describes the message flightpath.SubscribeVehicleStateResponse.
+ * Use `create(SubscribeVehicleStateResponseSchema)` to create a new message.
+ */
+export const SubscribeVehicleStateResponseSchema: GenMessage<SubscribeVehicleStateResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 86);
+
+/**
+ * VehicleState is the latest known state of a drone, aggregated from several MAVLink messages.
+ * Parts of the state that have not been received yet are not set.
+ *
+ * @generated from message flightpath.VehicleState
+ */
+export type VehicleState = Message<"flightpath.VehicleState"> & {
+  /**
+   * Timestamp of the latest message received from the drone (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 last_message_ms = 1;
+   */
+  lastMessageMs: bigint;
+
+  /**
+   * Latest HEARTBEAT of the autopilot
+   *
+   * @generated from field: flightpath.Heartbeat heartbeat = 2;
+   */
+  heartbeat?: Heartbeat;
+
+  /**
+   * Whether the drone is armed (from the heartbeat)
+   *
+   * @generated from field: bool armed = 3;
+   */
+  armed: boolean;
+
+  /**
+   * Flight mode (from the heartbeat)
+   *
+   * @generated from field: flightpath.CustomMode flight_mode = 4;
+   */
+  flightMode?: CustomMode;
+
+  /**
+   * Latest fused position estimate (GLOBAL_POSITION_INT)
+   *
+   * @generated from field: flightpath.Position position = 5;
+   */
+  position?: Position;
+
+  /**
+   * Latest attitude (ATTITUDE)
+   *
+   * @generated from field: flightpath.Attitude attitude = 6;
+   */
+  attitude?: Attitude;
+
+  /**
+   * Latest state of every battery, by ID (BATTERY_STATUS, or SYS_STATUS for drones that do not send BATTERY_STATUS)
+   *
+   * @generated from field: repeated flightpath.Battery batteries = 7;
+   */
+  batteries: Battery[];
+
+  /**
+   * Latest reading of the first GPS receiver (GPS_RAW_INT)
+   *
+   * @generated from field: flightpath.GpsReceiver gps = 8;
+   */
+  gps?: GpsReceiver;
+
+  /**
+   * Latest landed state (EXTENDED_SYS_STATE)
+   *
+   * @generated from field: flightpath.MavLandedState landed_state = 9;
+   */
+  landedState: MavLandedState;
+};
+
+/**
+ * Describes the message flightpath.VehicleState.
+ * Use `create(VehicleStateSchema)` to create a new message.
+ */
+export const VehicleStateSchema: GenMessage<VehicleState> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 87);
+
+/**
+ * VfrHud represents the VFR_HUD MAVLink message
+ * Metrics typically displayed on a HUD for fixed wing aircraft.
+ *
+ * @generated from message flightpath.VfrHud
+ */
+export type VfrHud = Message<"flightpath.VfrHud"> & {
+  /**
+   * Vehicle speed in form appropriate for vehicle type. For standard aircraft this is typically calibrated airspeed (CAS) or indicated airspeed (IAS). (m/s)
+   *
+   * @generated from field: float airspeed = 1;
+   */
+  airspeed: number;
+
+  /**
+   * Current ground speed (m/s)
+   *
+   * @generated from field: float groundspeed = 2;
+   */
+  groundspeed: number;
+
+  /**
+   * Current heading in compass units (0-360, 0=north) (deg)
+   *
+   * @generated from field: int32 heading = 3;
+   */
+  heading: number;
+
+  /**
+   * Current throttle setting (0 to 100) (%)
+   *
+   * @generated from field: uint32 throttle = 4;
+   */
+  throttle: number;
+
+  /**
+   * Current altitude (MSL) (m)
+   *
+   * @generated from field: float alt = 5;
+   */
+  alt: number;
+
+  /**
+   * Current climb rate (m/s)
+   *
+   * @generated from field: float climb = 6;
+   */
+  climb: number;
+};
+
+/**
+ * Describes the message flightpath.VfrHud.
+ * Use `create(VfrHudSchema)` to create a new message.
+ */
+export const VfrHudSchema: GenMessage<VfrHud> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 88);
+
+/**
+ * GlobalPositionInt represents the GLOBAL_POSITION_INT MAVLink message
+ * The filtered global position (e.g. fused GPS and accelerometers).
+ * This is the position estimate the vehicle actually flies on.
+ *
+ * @generated from message flightpath.GlobalPositionInt
+ */
+export type GlobalPositionInt = Message<"flightpath.GlobalPositionInt"> & {
+  /**
+   * Timestamp (time since system boot) (ms)
+   *
+   * @generated from field: uint32 time_boot_ms = 1;
+   */
+  timeBootMs: number;
+
+  /**
+   * Latitude (WGS84) in degrees * 1E7
+   *
+   * @generated from field: int32 lat = 2;
+   */
+  lat: number;
+
+  /**
+   * Longitude (WGS84) in degrees * 1E7
+   *
+   * @generated from field: int32 lon = 3;
+   */
+  lon: number;
+
+  /**
+   * Altitude (MSL). Note that virtually all GPS modules provide both WGS84 and MSL. (mm)
+   *
+   * @generated from field: int32 alt = 4;
+   */
+  alt: number;
+
+  /**
+   * Altitude above home (mm)
+   *
+   * @generated from field: int32 relative_alt = 5;
+   */
+  relativeAlt: number;
+
+  /**
+   * Ground X speed (latitude, positive north) (cm/s)
+   *
+   * @generated from field: int32 vx = 6;
+   */
+  vx: number;
+
+  /**
+   * Ground Y speed (longitude, positive east) (cm/s)
+   *
+   * @generated from field: int32 vy = 7;
+   */
+  vy: number;
+
+  /**
+   * Ground Z speed (altitude, positive down) (cm/s)
+   *
+   * @generated from field: int32 vz = 8;
+   */
+  vz: number;
+
+  /**
+   * Vehicle heading (yaw angle) in degrees * 100, 0.0..359.99 degrees. If unknown, set to: UINT16_MAX
+   *
+   * @generated from field: uint32 hdg = 9;
+   */
+  hdg: number;
+};
+
+/**
+ * Describes the message flightpath.GlobalPositionInt.
+ * Use `create(GlobalPositionIntSchema)` to create a new message.
+ */
+export const GlobalPositionIntSchema: GenMessage<GlobalPositionInt> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 89);
+
+/**
+ * SubscribeLandedStateRequest is the request message for SubscribeLandedState
+ *
+ * @generated from message flightpath.SubscribeLandedStateRequest
+ */
+export type SubscribeLandedStateRequest = Message<"flightpath.SubscribeLandedStateRequest"> & {
+  /**
+   * Send every EXTENDED_SYS_STATE message instead of only the ones where the landed state or
+   * VTOL state has changed
+   *
+   * @generated from field: bool every_message = 1;
+   */
+  everyMessage: boolean;
+};
+
+/**
+ * Describes the message flightpath.SubscribeLandedStateRequest.
+ * Use `create(SubscribeLandedStateRequestSchema)` to create a new message.
+ */
+export const SubscribeLandedStateRequestSchema: GenMessage<SubscribeLandedStateRequest> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 90);
+
+/**
+ * SubscribeLandedStateResponse contains EXTENDED_SYS_STATE message data
+ *
+ * @generated from message flightpath.SubscribeLandedStateResponse
+ */
+export type SubscribeLandedStateResponse = Message<"flightpath.SubscribeLandedStateResponse"> & {
+  /**
+   * Timestamp when this state was captured (milliseconds since Unix epoch)
+   *
+   * @generated from field: int64 timestamp_ms = 1;
+   */
+  timestampMs: bigint;
+
+  /**
+   * System ID of the component sending the state
+   *
+   * @generated from field: uint32 system_id = 2;
+   */
+  systemId: number;
+
+  /**
+   * Component ID of the component sending the state
+   *
+   * @generated from field: uint32 component_id = 3;
+   */
+  componentId: number;
+
+  /**
+   * EXTENDED_SYS_STATE message data
+   *
+   * @generated from field: flightpath.ExtendedSysState extended_sys_state = 4;
+   */
+  extendedSysState?: ExtendedSysState;
+};
+
+/**
+ * Describes the message flightpath.SubscribeLandedStateResponse.
+ * Use `create(SubscribeLandedStateResponseSchema)` to create a new message.
+ */
+export const SubscribeLandedStateResponseSchema: GenMessage<SubscribeLandedStateResponse> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 91);
+
+/**
+ * ExtendedSysState represents the EXTENDED_SYS_STATE MAVLink message
+ * Provides state for additional features (VTOL state, landed state).
+ *
+ * @generated from message flightpath.ExtendedSysState
+ */
+export type ExtendedSysState = Message<"flightpath.ExtendedSysState"> & {
+  /**
+   * The VTOL state if applicable. Is set to MAV_VTOL_STATE_UNDEFINED if UAV is not in VTOL configuration.
+   *
+   * @generated from field: flightpath.MavVtolState vtol_state = 1;
+   */
+  vtolState: MavVtolState;
+
+  /**
+   * The landed state. Is set to MAV_LANDED_STATE_UNDEFINED if landed state is unknown.
+   *
+   * @generated from field: flightpath.MavLandedState landed_state = 2;
+   */
+  landedState: MavLandedState;
+};
+
+/**
+ * Describes the message flightpath.ExtendedSysState.
+ * Use `create(ExtendedSysStateSchema)` to create a new message.
+ */
+export const ExtendedSysStateSchema: GenMessage<ExtendedSysState> = /*@__PURE__*/
+  messageDesc(file_flightpath_telemetry, 92);
+
+/**
+ * BatterySource is the MAVLink message a battery state was decoded from
+ *
+ * @generated from enum flightpath.BatterySource
+ */
+export enum BatterySource {
+  /**
+   * @generated from enum value: BATTERY_SOURCE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * BATTERY_STATUS message
+   *
+   * @generated from enum value: BATTERY_SOURCE_BATTERY_STATUS = 1;
+   */
+  BATTERY_STATUS = 1,
+
+  /**
+   * SYS_STATUS message (only voltage, current and remaining charge are known)
+   *
+   * @generated from enum value: BATTERY_SOURCE_SYS_STATUS = 2;
+   */
+  SYS_STATUS = 2,
+}
+
+/**
+ * Describes the enum flightpath.BatterySource.
+ */
+export const BatterySourceSchema: GenEnum<BatterySource> = /*@__PURE__*/
+  enumDesc(file_flightpath_telemetry, 0);
+
+/**
+ * AdsbAltitudeType represents ADS-B altitude types from MAVLink ADSB_ALTITUDE_TYPE enum
+ * All values are incremented by 1 to accommodate ADSB_ALTITUDE_TYPE_UNSPECIFIED
+ *
+ * @generated from enum flightpath.AdsbAltitudeType
+ */
+export enum AdsbAltitudeType {
+  /**
+   * @generated from enum value: ADSB_ALTITUDE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Altitude reported from a Baro source using QNH reference
+   *
+   * @generated from enum value: ADSB_ALTITUDE_TYPE_PRESSURE_QNH = 1;
+   */
+  PRESSURE_QNH = 1,
+
+  /**
+   * Altitude reported from a GNSS source
+   *
+   * @generated from enum value: ADSB_ALTITUDE_TYPE_GEOMETRIC = 2;
+   */
+  GEOMETRIC = 2,
+}
+
+/**
+ * Describes the enum flightpath.AdsbAltitudeType.
+ */
+export const AdsbAltitudeTypeSchema: GenEnum<AdsbAltitudeType> = /*@__PURE__*/
+  enumDesc(file_flightpath_telemetry, 1);
+
+/**
+ * AdsbEmitterType represents ADS-B emitter types from MAVLink ADSB_EMITTER_TYPE enum
+ * All values are incremented by 1 to accommodate ADSB_EMITTER_TYPE_UNSPECIFIED
+ *
+ * @generated from enum flightpath.AdsbEmitterType
+ */
+export enum AdsbEmitterType {
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_NO_INFO = 1;
+   */
+  NO_INFO = 1,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_LIGHT = 2;
+   */
+  LIGHT = 2,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_SMALL = 3;
+   */
+  SMALL = 3,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_LARGE = 4;
+   */
+  LARGE = 4,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_HIGH_VORTEX_LARGE = 5;
+   */
+  HIGH_VORTEX_LARGE = 5,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_HEAVY = 6;
+   */
+  HEAVY = 6,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_HIGHLY_MANUV = 7;
+   */
+  HIGHLY_MANUV = 7,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_ROTOCRAFT = 8;
+   */
+  ROTOCRAFT = 8,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_UNASSIGNED = 9;
+   */
+  UNASSIGNED = 9,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_GLIDER = 10;
+   */
+  GLIDER = 10,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_LIGHTER_AIR = 11;
+   */
+  LIGHTER_AIR = 11,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_PARACHUTE = 12;
+   */
+  PARACHUTE = 12,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_ULTRA_LIGHT = 13;
+   */
+  ULTRA_LIGHT = 13,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_UNASSIGNED2 = 14;
+   */
+  UNASSIGNED2 = 14,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_UAV = 15;
+   */
+  UAV = 15,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_SPACE = 16;
+   */
+  SPACE = 16,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_UNASSGINED3 = 17;
+   */
+  UNASSGINED3 = 17,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_EMERGENCY_SURFACE = 18;
+   */
+  EMERGENCY_SURFACE = 18,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_SERVICE_SURFACE = 19;
+   */
+  SERVICE_SURFACE = 19,
+
+  /**
+   * @generated from enum value: ADSB_EMITTER_TYPE_POINT_OBSTACLE = 20;
+   */
+  POINT_OBSTACLE = 20,
+}
+
+/**
+ * Describes the enum flightpath.AdsbEmitterType.
+ */
+export const AdsbEmitterTypeSchema: GenEnum<AdsbEmitterType> = /*@__PURE__*/
+  enumDesc(file_flightpath_telemetry, 2);
+
 /**
  * GpsFixType represents GPS fix types from MAVLink GPS_FIX_TYPE enum
  * All values are incremented by 1 to accommodate GPS_FIX_TYPE_UNSPECIFIED
  *
- * @generated from enum flightpath.GpsFixType
+ * @generated from enum flightpath.GpsFixType
+ */
+export enum GpsFixType {
+  /**
+   * @generated from enum value: GPS_FIX_TYPE_UNSPECIFIED = 0;
+   */
+  GPS_FIX_TYPE_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: GPS_FIX_TYPE_NO_GPS = 1;
+   */
+  GPS_FIX_TYPE_NO_GPS = 1,
+
+  /**
+   * @generated from enum value: GPS_FIX_TYPE_NO_FIX = 2;
+   */
+  GPS_FIX_TYPE_NO_FIX = 2,
+
+  /**
+   * @generated from enum value: GPS_FIX_TYPE_2D_FIX = 3;
+   */
+  GPS_FIX_TYPE_2D_FIX = 3,
+
+  /**
+   * @generated from enum value: GPS_FIX_TYPE_3D_FIX = 4;
+   */
+  GPS_FIX_TYPE_3D_FIX = 4,
+
+  /**
+   * @generated from enum value: GPS_FIX_TYPE_DGPS = 5;
+   */
+  GPS_FIX_TYPE_DGPS = 5,
+
+  /**
+   * @generated from enum value: GPS_FIX_TYPE_RTK_FLOAT = 6;
+   */
+  GPS_FIX_TYPE_RTK_FLOAT = 6,
+
+  /**
+   * @generated from enum value: GPS_FIX_TYPE_RTK_FIXED = 7;
+   */
+  GPS_FIX_TYPE_RTK_FIXED = 7,
+
+  /**
+   * @generated from enum value: GPS_FIX_TYPE_STATIC = 8;
+   */
+  GPS_FIX_TYPE_STATIC = 8,
+
+  /**
+   * @generated from enum value: GPS_FIX_TYPE_PPP = 9;
+   */
+  GPS_FIX_TYPE_PPP = 9,
+}
+
+/**
+ * Describes the enum flightpath.GpsFixType.
+ */
+export const GpsFixTypeSchema: GenEnum<GpsFixType> = /*@__PURE__*/
+  enumDesc(file_flightpath_telemetry, 3);
+
+/**
+ * MavSensorOrientation represents sensor orientations from MAVLink MAV_SENSOR_ORIENTATION enum
+ * All values are incremented by 1 to accommodate MAV_SENSOR_ORIENTATION_UNSPECIFIED
+ *
+ * @generated from enum flightpath.MavSensorOrientation
+ */
+export enum MavSensorOrientation {
+  /**
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Roll: 0, Pitch: 0, Yaw: 0
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_NONE = 1;
+   */
+  ROTATION_NONE = 1,
+
+  /**
+   * Roll: 0, Pitch: 0, Yaw: 45
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_YAW_45 = 2;
+   */
+  ROTATION_YAW_45 = 2,
+
+  /**
+   * Roll: 0, Pitch: 0, Yaw: 90
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_YAW_90 = 3;
+   */
+  ROTATION_YAW_90 = 3,
+
+  /**
+   * Roll: 0, Pitch: 0, Yaw: 135
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_YAW_135 = 4;
+   */
+  ROTATION_YAW_135 = 4,
+
+  /**
+   * Roll: 0, Pitch: 0, Yaw: 180
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_YAW_180 = 5;
+   */
+  ROTATION_YAW_180 = 5,
+
+  /**
+   * Roll: 0, Pitch: 0, Yaw: 225
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_YAW_225 = 6;
+   */
+  ROTATION_YAW_225 = 6,
+
+  /**
+   * Roll: 0, Pitch: 0, Yaw: 270
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_YAW_270 = 7;
+   */
+  ROTATION_YAW_270 = 7,
+
+  /**
+   * Roll: 0, Pitch: 0, Yaw: 315
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_YAW_315 = 8;
+   */
+  ROTATION_YAW_315 = 8,
+
+  /**
+   * Roll: 180, Pitch: 0, Yaw: 0
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180 = 9;
+   */
+  ROTATION_ROLL_180 = 9,
+
+  /**
+   * Roll: 180, Pitch: 0, Yaw: 45
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_45 = 10;
+   */
+  ROTATION_ROLL_180_YAW_45 = 10,
+
+  /**
+   * Roll: 180, Pitch: 0, Yaw: 90
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_90 = 11;
+   */
+  ROTATION_ROLL_180_YAW_90 = 11,
+
+  /**
+   * Roll: 180, Pitch: 0, Yaw: 135
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_135 = 12;
+   */
+  ROTATION_ROLL_180_YAW_135 = 12,
+
+  /**
+   * Roll: 0, Pitch: 180, Yaw: 0
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_PITCH_180 = 13;
+   */
+  ROTATION_PITCH_180 = 13,
+
+  /**
+   * Roll: 180, Pitch: 0, Yaw: 225
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_225 = 14;
+   */
+  ROTATION_ROLL_180_YAW_225 = 14,
+
+  /**
+   * Roll: 180, Pitch: 0, Yaw: 270
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_270 = 15;
+   */
+  ROTATION_ROLL_180_YAW_270 = 15,
+
+  /**
+   * Roll: 180, Pitch: 0, Yaw: 315
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_YAW_315 = 16;
+   */
+  ROTATION_ROLL_180_YAW_315 = 16,
+
+  /**
+   * Roll: 90, Pitch: 0, Yaw: 0
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90 = 17;
+   */
+  ROTATION_ROLL_90 = 17,
+
+  /**
+   * Roll: 90, Pitch: 0, Yaw: 45
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_45 = 18;
+   */
+  ROTATION_ROLL_90_YAW_45 = 18,
+
+  /**
+   * Roll: 90, Pitch: 0, Yaw: 90
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_90 = 19;
+   */
+  ROTATION_ROLL_90_YAW_90 = 19,
+
+  /**
+   * Roll: 90, Pitch: 0, Yaw: 135
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_135 = 20;
+   */
+  ROTATION_ROLL_90_YAW_135 = 20,
+
+  /**
+   * Roll: 270, Pitch: 0, Yaw: 0
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270 = 21;
+   */
+  ROTATION_ROLL_270 = 21,
+
+  /**
+   * Roll: 270, Pitch: 0, Yaw: 45
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_YAW_45 = 22;
+   */
+  ROTATION_ROLL_270_YAW_45 = 22,
+
+  /**
+   * Roll: 270, Pitch: 0, Yaw: 90
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_YAW_90 = 23;
+   */
+  ROTATION_ROLL_270_YAW_90 = 23,
+
+  /**
+   * Roll: 270, Pitch: 0, Yaw: 135
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_YAW_135 = 24;
+   */
+  ROTATION_ROLL_270_YAW_135 = 24,
+
+  /**
+   * Roll: 0, Pitch: 90, Yaw: 0
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_PITCH_90 = 25;
+   */
+  ROTATION_PITCH_90 = 25,
+
+  /**
+   * Roll: 0, Pitch: 270, Yaw: 0
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_PITCH_270 = 26;
+   */
+  ROTATION_PITCH_270 = 26,
+
+  /**
+   * Roll: 0, Pitch: 180, Yaw: 90
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_PITCH_180_YAW_90 = 27;
+   */
+  ROTATION_PITCH_180_YAW_90 = 27,
+
+  /**
+   * Roll: 0, Pitch: 180, Yaw: 270
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_PITCH_180_YAW_270 = 28;
+   */
+  ROTATION_PITCH_180_YAW_270 = 28,
+
+  /**
+   * Roll: 90, Pitch: 90, Yaw: 0
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_90 = 29;
+   */
+  ROTATION_ROLL_90_PITCH_90 = 29,
+
+  /**
+   * Roll: 180, Pitch: 90, Yaw: 0
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_PITCH_90 = 30;
+   */
+  ROTATION_ROLL_180_PITCH_90 = 30,
+
+  /**
+   * Roll: 270, Pitch: 90, Yaw: 0
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_PITCH_90 = 31;
+   */
+  ROTATION_ROLL_270_PITCH_90 = 31,
+
+  /**
+   * Roll: 90, Pitch: 180, Yaw: 0
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_180 = 32;
+   */
+  ROTATION_ROLL_90_PITCH_180 = 32,
+
+  /**
+   * Roll: 270, Pitch: 180, Yaw: 0
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_PITCH_180 = 33;
+   */
+  ROTATION_ROLL_270_PITCH_180 = 33,
+
+  /**
+   * Roll: 90, Pitch: 270, Yaw: 0
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_270 = 34;
+   */
+  ROTATION_ROLL_90_PITCH_270 = 34,
+
+  /**
+   * Roll: 180, Pitch: 270, Yaw: 0
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_180_PITCH_270 = 35;
+   */
+  ROTATION_ROLL_180_PITCH_270 = 35,
+
+  /**
+   * Roll: 270, Pitch: 270, Yaw: 0
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_270_PITCH_270 = 36;
+   */
+  ROTATION_ROLL_270_PITCH_270 = 36,
+
+  /**
+   * Roll: 90, Pitch: 180, Yaw: 90
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_180_YAW_90 = 37;
+   */
+  ROTATION_ROLL_90_PITCH_180_YAW_90 = 37,
+
+  /**
+   * Roll: 90, Pitch: 0, Yaw: 270
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_YAW_270 = 38;
+   */
+  ROTATION_ROLL_90_YAW_270 = 38,
+
+  /**
+   * Roll: 90, Pitch: 68, Yaw: 293
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_68_YAW_293 = 39;
+   */
+  ROTATION_ROLL_90_PITCH_68_YAW_293 = 39,
+
+  /**
+   * Pitch: 315
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_PITCH_315 = 40;
+   */
+  ROTATION_PITCH_315 = 40,
+
+  /**
+   * Roll: 90, Pitch: 315
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_ROLL_90_PITCH_315 = 41;
+   */
+  ROTATION_ROLL_90_PITCH_315 = 41,
+
+  /**
+   * Custom orientation
+   *
+   * @generated from enum value: MAV_SENSOR_ORIENTATION_ROTATION_CUSTOM = 101;
+   */
+  ROTATION_CUSTOM = 101,
+}
+
+/**
+ * Describes the enum flightpath.MavSensorOrientation.
+ */
+export const MavSensorOrientationSchema: GenEnum<MavSensorOrientation> = /*@__PURE__*/
+  enumDesc(file_flightpath_telemetry, 4);
+
+/**
+ * MavSeverity represents message severities from MAVLink MAV_SEVERITY enum (RFC-5424)
+ * All values are incremented by 1 to accommodate MAV_SEVERITY_UNSPECIFIED
+ * Lower values are more severe.
+ *
+ * @generated from enum flightpath.MavSeverity
+ */
+export enum MavSeverity {
+  /**
+   * @generated from enum value: MAV_SEVERITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * System is unusable. This is a "panic" condition.
+   *
+   * @generated from enum value: MAV_SEVERITY_EMERGENCY = 1;
+   */
+  EMERGENCY = 1,
+
+  /**
+   * Action should be taken immediately. Indicates error in non-critical systems.
+   *
+   * @generated from enum value: MAV_SEVERITY_ALERT = 2;
+   */
+  ALERT = 2,
+
+  /**
+   * Action must be taken immediately. Indicates failure in a primary system.
+   *
+   * @generated from enum value: MAV_SEVERITY_CRITICAL = 3;
+   */
+  CRITICAL = 3,
+
+  /**
+   * Indicates an error in secondary/redundant systems
+   *
+   * @generated from enum value: MAV_SEVERITY_ERROR = 4;
+   */
+  ERROR = 4,
+
+  /**
+   * Indicates about a possible future error if this is not resolved within a given timeframe. Example would be a low battery warning.
+   *
+   * @generated from enum value: MAV_SEVERITY_WARNING = 5;
+   */
+  WARNING = 5,
+
+  /**
+   * An unusual event has occurred, though not an error condition. This should be investigated for the root cause.
+   *
+   * @generated from enum value: MAV_SEVERITY_NOTICE = 6;
+   */
+  NOTICE = 6,
+
+  /**
+   * Normal operational messages. Useful for logging. No action is required for these messages.
+   *
+   * @generated from enum value: MAV_SEVERITY_INFO = 7;
+   */
+  INFO = 7,
+
+  /**
+   * Useful non-operational messages that can assist in debugging. These should not occur during normal operation.
+   *
+   * @generated from enum value: MAV_SEVERITY_DEBUG = 8;
+   */
+  DEBUG = 8,
+}
+
+/**
+ * Describes the enum flightpath.MavSeverity.
+ */
+export const MavSeveritySchema: GenEnum<MavSeverity> = /*@__PURE__*/
+  enumDesc(file_flightpath_telemetry, 5);
+
+/**
+ * MavVtolState represents VTOL states from MAVLink MAV_VTOL_STATE enum
+ * All values are incremented by 1 to accommodate MAV_VTOL_STATE_UNSPECIFIED
+ *
+ * @generated from enum flightpath.MavVtolState
+ */
+export enum MavVtolState {
+  /**
+   * @generated from enum value: MAV_VTOL_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MAV_VTOL_STATE_UNDEFINED = 1;
+   */
+  UNDEFINED = 1,
+
+  /**
+   * @generated from enum value: MAV_VTOL_STATE_TRANSITION_TO_FW = 2;
+   */
+  TRANSITION_TO_FW = 2,
+
+  /**
+   * @generated from enum value: MAV_VTOL_STATE_TRANSITION_TO_MC = 3;
+   */
+  TRANSITION_TO_MC = 3,
+
+  /**
+   * @generated from enum value: MAV_VTOL_STATE_MC = 4;
+   */
+  MC = 4,
+
+  /**
+   * @generated from enum value: MAV_VTOL_STATE_FW = 5;
+   */
+  FW = 5,
+}
+
+/**
+ * Describes the enum flightpath.MavVtolState.
+ */
+export const MavVtolStateSchema: GenEnum<MavVtolState> = /*@__PURE__*/
+  enumDesc(file_flightpath_telemetry, 6);
+
+/**
+ * MavLandedState represents landed detector states from MAVLink MAV_LANDED_STATE enum
+ * All values are incremented by 1 to accommodate MAV_LANDED_STATE_UNSPECIFIED
+ *
+ * @generated from enum flightpath.MavLandedState
+ */
+export enum MavLandedState {
+  /**
+   * @generated from enum value: MAV_LANDED_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MAV_LANDED_STATE_UNDEFINED = 1;
+   */
+  UNDEFINED = 1,
+
+  /**
+   * @generated from enum value: MAV_LANDED_STATE_ON_GROUND = 2;
+   */
+  ON_GROUND = 2,
+
+  /**
+   * @generated from enum value: MAV_LANDED_STATE_IN_AIR = 3;
+   */
+  IN_AIR = 3,
+
+  /**
+   * @generated from enum value: MAV_LANDED_STATE_TAKEOFF = 4;
+   */
+  TAKEOFF = 4,
+
+  /**
+   * @generated from enum value: MAV_LANDED_STATE_LANDING = 5;
+   */
+  LANDING = 5,
+}
+
+/**
+ * Describes the enum flightpath.MavLandedState.
+ */
+export const MavLandedStateSchema: GenEnum<MavLandedState> = /*@__PURE__*/
+  enumDesc(file_flightpath_telemetry, 7);
+
+/**
+ * MavBatteryChargeState represents battery charge states from MAVLink MAV_BATTERY_CHARGE_STATE enum
+ * All values are incremented by 1 to accommodate MAV_BATTERY_CHARGE_STATE_UNSPECIFIED
+ *
+ * @generated from enum flightpath.MavBatteryChargeState
+ */
+export enum MavBatteryChargeState {
+  /**
+   * @generated from enum value: MAV_BATTERY_CHARGE_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Low battery state is not provided
+   *
+   * @generated from enum value: MAV_BATTERY_CHARGE_STATE_UNDEFINED = 1;
+   */
+  UNDEFINED = 1,
+
+  /**
+   * Battery is not in low state. Normal operation.
+   *
+   * @generated from enum value: MAV_BATTERY_CHARGE_STATE_OK = 2;
+   */
+  OK = 2,
+
+  /**
+   * Battery state is low, warn and monitor close
+   *
+   * @generated from enum value: MAV_BATTERY_CHARGE_STATE_LOW = 3;
+   */
+  LOW = 3,
+
+  /**
+   * Battery state is critical, return or abort immediately
+   *
+   * @generated from enum value: MAV_BATTERY_CHARGE_STATE_CRITICAL = 4;
+   */
+  CRITICAL = 4,
+
+  /**
+   * Battery state is too low for ordinary abort sequence. Perform fastest possible emergency stop to prevent damage.
+   *
+   * @generated from enum value: MAV_BATTERY_CHARGE_STATE_EMERGENCY = 5;
+   */
+  EMERGENCY = 5,
+
+  /**
+   * Battery failed, damage unavoidable
+   *
+   * @generated from enum value: MAV_BATTERY_CHARGE_STATE_FAILED = 6;
+   */
+  FAILED = 6,
+
+  /**
+   * Battery is diagnosed to be defective or an error occurred, usage is discouraged / prohibited
+   *
+   * @generated from enum value: MAV_BATTERY_CHARGE_STATE_UNHEALTHY = 7;
+   */
+  UNHEALTHY = 7,
+
+  /**
+   * Battery is charging
+   *
+   * @generated from enum value: MAV_BATTERY_CHARGE_STATE_CHARGING = 8;
+   */
+  CHARGING = 8,
+}
+
+/**
+ * Describes the enum flightpath.MavBatteryChargeState.
+ */
+export const MavBatteryChargeStateSchema: GenEnum<MavBatteryChargeState> = /*@__PURE__*/
+  enumDesc(file_flightpath_telemetry, 8);
+
+/**
+ * MavBatteryFunction represents battery functions from MAVLink MAV_BATTERY_FUNCTION enum
+ * All values are incremented by 1 to accommodate MAV_BATTERY_FUNCTION_UNSPECIFIED
+ *
+ * @generated from enum flightpath.MavBatteryFunction
+ */
+export enum MavBatteryFunction {
+  /**
+   * @generated from enum value: MAV_BATTERY_FUNCTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MAV_BATTERY_FUNCTION_UNKNOWN = 1;
+   */
+  UNKNOWN = 1,
+
+  /**
+   * @generated from enum value: MAV_BATTERY_FUNCTION_ALL = 2;
+   */
+  ALL = 2,
+
+  /**
+   * @generated from enum value: MAV_BATTERY_FUNCTION_PROPULSION = 3;
+   */
+  PROPULSION = 3,
+
+  /**
+   * @generated from enum value: MAV_BATTERY_FUNCTION_AVIONICS = 4;
+   */
+  AVIONICS = 4,
+
+  /**
+   * @generated from enum value: MAV_BATTERY_FUNCTION_PAYLOAD = 5;
+   */
+  PAYLOAD = 5,
+}
+
+/**
+ * Describes the enum flightpath.MavBatteryFunction.
+ */
+export const MavBatteryFunctionSchema: GenEnum<MavBatteryFunction> = /*@__PURE__*/
+  enumDesc(file_flightpath_telemetry, 9);
+
+/**
+ * MavBatteryMode represents battery modes from MAVLink MAV_BATTERY_MODE enum
+ * All values are incremented by 1 to accommodate MAV_BATTERY_MODE_UNSPECIFIED
+ *
+ * @generated from enum flightpath.MavBatteryMode
+ */
+export enum MavBatteryMode {
+  /**
+   * @generated from enum value: MAV_BATTERY_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Battery mode not supported/unknown battery mode/normal operation
+   *
+   * @generated from enum value: MAV_BATTERY_MODE_UNKNOWN = 1;
+   */
+  UNKNOWN = 1,
+
+  /**
+   * Battery is auto discharging (towards storage level)
+   *
+   * @generated from enum value: MAV_BATTERY_MODE_AUTO_DISCHARGING = 2;
+   */
+  AUTO_DISCHARGING = 2,
+
+  /**
+   * Battery in hot-swap mode (current limited to prevent spikes that might damage sensitive electrical circuits)
+   *
+   * @generated from enum value: MAV_BATTERY_MODE_HOT_SWAP = 3;
+   */
+  HOT_SWAP = 3,
+}
+
+/**
+ * Describes the enum flightpath.MavBatteryMode.
+ */
+export const MavBatteryModeSchema: GenEnum<MavBatteryMode> = /*@__PURE__*/
+  enumDesc(file_flightpath_telemetry, 10);
+
+/**
+ * MavBatteryType represents battery chemistries from MAVLink MAV_BATTERY_TYPE enum
+ * All values are incremented by 1 to accommodate MAV_BATTERY_TYPE_UNSPECIFIED
+ *
+ * @generated from enum flightpath.MavBatteryType
+ */
+export enum MavBatteryType {
+  /**
+   * @generated from enum value: MAV_BATTERY_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MAV_BATTERY_TYPE_UNKNOWN = 1;
+   */
+  UNKNOWN = 1,
+
+  /**
+   * @generated from enum value: MAV_BATTERY_TYPE_LIPO = 2;
+   */
+  LIPO = 2,
+
+  /**
+   * @generated from enum value: MAV_BATTERY_TYPE_LIFE = 3;
+   */
+  LIFE = 3,
+
+  /**
+   * @generated from enum value: MAV_BATTERY_TYPE_LION = 4;
+   */
+  LION = 4,
+
+  /**
+   * @generated from enum value: MAV_BATTERY_TYPE_NIMH = 5;
+   */
+  NIMH = 5,
+}
+
+/**
+ * Describes the enum flightpath.MavBatteryType.
+ */
+export const MavBatteryTypeSchema: GenEnum<MavBatteryType> = /*@__PURE__*/
+  enumDesc(file_flightpath_telemetry, 11);
+
+/**
+ * MavDistanceSensor represents distance sensor types from MAVLink MAV_DISTANCE_SENSOR enum
+ * All values are incremented by 1 to accommodate MAV_DISTANCE_SENSOR_UNSPECIFIED
+ *
+ * @generated from enum flightpath.MavDistanceSensor
+ */
+export enum MavDistanceSensor {
+  /**
+   * @generated from enum value: MAV_DISTANCE_SENSOR_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Laser rangefinder, e.g. LightWare SF02/F or PulsedLight units
+   *
+   * @generated from enum value: MAV_DISTANCE_SENSOR_LASER = 1;
+   */
+  LASER = 1,
+
+  /**
+   * Ultrasound rangefinder, e.g. MaxBotix units
+   *
+   * @generated from enum value: MAV_DISTANCE_SENSOR_ULTRASOUND = 2;
+   */
+  ULTRASOUND = 2,
+
+  /**
+   * Infrared rangefinder, e.g. Sharp units
+   *
+   * @generated from enum value: MAV_DISTANCE_SENSOR_INFRARED = 3;
+   */
+  INFRARED = 3,
+
+  /**
+   * Radar type, e.g. uLanding units
+   *
+   * @generated from enum value: MAV_DISTANCE_SENSOR_RADAR = 4;
+   */
+  RADAR = 4,
+
+  /**
+   * Broken or unknown type, e.g. analog units
+   *
+   * @generated from enum value: MAV_DISTANCE_SENSOR_UNKNOWN = 5;
+   */
+  UNKNOWN = 5,
+}
+
+/**
+ * Describes the enum flightpath.MavDistanceSensor.
+ */
+export const MavDistanceSensorSchema: GenEnum<MavDistanceSensor> = /*@__PURE__*/
+  enumDesc(file_flightpath_telemetry, 12);
+
+/**
+ * MavEstimatorType represents estimator types from MAVLink MAV_ESTIMATOR_TYPE enum
+ * All values are incremented by 1 to accommodate MAV_ESTIMATOR_TYPE_UNSPECIFIED
+ *
+ * @generated from enum flightpath.MavEstimatorType
  */
-export enum GpsFixType {
+export enum MavEstimatorType {
   /**
-   * @generated from enum value: GPS_FIX_TYPE_UNSPECIFIED = 0;
+   * @generated from enum value: MAV_ESTIMATOR_TYPE_UNSPECIFIED = 0;
    */
-  GPS_FIX_TYPE_UNSPECIFIED = 0,
+  UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: GPS_FIX_TYPE_NO_GPS = 1;
+   * Unknown type of the estimator
+   *
+   * @generated from enum value: MAV_ESTIMATOR_TYPE_UNKNOWN = 1;
    */
-  GPS_FIX_TYPE_NO_GPS = 1,
+  UNKNOWN = 1,
 
   /**
-   * @generated from enum value: GPS_FIX_TYPE_NO_FIX = 2;
+   * This is a naive estimator without any real covariance feedback
+   *
+   * @generated from enum value: MAV_ESTIMATOR_TYPE_NAIVE = 2;
    */
-  GPS_FIX_TYPE_NO_FIX = 2,
+  NAIVE = 2,
 
   /**
-   * @generated from enum value: GPS_FIX_TYPE_2D_FIX = 3;
+   * Computer vision based estimate. Might be up to scale.
+   *
+   * @generated from enum value: MAV_ESTIMATOR_TYPE_VISION = 3;
    */
-  GPS_FIX_TYPE_2D_FIX = 3,
+  VISION = 3,
 
   /**
-   * @generated from enum value: GPS_FIX_TYPE_3D_FIX = 4;
+   * Visual-inertial estimate
+   *
+   * @generated from enum value: MAV_ESTIMATOR_TYPE_VIO = 4;
    */
-  GPS_FIX_TYPE_3D_FIX = 4,
+  VIO = 4,
 
   /**
-   * @generated from enum value: GPS_FIX_TYPE_DGPS = 5;
+   * Plain GPS estimate
+   *
+   * @generated from enum value: MAV_ESTIMATOR_TYPE_GPS = 5;
    */
-  GPS_FIX_TYPE_DGPS = 5,
+  GPS = 5,
 
   /**
-   * @generated from enum value: GPS_FIX_TYPE_RTK_FLOAT = 6;
+   * Estimator integrating GPS and inertial sensing
+   *
+   * @generated from enum value: MAV_ESTIMATOR_TYPE_GPS_INS = 6;
    */
-  GPS_FIX_TYPE_RTK_FLOAT = 6,
+  GPS_INS = 6,
 
   /**
-   * @generated from enum value: GPS_FIX_TYPE_RTK_FIXED = 7;
+   * Estimate from external motion capturing system
+   *
+   * @generated from enum value: MAV_ESTIMATOR_TYPE_MOCAP = 7;
    */
-  GPS_FIX_TYPE_RTK_FIXED = 7,
+  MOCAP = 7,
 
   /**
-   * @generated from enum value: GPS_FIX_TYPE_STATIC = 8;
+   * Estimator based on lidar sensor input
+   *
+   * @generated from enum value: MAV_ESTIMATOR_TYPE_LIDAR = 8;
    */
-  GPS_FIX_TYPE_STATIC = 8,
+  LIDAR = 8,
 
   /**
-   * @generated from enum value: GPS_FIX_TYPE_PPP = 9;
+   * Estimator on autopilot
+   *
+   * @generated from enum value: MAV_ESTIMATOR_TYPE_AUTOPILOT = 9;
    */
-  GPS_FIX_TYPE_PPP = 9,
+  AUTOPILOT = 9,
 }
 
 /**
- * Describes the enum flightpath.GpsFixType.
+ * Describes the enum flightpath.MavEstimatorType.
  */
-export const GpsFixTypeSchema: GenEnum<GpsFixType> = /*@__PURE__*/
-  enumDesc(file_flightpath_telemetry, 0);
+export const MavEstimatorTypeSchema: GenEnum<MavEstimatorType> = /*@__PURE__*/
+  enumDesc(file_flightpath_telemetry, 13);
+
+/**
+ * MavFrame represents coordinate frames from MAVLink MAV_FRAME enum
+ * All values are incremented by 1 to accommodate MAV_FRAME_UNSPECIFIED
+ *
+ * @generated from enum flightpath.MavFrame
+ */
+export enum MavFrame {
+  /**
+   * @generated from enum value: MAV_FRAME_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Global (WGS84) coordinate frame + altitude relative to mean sea level (MSL)
+   *
+   * @generated from enum value: MAV_FRAME_GLOBAL = 1;
+   */
+  GLOBAL = 1,
+
+  /**
+   * NED local tangent frame (x: North, y: East, z: Down) with origin fixed relative to earth
+   *
+   * @generated from enum value: MAV_FRAME_LOCAL_NED = 2;
+   */
+  LOCAL_NED = 2,
+
+  /**
+   * NOT a coordinate frame, indicates a mission command
+   *
+   * @generated from enum value: MAV_FRAME_MISSION = 3;
+   */
+  MISSION = 3,
+
+  /**
+   * Global (WGS84) coordinate frame + altitude relative to the home position
+   *
+   * @generated from enum value: MAV_FRAME_GLOBAL_RELATIVE_ALT = 4;
+   */
+  GLOBAL_RELATIVE_ALT = 4,
+
+  /**
+   * ENU local tangent frame (x: East, y: North, z: Up) with origin fixed relative to earth
+   *
+   * @generated from enum value: MAV_FRAME_LOCAL_ENU = 5;
+   */
+  LOCAL_ENU = 5,
+
+  /**
+   * Global (WGS84) coordinate frame (scaled) + altitude relative to mean sea level (MSL)
+   *
+   * @generated from enum value: MAV_FRAME_GLOBAL_INT = 6;
+   */
+  GLOBAL_INT = 6,
+
+  /**
+   * Global (WGS84) coordinate frame (scaled) + altitude relative to the home position
+   *
+   * @generated from enum value: MAV_FRAME_GLOBAL_RELATIVE_ALT_INT = 7;
+   */
+  GLOBAL_RELATIVE_ALT_INT = 7,
+
+  /**
+   * NED local tangent frame (x: North, y: East, z: Down) with origin that travels with the vehicle
+   *
+   * @generated from enum value: MAV_FRAME_LOCAL_OFFSET_NED = 8;
+   */
+  LOCAL_OFFSET_NED = 8,
+
+  /**
+   * Same as MAV_FRAME_LOCAL_NED for positions, same as MAV_FRAME_BODY_FRD for velocities/accelerations
+   *
+   * @generated from enum value: MAV_FRAME_BODY_NED = 9;
+   */
+  BODY_NED = 9,
+
+  /**
+   * Same as MAV_FRAME_BODY_FRD
+   *
+   * @generated from enum value: MAV_FRAME_BODY_OFFSET_NED = 10;
+   */
+  BODY_OFFSET_NED = 10,
+
+  /**
+   * Global (WGS84) coordinate frame with AGL altitude (altitude at ground level)
+   *
+   * @generated from enum value: MAV_FRAME_GLOBAL_TERRAIN_ALT = 11;
+   */
+  GLOBAL_TERRAIN_ALT = 11,
+
+  /**
+   * Global (WGS84) coordinate frame (scaled) with AGL altitude (altitude at ground level)
+   *
+   * @generated from enum value: MAV_FRAME_GLOBAL_TERRAIN_ALT_INT = 12;
+   */
+  GLOBAL_TERRAIN_ALT_INT = 12,
+
+  /**
+   * FRD local frame aligned to the vehicle's attitude (x: Forward, y: Right, z: Down) with an origin that travels with the vehicle
+   *
+   * @generated from enum value: MAV_FRAME_BODY_FRD = 13;
+   */
+  BODY_FRD = 13,
+
+  /**
+   * FRD local tangent frame (x: Forward, y: Right, z: Down) with origin fixed relative to earth
+   *
+   * @generated from enum value: MAV_FRAME_LOCAL_FRD = 21;
+   */
+  LOCAL_FRD = 21,
+
+  /**
+   * FLU local tangent frame (x: Forward, y: Left, z: Up) with origin fixed relative to earth
+   *
+   * @generated from enum value: MAV_FRAME_LOCAL_FLU = 22;
+   */
+  LOCAL_FLU = 22,
+}
+
+/**
+ * Describes the enum flightpath.MavFrame.
+ */
+export const MavFrameSchema: GenEnum<MavFrame> = /*@__PURE__*/
+  enumDesc(file_flightpath_telemetry, 14);
+
+/**
+ * RtkBaselineCoordinateSystem represents RTK baseline coordinate systems from MAVLink RTK_BASELINE_COORDINATE_SYSTEM enum
+ * All values are incremented by 1 to accommodate RTK_BASELINE_COORDINATE_SYSTEM_UNSPECIFIED
+ *
+ * @generated from enum flightpath.RtkBaselineCoordinateSystem
+ */
+export enum RtkBaselineCoordinateSystem {
+  /**
+   * @generated from enum value: RTK_BASELINE_COORDINATE_SYSTEM_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Earth-centered, Earth-fixed
+   *
+   * @generated from enum value: RTK_BASELINE_COORDINATE_SYSTEM_ECEF = 1;
+   */
+  ECEF = 1,
+
+  /**
+   * RTK basestation centered, north, east, down
+   *
+   * @generated from enum value: RTK_BASELINE_COORDINATE_SYSTEM_NED = 2;
+   */
+  NED = 2,
+}
+
+/**
+ * Describes the enum flightpath.RtkBaselineCoordinateSystem.
+ */
+export const RtkBaselineCoordinateSystemSchema: GenEnum<RtkBaselineCoordinateSystem> = /*@__PURE__*/
+  enumDesc(file_flightpath_telemetry, 15);
 
 /**
  * Real-time telemetry data (position, attitude, sensors, status)
@@ -277,6 +6189,246 @@ export const TelemetryService: GenService<{
     input: typeof SubscribeRawGpsRequestSchema;
     output: typeof SubscribeRawGpsResponseSchema;
   },
+  /**
+   * Subscribe to ORBIT_EXECUTION_STATUS messages from the drone (sent while an orbit is in progress).
+   * An update with active = false is sent when the drone stops reporting an orbit.
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeOrbitExecutionStatus
+   */
+  subscribeOrbitExecutionStatus: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeOrbitExecutionStatusRequestSchema;
+    output: typeof SubscribeOrbitExecutionStatusResponseSchema;
+  },
+  /**
+   * Subscribe to the attitude of the drone (ATTITUDE and ATTITUDE_QUATERNION messages).
+   * An update is sent whenever either message is received and carries the latest of both.
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeAttitude
+   */
+  subscribeAttitude: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeAttitudeRequestSchema;
+    output: typeof SubscribeAttitudeResponseSchema;
+  },
+  /**
+   * Subscribe to the fused position estimate of the drone (GLOBAL_POSITION_INT messages)
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribePosition
+   */
+  subscribePosition: {
+    methodKind: "server_streaming";
+    input: typeof SubscribePositionRequestSchema;
+    output: typeof SubscribePositionResponseSchema;
+  },
+  /**
+   * Subscribe to the local position of the drone (LOCAL_POSITION_NED and ODOMETRY messages).
+   * An update is sent whenever either message is received and carries the latest of both.
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeLocalPosition
+   */
+  subscribeLocalPosition: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeLocalPositionRequestSchema;
+    output: typeof SubscribeLocalPositionResponseSchema;
+  },
+  /**
+   * Subscribe to the state of every battery of the drone (BATTERY_STATUS messages, or SYS_STATUS
+   * for drones that do not send BATTERY_STATUS)
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeBattery
+   */
+  subscribeBattery: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeBatteryRequestSchema;
+    output: typeof SubscribeBatteryResponseSchema;
+  },
+  /**
+   * Subscribe to VFR_HUD messages from the drone (airspeed, groundspeed, heading, throttle, altitude, climb rate)
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeFlightMetrics
+   */
+  subscribeFlightMetrics: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeFlightMetricsRequestSchema;
+    output: typeof SubscribeFlightMetricsResponseSchema;
+  },
+  /**
+   * Subscribe to the landed state and VTOL state of the drone (EXTENDED_SYS_STATE messages).
+   * By default, an update is only sent when either state changes.
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeLandedState
+   */
+  subscribeLandedState: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeLandedStateRequestSchema;
+    output: typeof SubscribeLandedStateResponseSchema;
+  },
+  /**
+   * Subscribe to STATUSTEXT messages from the drone (e.g. preflight check failures and warnings).
+   * Messages split across several STATUSTEXT chunks are reassembled before being sent.
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeStatusText
+   */
+  subscribeStatusText: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeStatusTextRequestSchema;
+    output: typeof SubscribeStatusTextResponseSchema;
+  },
+  /**
+   * Get the home position of the drone (HOME_POSITION message).
+   * If no HOME_POSITION has been received yet, it is requested with MAV_CMD_REQUEST_MESSAGE.
+   *
+   * @generated from rpc flightpath.TelemetryService.GetHomePosition
+   */
+  getHomePosition: {
+    methodKind: "unary";
+    input: typeof GetHomePositionRequestSchema;
+    output: typeof GetHomePositionResponseSchema;
+  },
+  /**
+   * Subscribe to changes of the home position of the drone (HOME_POSITION messages).
+   * An update is sent for the first HOME_POSITION of every component, then only when it changes.
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeHomePosition
+   */
+  subscribeHomePosition: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeHomePositionRequestSchema;
+    output: typeof SubscribeHomePositionResponseSchema;
+  },
+  /**
+   * Subscribe to the RC input of the drone (RC_CHANNELS messages)
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeRcChannels
+   */
+  subscribeRcChannels: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeRcChannelsRequestSchema;
+    output: typeof SubscribeRcChannelsResponseSchema;
+  },
+  /**
+   * Subscribe to the servo/actuator outputs of the drone (SERVO_OUTPUT_RAW and ACTUATOR_OUTPUT_STATUS messages).
+   * An update is sent whenever either message is received and carries the latest of both.
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeActuatorOutputs
+   */
+  subscribeActuatorOutputs: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeActuatorOutputsRequestSchema;
+    output: typeof SubscribeActuatorOutputsResponseSchema;
+  },
+  /**
+   * Subscribe to the state estimator health of the drone (ESTIMATOR_STATUS and VIBRATION messages).
+   * An update is sent whenever either message is received and carries the latest of both.
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeEstimatorStatus
+   */
+  subscribeEstimatorStatus: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeEstimatorStatusRequestSchema;
+    output: typeof SubscribeEstimatorStatusResponseSchema;
+  },
+  /**
+   * Subscribe to the wind estimate of the drone (WIND_COV messages).
+   * The ArduPilot WIND message is not part of the common dialect and is not decoded.
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeWind
+   */
+  subscribeWind: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeWindRequestSchema;
+    output: typeof SubscribeWindResponseSchema;
+  },
+  /**
+   * Subscribe to the readings of the distance sensors of the drone (DISTANCE_SENSOR messages).
+   * Every sensor sends its own messages, identified by component and sensor ID.
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeDistanceSensor
+   */
+  subscribeDistanceSensor: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeDistanceSensorRequestSchema;
+    output: typeof SubscribeDistanceSensorResponseSchema;
+  },
+  /**
+   * Subscribe to the obstacles detected around the drone (OBSTACLE_DISTANCE messages)
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeObstacleDistance
+   */
+  subscribeObstacleDistance: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeObstacleDistanceRequestSchema;
+    output: typeof SubscribeObstacleDistanceResponseSchema;
+  },
+  /**
+   * Subscribe to the raw readings of the GPS receivers of the drone (GPS_RAW_INT for the first
+   * receiver, GPS2_RAW for the second). Every update carries the instance of the receiver it came from.
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeGpsReceivers
+   */
+  subscribeGpsReceivers: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeGpsReceiversRequestSchema;
+    output: typeof SubscribeGpsReceiversResponseSchema;
+  },
+  /**
+   * Subscribe to the satellites seen by the GPS receiver of the drone (GPS_STATUS messages).
+   * GPS_STATUS does not identify the receiver it describes.
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeGpsStatus
+   */
+  subscribeGpsStatus: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeGpsStatusRequestSchema;
+    output: typeof SubscribeGpsStatusResponseSchema;
+  },
+  /**
+   * Subscribe to the RTK status of the GPS receivers of the drone (GPS_RTK for the first receiver,
+   * GPS2_RTK for the second). Every update carries the instance of the receiver it came from.
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeGpsRtk
+   */
+  subscribeGpsRtk: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeGpsRtkRequestSchema;
+    output: typeof SubscribeGpsRtkResponseSchema;
+  },
+  /**
+   * Subscribe to the onboard sensor health, CPU load and communication drop rate of the drone
+   * (SYS_STATUS messages)
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeSystemStatus
+   */
+  subscribeSystemStatus: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeSystemStatusRequestSchema;
+    output: typeof SubscribeSystemStatusResponseSchema;
+  },
+  /**
+   * Subscribe to the aircraft reported by the ADS-B receiver of the drone (ADSB_VEHICLE messages),
+   * with their separation from and closing rate to the drone. When an aircraft is no longer reported
+   * for a while, a final update with expired = true is sent.
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeTraffic
+   */
+  subscribeTraffic: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeTrafficRequestSchema;
+    output: typeof SubscribeTrafficResponseSchema;
+  },
+  /**
+   * Subscribe to a snapshot of the state of every drone (heartbeat, position, attitude, batteries,
+   * GPS, landed state and flight mode), sent at a fixed rate regardless of how fast the underlying
+   * MAVLink messages arrive.
+   *
+   * @generated from rpc flightpath.TelemetryService.SubscribeVehicleState
+   */
+  subscribeVehicleState: {
+    methodKind: "server_streaming";
+    input: typeof SubscribeVehicleStateRequestSchema;
+    output: typeof SubscribeVehicleStateResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_flightpath_telemetry, 0);
 
@@ -27,8 +27,9 @@ import (
 // This follows the convention over configuration principle: sensible defaults
 // with optional overrides for production environments.
 type Config struct {
-	Server  ServerConfig
-	MAVLink MAVLinkConfig
+	Server    ServerConfig
+	MAVLink   MAVLinkConfig
+	Estimator EstimatorConfig
 }
 
 // ServerConfig holds server-related configuration
@@ -38,6 +39,17 @@ type ServerConfig struct {
 	CORSOrigins []string
 }
 
+// EstimatorConfig holds the thresholds used to decide whether a drone's state estimator is
+// healthy (see TelemetryService.SubscribeEstimatorStatus)
+type EstimatorConfig struct {
+	// Maximum innovation test ratio of any ESTIMATOR_STATUS measurement (a ratio above 1 means
+	// the estimator rejects the measurement)
+	MaxTestRatio float32
+
+	// Maximum VIBRATION level on any axis (m/s/s)
+	MaxVibration float32
+}
+
 // MAVLinkConfig holds MAVLink connection configuration.
 // Uses gomavlib's EndpointConf interface directly, which provides a discriminated union
 // pattern with type-safe endpoint configurations.
@@ -68,6 +80,11 @@ func Default() *Config {
 			// Default to UDP server on port 14550 (standard PX4 SITL port)
 			Endpoint: gomavlib.EndpointUDPServer{Address: "0.0.0.0:14550"},
 		},
+		Estimator: EstimatorConfig{
+			MaxTestRatio: 1.0,
+			// Vibration above 30 m/s/s commonly causes estimator problems
+			MaxVibration: 30,
+		},
 	}
 }
 
@@ -83,6 +100,13 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid MAVLink configuration: %w", err)
 	}
 
+	if !(c.Estimator.MaxTestRatio > 0) {
+		return fmt.Errorf("invalid estimator max test ratio: %v (must be greater than 0)", c.Estimator.MaxTestRatio)
+	}
+	if !(c.Estimator.MaxVibration > 0) {
+		return fmt.Errorf("invalid estimator max vibration: %v (must be greater than 0)", c.Estimator.MaxVibration)
+	}
+
 	return nil
 }
 
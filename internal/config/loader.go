@@ -26,6 +26,8 @@ import (
 //   - FLIGHTPATH_MAVLINK_SERIAL_BAUD: Serial baud rate (default: 57600, required if type is "serial")
 //   - FLIGHTPATH_MAVLINK_UDP_ADDRESS: UDP address in "host:port" format (default: "0.0.0.0:14550")
 //   - FLIGHTPATH_MAVLINK_TCP_ADDRESS: TCP address in "host:port" format (required if type is "tcp-server" or "tcp-client")
+//   - FLIGHTPATH_ESTIMATOR_MAX_TEST_RATIO: Maximum healthy estimator innovation test ratio (float, default: 1.0)
+//   - FLIGHTPATH_ESTIMATOR_MAX_VIBRATION: Maximum healthy vibration level in m/s/s (float, default: 30)
 //
 // Example usage:
 //
@@ -60,6 +62,18 @@ func Load() (*Config, error) {
 	// Load MAVLink configuration from environment variables
 	loadMAVLinkConfig(cfg)
 
+	if maxTestRatio := os.Getenv("FLIGHTPATH_ESTIMATOR_MAX_TEST_RATIO"); maxTestRatio != "" {
+		if r, err := strconv.ParseFloat(maxTestRatio, 32); err == nil {
+			cfg.Estimator.MaxTestRatio = float32(r)
+		}
+	}
+
+	if maxVibration := os.Getenv("FLIGHTPATH_ESTIMATOR_MAX_VIBRATION"); maxVibration != "" {
+		if v, err := strconv.ParseFloat(maxVibration, 32); err == nil {
+			cfg.Estimator.MaxVibration = float32(v)
+		}
+	}
+
 	// Validate configuration (fail-fast)
 	if err := cfg.Validate(); err != nil {
 		return nil, err
@@ -148,6 +162,7 @@ func logConfig(cfg *Config) {
 	if len(cfg.Server.CORSOrigins) > 0 {
 		log.Printf("CORS Origins: %s", strings.Join(cfg.Server.CORSOrigins, ", "))
 	}
+	log.Printf("Estimator: Max test ratio: %v, Max vibration: %v m/s/s", cfg.Estimator.MaxTestRatio, cfg.Estimator.MaxVibration)
 
 	if cfg.MAVLink.Endpoint == nil {
 		log.Println("MAVLink: Not configured")
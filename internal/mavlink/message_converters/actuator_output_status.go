@@ -0,0 +1,35 @@
+package message_converters
+
+import (
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// ActuatorOutputStatusToProtobuf
+// Converts a MAVLink ACTUATOR_OUTPUT_STATUS message to a protobuf ActuatorOutputStatus message.
+func ActuatorOutputStatusToProtobuf(msg *common.MessageActuatorOutputStatus) *flightpath.ActuatorOutputStatus {
+	return &flightpath.ActuatorOutputStatus{
+		TimeUsec: msg.TimeUsec,
+		Active:   msg.Active,
+		Actuator: msg.Actuator[:],
+	}
+}
+
+// ActuatorOutputStatusToActuatorOutputs
+// Converts a protobuf ActuatorOutputStatus message to a protobuf ActuatorOutputs message, leaving
+// out unused actuators (beyond the number of active outputs). 0 is a valid output value.
+func ActuatorOutputStatusToActuatorOutputs(msg *flightpath.ActuatorOutputStatus) *flightpath.ActuatorOutputs {
+	actuatorOutputs := &flightpath.ActuatorOutputs{
+		TimeUsec: msg.TimeUsec,
+	}
+	for i, value := range msg.Actuator {
+		if uint32(i) >= msg.Active {
+			break
+		}
+		actuatorOutputs.Actuators = append(actuatorOutputs.Actuators, &flightpath.ActuatorValue{
+			Actuator: uint32(i + 1),
+			Value:    value,
+		})
+	}
+	return actuatorOutputs
+}
@@ -0,0 +1,46 @@
+package message_converters
+
+import (
+	"testing"
+
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+)
+
+func TestActuatorOutputStatusToActuatorOutputs(t *testing.T) {
+	// Actuators 1-3 are in use, the second one at 0
+	var values [32]float32
+	copy(values[:], []float32{1500, 0, 1520, 1900, 1900})
+
+	tests := []struct {
+		name   string
+		active uint32
+		want   []float32
+	}{
+		{name: "active outputs only", active: 3, want: []float32{1500, 0, 1520}},
+		{name: "no active output", active: 0},
+		{name: "active beyond the array", active: 40, want: values[:]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputs := ActuatorOutputStatusToActuatorOutputs(ActuatorOutputStatusToProtobuf(&common.MessageActuatorOutputStatus{
+				TimeUsec: 1234,
+				Active:   tt.active,
+				Actuator: values,
+			}))
+
+			if outputs.TimeUsec != 1234 {
+				t.Errorf("TimeUsec = %d, want 1234", outputs.TimeUsec)
+			}
+			if len(outputs.Actuators) != len(tt.want) {
+				t.Fatalf("Actuators = %v, want %d values", outputs.Actuators, len(tt.want))
+			}
+			for i, want := range tt.want {
+				got := outputs.Actuators[i]
+				if got.Actuator != uint32(i+1) || got.Value != want {
+					t.Errorf("Actuators[%d] = {%d %v}, want {%d %v}", i, got.Actuator, got.Value, i+1, want)
+				}
+			}
+		})
+	}
+}
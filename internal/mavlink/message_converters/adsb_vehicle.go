@@ -0,0 +1,75 @@
+package message_converters
+
+import (
+	"strings"
+
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// AdsbVehicleToProtobuf
+// Converts a MAVLink ADSB_VEHICLE message to a protobuf AdsbVehicle message.
+func AdsbVehicleToProtobuf(msg *common.MessageAdsbVehicle) *flightpath.AdsbVehicle {
+	return &flightpath.AdsbVehicle{
+		IcaoAddress:  msg.IcaoAddress,
+		Lat:          msg.Lat,
+		Lon:          msg.Lon,
+		AltitudeType: AdsbAltitudeTypeToProtobuf(msg.AltitudeType),
+		Altitude:     msg.Altitude,
+		Heading:      uint32(msg.Heading),
+		HorVelocity:  uint32(msg.HorVelocity),
+		VerVelocity:  int32(msg.VerVelocity),
+		Callsign:     msg.Callsign,
+		EmitterType:  AdsbEmitterTypeToProtobuf(msg.EmitterType),
+		Tslc:         uint32(msg.Tslc),
+		Flags:        uint32(msg.Flags),
+		Squawk:       uint32(msg.Squawk),
+	}
+}
+
+// AdsbVehicleToTrafficTrack
+// Converts a protobuf AdsbVehicle message to a protobuf TrafficTrack message in SI units.
+// Values not flagged as valid in ADSB_FLAGS are left unset.
+func AdsbVehicleToTrafficTrack(msg *flightpath.AdsbVehicle) *flightpath.TrafficTrack {
+	flags := common.ADSB_FLAGS(msg.Flags)
+
+	track := &flightpath.TrafficTrack{
+		IcaoAddress:          msg.IcaoAddress,
+		EmitterType:          msg.EmitterType,
+		AltitudeType:         msg.AltitudeType,
+		Simulated:            (flags & common.ADSB_FLAGS_SIMULATED) != 0,
+		TimeSinceLastContact: msg.Tslc,
+	}
+	if (flags & common.ADSB_FLAGS_VALID_CALLSIGN) != 0 {
+		// The callsign is padded with spaces
+		callsign := strings.TrimSpace(msg.Callsign)
+		track.Callsign = &callsign
+	}
+	if (flags & common.ADSB_FLAGS_VALID_SQUAWK) != 0 {
+		squawk := msg.Squawk
+		track.Squawk = &squawk
+	}
+	if (flags & common.ADSB_FLAGS_VALID_COORDS) != 0 {
+		latitude := float64(msg.Lat) / 1e7
+		longitude := float64(msg.Lon) / 1e7
+		track.Latitude = &latitude
+		track.Longitude = &longitude
+	}
+	if (flags & common.ADSB_FLAGS_VALID_ALTITUDE) != 0 {
+		altitude := float32(msg.Altitude) / 1000
+		track.Altitude = &altitude
+	}
+	if (flags & common.ADSB_FLAGS_VALID_HEADING) != 0 {
+		heading := float32(msg.Heading) / 100
+		track.Heading = &heading
+	}
+	if (flags & common.ADSB_FLAGS_VALID_VELOCITY) != 0 {
+		horizontalSpeed := float32(msg.HorVelocity) / 100
+		track.HorizontalSpeed = &horizontalSpeed
+	}
+	if (flags & common.ADSB_FLAGS_VERTICAL_VELOCITY_VALID) != 0 {
+		verticalSpeed := float32(msg.VerVelocity) / 100
+		track.VerticalSpeed = &verticalSpeed
+	}
+	return track
+}
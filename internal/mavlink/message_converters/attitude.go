@@ -0,0 +1,24 @@
+package message_converters
+
+import (
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// AttitudeToProtobuf
+// Converts a MAVLink ATTITUDE message to a protobuf Attitude message.
+// Euler angles are provided both in radians and in degrees.
+func AttitudeToProtobuf(msg *common.MessageAttitude) *flightpath.Attitude {
+	return &flightpath.Attitude{
+		TimeBootMs: msg.TimeBootMs,
+		Roll:       msg.Roll,
+		Pitch:      msg.Pitch,
+		Yaw:        msg.Yaw,
+		RollDeg:    RadiansToDegrees(msg.Roll),
+		PitchDeg:   RadiansToDegrees(msg.Pitch),
+		YawDeg:     RadiansToDegrees(msg.Yaw),
+		Rollspeed:  msg.Rollspeed,
+		Pitchspeed: msg.Pitchspeed,
+		Yawspeed:   msg.Yawspeed,
+	}
+}
@@ -0,0 +1,22 @@
+package message_converters
+
+import (
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// AttitudeQuaternionToProtobuf
+// Converts a MAVLink ATTITUDE_QUATERNION message to a protobuf AttitudeQuaternion message.
+func AttitudeQuaternionToProtobuf(msg *common.MessageAttitudeQuaternion) *flightpath.AttitudeQuaternion {
+	return &flightpath.AttitudeQuaternion{
+		TimeBootMs:  msg.TimeBootMs,
+		Q1:          msg.Q1,
+		Q2:          msg.Q2,
+		Q3:          msg.Q3,
+		Q4:          msg.Q4,
+		Rollspeed:   msg.Rollspeed,
+		Pitchspeed:  msg.Pitchspeed,
+		Yawspeed:    msg.Yawspeed,
+		ReprOffsetQ: msg.ReprOffsetQ[:],
+	}
+}
@@ -0,0 +1,121 @@
+package message_converters
+
+import (
+	"math"
+
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// BatteryStatusToProtobuf
+// Converts a MAVLink BATTERY_STATUS message to a protobuf BatteryStatus message.
+func BatteryStatusToProtobuf(msg *common.MessageBatteryStatus) *flightpath.BatteryStatus {
+	voltages := make([]uint32, len(msg.Voltages))
+	for i, voltage := range msg.Voltages {
+		voltages[i] = uint32(voltage)
+	}
+	voltagesExt := make([]uint32, len(msg.VoltagesExt))
+	for i, voltage := range msg.VoltagesExt {
+		voltagesExt[i] = uint32(voltage)
+	}
+
+	return &flightpath.BatteryStatus{
+		Id:               uint32(msg.Id),
+		BatteryFunction:  MavBatteryFunctionToProtobuf(msg.BatteryFunction),
+		Type:             MavBatteryTypeToProtobuf(msg.Type),
+		Temperature:      int32(msg.Temperature),
+		Voltages:         voltages,
+		CurrentBattery:   int32(msg.CurrentBattery),
+		CurrentConsumed:  msg.CurrentConsumed,
+		EnergyConsumed:   msg.EnergyConsumed,
+		BatteryRemaining: int32(msg.BatteryRemaining),
+		TimeRemaining:    msg.TimeRemaining,
+		ChargeState:      MavBatteryChargeStateToProtobuf(msg.ChargeState),
+		VoltagesExt:      voltagesExt,
+		Mode:             MavBatteryModeToProtobuf(msg.Mode),
+		FaultBitmask:     uint32(msg.FaultBitmask),
+	}
+}
+
+// BatteryStatusToBattery
+// Converts a protobuf BatteryStatus message to a protobuf Battery message in normalized units
+// (volts, amperes, joules, degrees Celsius). Values marked as unknown are left unset.
+// Cells 1 to 10 are unused when set to UINT16_MAX and extended cells 11 to 14 when set to 0.
+// Cell voltages are only known if the second cell is set: otherwise the first cell holds the total
+// voltage (so a single-cell pack is reported by its total voltage only). Totals above
+// UINT16_MAX-1 mV are sent as UINT16_MAX-1 in the first cell and the remainder in the second, in
+// which case only the total voltage is set.
+func BatteryStatusToBattery(msg *flightpath.BatteryStatus) *flightpath.Battery {
+	battery := &flightpath.Battery{
+		Id:          msg.Id,
+		Source:      flightpath.BatterySource_BATTERY_SOURCE_BATTERY_STATUS,
+		Function:    msg.BatteryFunction,
+		Type:        msg.Type,
+		ChargeState: msg.ChargeState,
+		Mode:        msg.Mode,
+		Faults:      BatteryFaultsToProtobuf(common.MAV_BATTERY_FAULT(msg.FaultBitmask)),
+	}
+
+	voltages := msg.Voltages
+	switch {
+	case len(voltages) == 0 || voltages[0] == math.MaxUint16:
+		// Voltage unknown
+	case voltages[0] == math.MaxUint16-1:
+		// Total voltage above UINT16_MAX-1 mV: the first cell holds UINT16_MAX-1, the second the remainder
+		voltage := float32(voltages[0]) / 1000
+		if len(voltages) > 1 && voltages[1] != math.MaxUint16 {
+			voltage += float32(voltages[1]) / 1000
+		}
+		battery.Voltage = &voltage
+	case len(voltages) < 2 || voltages[1] == math.MaxUint16:
+		// Cell voltages unknown: the first cell holds the total voltage
+		voltage := float32(voltages[0]) / 1000
+		battery.Voltage = &voltage
+	default:
+		var cellVoltages []float32
+		for _, cellVoltage := range voltages {
+			if cellVoltage != math.MaxUint16 {
+				cellVoltages = append(cellVoltages, float32(cellVoltage)/1000)
+			}
+		}
+		for _, cellVoltage := range msg.VoltagesExt {
+			if cellVoltage != 0 {
+				cellVoltages = append(cellVoltages, float32(cellVoltage)/1000)
+			}
+		}
+
+		var voltage float32
+		for _, cellVoltage := range cellVoltages {
+			voltage += cellVoltage
+		}
+		battery.Voltage = &voltage
+		battery.CellVoltages = cellVoltages
+	}
+
+	if msg.CurrentBattery != -1 {
+		current := float32(msg.CurrentBattery) / 100
+		battery.Current = &current
+	}
+	if msg.CurrentConsumed != -1 {
+		consumedCharge := float32(msg.CurrentConsumed)
+		battery.ConsumedCharge = &consumedCharge
+	}
+	if msg.EnergyConsumed != -1 {
+		consumedEnergy := float32(msg.EnergyConsumed) * 100
+		battery.ConsumedEnergy = &consumedEnergy
+	}
+	if msg.Temperature != math.MaxInt16 {
+		temperature := float32(msg.Temperature) / 100
+		battery.Temperature = &temperature
+	}
+	if msg.BatteryRemaining != -1 {
+		remainingPercent := float32(msg.BatteryRemaining)
+		battery.RemainingPercent = &remainingPercent
+	}
+	if msg.TimeRemaining > 0 {
+		timeRemaining := uint32(msg.TimeRemaining)
+		battery.TimeRemaining = &timeRemaining
+	}
+
+	return battery
+}
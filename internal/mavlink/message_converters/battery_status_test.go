@@ -0,0 +1,172 @@
+package message_converters
+
+import (
+	"math"
+	"testing"
+
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+func float32Ptr(v float32) *float32 {
+	return &v
+}
+
+// equalFloat32Ptr compares optional values in normalized units, allowing for float32 rounding
+func equalFloat32Ptr(got, want *float32) bool {
+	if got == nil || want == nil {
+		return got == want
+	}
+	return math.Abs(float64(*got-*want)) < 1e-3
+}
+
+func formatFloat32Ptr(v *float32) any {
+	if v == nil {
+		return "unset"
+	}
+	return *v
+}
+
+// batteryVoltages returns BATTERY_STATUS cell voltages (mV) with unused cells set to UINT16_MAX
+func batteryVoltages(cells ...uint16) [10]uint16 {
+	var voltages [10]uint16
+	for i := range voltages {
+		voltages[i] = math.MaxUint16
+	}
+	copy(voltages[:], cells)
+	return voltages
+}
+
+func TestBatteryStatusToBattery(t *testing.T) {
+	// Fields of a battery reporting nothing but its voltages
+	unknown := func(voltages [10]uint16, voltagesExt [4]uint16) *common.MessageBatteryStatus {
+		return &common.MessageBatteryStatus{
+			Temperature:      math.MaxInt16,
+			Voltages:         voltages,
+			CurrentBattery:   -1,
+			CurrentConsumed:  -1,
+			EnergyConsumed:   -1,
+			BatteryRemaining: -1,
+			VoltagesExt:      voltagesExt,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		msg         *common.MessageBatteryStatus
+		wantVoltage *float32
+		wantCells   []float32
+	}{
+		{
+			name:        "4S pack",
+			msg:         unknown(batteryVoltages(4000, 4010, 4020, 3990), [4]uint16{}),
+			wantVoltage: float32Ptr(16.02),
+			wantCells:   []float32{4.0, 4.01, 4.02, 3.99},
+		},
+		{
+			name:        "14S pack with extended cells",
+			msg:         unknown(batteryVoltages(4000, 4000, 4000, 4000, 4000, 4000, 4000, 4000, 4000, 4000), [4]uint16{4100, 4100, 4100, 4100}),
+			wantVoltage: float32Ptr(56.4),
+			wantCells:   []float32{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4.1, 4.1, 4.1, 4.1},
+		},
+		{
+			name:        "2S pack, UINT16_MAX cells are skipped",
+			msg:         unknown(batteryVoltages(4000, 4100), [4]uint16{}),
+			wantVoltage: float32Ptr(8.1),
+			wantCells:   []float32{4.0, 4.1},
+		},
+		{
+			name:        "total voltage only",
+			msg:         unknown(batteryVoltages(12600), [4]uint16{}),
+			wantVoltage: float32Ptr(12.6),
+		},
+		{
+			name:        "high voltage pack",
+			msg:         unknown(batteryVoltages(math.MaxUint16-1, 20000), [4]uint16{}),
+			wantVoltage: float32Ptr(85.534),
+		},
+		{
+			name:        "high voltage pack without remainder",
+			msg:         unknown(batteryVoltages(math.MaxUint16-1), [4]uint16{}),
+			wantVoltage: float32Ptr(65.534),
+		},
+		{
+			name: "voltage unknown",
+			msg:  unknown(batteryVoltages(), [4]uint16{}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			battery := BatteryStatusToBattery(BatteryStatusToProtobuf(tt.msg))
+
+			if battery.Source != flightpath.BatterySource_BATTERY_SOURCE_BATTERY_STATUS {
+				t.Errorf("Source = %s, want BATTERY_STATUS", battery.Source)
+			}
+			if !equalFloat32Ptr(battery.Voltage, tt.wantVoltage) {
+				t.Errorf("Voltage = %v, want %v", formatFloat32Ptr(battery.Voltage), formatFloat32Ptr(tt.wantVoltage))
+			}
+			if len(battery.CellVoltages) != len(tt.wantCells) {
+				t.Fatalf("CellVoltages = %v, want %v", battery.CellVoltages, tt.wantCells)
+			}
+			for i := range tt.wantCells {
+				if !equalFloat32Ptr(&battery.CellVoltages[i], &tt.wantCells[i]) {
+					t.Errorf("CellVoltages = %v, want %v", battery.CellVoltages, tt.wantCells)
+					break
+				}
+			}
+
+			// Unknown values are left unset
+			for name, value := range map[string]*float32{
+				"Current":          battery.Current,
+				"ConsumedCharge":   battery.ConsumedCharge,
+				"ConsumedEnergy":   battery.ConsumedEnergy,
+				"Temperature":      battery.Temperature,
+				"RemainingPercent": battery.RemainingPercent,
+			} {
+				if value != nil {
+					t.Errorf("%s = %v, want unset", name, *value)
+				}
+			}
+			if battery.TimeRemaining != nil {
+				t.Errorf("TimeRemaining = %d, want unset", *battery.TimeRemaining)
+			}
+		})
+	}
+}
+
+func TestBatteryStatusToBatteryUnits(t *testing.T) {
+	battery := BatteryStatusToBattery(BatteryStatusToProtobuf(&common.MessageBatteryStatus{
+		Id:               2,
+		Temperature:      2550,
+		Voltages:         batteryVoltages(4200, 4200, 4200),
+		CurrentBattery:   1250,
+		CurrentConsumed:  1200,
+		EnergyConsumed:   500,
+		BatteryRemaining: 75,
+		TimeRemaining:    600,
+	}))
+
+	if battery.Id != 2 {
+		t.Errorf("Id = %d, want 2", battery.Id)
+	}
+	for _, tt := range []struct {
+		name string
+		got  *float32
+		want float32
+	}{
+		{"Voltage", battery.Voltage, 12.6},
+		{"Current", battery.Current, 12.5},
+		{"ConsumedCharge", battery.ConsumedCharge, 1200},
+		{"ConsumedEnergy", battery.ConsumedEnergy, 50000},
+		{"Temperature", battery.Temperature, 25.5},
+		{"RemainingPercent", battery.RemainingPercent, 75},
+	} {
+		if !equalFloat32Ptr(tt.got, &tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, formatFloat32Ptr(tt.got), tt.want)
+		}
+	}
+	if battery.TimeRemaining == nil || *battery.TimeRemaining != 600 {
+		t.Errorf("TimeRemaining = %v, want 600", battery.TimeRemaining)
+	}
+}
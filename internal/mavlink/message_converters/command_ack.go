@@ -0,0 +1,19 @@
+package message_converters
+
+import (
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// CommandAckToProtobuf
+// Converts a MAVLink COMMAND_ACK message to a protobuf CommandAck message.
+func CommandAckToProtobuf(msg *common.MessageCommandAck) *flightpath.CommandAck {
+	return &flightpath.CommandAck{
+		Command:         uint32(msg.Command),
+		Result:          MavResultToProtobuf(msg.Result),
+		Progress:        uint32(msg.Progress),
+		ResultParam2:    msg.ResultParam2,
+		TargetSystem:    uint32(msg.TargetSystem),
+		TargetComponent: uint32(msg.TargetComponent),
+	}
+}
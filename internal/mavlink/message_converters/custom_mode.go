@@ -0,0 +1,174 @@
+package message_converters
+
+import (
+	"fmt"
+
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// autopilotMode
+// Maps an autopilot-specific custom_mode value to the platform-agnostic MainMode/SubMode pair.
+type autopilotMode struct {
+	customMode uint32
+	mainMode   flightpath.MainMode
+	subMode    flightpath.SubMode
+}
+
+// px4CustomMode
+// Builds a PX4 custom_mode from its main mode (bits 16-23) and sub mode (bits 24-31).
+// See DecodePX4CustomMode for the layout.
+func px4CustomMode(mainMode, subMode uint8) uint32 {
+	return uint32(mainMode)<<16 | uint32(subMode)<<24
+}
+
+// px4Modes
+// PX4 flight modes.
+// Based on: https://github.com/PX4/PX4-Autopilot/blob/main/src/modules/commander/px4_custom_mode.h
+// The first entry of each main mode is its default when no sub mode is requested.
+var px4Modes = []autopilotMode{
+	{px4CustomMode(1, 0), flightpath.MainMode_MAIN_MODE_MANUAL, flightpath.SubMode_SUB_MODE_UNSPECIFIED},
+	{px4CustomMode(2, 0), flightpath.MainMode_MAIN_MODE_ALTCTL, flightpath.SubMode_SUB_MODE_UNSPECIFIED},
+	{px4CustomMode(3, 0), flightpath.MainMode_MAIN_MODE_POSCTL, flightpath.SubMode_SUB_MODE_POSCTL_POSCTL},
+	{px4CustomMode(3, 1), flightpath.MainMode_MAIN_MODE_POSCTL, flightpath.SubMode_SUB_MODE_POSCTL_ORBIT},
+	{px4CustomMode(3, 2), flightpath.MainMode_MAIN_MODE_POSCTL, flightpath.SubMode_SUB_MODE_POSCTL_SLOW},
+	{px4CustomMode(4, 4), flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_MISSION},
+	{px4CustomMode(4, 1), flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_READY},
+	{px4CustomMode(4, 2), flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_TAKEOFF},
+	{px4CustomMode(4, 3), flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_LOITER},
+	{px4CustomMode(4, 5), flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_RTL},
+	{px4CustomMode(4, 6), flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_LAND},
+	{px4CustomMode(4, 8), flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_FOLLOW_TARGET},
+	{px4CustomMode(4, 9), flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_PRECLAND},
+	{px4CustomMode(4, 10), flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_VTOL_TAKEOFF},
+	{px4CustomMode(4, 11), flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_EXTERNAL1},
+	{px4CustomMode(4, 12), flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_EXTERNAL2},
+	{px4CustomMode(4, 13), flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_EXTERNAL3},
+	{px4CustomMode(4, 14), flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_EXTERNAL4},
+	{px4CustomMode(4, 15), flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_EXTERNAL5},
+	{px4CustomMode(4, 16), flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_EXTERNAL6},
+	{px4CustomMode(4, 17), flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_EXTERNAL7},
+	{px4CustomMode(4, 18), flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_EXTERNAL8},
+	{px4CustomMode(5, 0), flightpath.MainMode_MAIN_MODE_ACRO, flightpath.SubMode_SUB_MODE_UNSPECIFIED},
+	{px4CustomMode(6, 0), flightpath.MainMode_MAIN_MODE_OFFBOARD, flightpath.SubMode_SUB_MODE_UNSPECIFIED},
+	{px4CustomMode(7, 0), flightpath.MainMode_MAIN_MODE_STABILIZED, flightpath.SubMode_SUB_MODE_UNSPECIFIED},
+	{px4CustomMode(8, 0), flightpath.MainMode_MAIN_MODE_RATTITUDE_LEGACY, flightpath.SubMode_SUB_MODE_UNSPECIFIED},
+	{px4CustomMode(9, 0), flightpath.MainMode_MAIN_MODE_SIMPLE, flightpath.SubMode_SUB_MODE_UNSPECIFIED},
+	{px4CustomMode(10, 0), flightpath.MainMode_MAIN_MODE_TERMINATION, flightpath.SubMode_SUB_MODE_UNSPECIFIED},
+	{px4CustomMode(11, 0), flightpath.MainMode_MAIN_MODE_ALTITUDE_CRUISE, flightpath.SubMode_SUB_MODE_UNSPECIFIED},
+}
+
+// arduCopterModes
+// ArduCopter flight modes that have a PX4 equivalent.
+// Based on: https://mavlink.io/en/messages/ardupilotmega.html#COPTER_MODE
+// The first entry of each main mode is its default when no sub mode is requested.
+var arduCopterModes = []autopilotMode{
+	{0, flightpath.MainMode_MAIN_MODE_STABILIZED, flightpath.SubMode_SUB_MODE_UNSPECIFIED},   // STABILIZE
+	{1, flightpath.MainMode_MAIN_MODE_ACRO, flightpath.SubMode_SUB_MODE_UNSPECIFIED},         // ACRO
+	{2, flightpath.MainMode_MAIN_MODE_ALTCTL, flightpath.SubMode_SUB_MODE_UNSPECIFIED},       // ALT_HOLD
+	{3, flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_MISSION},        // AUTO
+	{4, flightpath.MainMode_MAIN_MODE_OFFBOARD, flightpath.SubMode_SUB_MODE_UNSPECIFIED},     // GUIDED
+	{5, flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_LOITER},         // LOITER
+	{6, flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_RTL},            // RTL
+	{7, flightpath.MainMode_MAIN_MODE_POSCTL, flightpath.SubMode_SUB_MODE_POSCTL_ORBIT},      // CIRCLE
+	{9, flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_LAND},           // LAND
+	{16, flightpath.MainMode_MAIN_MODE_POSCTL, flightpath.SubMode_SUB_MODE_POSCTL_POSCTL},    // POSHOLD
+	{23, flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_FOLLOW_TARGET}, // FOLLOW
+}
+
+// arduPlaneModes
+// ArduPlane flight modes that have a PX4 equivalent.
+// Based on: https://mavlink.io/en/messages/ardupilotmega.html#PLANE_MODE
+// The first entry of each main mode is its default when no sub mode is requested.
+var arduPlaneModes = []autopilotMode{
+	{0, flightpath.MainMode_MAIN_MODE_MANUAL, flightpath.SubMode_SUB_MODE_UNSPECIFIED},     // MANUAL
+	{2, flightpath.MainMode_MAIN_MODE_STABILIZED, flightpath.SubMode_SUB_MODE_UNSPECIFIED}, // STABILIZE
+	{4, flightpath.MainMode_MAIN_MODE_ACRO, flightpath.SubMode_SUB_MODE_UNSPECIFIED},       // ACRO
+	{6, flightpath.MainMode_MAIN_MODE_ALTCTL, flightpath.SubMode_SUB_MODE_UNSPECIFIED},     // FLY_BY_WIRE_B
+	{7, flightpath.MainMode_MAIN_MODE_POSCTL, flightpath.SubMode_SUB_MODE_POSCTL_POSCTL},   // CRUISE
+	{1, flightpath.MainMode_MAIN_MODE_POSCTL, flightpath.SubMode_SUB_MODE_POSCTL_ORBIT},    // CIRCLE
+	{10, flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_MISSION},     // AUTO
+	{11, flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_RTL},         // RTL
+	{12, flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_LOITER},      // LOITER
+	{13, flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_TAKEOFF},     // TAKEOFF
+	{15, flightpath.MainMode_MAIN_MODE_OFFBOARD, flightpath.SubMode_SUB_MODE_UNSPECIFIED},  // GUIDED
+}
+
+// autopilotModes
+// Returns the flight mode table of an autopilot / vehicle type, or nil if it is not supported.
+// ArduPilot mode numbers depend on the vehicle firmware (Copter, Plane...), which is derived from MAV_TYPE.
+func autopilotModes(autopilot common.MAV_AUTOPILOT, mavType common.MAV_TYPE) []autopilotMode {
+	switch autopilot {
+	case common.MAV_AUTOPILOT_PX4:
+		return px4Modes
+	case common.MAV_AUTOPILOT_ARDUPILOTMEGA:
+		switch mavType {
+		case common.MAV_TYPE_QUADROTOR,
+			common.MAV_TYPE_COAXIAL,
+			common.MAV_TYPE_HELICOPTER,
+			common.MAV_TYPE_HEXAROTOR,
+			common.MAV_TYPE_OCTOROTOR,
+			common.MAV_TYPE_TRICOPTER,
+			common.MAV_TYPE_DODECAROTOR,
+			common.MAV_TYPE_DECAROTOR,
+			common.MAV_TYPE_GENERIC_MULTIROTOR:
+			return arduCopterModes
+		case common.MAV_TYPE_FIXED_WING,
+			common.MAV_TYPE_VTOL_TAILSITTER_DUOROTOR,
+			common.MAV_TYPE_VTOL_TAILSITTER_QUADROTOR,
+			common.MAV_TYPE_VTOL_TILTROTOR,
+			common.MAV_TYPE_VTOL_FIXEDROTOR,
+			common.MAV_TYPE_VTOL_TAILSITTER,
+			common.MAV_TYPE_VTOL_TILTWING:
+			return arduPlaneModes
+		}
+	}
+	return nil
+}
+
+// ArduPilotCustomModeToProtobuf
+// Converts an ArduPilot custom_mode (mode number) to protobuf CustomMode message.
+// Modes without a PX4 equivalent are returned as unspecified values.
+func ArduPilotCustomModeToProtobuf(customMode uint32, mavType common.MAV_TYPE) *flightpath.CustomMode {
+	for _, mode := range autopilotModes(common.MAV_AUTOPILOT_ARDUPILOTMEGA, mavType) {
+		if mode.customMode == customMode {
+			return &flightpath.CustomMode{
+				MainMode: mode.mainMode,
+				SubMode:  mode.subMode,
+			}
+		}
+	}
+
+	return &flightpath.CustomMode{
+		MainMode: flightpath.MainMode_MAIN_MODE_UNSPECIFIED,
+		SubMode:  flightpath.SubMode_SUB_MODE_UNSPECIFIED,
+	}
+}
+
+// ProtobufToCustomMode
+// Encodes a protobuf MainMode/SubMode pair into the custom_mode of the given autopilot:
+// the PX4 custom_mode layout for PX4, or the mode number for ArduPilot (Copter and Plane).
+// If subMode is unspecified, the default sub mode of mainMode is used.
+// Returns an error if the autopilot or the mode is not supported.
+func ProtobufToCustomMode(
+	mainMode flightpath.MainMode,
+	subMode flightpath.SubMode,
+	autopilot common.MAV_AUTOPILOT,
+	mavType common.MAV_TYPE,
+) (uint32, error) {
+	modes := autopilotModes(autopilot, mavType)
+	if modes == nil {
+		return 0, fmt.Errorf("flight modes of autopilot %s (%s) are not supported", autopilot, mavType)
+	}
+
+	for _, mode := range modes {
+		if mode.mainMode != mainMode {
+			continue
+		}
+		if subMode == flightpath.SubMode_SUB_MODE_UNSPECIFIED || mode.subMode == subMode {
+			return mode.customMode, nil
+		}
+	}
+
+	return 0, fmt.Errorf("flight mode %s/%s is not supported by autopilot %s", mainMode, subMode, autopilot)
+}
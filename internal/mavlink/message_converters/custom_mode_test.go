@@ -0,0 +1,91 @@
+package message_converters
+
+import (
+	"testing"
+
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+func TestCustomModeRoundTrip(t *testing.T) {
+	tables := []struct {
+		name      string
+		autopilot common.MAV_AUTOPILOT
+		mavType   common.MAV_TYPE
+		modes     []autopilotMode
+	}{
+		{"PX4", common.MAV_AUTOPILOT_PX4, common.MAV_TYPE_QUADROTOR, px4Modes},
+		{"ArduCopter", common.MAV_AUTOPILOT_ARDUPILOTMEGA, common.MAV_TYPE_QUADROTOR, arduCopterModes},
+		{"ArduPlane", common.MAV_AUTOPILOT_ARDUPILOTMEGA, common.MAV_TYPE_FIXED_WING, arduPlaneModes},
+	}
+
+	for _, table := range tables {
+		for _, mode := range table.modes {
+			t.Run(table.name+"/"+mode.mainMode.String()+"/"+mode.subMode.String(), func(t *testing.T) {
+				decoded := CustomModeToProtobuf(mode.customMode, table.autopilot, table.mavType)
+				if decoded.MainMode != mode.mainMode || decoded.SubMode != mode.subMode {
+					t.Errorf("CustomModeToProtobuf(%#x) = %s/%s, want %s/%s",
+						mode.customMode, decoded.MainMode, decoded.SubMode, mode.mainMode, mode.subMode)
+				}
+
+				encoded, err := ProtobufToCustomMode(decoded.MainMode, decoded.SubMode, table.autopilot, table.mavType)
+				if err != nil {
+					t.Fatalf("ProtobufToCustomMode(%s/%s) error = %v", decoded.MainMode, decoded.SubMode, err)
+				}
+				if encoded != mode.customMode {
+					t.Errorf("ProtobufToCustomMode(%s/%s) = %#x, want %#x", decoded.MainMode, decoded.SubMode, encoded, mode.customMode)
+				}
+			})
+		}
+	}
+}
+
+func TestProtobufToCustomModeDefaultSubMode(t *testing.T) {
+	tests := []struct {
+		name      string
+		mainMode  flightpath.MainMode
+		autopilot common.MAV_AUTOPILOT
+		mavType   common.MAV_TYPE
+		want      uint32
+	}{
+		{"PX4 POSCTL", flightpath.MainMode_MAIN_MODE_POSCTL, common.MAV_AUTOPILOT_PX4, common.MAV_TYPE_QUADROTOR, px4CustomMode(3, 0)},
+		{"PX4 AUTO", flightpath.MainMode_MAIN_MODE_AUTO, common.MAV_AUTOPILOT_PX4, common.MAV_TYPE_QUADROTOR, px4CustomMode(4, 4)},
+		{"ArduCopter AUTO", flightpath.MainMode_MAIN_MODE_AUTO, common.MAV_AUTOPILOT_ARDUPILOTMEGA, common.MAV_TYPE_HEXAROTOR, 3},
+		{"ArduPlane POSCTL", flightpath.MainMode_MAIN_MODE_POSCTL, common.MAV_AUTOPILOT_ARDUPILOTMEGA, common.MAV_TYPE_VTOL_TILTROTOR, 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ProtobufToCustomMode(tt.mainMode, flightpath.SubMode_SUB_MODE_UNSPECIFIED, tt.autopilot, tt.mavType)
+			if err != nil {
+				t.Fatalf("ProtobufToCustomMode() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ProtobufToCustomMode() = %#x, want %#x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProtobufToCustomModeUnsupported(t *testing.T) {
+	tests := []struct {
+		name      string
+		mainMode  flightpath.MainMode
+		subMode   flightpath.SubMode
+		autopilot common.MAV_AUTOPILOT
+		mavType   common.MAV_TYPE
+	}{
+		{"generic autopilot", flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_UNSPECIFIED, common.MAV_AUTOPILOT_GENERIC, common.MAV_TYPE_QUADROTOR},
+		{"ArduPilot rover", flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_UNSPECIFIED, common.MAV_AUTOPILOT_ARDUPILOTMEGA, common.MAV_TYPE_GROUND_ROVER},
+		{"ArduCopter takeoff", flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_AUTO_TAKEOFF, common.MAV_AUTOPILOT_ARDUPILOTMEGA, common.MAV_TYPE_QUADROTOR},
+		{"PX4 sub mode of another main mode", flightpath.MainMode_MAIN_MODE_AUTO, flightpath.SubMode_SUB_MODE_POSCTL_ORBIT, common.MAV_AUTOPILOT_PX4, common.MAV_TYPE_QUADROTOR},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := ProtobufToCustomMode(tt.mainMode, tt.subMode, tt.autopilot, tt.mavType); err == nil {
+				t.Errorf("ProtobufToCustomMode() = %#x, want an error", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package message_converters
+
+import (
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// DistanceSensorToProtobuf
+// Converts a MAVLink DISTANCE_SENSOR message to a protobuf DistanceSensor message.
+func DistanceSensorToProtobuf(msg *common.MessageDistanceSensor) *flightpath.DistanceSensor {
+	return &flightpath.DistanceSensor{
+		TimeBootMs:      msg.TimeBootMs,
+		MinDistance:     uint32(msg.MinDistance),
+		MaxDistance:     uint32(msg.MaxDistance),
+		CurrentDistance: uint32(msg.CurrentDistance),
+		Type:            MavDistanceSensorToProtobuf(msg.Type),
+		Id:              uint32(msg.Id),
+		Orientation:     MavSensorOrientationToProtobuf(msg.Orientation),
+		Covariance:      uint32(msg.Covariance),
+		HorizontalFov:   msg.HorizontalFov,
+		VerticalFov:     msg.VerticalFov,
+		Quaternion:      msg.Quaternion[:],
+		SignalQuality:   uint32(msg.SignalQuality),
+	}
+}
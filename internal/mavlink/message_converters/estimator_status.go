@@ -0,0 +1,23 @@
+package message_converters
+
+import (
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// EstimatorStatusToProtobuf
+// Converts a MAVLink ESTIMATOR_STATUS message to a protobuf EstimatorStatus message.
+func EstimatorStatusToProtobuf(msg *common.MessageEstimatorStatus) *flightpath.EstimatorStatus {
+	return &flightpath.EstimatorStatus{
+		TimeUsec:         msg.TimeUsec,
+		Flags:            EstimatorStatusFlagsToProtobuf(msg.Flags),
+		VelRatio:         msg.VelRatio,
+		PosHorizRatio:    msg.PosHorizRatio,
+		PosVertRatio:     msg.PosVertRatio,
+		MagRatio:         msg.MagRatio,
+		HaglRatio:        msg.HaglRatio,
+		TasRatio:         msg.TasRatio,
+		PosHorizAccuracy: msg.PosHorizAccuracy,
+		PosVertAccuracy:  msg.PosVertAccuracy,
+	}
+}
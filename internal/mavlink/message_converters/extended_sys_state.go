@@ -0,0 +1,15 @@
+package message_converters
+
+import (
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// ExtendedSysStateToProtobuf
+// Converts a MAVLink EXTENDED_SYS_STATE message to a protobuf ExtendedSysState message.
+func ExtendedSysStateToProtobuf(msg *common.MessageExtendedSysState) *flightpath.ExtendedSysState {
+	return &flightpath.ExtendedSysState{
+		VtolState:   MavVtolStateToProtobuf(msg.VtolState),
+		LandedState: MavLandedStateToProtobuf(msg.LandedState),
+	}
+}
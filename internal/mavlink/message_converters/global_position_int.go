@@ -0,0 +1,44 @@
+package message_converters
+
+import (
+	"math"
+
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// GlobalPositionIntToProtobuf
+// Converts a MAVLink GLOBAL_POSITION_INT message to a protobuf GlobalPositionInt message.
+func GlobalPositionIntToProtobuf(msg *common.MessageGlobalPositionInt) *flightpath.GlobalPositionInt {
+	return &flightpath.GlobalPositionInt{
+		TimeBootMs:  msg.TimeBootMs,
+		Lat:         msg.Lat,
+		Lon:         msg.Lon,
+		Alt:         msg.Alt,
+		RelativeAlt: msg.RelativeAlt,
+		Vx:          int32(msg.Vx),
+		Vy:          int32(msg.Vy),
+		Vz:          int32(msg.Vz),
+		Hdg:         uint32(msg.Hdg),
+	}
+}
+
+// GlobalPositionIntToPosition
+// Converts a protobuf GlobalPositionInt message to a protobuf Position message in normalized units
+// (degrees, metres, metres per second). The heading is left unset if unknown (UINT16_MAX).
+func GlobalPositionIntToPosition(msg *flightpath.GlobalPositionInt) *flightpath.Position {
+	position := &flightpath.Position{
+		Latitude:         float64(msg.Lat) / 1e7,
+		Longitude:        float64(msg.Lon) / 1e7,
+		AbsoluteAltitude: float32(msg.Alt) / 1000,
+		RelativeAltitude: float32(msg.RelativeAlt) / 1000,
+		VelocityNorth:    float32(msg.Vx) / 100,
+		VelocityEast:     float32(msg.Vy) / 100,
+		VelocityDown:     float32(msg.Vz) / 100,
+	}
+	if msg.Hdg != math.MaxUint16 {
+		heading := float32(msg.Hdg) / 100
+		position.Heading = &heading
+	}
+	return position
+}
@@ -0,0 +1,52 @@
+package message_converters
+
+import (
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// Gps2RawToProtobuf
+// Converts a MAVLink GPS2_RAW message to a protobuf Gps2Raw message.
+func Gps2RawToProtobuf(msg *common.MessageGps2Raw) *flightpath.Gps2Raw {
+	return &flightpath.Gps2Raw{
+		TimeUsec:          msg.TimeUsec,
+		FixType:           GpsFixTypeToProtobuf(msg.FixType),
+		Lat:               msg.Lat,
+		Lon:               msg.Lon,
+		Alt:               msg.Alt,
+		Eph:               uint32(msg.Eph),
+		Epv:               uint32(msg.Epv),
+		Vel:               uint32(msg.Vel),
+		Cog:               uint32(msg.Cog),
+		SatellitesVisible: uint32(msg.SatellitesVisible),
+		DgpsNumch:         uint32(msg.DgpsNumch),
+		DgpsAge:           msg.DgpsAge,
+		Yaw:               uint32(msg.Yaw),
+		AltEllipsoid:      msg.AltEllipsoid,
+		HAcc:              msg.HAcc,
+		VAcc:              msg.VAcc,
+		VelAcc:            msg.VelAcc,
+		HdgAcc:            msg.HdgAcc,
+	}
+}
+
+// Gps2RawToGpsReceiver
+// Converts a protobuf Gps2Raw message to a protobuf GpsReceiver message in SI units.
+// GPS2_RAW has the same fields as GPS_RAW_INT (plus DGPS information), see GpsRawIntToGpsReceiver.
+func Gps2RawToGpsReceiver(msg *flightpath.Gps2Raw) *flightpath.GpsReceiver {
+	return GpsRawIntToGpsReceiver(&flightpath.GpsRawInt{
+		FixType:           msg.FixType,
+		Lat:               msg.Lat,
+		Lon:               msg.Lon,
+		Alt:               msg.Alt,
+		Eph:               msg.Eph,
+		Epv:               msg.Epv,
+		Vel:               msg.Vel,
+		Cog:               msg.Cog,
+		SatellitesVisible: msg.SatellitesVisible,
+		HAcc:              msg.HAcc,
+		VAcc:              msg.VAcc,
+		VelAcc:            msg.VelAcc,
+		Yaw:               msg.Yaw,
+	})
+}
@@ -1,6 +1,8 @@
 package message_converters
 
 import (
+	"math"
+
 	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
 	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
 )
@@ -27,3 +29,54 @@ func GpsRawIntToProtobuf(msg *common.MessageGpsRawInt) *flightpath.GpsRawInt {
 		Yaw:               uint32(msg.Yaw),
 	}
 }
+
+// GpsRawIntToGpsReceiver
+// Converts a protobuf GpsRawInt message to a protobuf GpsReceiver message in SI units.
+// Values reported as unknown are left unset. The accuracy fields are extensions that default to
+// 0 when not sent, so 0 is treated as not reported.
+func GpsRawIntToGpsReceiver(msg *flightpath.GpsRawInt) *flightpath.GpsReceiver {
+	receiver := &flightpath.GpsReceiver{
+		FixType:   msg.FixType,
+		Latitude:  float64(msg.Lat) / 1e7,
+		Longitude: float64(msg.Lon) / 1e7,
+		Altitude:  float32(msg.Alt) / 1000,
+	}
+	if msg.Eph != math.MaxUint16 {
+		hdop := float32(msg.Eph) / 100
+		receiver.Hdop = &hdop
+	}
+	if msg.Epv != math.MaxUint16 {
+		vdop := float32(msg.Epv) / 100
+		receiver.Vdop = &vdop
+	}
+	if msg.Vel != math.MaxUint16 {
+		groundSpeed := float32(msg.Vel) / 100
+		receiver.GroundSpeed = &groundSpeed
+	}
+	if msg.Cog != math.MaxUint16 {
+		course := float32(msg.Cog) / 100
+		receiver.Course = &course
+	}
+	if msg.SatellitesVisible != math.MaxUint8 {
+		satellitesVisible := msg.SatellitesVisible
+		receiver.SatellitesVisible = &satellitesVisible
+	}
+	if msg.HAcc != 0 {
+		horizontalAccuracy := float32(msg.HAcc) / 1000
+		receiver.HorizontalAccuracy = &horizontalAccuracy
+	}
+	if msg.VAcc != 0 {
+		verticalAccuracy := float32(msg.VAcc) / 1000
+		receiver.VerticalAccuracy = &verticalAccuracy
+	}
+	if msg.VelAcc != 0 {
+		speedAccuracy := float32(msg.VelAcc) / 1000
+		receiver.SpeedAccuracy = &speedAccuracy
+	}
+	// 0 = yaw not provided, UINT16_MAX = yaw currently unavailable, 36000 = north
+	if msg.Yaw != 0 && msg.Yaw != math.MaxUint16 {
+		yaw := float32(msg.Yaw%36000) / 100
+		receiver.Yaw = &yaw
+	}
+	return receiver
+}
@@ -0,0 +1,51 @@
+package message_converters
+
+import (
+	"math"
+
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// GpsRtkToProtobuf
+// Converts a MAVLink GPS_RTK message to a protobuf GpsRtk message.
+func GpsRtkToProtobuf(msg *common.MessageGpsRtk) *flightpath.GpsRtk {
+	return &flightpath.GpsRtk{
+		TimeLastBaselineMs: msg.TimeLastBaselineMs,
+		RtkReceiverId:      uint32(msg.RtkReceiverId),
+		Wn:                 uint32(msg.Wn),
+		Tow:                msg.Tow,
+		RtkHealth:          uint32(msg.RtkHealth),
+		RtkRate:            uint32(msg.RtkRate),
+		Nsats:              uint32(msg.Nsats),
+		BaselineCoordsType: RtkBaselineCoordinateSystemToProtobuf(msg.BaselineCoordsType),
+		BaselineAMm:        msg.BaselineAMm,
+		BaselineBMm:        msg.BaselineBMm,
+		BaselineCMm:        msg.BaselineCMm,
+		Accuracy:           msg.Accuracy,
+		IarNumHypotheses:   msg.IarNumHypotheses,
+	}
+}
+
+// Gps2RtkToProtobuf
+// Converts a MAVLink GPS2_RTK message to a protobuf GpsRtk message (GPS2_RTK has the same fields
+// as GPS_RTK).
+func Gps2RtkToProtobuf(msg *common.MessageGps2Rtk) *flightpath.GpsRtk {
+	return GpsRtkToProtobuf((*common.MessageGpsRtk)(msg))
+}
+
+// GpsRtkToRtkBaseline
+// Converts a protobuf GpsRtk message to a protobuf RtkBaseline message in meters.
+func GpsRtkToRtkBaseline(msg *flightpath.GpsRtk) *flightpath.RtkBaseline {
+	a := float64(msg.BaselineAMm) / 1000
+	b := float64(msg.BaselineBMm) / 1000
+	c := float64(msg.BaselineCMm) / 1000
+
+	return &flightpath.RtkBaseline{
+		CoordinateSystem: msg.BaselineCoordsType,
+		A:                float32(a),
+		B:                float32(b),
+		C:                float32(c),
+		Length:           float32(math.Sqrt(a*a + b*b + c*c)),
+	}
+}
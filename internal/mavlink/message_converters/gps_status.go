@@ -0,0 +1,47 @@
+package message_converters
+
+import (
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// GpsStatusToProtobuf
+// Converts a MAVLink GPS_STATUS message to a protobuf GpsStatus message.
+func GpsStatusToProtobuf(msg *common.MessageGpsStatus) *flightpath.GpsStatus {
+	gpsStatus := &flightpath.GpsStatus{
+		SatellitesVisible:  uint32(msg.SatellitesVisible),
+		SatellitePrn:       make([]uint32, len(msg.SatellitePrn)),
+		SatelliteUsed:      make([]uint32, len(msg.SatelliteUsed)),
+		SatelliteElevation: make([]uint32, len(msg.SatelliteElevation)),
+		SatelliteAzimuth:   make([]uint32, len(msg.SatelliteAzimuth)),
+		SatelliteSnr:       make([]uint32, len(msg.SatelliteSnr)),
+	}
+	for i := range msg.SatellitePrn {
+		gpsStatus.SatellitePrn[i] = uint32(msg.SatellitePrn[i])
+		gpsStatus.SatelliteUsed[i] = uint32(msg.SatelliteUsed[i])
+		gpsStatus.SatelliteElevation[i] = uint32(msg.SatelliteElevation[i])
+		gpsStatus.SatelliteAzimuth[i] = uint32(msg.SatelliteAzimuth[i])
+		gpsStatus.SatelliteSnr[i] = uint32(msg.SatelliteSnr[i])
+	}
+	return gpsStatus
+}
+
+// GpsStatusToSatellites
+// Converts a protobuf GpsStatus message to the list of its visible satellites (the first
+// satellites_visible entries of the arrays, at most 20).
+func GpsStatusToSatellites(msg *flightpath.GpsStatus) []*flightpath.GpsSatellite {
+	count := min(int(msg.SatellitesVisible), len(msg.SatellitePrn))
+
+	satellites := make([]*flightpath.GpsSatellite, count)
+	for i := range count {
+		satellites[i] = &flightpath.GpsSatellite{
+			Prn:       msg.SatellitePrn[i],
+			Used:      msg.SatelliteUsed[i] != 0,
+			Elevation: msg.SatelliteElevation[i],
+			// 0: 0 deg, 255: 360 deg
+			Azimuth: float32(msg.SatelliteAzimuth[i]) * 360 / 255,
+			Snr:     msg.SatelliteSnr[i],
+		}
+	}
+	return satellites
+}
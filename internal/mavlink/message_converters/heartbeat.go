@@ -12,7 +12,7 @@ func HeartbeatToProtobuf(msg *common.MessageHeartbeat) *flightpath.Heartbeat {
 		Type:           MavTypeToProtobuf(msg.Type),
 		Autopilot:      MavAutopilotToProtobuf(msg.Autopilot),
 		BaseMode:       BaseModeToProtobuf(msg.BaseMode),
-		CustomMode:     CustomModeToProtobuf(msg.CustomMode, msg.Autopilot),
+		CustomMode:     CustomModeToProtobuf(msg.CustomMode, msg.Autopilot, msg.Type),
 		SystemStatus:   MavStateToProtobuf(msg.SystemStatus),
 		MavlinkVersion: uint32(msg.MavlinkVersion),
 	}
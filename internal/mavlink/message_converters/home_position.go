@@ -0,0 +1,35 @@
+package message_converters
+
+import (
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// HomePositionToProtobuf
+// Converts a MAVLink HOME_POSITION message to a protobuf HomePosition message.
+func HomePositionToProtobuf(msg *common.MessageHomePosition) *flightpath.HomePosition {
+	return &flightpath.HomePosition{
+		Latitude:  msg.Latitude,
+		Longitude: msg.Longitude,
+		Altitude:  msg.Altitude,
+		X:         msg.X,
+		Y:         msg.Y,
+		Z:         msg.Z,
+		Q:         msg.Q[:],
+		ApproachX: msg.ApproachX,
+		ApproachY: msg.ApproachY,
+		ApproachZ: msg.ApproachZ,
+		TimeUsec:  msg.TimeUsec,
+	}
+}
+
+// HomePositionToHomeLocation
+// Converts a protobuf HomePosition message to a protobuf HomeLocation message in normalized units
+// (degrees, metres).
+func HomePositionToHomeLocation(msg *flightpath.HomePosition) *flightpath.HomeLocation {
+	return &flightpath.HomeLocation{
+		Latitude:         float64(msg.Latitude) / 1e7,
+		Longitude:        float64(msg.Longitude) / 1e7,
+		AbsoluteAltitude: float32(msg.Altitude) / 1000,
+	}
+}
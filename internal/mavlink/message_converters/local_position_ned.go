@@ -0,0 +1,20 @@
+package message_converters
+
+import (
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// LocalPositionNedToProtobuf
+// Converts a MAVLink LOCAL_POSITION_NED message to a protobuf LocalPositionNed message.
+func LocalPositionNedToProtobuf(msg *common.MessageLocalPositionNed) *flightpath.LocalPositionNed {
+	return &flightpath.LocalPositionNed{
+		TimeBootMs: msg.TimeBootMs,
+		X:          msg.X,
+		Y:          msg.Y,
+		Z:          msg.Z,
+		Vx:         msg.Vx,
+		Vy:         msg.Vy,
+		Vz:         msg.Vz,
+	}
+}
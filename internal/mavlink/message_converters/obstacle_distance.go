@@ -0,0 +1,60 @@
+package message_converters
+
+import (
+	"math"
+
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// ObstacleDistanceToProtobuf
+// Converts a MAVLink OBSTACLE_DISTANCE message to a protobuf ObstacleDistance message.
+func ObstacleDistanceToProtobuf(msg *common.MessageObstacleDistance) *flightpath.ObstacleDistance {
+	distances := make([]uint32, len(msg.Distances))
+	for i, distance := range msg.Distances {
+		distances[i] = uint32(distance)
+	}
+
+	return &flightpath.ObstacleDistance{
+		TimeUsec:    msg.TimeUsec,
+		SensorType:  MavDistanceSensorToProtobuf(msg.SensorType),
+		Distances:   distances,
+		Increment:   uint32(msg.Increment),
+		MinDistance: uint32(msg.MinDistance),
+		MaxDistance: uint32(msg.MaxDistance),
+		IncrementF:  msg.IncrementF,
+		AngleOffset: msg.AngleOffset,
+		Frame:       MavFrameToProtobuf(msg.Frame),
+	}
+}
+
+// ObstacleDistanceToSectors
+// Resolves the direction of every element of a protobuf ObstacleDistance message: element i is
+// at angle_offset + i * increment (increment_f if non-zero), normalized to [0, 360).
+// Unknown/unused elements (UINT16_MAX) are left out, and elements beyond max_distance (no
+// obstacle) have no distance.
+func ObstacleDistanceToSectors(msg *flightpath.ObstacleDistance) []*flightpath.ObstacleSector {
+	increment := float64(msg.IncrementF)
+	if increment == 0 {
+		increment = float64(msg.Increment)
+	}
+
+	var sectors []*flightpath.ObstacleSector
+	for i, distance := range msg.Distances {
+		if distance == math.MaxUint16 {
+			continue
+		}
+
+		angle := math.Mod(float64(msg.AngleOffset)+float64(i)*increment, 360)
+		if angle < 0 {
+			angle += 360
+		}
+		sector := &flightpath.ObstacleSector{Angle: float32(angle)}
+		if distance <= msg.MaxDistance {
+			distanceM := float32(distance) / 100
+			sector.Distance = &distanceM
+		}
+		sectors = append(sectors, sector)
+	}
+	return sectors
+}
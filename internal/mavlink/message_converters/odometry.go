@@ -0,0 +1,32 @@
+package message_converters
+
+import (
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// OdometryToProtobuf
+// Converts a MAVLink ODOMETRY message to a protobuf Odometry message.
+// The covariance matrices are decoded into their full form (see CovarianceToProtobuf).
+func OdometryToProtobuf(msg *common.MessageOdometry) *flightpath.Odometry {
+	return &flightpath.Odometry{
+		TimeUsec:           msg.TimeUsec,
+		FrameId:            MavFrameToProtobuf(msg.FrameId),
+		ChildFrameId:       MavFrameToProtobuf(msg.ChildFrameId),
+		X:                  msg.X,
+		Y:                  msg.Y,
+		Z:                  msg.Z,
+		Q:                  msg.Q[:],
+		Vx:                 msg.Vx,
+		Vy:                 msg.Vy,
+		Vz:                 msg.Vz,
+		Rollspeed:          msg.Rollspeed,
+		Pitchspeed:         msg.Pitchspeed,
+		Yawspeed:           msg.Yawspeed,
+		PoseCovariance:     CovarianceToProtobuf(msg.PoseCovariance),
+		VelocityCovariance: CovarianceToProtobuf(msg.VelocityCovariance),
+		ResetCounter:       uint32(msg.ResetCounter),
+		EstimatorType:      MavEstimatorTypeToProtobuf(msg.EstimatorType),
+		Quality:            int32(msg.Quality),
+	}
+}
@@ -0,0 +1,19 @@
+package message_converters
+
+import (
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// OrbitExecutionStatusToProtobuf
+// Converts a MAVLink ORBIT_EXECUTION_STATUS message to a protobuf OrbitExecutionStatus message.
+func OrbitExecutionStatusToProtobuf(msg *common.MessageOrbitExecutionStatus) *flightpath.OrbitExecutionStatus {
+	return &flightpath.OrbitExecutionStatus{
+		TimeUsec: msg.TimeUsec,
+		Radius:   msg.Radius,
+		Frame:    MavFrameToProtobuf(msg.Frame),
+		X:        msg.X,
+		Y:        msg.Y,
+		Z:        msg.Z,
+	}
+}
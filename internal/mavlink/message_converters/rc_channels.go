@@ -0,0 +1,48 @@
+package message_converters
+
+import (
+	"math"
+
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// RcChannelsToProtobuf
+// Converts a MAVLink RC_CHANNELS message to a protobuf RcChannels message.
+func RcChannelsToProtobuf(msg *common.MessageRcChannels) *flightpath.RcChannels {
+	return &flightpath.RcChannels{
+		TimeBootMs: msg.TimeBootMs,
+		Chancount:  uint32(msg.Chancount),
+		ChanRaw: []uint32{
+			uint32(msg.Chan1Raw), uint32(msg.Chan2Raw), uint32(msg.Chan3Raw), uint32(msg.Chan4Raw),
+			uint32(msg.Chan5Raw), uint32(msg.Chan6Raw), uint32(msg.Chan7Raw), uint32(msg.Chan8Raw),
+			uint32(msg.Chan9Raw), uint32(msg.Chan10Raw), uint32(msg.Chan11Raw), uint32(msg.Chan12Raw),
+			uint32(msg.Chan13Raw), uint32(msg.Chan14Raw), uint32(msg.Chan15Raw), uint32(msg.Chan16Raw),
+			uint32(msg.Chan17Raw), uint32(msg.Chan18Raw),
+		},
+		Rssi: uint32(msg.Rssi),
+	}
+}
+
+// RcChannelsToRcInput
+// Converts a protobuf RcChannels message to a protobuf RcInput message, leaving out unused
+// channels (UINT16_MAX) and an unknown RSSI (UINT8_MAX).
+func RcChannelsToRcInput(msg *flightpath.RcChannels) *flightpath.RcInput {
+	rcInput := &flightpath.RcInput{
+		TimeBootMs:   msg.TimeBootMs,
+		ChannelCount: msg.Chancount,
+	}
+	for i, value := range msg.ChanRaw {
+		if value != math.MaxUint16 {
+			rcInput.Channels = append(rcInput.Channels, &flightpath.ChannelValue{
+				Channel: uint32(i + 1),
+				Value:   value,
+			})
+		}
+	}
+	if msg.Rssi != math.MaxUint8 {
+		rssi := msg.Rssi
+		rcInput.Rssi = &rssi
+	}
+	return rcInput
+}
@@ -0,0 +1,53 @@
+package message_converters
+
+import (
+	"math"
+	"sort"
+
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// Number of outputs of a SERVO_OUTPUT_RAW port
+const servoOutputsPerPort = 16
+
+// ServoOutputRawToProtobuf
+// Converts a MAVLink SERVO_OUTPUT_RAW message to a protobuf ServoOutputRaw message.
+func ServoOutputRawToProtobuf(msg *common.MessageServoOutputRaw) *flightpath.ServoOutputRaw {
+	return &flightpath.ServoOutputRaw{
+		TimeUsec: msg.TimeUsec,
+		Port:     uint32(msg.Port),
+		ServoRaw: []uint32{
+			uint32(msg.Servo1Raw), uint32(msg.Servo2Raw), uint32(msg.Servo3Raw), uint32(msg.Servo4Raw),
+			uint32(msg.Servo5Raw), uint32(msg.Servo6Raw), uint32(msg.Servo7Raw), uint32(msg.Servo8Raw),
+			uint32(msg.Servo9Raw), uint32(msg.Servo10Raw), uint32(msg.Servo11Raw), uint32(msg.Servo12Raw),
+			uint32(msg.Servo13Raw), uint32(msg.Servo14Raw), uint32(msg.Servo15Raw), uint32(msg.Servo16Raw),
+		},
+	}
+}
+
+// ServoOutputRawToServoOutputs
+// Groups the protobuf ServoOutputRaw messages of several ports into a single protobuf
+// ServoOutputs message, numbering channels across ports (port 0 holds channels 1-16, port 1
+// channels 17-32, etc.).
+// Channels reported as unused (UINT16_MAX) are left out. 0 is a valid output value (e.g. a
+// stopped DShot motor), including for outputs 9-16.
+func ServoOutputRawToServoOutputs(ports []*flightpath.ServoOutputRaw) *flightpath.ServoOutputs {
+	sorted := append([]*flightpath.ServoOutputRaw(nil), ports...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Port < sorted[j].Port })
+
+	servoOutputs := &flightpath.ServoOutputs{}
+	for _, port := range sorted {
+		servoOutputs.Ports = append(servoOutputs.Ports, port.Port)
+		for i, value := range port.ServoRaw {
+			if value == math.MaxUint16 {
+				continue
+			}
+			servoOutputs.Channels = append(servoOutputs.Channels, &flightpath.ChannelValue{
+				Channel: port.Port*servoOutputsPerPort + uint32(i) + 1,
+				Value:   value,
+			})
+		}
+	}
+	return servoOutputs
+}
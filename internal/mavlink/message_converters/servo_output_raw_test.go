@@ -0,0 +1,124 @@
+package message_converters
+
+import (
+	"math"
+	"testing"
+
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+func TestServoOutputRawToServoOutputs(t *testing.T) {
+	// Outputs 1-4 drive motors, output 5 a stopped DShot motor, the rest are unused
+	main := &common.MessageServoOutputRaw{
+		Port:       0,
+		Servo1Raw:  1500,
+		Servo2Raw:  1510,
+		Servo3Raw:  1520,
+		Servo4Raw:  1530,
+		Servo5Raw:  0,
+		Servo6Raw:  math.MaxUint16,
+		Servo7Raw:  math.MaxUint16,
+		Servo8Raw:  math.MaxUint16,
+		Servo9Raw:  math.MaxUint16,
+		Servo10Raw: math.MaxUint16,
+		Servo11Raw: math.MaxUint16,
+		Servo12Raw: math.MaxUint16,
+		Servo13Raw: math.MaxUint16,
+		Servo14Raw: math.MaxUint16,
+		Servo15Raw: math.MaxUint16,
+		Servo16Raw: 1000,
+	}
+	aux := &common.MessageServoOutputRaw{
+		Port:       1,
+		Servo1Raw:  2000,
+		Servo2Raw:  math.MaxUint16,
+		Servo3Raw:  math.MaxUint16,
+		Servo4Raw:  math.MaxUint16,
+		Servo5Raw:  math.MaxUint16,
+		Servo6Raw:  math.MaxUint16,
+		Servo7Raw:  math.MaxUint16,
+		Servo8Raw:  math.MaxUint16,
+		Servo9Raw:  0,
+		Servo10Raw: math.MaxUint16,
+		Servo11Raw: math.MaxUint16,
+		Servo12Raw: math.MaxUint16,
+		Servo13Raw: math.MaxUint16,
+		Servo14Raw: math.MaxUint16,
+		Servo15Raw: math.MaxUint16,
+		Servo16Raw: math.MaxUint16,
+	}
+	mainChannels := []*flightpath.ChannelValue{
+		{Channel: 1, Value: 1500},
+		{Channel: 2, Value: 1510},
+		{Channel: 3, Value: 1520},
+		{Channel: 4, Value: 1530},
+		{Channel: 5, Value: 0},
+		{Channel: 16, Value: 1000},
+	}
+	auxChannels := []*flightpath.ChannelValue{
+		{Channel: 17, Value: 2000},
+		{Channel: 25, Value: 0},
+	}
+
+	tests := []struct {
+		name         string
+		ports        []*common.MessageServoOutputRaw
+		wantPorts    []uint32
+		wantChannels []*flightpath.ChannelValue
+	}{
+		{
+			name:         "single port",
+			ports:        []*common.MessageServoOutputRaw{main},
+			wantPorts:    []uint32{0},
+			wantChannels: mainChannels,
+		},
+		{
+			name:         "channels numbered across ports",
+			ports:        []*common.MessageServoOutputRaw{main, aux},
+			wantPorts:    []uint32{0, 1},
+			wantChannels: append(append([]*flightpath.ChannelValue(nil), mainChannels...), auxChannels...),
+		},
+		{
+			name:         "ports received out of order",
+			ports:        []*common.MessageServoOutputRaw{aux, main},
+			wantPorts:    []uint32{0, 1},
+			wantChannels: append(append([]*flightpath.ChannelValue(nil), mainChannels...), auxChannels...),
+		},
+		{
+			name:         "second port only",
+			ports:        []*common.MessageServoOutputRaw{aux},
+			wantPorts:    []uint32{1},
+			wantChannels: auxChannels,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ports []*flightpath.ServoOutputRaw
+			for _, port := range tt.ports {
+				ports = append(ports, ServoOutputRawToProtobuf(port))
+			}
+			outputs := ServoOutputRawToServoOutputs(ports)
+
+			if len(outputs.Ports) != len(tt.wantPorts) {
+				t.Fatalf("Ports = %v, want %v", outputs.Ports, tt.wantPorts)
+			}
+			for i := range tt.wantPorts {
+				if outputs.Ports[i] != tt.wantPorts[i] {
+					t.Errorf("Ports = %v, want %v", outputs.Ports, tt.wantPorts)
+					break
+				}
+			}
+			if len(outputs.Channels) != len(tt.wantChannels) {
+				t.Fatalf("Channels = %v, want %v", outputs.Channels, tt.wantChannels)
+			}
+			for i, want := range tt.wantChannels {
+				got := outputs.Channels[i]
+				if got.Channel != want.Channel || got.Value != want.Value {
+					t.Errorf("Channels[%d] = {%d %d}, want {%d %d}", i, got.Channel, got.Value, want.Channel, want.Value)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package message_converters
+
+import (
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// StatusTextToProtobuf
+// Converts a MAVLink STATUSTEXT message to a protobuf StatusText message.
+func StatusTextToProtobuf(msg *common.MessageStatustext) *flightpath.StatusText {
+	return &flightpath.StatusText{
+		Severity: MavSeverityToProtobuf(msg.Severity),
+		Text:     msg.Text,
+		Id:       uint32(msg.Id),
+		ChunkSeq: uint32(msg.ChunkSeq),
+	}
+}
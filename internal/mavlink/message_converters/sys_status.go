@@ -0,0 +1,78 @@
+package message_converters
+
+import (
+	"math"
+
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// SysStatusToProtobuf
+// Converts a MAVLink SYS_STATUS message to a protobuf SysStatus message.
+func SysStatusToProtobuf(msg *common.MessageSysStatus) *flightpath.SysStatus {
+	return &flightpath.SysStatus{
+		OnboardControlSensorsPresent:         uint32(msg.OnboardControlSensorsPresent),
+		OnboardControlSensorsEnabled:         uint32(msg.OnboardControlSensorsEnabled),
+		OnboardControlSensorsHealth:          uint32(msg.OnboardControlSensorsHealth),
+		Load:                                 uint32(msg.Load),
+		VoltageBattery:                       uint32(msg.VoltageBattery),
+		CurrentBattery:                       int32(msg.CurrentBattery),
+		BatteryRemaining:                     int32(msg.BatteryRemaining),
+		DropRateComm:                         uint32(msg.DropRateComm),
+		ErrorsComm:                           uint32(msg.ErrorsComm),
+		ErrorsCount1:                         uint32(msg.ErrorsCount1),
+		ErrorsCount2:                         uint32(msg.ErrorsCount2),
+		ErrorsCount3:                         uint32(msg.ErrorsCount3),
+		ErrorsCount4:                         uint32(msg.ErrorsCount4),
+		OnboardControlSensorsPresentExtended: uint32(msg.OnboardControlSensorsPresentExtended),
+		OnboardControlSensorsEnabledExtended: uint32(msg.OnboardControlSensorsEnabledExtended),
+		OnboardControlSensorsHealthExtended:  uint32(msg.OnboardControlSensorsHealthExtended),
+	}
+}
+
+// SysStatusToBattery
+// Converts the battery fields of a protobuf SysStatus message to a protobuf Battery message in
+// normalized units, for drones that do not send BATTERY_STATUS. Values marked as unknown are left
+// unset. Returns nil if SYS_STATUS carries no battery information at all.
+func SysStatusToBattery(msg *flightpath.SysStatus) *flightpath.Battery {
+	if msg.VoltageBattery == math.MaxUint16 && msg.CurrentBattery == -1 && msg.BatteryRemaining == -1 {
+		return nil
+	}
+
+	battery := &flightpath.Battery{
+		Source: flightpath.BatterySource_BATTERY_SOURCE_SYS_STATUS,
+	}
+	if msg.VoltageBattery != math.MaxUint16 {
+		voltage := float32(msg.VoltageBattery) / 1000
+		battery.Voltage = &voltage
+	}
+	if msg.CurrentBattery != -1 {
+		current := float32(msg.CurrentBattery) / 100
+		battery.Current = &current
+	}
+	if msg.BatteryRemaining != -1 {
+		remainingPercent := float32(msg.BatteryRemaining)
+		battery.RemainingPercent = &remainingPercent
+	}
+	return battery
+}
+
+// SysStatusToSystemStatus
+// Converts a protobuf SysStatus message to a protobuf SystemStatus message, with the sensor
+// bitmaps decoded and the load and drop rate in percent.
+func SysStatusToSystemStatus(msg *flightpath.SysStatus) *flightpath.SystemStatus {
+	return &flightpath.SystemStatus{
+		Sensors: SysStatusSensorsToProtobuf(
+			common.MAV_SYS_STATUS_SENSOR(msg.OnboardControlSensorsPresent),
+			common.MAV_SYS_STATUS_SENSOR(msg.OnboardControlSensorsEnabled),
+			common.MAV_SYS_STATUS_SENSOR(msg.OnboardControlSensorsHealth),
+			common.MAV_SYS_STATUS_SENSOR_EXTENDED(msg.OnboardControlSensorsPresentExtended),
+			common.MAV_SYS_STATUS_SENSOR_EXTENDED(msg.OnboardControlSensorsEnabledExtended),
+			common.MAV_SYS_STATUS_SENSOR_EXTENDED(msg.OnboardControlSensorsHealthExtended),
+		),
+		// Load is in d% and the drop rate in c%
+		CpuLoad:      float32(msg.Load) / 10,
+		DropRateComm: float32(msg.DropRateComm) / 100,
+		ErrorsComm:   msg.ErrorsComm,
+	}
+}
@@ -0,0 +1,76 @@
+package message_converters
+
+import (
+	"math"
+	"testing"
+
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+func TestSysStatusToBattery(t *testing.T) {
+	tests := []struct {
+		name          string
+		msg           *common.MessageSysStatus
+		wantNil       bool
+		wantVoltage   *float32
+		wantCurrent   *float32
+		wantRemaining *float32
+	}{
+		{
+			name:          "all battery fields",
+			msg:           &common.MessageSysStatus{VoltageBattery: 12600, CurrentBattery: 1500, BatteryRemaining: 80},
+			wantVoltage:   float32Ptr(12.6),
+			wantCurrent:   float32Ptr(15),
+			wantRemaining: float32Ptr(80),
+		},
+		{
+			name:          "current and remaining unknown",
+			msg:           &common.MessageSysStatus{VoltageBattery: 11100, CurrentBattery: -1, BatteryRemaining: -1},
+			wantVoltage:   float32Ptr(11.1),
+			wantCurrent:   nil,
+			wantRemaining: nil,
+		},
+		{
+			name:        "voltage unknown",
+			msg:         &common.MessageSysStatus{VoltageBattery: math.MaxUint16, CurrentBattery: 500, BatteryRemaining: -1},
+			wantCurrent: float32Ptr(5),
+		},
+		{
+			name:    "no battery information",
+			msg:     &common.MessageSysStatus{VoltageBattery: math.MaxUint16, CurrentBattery: -1, BatteryRemaining: -1},
+			wantNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			battery := SysStatusToBattery(SysStatusToProtobuf(tt.msg))
+			if tt.wantNil {
+				if battery != nil {
+					t.Fatalf("SysStatusToBattery() = %v, want nil", battery)
+				}
+				return
+			}
+			if battery == nil {
+				t.Fatal("SysStatusToBattery() = nil")
+			}
+
+			if battery.Source != flightpath.BatterySource_BATTERY_SOURCE_SYS_STATUS {
+				t.Errorf("Source = %s, want SYS_STATUS", battery.Source)
+			}
+			if !equalFloat32Ptr(battery.Voltage, tt.wantVoltage) {
+				t.Errorf("Voltage = %v, want %v", formatFloat32Ptr(battery.Voltage), formatFloat32Ptr(tt.wantVoltage))
+			}
+			if !equalFloat32Ptr(battery.Current, tt.wantCurrent) {
+				t.Errorf("Current = %v, want %v", formatFloat32Ptr(battery.Current), formatFloat32Ptr(tt.wantCurrent))
+			}
+			if !equalFloat32Ptr(battery.RemainingPercent, tt.wantRemaining) {
+				t.Errorf("RemainingPercent = %v, want %v", formatFloat32Ptr(battery.RemainingPercent), formatFloat32Ptr(tt.wantRemaining))
+			}
+			if len(battery.CellVoltages) != 0 {
+				t.Errorf("CellVoltages = %v, want none", battery.CellVoltages)
+			}
+		})
+	}
+}
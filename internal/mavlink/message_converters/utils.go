@@ -2,12 +2,31 @@ package message_converters
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
 	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
 )
 
+// AdsbAltitudeTypeToProtobuf
+// Converts MAVLink ADSB_ALTITUDE_TYPE to protobuf AdsbAltitudeType enum.
+// Proto enum values are incremented by 1 to accommodate ADSB_ALTITUDE_TYPE_UNSPECIFIED at 0.
+// MAVLink 0 (PRESSURE_QNH) maps to proto 1 (PRESSURE_QNH), MAVLink 1 (GEOMETRIC) maps to proto 2 (GEOMETRIC).
+func AdsbAltitudeTypeToProtobuf(altitudeType common.ADSB_ALTITUDE_TYPE) flightpath.AdsbAltitudeType {
+	// Add 1 to MAVLink value to account for UNSPECIFIED at 0 in proto
+	return flightpath.AdsbAltitudeType(altitudeType + 1)
+}
+
+// AdsbEmitterTypeToProtobuf
+// Converts MAVLink ADSB_EMITTER_TYPE to protobuf AdsbEmitterType enum.
+// Proto enum values are incremented by 1 to accommodate ADSB_EMITTER_TYPE_UNSPECIFIED at 0.
+// MAVLink 0 (NO_INFO) maps to proto 1 (NO_INFO), MAVLink 1 (LIGHT) maps to proto 2 (LIGHT), etc.
+func AdsbEmitterTypeToProtobuf(emitterType common.ADSB_EMITTER_TYPE) flightpath.AdsbEmitterType {
+	// Add 1 to MAVLink value to account for UNSPECIFIED at 0 in proto
+	return flightpath.AdsbEmitterType(emitterType + 1)
+}
+
 // BaseModeToProtobuf
 // Converts MAVLink base_mode bitfield to protobuf BaseMode structured message.
 // MAVLink MAV_MODE_FLAG bit positions (from MAVLink spec):
@@ -44,18 +63,72 @@ func BaseModeToProtobuf(baseMode common.MAV_MODE_FLAG) *flightpath.BaseMode {
 	}
 }
 
+// BatteryFaultsToProtobuf
+// Converts MAVLink MAV_BATTERY_FAULT bitfield to protobuf BatteryFaults structured message.
+// MAVLink MAV_BATTERY_FAULT bit positions (from MAVLink spec):
+// Bit 0 (1):   MAV_BATTERY_FAULT_DEEP_DISCHARGE
+// Bit 1 (2):   MAV_BATTERY_FAULT_SPIKES
+// Bit 2 (4):   MAV_BATTERY_FAULT_CELL_FAIL
+// Bit 3 (8):   MAV_BATTERY_FAULT_OVER_CURRENT
+// Bit 4 (16):  MAV_BATTERY_FAULT_OVER_TEMPERATURE
+// Bit 5 (32):  MAV_BATTERY_FAULT_UNDER_TEMPERATURE
+// Bit 6 (64):  MAV_BATTERY_FAULT_INCOMPATIBLE_VOLTAGE
+// Bit 7 (128): MAV_BATTERY_FAULT_INCOMPATIBLE_FIRMWARE
+// Bit 8 (256): BATTERY_FAULT_INCOMPATIBLE_CELLS_CONFIGURATION
+func BatteryFaultsToProtobuf(faults common.MAV_BATTERY_FAULT) *flightpath.BatteryFaults {
+	return &flightpath.BatteryFaults{
+		DeepDischarge:                  faults&common.MAV_BATTERY_FAULT_DEEP_DISCHARGE != 0,
+		Spikes:                         faults&common.MAV_BATTERY_FAULT_SPIKES != 0,
+		CellFail:                       faults&common.MAV_BATTERY_FAULT_CELL_FAIL != 0,
+		OverCurrent:                    faults&common.MAV_BATTERY_FAULT_OVER_CURRENT != 0,
+		OverTemperature:                faults&common.MAV_BATTERY_FAULT_OVER_TEMPERATURE != 0,
+		UnderTemperature:               faults&common.MAV_BATTERY_FAULT_UNDER_TEMPERATURE != 0,
+		IncompatibleVoltage:            faults&common.MAV_BATTERY_FAULT_INCOMPATIBLE_VOLTAGE != 0,
+		IncompatibleFirmware:           faults&common.MAV_BATTERY_FAULT_INCOMPATIBLE_FIRMWARE != 0,
+		IncompatibleCellsConfiguration: faults&common.BATTERY_FAULT_INCOMPATIBLE_CELLS_CONFIGURATION != 0,
+	}
+}
+
+// CovarianceToProtobuf
+// Decodes the upper right triangle of a 6x6 cross-covariance matrix (21 values, row-major) into
+// a protobuf Covariance message with the full symmetric matrix.
+// A NaN first element marks the whole matrix as unknown; other NaN elements are kept as is.
+func CovarianceToProtobuf(upperTriangle [21]float32) *flightpath.Covariance {
+	if math.IsNaN(float64(upperTriangle[0])) {
+		return &flightpath.Covariance{Known: false}
+	}
+
+	covariance := &flightpath.Covariance{
+		Known:     true,
+		Variances: make([]float32, 6),
+		Matrix:    make([]float32, 36),
+	}
+	i := 0
+	for row := 0; row < 6; row++ {
+		for col := row; col < 6; col++ {
+			covariance.Matrix[row*6+col] = upperTriangle[i]
+			covariance.Matrix[col*6+row] = upperTriangle[i]
+			i++
+		}
+		covariance.Variances[row] = covariance.Matrix[row*6+row]
+	}
+	return covariance
+}
+
 // CustomModeToProtobuf
 // Converts MAVLink custom_mode uint32 to protobuf CustomMode message.
 // For PX4 autopilots, decodes the custom_mode into main_mode and sub_mode.
+// For ArduPilot autopilots, maps the mode number (which depends on the vehicle type) to the
+// equivalent main_mode and sub_mode.
 // For other autopilots, returns unspecified values.
-func CustomModeToProtobuf(customMode uint32, autopilot common.MAV_AUTOPILOT) *flightpath.CustomMode {
+func CustomModeToProtobuf(customMode uint32, autopilot common.MAV_AUTOPILOT, mavType common.MAV_TYPE) *flightpath.CustomMode {
 	if autopilot == common.MAV_AUTOPILOT_PX4 {
 		// Extract main_mode and sub_mode from PX4 custom_mode uint32
 		px4MainMode := uint8((customMode >> 16) & 0xFF)
 		px4SubMode := uint8((customMode >> 24) & 0xFF)
 
 		mainMode := FlightMainModeToProtobuf(px4MainMode)
-		subMode := FlightSubModeToProtobuf(px4SubMode)
+		subMode := FlightSubModeToProtobuf(px4MainMode, px4SubMode)
 
 		return &flightpath.CustomMode{
 			MainMode: mainMode,
@@ -63,13 +136,49 @@ func CustomModeToProtobuf(customMode uint32, autopilot common.MAV_AUTOPILOT) *fl
 		}
 	}
 
-	// For non-PX4 autopilots, set to unspecified
+	if autopilot == common.MAV_AUTOPILOT_ARDUPILOTMEGA {
+		return ArduPilotCustomModeToProtobuf(customMode, mavType)
+	}
+
+	// For other autopilots, set to unspecified
 	return &flightpath.CustomMode{
 		MainMode: flightpath.MainMode_MAIN_MODE_UNSPECIFIED,
 		SubMode:  flightpath.SubMode_SUB_MODE_UNSPECIFIED,
 	}
 }
 
+// EstimatorStatusFlagsToProtobuf
+// Converts MAVLink ESTIMATOR_STATUS_FLAGS bitfield to protobuf EstimatorStatusFlags structured message.
+// MAVLink ESTIMATOR_STATUS_FLAGS bit positions (from MAVLink spec):
+// Bit 0 (1):     ESTIMATOR_ATTITUDE
+// Bit 1 (2):     ESTIMATOR_VELOCITY_HORIZ
+// Bit 2 (4):     ESTIMATOR_VELOCITY_VERT
+// Bit 3 (8):     ESTIMATOR_POS_HORIZ_REL
+// Bit 4 (16):    ESTIMATOR_POS_HORIZ_ABS
+// Bit 5 (32):    ESTIMATOR_POS_VERT_ABS
+// Bit 6 (64):    ESTIMATOR_POS_VERT_AGL
+// Bit 7 (128):   ESTIMATOR_CONST_POS_MODE
+// Bit 8 (256):   ESTIMATOR_PRED_POS_HORIZ_REL
+// Bit 9 (512):   ESTIMATOR_PRED_POS_HORIZ_ABS
+// Bit 10 (1024): ESTIMATOR_GPS_GLITCH
+// Bit 11 (2048): ESTIMATOR_ACCEL_ERROR
+func EstimatorStatusFlagsToProtobuf(flags common.ESTIMATOR_STATUS_FLAGS) *flightpath.EstimatorStatusFlags {
+	return &flightpath.EstimatorStatusFlags{
+		Attitude:        flags&common.ESTIMATOR_ATTITUDE != 0,
+		VelocityHoriz:   flags&common.ESTIMATOR_VELOCITY_HORIZ != 0,
+		VelocityVert:    flags&common.ESTIMATOR_VELOCITY_VERT != 0,
+		PosHorizRel:     flags&common.ESTIMATOR_POS_HORIZ_REL != 0,
+		PosHorizAbs:     flags&common.ESTIMATOR_POS_HORIZ_ABS != 0,
+		PosVertAbs:      flags&common.ESTIMATOR_POS_VERT_ABS != 0,
+		PosVertAgl:      flags&common.ESTIMATOR_POS_VERT_AGL != 0,
+		ConstPosMode:    flags&common.ESTIMATOR_CONST_POS_MODE != 0,
+		PredPosHorizRel: flags&common.ESTIMATOR_PRED_POS_HORIZ_REL != 0,
+		PredPosHorizAbs: flags&common.ESTIMATOR_PRED_POS_HORIZ_ABS != 0,
+		GpsGlitch:       flags&common.ESTIMATOR_GPS_GLITCH != 0,
+		AccelError:      flags&common.ESTIMATOR_ACCEL_ERROR != 0,
+	}
+}
+
 // FlightMainModeToProtobuf
 // Converts flight main mode (uint8, 1-based) to protobuf MainMode enum.
 // Direct mapping: 0→UNSPECIFIED, 1→MANUAL, 2→ALTCTL, etc.
@@ -78,10 +187,18 @@ func FlightMainModeToProtobuf(mode uint8) flightpath.MainMode {
 }
 
 // FlightSubModeToProtobuf
-// Converts flight sub-mode (uint8, 1-based) to protobuf SubMode enum.
-// Direct mapping: 0→UNSPECIFIED, 1→AUTO_READY, 2→AUTO_TAKEOFF, etc.
-func FlightSubModeToProtobuf(subMode uint8) flightpath.SubMode {
-	return flightpath.SubMode(subMode)
+// Converts PX4 flight sub-mode (uint8) to protobuf SubMode enum.
+// Sub-modes are numbered per main mode (AUTO: 1→AUTO_READY, 2→AUTO_TAKEOFF, etc.,
+// POSCTL: 0→POSCTL_POSCTL, 1→POSCTL_ORBIT, etc.), so the main mode is needed to decode them.
+// Unknown combinations map to UNSPECIFIED.
+func FlightSubModeToProtobuf(mainMode uint8, subMode uint8) flightpath.SubMode {
+	customMode := px4CustomMode(mainMode, subMode)
+	for _, mode := range px4Modes {
+		if mode.customMode == customMode {
+			return mode.subMode
+		}
+	}
+	return flightpath.SubMode_SUB_MODE_UNSPECIFIED
 }
 
 // GpsFixTypeToProtobuf
@@ -103,6 +220,105 @@ func MavAutopilotToProtobuf(autopilot common.MAV_AUTOPILOT) flightpath.MavAutopi
 	return flightpath.MavAutopilot(autopilot)
 }
 
+// MavBatteryChargeStateToProtobuf
+// Converts MAVLink MAV_BATTERY_CHARGE_STATE to protobuf MavBatteryChargeState enum.
+// Proto enum values are incremented by 1 to accommodate MAV_BATTERY_CHARGE_STATE_UNSPECIFIED at 0.
+// MAVLink 0 (UNDEFINED) maps to proto 1 (UNDEFINED), MAVLink 1 (OK) maps to proto 2 (OK), etc.
+func MavBatteryChargeStateToProtobuf(state common.MAV_BATTERY_CHARGE_STATE) flightpath.MavBatteryChargeState {
+	// Add 1 to MAVLink value to account for UNSPECIFIED at 0 in proto
+	return flightpath.MavBatteryChargeState(state + 1)
+}
+
+// MavBatteryFunctionToProtobuf
+// Converts MAVLink MAV_BATTERY_FUNCTION to protobuf MavBatteryFunction enum.
+// Proto enum values are incremented by 1 to accommodate MAV_BATTERY_FUNCTION_UNSPECIFIED at 0.
+// MAVLink 0 (UNKNOWN) maps to proto 1 (UNKNOWN), MAVLink 1 (ALL) maps to proto 2 (ALL), etc.
+func MavBatteryFunctionToProtobuf(function common.MAV_BATTERY_FUNCTION) flightpath.MavBatteryFunction {
+	// Add 1 to MAVLink value to account for UNSPECIFIED at 0 in proto
+	return flightpath.MavBatteryFunction(function + 1)
+}
+
+// MavBatteryModeToProtobuf
+// Converts MAVLink MAV_BATTERY_MODE to protobuf MavBatteryMode enum.
+// Proto enum values are incremented by 1 to accommodate MAV_BATTERY_MODE_UNSPECIFIED at 0.
+// MAVLink 0 (UNKNOWN) maps to proto 1 (UNKNOWN), MAVLink 1 (AUTO_DISCHARGING) maps to proto 2, etc.
+func MavBatteryModeToProtobuf(mode common.MAV_BATTERY_MODE) flightpath.MavBatteryMode {
+	// Add 1 to MAVLink value to account for UNSPECIFIED at 0 in proto
+	return flightpath.MavBatteryMode(mode + 1)
+}
+
+// MavBatteryTypeToProtobuf
+// Converts MAVLink MAV_BATTERY_TYPE to protobuf MavBatteryType enum.
+// Proto enum values are incremented by 1 to accommodate MAV_BATTERY_TYPE_UNSPECIFIED at 0.
+// MAVLink 0 (UNKNOWN) maps to proto 1 (UNKNOWN), MAVLink 1 (LIPO) maps to proto 2 (LIPO), etc.
+func MavBatteryTypeToProtobuf(batteryType common.MAV_BATTERY_TYPE) flightpath.MavBatteryType {
+	// Add 1 to MAVLink value to account for UNSPECIFIED at 0 in proto
+	return flightpath.MavBatteryType(batteryType + 1)
+}
+
+// MavDistanceSensorToProtobuf
+// Converts MAVLink MAV_DISTANCE_SENSOR to protobuf MavDistanceSensor enum.
+// Proto enum values are incremented by 1 to accommodate MAV_DISTANCE_SENSOR_UNSPECIFIED at 0.
+// MAVLink 0 (LASER) maps to proto 1 (LASER), MAVLink 1 (ULTRASOUND) maps to proto 2 (ULTRASOUND), etc.
+func MavDistanceSensorToProtobuf(sensorType common.MAV_DISTANCE_SENSOR) flightpath.MavDistanceSensor {
+	// Add 1 to MAVLink value to account for UNSPECIFIED at 0 in proto
+	return flightpath.MavDistanceSensor(sensorType + 1)
+}
+
+// MavEstimatorTypeToProtobuf
+// Converts MAVLink MAV_ESTIMATOR_TYPE to protobuf MavEstimatorType enum.
+// Proto enum values are incremented by 1 to accommodate MAV_ESTIMATOR_TYPE_UNSPECIFIED at 0.
+// MAVLink 0 (UNKNOWN) maps to proto 1 (UNKNOWN), MAVLink 1 (NAIVE) maps to proto 2 (NAIVE), etc.
+func MavEstimatorTypeToProtobuf(estimatorType common.MAV_ESTIMATOR_TYPE) flightpath.MavEstimatorType {
+	// Add 1 to MAVLink value to account for UNSPECIFIED at 0 in proto
+	return flightpath.MavEstimatorType(estimatorType + 1)
+}
+
+// MavFrameToProtobuf
+// Converts MAVLink MAV_FRAME to protobuf MavFrame enum.
+// Proto enum values are incremented by 1 to accommodate MAV_FRAME_UNSPECIFIED at 0.
+// MAVLink 0 (GLOBAL) maps to proto 1 (GLOBAL), MAVLink 1 (LOCAL_NED) maps to proto 2 (LOCAL_NED), etc.
+func MavFrameToProtobuf(frame common.MAV_FRAME) flightpath.MavFrame {
+	// Add 1 to MAVLink value to account for UNSPECIFIED at 0 in proto
+	return flightpath.MavFrame(frame + 1)
+}
+
+// MavLandedStateToProtobuf
+// Converts MAVLink MAV_LANDED_STATE to protobuf MavLandedState enum.
+// Proto enum values are incremented by 1 to accommodate MAV_LANDED_STATE_UNSPECIFIED at 0.
+// MAVLink 0 (UNDEFINED) maps to proto 1 (UNDEFINED), MAVLink 1 (ON_GROUND) maps to proto 2 (ON_GROUND), etc.
+func MavLandedStateToProtobuf(state common.MAV_LANDED_STATE) flightpath.MavLandedState {
+	// Add 1 to MAVLink value to account for UNSPECIFIED at 0 in proto
+	return flightpath.MavLandedState(state + 1)
+}
+
+// MavResultToProtobuf
+// Converts MAVLink MAV_RESULT to protobuf MavResult enum.
+// Proto enum values are incremented by 1 to accommodate MAV_RESULT_UNSPECIFIED at 0.
+// MAVLink 0 (ACCEPTED) maps to proto 1 (ACCEPTED), MAVLink 1 (TEMPORARILY_REJECTED) maps to proto 2, etc.
+func MavResultToProtobuf(result common.MAV_RESULT) flightpath.MavResult {
+	// Add 1 to MAVLink value to account for UNSPECIFIED at 0 in proto
+	return flightpath.MavResult(result + 1)
+}
+
+// MavSensorOrientationToProtobuf
+// Converts MAVLink MAV_SENSOR_ORIENTATION to protobuf MavSensorOrientation enum.
+// Proto enum values are incremented by 1 to accommodate MAV_SENSOR_ORIENTATION_UNSPECIFIED at 0.
+// MAVLink 0 (ROTATION_NONE) maps to proto 1 (ROTATION_NONE), MAVLink 100 (ROTATION_CUSTOM) maps to proto 101, etc.
+func MavSensorOrientationToProtobuf(orientation common.MAV_SENSOR_ORIENTATION) flightpath.MavSensorOrientation {
+	// Add 1 to MAVLink value to account for UNSPECIFIED at 0 in proto
+	return flightpath.MavSensorOrientation(orientation + 1)
+}
+
+// MavSeverityToProtobuf
+// Converts MAVLink MAV_SEVERITY to protobuf MavSeverity enum.
+// Proto enum values are incremented by 1 to accommodate MAV_SEVERITY_UNSPECIFIED at 0.
+// MAVLink 0 (EMERGENCY) maps to proto 1 (EMERGENCY), MAVLink 1 (ALERT) maps to proto 2 (ALERT), etc.
+func MavSeverityToProtobuf(severity common.MAV_SEVERITY) flightpath.MavSeverity {
+	// Add 1 to MAVLink value to account for UNSPECIFIED at 0 in proto
+	return flightpath.MavSeverity(severity + 1)
+}
+
 // MavStateToProtobuf
 // Converts MAVLink MAV_STATE to protobuf MavState enum.
 // Note: MAV_STATE_UNINIT (0) maps to MAV_STATE_UNSPECIFIED (0) in protobuf
@@ -122,6 +338,128 @@ func MavTypeToProtobuf(mavType common.MAV_TYPE) flightpath.MavType {
 	return flightpath.MavType(mavType)
 }
 
+// MavVtolStateToProtobuf
+// Converts MAVLink MAV_VTOL_STATE to protobuf MavVtolState enum.
+// Proto enum values are incremented by 1 to accommodate MAV_VTOL_STATE_UNSPECIFIED at 0.
+// MAVLink 0 (UNDEFINED) maps to proto 1 (UNDEFINED), MAVLink 1 (TRANSITION_TO_FW) maps to proto 2, etc.
+func MavVtolStateToProtobuf(state common.MAV_VTOL_STATE) flightpath.MavVtolState {
+	// Add 1 to MAVLink value to account for UNSPECIFIED at 0 in proto
+	return flightpath.MavVtolState(state + 1)
+}
+
+// RadiansToDegrees
+// Converts an angle from radians (as sent in MAVLink attitude messages) to degrees.
+func RadiansToDegrees(angle float32) float32 {
+	return float32(float64(angle) * 180 / math.Pi)
+}
+
+// RtkBaselineCoordinateSystemToProtobuf
+// Converts MAVLink RTK_BASELINE_COORDINATE_SYSTEM to protobuf RtkBaselineCoordinateSystem enum.
+// Proto enum values are incremented by 1 to accommodate RTK_BASELINE_COORDINATE_SYSTEM_UNSPECIFIED at 0.
+// MAVLink 0 (ECEF) maps to proto 1 (ECEF), MAVLink 1 (NED) maps to proto 2 (NED).
+func RtkBaselineCoordinateSystemToProtobuf(system common.RTK_BASELINE_COORDINATE_SYSTEM) flightpath.RtkBaselineCoordinateSystem {
+	// Add 1 to MAVLink value to account for UNSPECIFIED at 0 in proto
+	return flightpath.RtkBaselineCoordinateSystem(system + 1)
+}
+
+// SysStatusSensorsToProtobuf
+// Converts the MAVLink MAV_SYS_STATUS_SENSOR and MAV_SYS_STATUS_SENSOR_EXTENDED bitfields of
+// SYS_STATUS (present, enabled and health) to protobuf SysStatusSensors structured message.
+// MAVLink MAV_SYS_STATUS_SENSOR bit positions (from MAVLink spec):
+// Bit 0 (1):           MAV_SYS_STATUS_SENSOR_3D_GYRO
+// Bit 1 (2):           MAV_SYS_STATUS_SENSOR_3D_ACCEL
+// Bit 2 (4):           MAV_SYS_STATUS_SENSOR_3D_MAG
+// Bit 3 (8):           MAV_SYS_STATUS_SENSOR_ABSOLUTE_PRESSURE
+// Bit 4 (16):          MAV_SYS_STATUS_SENSOR_DIFFERENTIAL_PRESSURE
+// Bit 5 (32):          MAV_SYS_STATUS_SENSOR_GPS
+// Bit 6 (64):          MAV_SYS_STATUS_SENSOR_OPTICAL_FLOW
+// Bit 7 (128):         MAV_SYS_STATUS_SENSOR_VISION_POSITION
+// Bit 8 (256):         MAV_SYS_STATUS_SENSOR_LASER_POSITION
+// Bit 9 (512):         MAV_SYS_STATUS_SENSOR_EXTERNAL_GROUND_TRUTH
+// Bit 10 (1024):       MAV_SYS_STATUS_SENSOR_ANGULAR_RATE_CONTROL
+// Bit 11 (2048):       MAV_SYS_STATUS_SENSOR_ATTITUDE_STABILIZATION
+// Bit 12 (4096):       MAV_SYS_STATUS_SENSOR_YAW_POSITION
+// Bit 13 (8192):       MAV_SYS_STATUS_SENSOR_Z_ALTITUDE_CONTROL
+// Bit 14 (16384):      MAV_SYS_STATUS_SENSOR_XY_POSITION_CONTROL
+// Bit 15 (32768):      MAV_SYS_STATUS_SENSOR_MOTOR_OUTPUTS
+// Bit 16 (65536):      MAV_SYS_STATUS_SENSOR_RC_RECEIVER
+// Bit 17 (131072):     MAV_SYS_STATUS_SENSOR_3D_GYRO2
+// Bit 18 (262144):     MAV_SYS_STATUS_SENSOR_3D_ACCEL2
+// Bit 19 (524288):     MAV_SYS_STATUS_SENSOR_3D_MAG2
+// Bit 20 (1048576):    MAV_SYS_STATUS_GEOFENCE
+// Bit 21 (2097152):    MAV_SYS_STATUS_AHRS
+// Bit 22 (4194304):    MAV_SYS_STATUS_TERRAIN
+// Bit 23 (8388608):    MAV_SYS_STATUS_REVERSE_MOTOR
+// Bit 24 (16777216):   MAV_SYS_STATUS_LOGGING
+// Bit 25 (33554432):   MAV_SYS_STATUS_SENSOR_BATTERY
+// Bit 26 (67108864):   MAV_SYS_STATUS_SENSOR_PROXIMITY
+// Bit 27 (134217728):  MAV_SYS_STATUS_SENSOR_SATCOM
+// Bit 28 (268435456):  MAV_SYS_STATUS_PREARM_CHECK
+// Bit 29 (536870912):  MAV_SYS_STATUS_OBSTACLE_AVOIDANCE
+// Bit 30 (1073741824): MAV_SYS_STATUS_SENSOR_PROPULSION
+// Bit 31 (2147483648): MAV_SYS_STATUS_EXTENSION_USED (the extended bitfields are used)
+// MAVLink MAV_SYS_STATUS_SENSOR_EXTENDED bit positions (from MAVLink spec):
+// Bit 0 (1):           MAV_SYS_STATUS_RECOVERY_SYSTEM
+func SysStatusSensorsToProtobuf(
+	present, enabled, health common.MAV_SYS_STATUS_SENSOR,
+	presentExtended, enabledExtended, healthExtended common.MAV_SYS_STATUS_SENSOR_EXTENDED,
+) *flightpath.SysStatusSensors {
+	sensor := func(bit common.MAV_SYS_STATUS_SENSOR) *flightpath.SensorStatus {
+		return &flightpath.SensorStatus{
+			Present: (present & bit) != 0,
+			Enabled: (enabled & bit) != 0,
+			Healthy: (health & bit) != 0,
+		}
+	}
+
+	// The extended bitfields are only valid if EXTENSION_USED is set in the present bitfield
+	extendedSensor := func(bit common.MAV_SYS_STATUS_SENSOR_EXTENDED) *flightpath.SensorStatus {
+		if (present & common.MAV_SYS_STATUS_EXTENSION_USED) == 0 {
+			return &flightpath.SensorStatus{}
+		}
+		return &flightpath.SensorStatus{
+			Present: (presentExtended & bit) != 0,
+			Enabled: (enabledExtended & bit) != 0,
+			Healthy: (healthExtended & bit) != 0,
+		}
+	}
+
+	return &flightpath.SysStatusSensors{
+		Gyro:                  sensor(common.MAV_SYS_STATUS_SENSOR_3D_GYRO),
+		Accel:                 sensor(common.MAV_SYS_STATUS_SENSOR_3D_ACCEL),
+		Mag:                   sensor(common.MAV_SYS_STATUS_SENSOR_3D_MAG),
+		AbsolutePressure:      sensor(common.MAV_SYS_STATUS_SENSOR_ABSOLUTE_PRESSURE),
+		DifferentialPressure:  sensor(common.MAV_SYS_STATUS_SENSOR_DIFFERENTIAL_PRESSURE),
+		Gps:                   sensor(common.MAV_SYS_STATUS_SENSOR_GPS),
+		OpticalFlow:           sensor(common.MAV_SYS_STATUS_SENSOR_OPTICAL_FLOW),
+		VisionPosition:        sensor(common.MAV_SYS_STATUS_SENSOR_VISION_POSITION),
+		LaserPosition:         sensor(common.MAV_SYS_STATUS_SENSOR_LASER_POSITION),
+		ExternalGroundTruth:   sensor(common.MAV_SYS_STATUS_SENSOR_EXTERNAL_GROUND_TRUTH),
+		AngularRateControl:    sensor(common.MAV_SYS_STATUS_SENSOR_ANGULAR_RATE_CONTROL),
+		AttitudeStabilization: sensor(common.MAV_SYS_STATUS_SENSOR_ATTITUDE_STABILIZATION),
+		YawPosition:           sensor(common.MAV_SYS_STATUS_SENSOR_YAW_POSITION),
+		ZAltitudeControl:      sensor(common.MAV_SYS_STATUS_SENSOR_Z_ALTITUDE_CONTROL),
+		XyPositionControl:     sensor(common.MAV_SYS_STATUS_SENSOR_XY_POSITION_CONTROL),
+		MotorOutputs:          sensor(common.MAV_SYS_STATUS_SENSOR_MOTOR_OUTPUTS),
+		RcReceiver:            sensor(common.MAV_SYS_STATUS_SENSOR_RC_RECEIVER),
+		Gyro2:                 sensor(common.MAV_SYS_STATUS_SENSOR_3D_GYRO2),
+		Accel2:                sensor(common.MAV_SYS_STATUS_SENSOR_3D_ACCEL2),
+		Mag2:                  sensor(common.MAV_SYS_STATUS_SENSOR_3D_MAG2),
+		Geofence:              sensor(common.MAV_SYS_STATUS_GEOFENCE),
+		Ahrs:                  sensor(common.MAV_SYS_STATUS_AHRS),
+		Terrain:               sensor(common.MAV_SYS_STATUS_TERRAIN),
+		ReverseMotor:          sensor(common.MAV_SYS_STATUS_REVERSE_MOTOR),
+		Logging:               sensor(common.MAV_SYS_STATUS_LOGGING),
+		Battery:               sensor(common.MAV_SYS_STATUS_SENSOR_BATTERY),
+		Proximity:             sensor(common.MAV_SYS_STATUS_SENSOR_PROXIMITY),
+		Satcom:                sensor(common.MAV_SYS_STATUS_SENSOR_SATCOM),
+		PrearmCheck:           sensor(common.MAV_SYS_STATUS_PREARM_CHECK),
+		ObstacleAvoidance:     sensor(common.MAV_SYS_STATUS_OBSTACLE_AVOIDANCE),
+		Propulsion:            sensor(common.MAV_SYS_STATUS_SENSOR_PROPULSION),
+		RecoverySystem:        extendedSensor(common.MAV_SYS_STATUS_RECOVERY_SYSTEM),
+	}
+}
+
 // DecodePX4CustomMode
 // Decodes PX4 CustomMode uint32 into human-readable format.
 // Based on: https://github.com/PX4/PX4-Autopilot/blob/main/src/modules/commander/px4_custom_mode.h
@@ -142,7 +480,7 @@ func DecodePX4CustomMode(customMode uint32) map[string]interface{} {
 
 	// Convert to protobuf enums
 	mainMode := FlightMainModeToProtobuf(px4MainMode)
-	subMode := FlightSubModeToProtobuf(px4SubMode)
+	subMode := FlightSubModeToProtobuf(px4MainMode, px4SubMode)
 
 	result := map[string]interface{}{
 		"raw":           fmt.Sprintf("0x%08X", customMode),
@@ -0,0 +1,20 @@
+package message_converters
+
+import (
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// VibrationToProtobuf
+// Converts a MAVLink VIBRATION message to a protobuf Vibration message.
+func VibrationToProtobuf(msg *common.MessageVibration) *flightpath.Vibration {
+	return &flightpath.Vibration{
+		TimeUsec:   msg.TimeUsec,
+		VibrationX: msg.VibrationX,
+		VibrationY: msg.VibrationY,
+		VibrationZ: msg.VibrationZ,
+		Clipping0:  msg.Clipping_0,
+		Clipping1:  msg.Clipping_1,
+		Clipping2:  msg.Clipping_2,
+	}
+}
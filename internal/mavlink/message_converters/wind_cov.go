@@ -0,0 +1,46 @@
+package message_converters
+
+import (
+	"math"
+
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// WindCovToProtobuf
+// Converts a MAVLink WIND_COV message to a protobuf WindCov message.
+func WindCovToProtobuf(msg *common.MessageWindCov) *flightpath.WindCov {
+	return &flightpath.WindCov{
+		TimeUsec:      msg.TimeUsec,
+		WindX:         msg.WindX,
+		WindY:         msg.WindY,
+		WindZ:         msg.WindZ,
+		VarHoriz:      msg.VarHoriz,
+		VarVert:       msg.VarVert,
+		WindAlt:       msg.WindAlt,
+		HorizAccuracy: msg.HorizAccuracy,
+		VertAccuracy:  msg.VertAccuracy,
+	}
+}
+
+// WindCovToWind
+// Converts a protobuf WindCov message to a protobuf Wind message (speed and the direction the
+// wind is blowing from). Returns nil if the horizontal wind is unknown (NaN).
+func WindCovToWind(msg *flightpath.WindCov) *flightpath.Wind {
+	north, east := float64(msg.WindX), float64(msg.WindY)
+	if math.IsNaN(north) || math.IsNaN(east) {
+		return nil
+	}
+
+	// wind_x/wind_y give the direction the air moves to, the opposite of where it comes from
+	direction := math.Mod(math.Atan2(-east, -north)*180/math.Pi+360, 360)
+	wind := &flightpath.Wind{
+		Speed:     float32(math.Hypot(north, east)),
+		Direction: float32(direction),
+	}
+	if !math.IsNaN(float64(msg.WindZ)) {
+		speedDown := msg.WindZ
+		wind.SpeedDown = &speedDown
+	}
+	return wind
+}
@@ -0,0 +1,676 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath/flightpathconnect"
+	"github.com/flightpath-dev/flightpath/internal/mavlink/message_converters"
+)
+
+const (
+	// Magic value for param2 of MAV_CMD_COMPONENT_ARM_DISARM that forces arming/disarming
+	armDisarmForceMagic = 21196
+
+	// How long to wait for a HEARTBEAT reporting the requested flight mode
+	flightModeConfirmTimeout = 3 * time.Second
+
+	// Default distance to the target (m) below which GotoLocation considers the drone arrived
+	gotoDefaultAcceptanceRadius = 2
+
+	// Minimum speed towards the target (m/s) for GotoLocation to estimate a time of arrival
+	gotoMinClosingSpeed = 0.1
+)
+
+// ActionService implements the ActionService gRPC service
+type ActionService struct {
+	flightpathconnect.UnimplementedActionServiceHandler
+	ctx *ServiceContext
+}
+
+// NewActionService creates a new ActionService instance
+func NewActionService(ctx *ServiceContext) *ActionService {
+	return &ActionService{
+		ctx: ctx,
+	}
+}
+
+// Arm
+// Arms the drone using MAV_CMD_COMPONENT_ARM_DISARM.
+// Returns once the drone has acknowledged the command.
+func (s *ActionService) Arm(
+	ctx context.Context,
+	req *connect.Request[flightpath.ArmRequest],
+) (*connect.Response[flightpath.ArmResponse], error) {
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.ctx.Commands.Execute(ctx, &Command{
+		Target:  target,
+		Command: common.MAV_CMD_COMPONENT_ARM_DISARM,
+		Params:  [7]float32{1},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&flightpath.ArmResponse{}), nil
+}
+
+// Disarm
+// Disarms the drone using MAV_CMD_COMPONENT_ARM_DISARM, optionally forcing it.
+// Returns once the drone has acknowledged the command.
+func (s *ActionService) Disarm(
+	ctx context.Context,
+	req *connect.Request[flightpath.DisarmRequest],
+) (*connect.Response[flightpath.DisarmResponse], error) {
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := &Command{
+		Target:  target,
+		Command: common.MAV_CMD_COMPONENT_ARM_DISARM,
+		Params:  [7]float32{0},
+	}
+	if req.Msg.Force {
+		cmd.Params[1] = armDisarmForceMagic
+	}
+	if err := s.ctx.Commands.Execute(ctx, cmd); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&flightpath.DisarmResponse{}), nil
+}
+
+// Takeoff
+// Takes off using MAV_CMD_NAV_TAKEOFF and streams flight phase updates until the drone hovers.
+// The altitude is sent in the frame the autopilot expects: PX4 reads param7 as an AMSL altitude,
+// so the requested altitude above home is converted using the drone's current GLOBAL_POSITION_INT,
+// while ArduPilot reads it as an altitude above home. Only PX4 has a default takeoff altitude
+// (used when no altitude is requested), other autopilots require one.
+func (s *ActionService) Takeoff(
+	ctx context.Context,
+	req *connect.Request[flightpath.TakeoffRequest],
+	stream *connect.ServerStream[flightpath.TakeoffResponse],
+) error {
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		return err
+	}
+	if req.Msg.Altitude < 0 || math.IsNaN(float64(req.Msg.Altitude)) {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid takeoff altitude %v", req.Msg.Altitude))
+	}
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("message dispatcher is not available"))
+	}
+
+	heartbeat, ok := s.ctx.Dispatcher.LatestHeartbeat(target.SystemID, target.ComponentID)
+	if !ok {
+		return connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf("no heartbeat received from system %d, cannot determine its autopilot", target.SystemID),
+		)
+	}
+	autopilot := heartbeat.Heartbeat.GetAutopilot()
+
+	hasAltitude := req.Msg.Altitude > 0
+	if !hasAltitude && autopilot != flightpath.MavAutopilot_MAV_AUTOPILOT_PX4 {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("takeoff altitude is required for autopilot %s", autopilot),
+		)
+	}
+
+	// Pitch, empty, empty, yaw, latitude, longitude, altitude (NaN = autopilot default)
+	nan := float32(math.NaN())
+	cmd := &Command{
+		Target:  target,
+		Command: common.MAV_CMD_NAV_TAKEOFF,
+		Params:  [7]float32{0, 0, 0, nan, nan, nan, nan},
+	}
+
+	switch {
+	case !hasAltitude:
+		// PX4 default takeoff altitude (MIS_TAKEOFF_ALT)
+	case autopilot == flightpath.MavAutopilot_MAV_AUTOPILOT_ARDUPILOTMEGA:
+		cmd.Params[6] = req.Msg.Altitude
+	default:
+		position, err := s.waitForGlobalPosition(ctx, target)
+		if err != nil {
+			return err
+		}
+		homeAltitude := float32(position.Alt-position.RelativeAlt) / 1000
+		cmd.Params[6] = homeAltitude + req.Msg.Altitude
+	}
+
+	return s.runManeuver(ctx, cmd, takeoffPhases(req.Msg.Altitude, hasAltitude),
+		func(phase flightpath.FlightPhase, st *maneuverState) error {
+			return stream.Send(&flightpath.TakeoffResponse{
+				TimestampMs:      time.Now().UnixMilli(),
+				Phase:            phase,
+				RelativeAltitude: st.RelativeAltitude,
+			})
+		},
+	)
+}
+
+// Land
+// Lands at the current position using MAV_CMD_NAV_LAND and streams flight phase updates until
+// the drone has landed and disarmed.
+func (s *ActionService) Land(
+	ctx context.Context,
+	req *connect.Request[flightpath.LandRequest],
+	stream *connect.ServerStream[flightpath.LandResponse],
+) error {
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		return err
+	}
+
+	// Abort altitude, land mode, empty, yaw, latitude, longitude, altitude (NaN = current)
+	nan := float32(math.NaN())
+	cmd := &Command{
+		Target:  target,
+		Command: common.MAV_CMD_NAV_LAND,
+		Params:  [7]float32{0, 0, 0, nan, nan, nan, nan},
+	}
+
+	return s.runManeuver(ctx, cmd, landPhases(),
+		func(phase flightpath.FlightPhase, st *maneuverState) error {
+			return stream.Send(&flightpath.LandResponse{
+				TimestampMs:      time.Now().UnixMilli(),
+				Phase:            phase,
+				RelativeAltitude: st.RelativeAltitude,
+			})
+		},
+	)
+}
+
+// ReturnToLaunch
+// Returns to the launch position using MAV_CMD_NAV_RETURN_TO_LAUNCH and streams flight phase
+// updates until the drone has landed and disarmed (or hovers above home).
+func (s *ActionService) ReturnToLaunch(
+	ctx context.Context,
+	req *connect.Request[flightpath.ReturnToLaunchRequest],
+	stream *connect.ServerStream[flightpath.ReturnToLaunchResponse],
+) error {
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		return err
+	}
+
+	cmd := &Command{
+		Target:  target,
+		Command: common.MAV_CMD_NAV_RETURN_TO_LAUNCH,
+	}
+
+	return s.runManeuver(ctx, cmd, returnToLaunchPhases(),
+		func(phase flightpath.FlightPhase, st *maneuverState) error {
+			return stream.Send(&flightpath.ReturnToLaunchResponse{
+				TimestampMs:      time.Now().UnixMilli(),
+				Phase:            phase,
+				RelativeAltitude: st.RelativeAltitude,
+			})
+		},
+	)
+}
+
+// SetFlightMode
+// Changes the flight mode using MAV_CMD_DO_SET_MODE.
+// The requested MainMode/SubMode is encoded into the custom_mode of the drone's autopilot
+// (known from its HEARTBEAT). The ACK alone is not trusted: returns once a HEARTBEAT reports
+// the requested mode.
+func (s *ActionService) SetFlightMode(
+	ctx context.Context,
+	req *connect.Request[flightpath.SetFlightModeRequest],
+) (*connect.Response[flightpath.SetFlightModeResponse], error) {
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.MainMode == flightpath.MainMode_MAIN_MODE_UNSPECIFIED {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("main_mode is required"))
+	}
+	if s.ctx.Dispatcher == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("message dispatcher is not available"))
+	}
+
+	// Subscribe before sending the command so that the confirming heartbeat is not missed
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	heartbeatChan := s.ctx.Dispatcher.SubscribeHeartbeat(ctx)
+
+	// The autopilot and vehicle type determine how the mode is encoded
+	current, ok := s.ctx.Dispatcher.LatestHeartbeat(target.SystemID, target.ComponentID)
+	if !ok {
+		current, err = waitForHeartbeat(ctx, heartbeatChan, target, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	autopilot := common.MAV_AUTOPILOT(current.Heartbeat.Autopilot)
+	mavType := common.MAV_TYPE(current.Heartbeat.Type)
+
+	customMode, err := message_converters.ProtobufToCustomMode(req.Msg.MainMode, req.Msg.SubMode, autopilot, mavType)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	expected := message_converters.CustomModeToProtobuf(customMode, autopilot, mavType)
+
+	// Base mode, custom mode, custom sub mode
+	// The armed flag is preserved, as some autopilots apply the whole base mode.
+	baseMode := common.MAV_MODE_FLAG_CUSTOM_MODE_ENABLED
+	if current.Heartbeat.GetBaseMode().GetSafetyArmed() {
+		baseMode |= common.MAV_MODE_FLAG_SAFETY_ARMED
+	}
+	cmd := &Command{
+		Target:  target,
+		Command: common.MAV_CMD_DO_SET_MODE,
+		Params:  [7]float32{float32(baseMode), float32(customMode)},
+	}
+	if autopilot == common.MAV_AUTOPILOT_PX4 {
+		// PX4 expects the main and sub modes separately rather than the packed custom_mode
+		cmd.Params[1] = float32((customMode >> 16) & 0xFF)
+		cmd.Params[2] = float32((customMode >> 24) & 0xFF)
+	}
+	if err := s.ctx.Commands.Execute(ctx, cmd); err != nil {
+		return nil, err
+	}
+
+	confirmed, err := waitForHeartbeat(ctx, heartbeatChan, target, func(heartbeat *flightpath.Heartbeat) bool {
+		mode := heartbeat.GetCustomMode()
+		return mode.GetMainMode() == expected.MainMode && mode.GetSubMode() == expected.SubMode
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&flightpath.SetFlightModeResponse{
+		CustomMode: confirmed.Heartbeat.CustomMode,
+	}), nil
+}
+
+// waitForHeartbeat
+// Waits up to flightModeConfirmTimeout for a heartbeat from the target that satisfies match
+// (any heartbeat if match is nil).
+func waitForHeartbeat(
+	ctx context.Context,
+	heartbeatChan <-chan HeartbeatEvent,
+	target CommandTarget,
+	match func(heartbeat *flightpath.Heartbeat) bool,
+) (HeartbeatEvent, error) {
+	timer := time.NewTimer(flightModeConfirmTimeout)
+	defer timer.Stop()
+
+	var last *flightpath.Heartbeat
+	for {
+		select {
+		case <-ctx.Done():
+			return HeartbeatEvent{}, ctx.Err()
+
+		case <-timer.C:
+			if last == nil {
+				return HeartbeatEvent{}, connect.NewError(
+					connect.CodeUnavailable,
+					fmt.Errorf("no heartbeat received from system %d", target.SystemID),
+				)
+			}
+			mode := last.GetCustomMode()
+			return HeartbeatEvent{}, connect.NewError(
+				connect.CodeDeadlineExceeded,
+				fmt.Errorf("flight mode change not confirmed: drone reports %s/%s", mode.GetMainMode(), mode.GetSubMode()),
+			)
+
+		case event, ok := <-heartbeatChan:
+			if !ok {
+				return HeartbeatEvent{}, connect.NewError(connect.CodeUnavailable, errors.New("message dispatcher stopped"))
+			}
+			if event.SystemID != target.SystemID || event.ComponentID != target.ComponentID {
+				continue
+			}
+			last = event.Heartbeat
+			if match == nil || match(event.Heartbeat) {
+				return event, nil
+			}
+		}
+	}
+}
+
+// GotoLocation
+// Flies to a WGS84 location using MAV_CMD_DO_REPOSITION (sent as COMMAND_INT for full
+// latitude/longitude precision) and streams the distance and ETA to the target, computed from
+// GLOBAL_POSITION_INT, until the drone is within the acceptance radius.
+// Fails if the drone does not switch to guided/hold mode within flightModeConfirmTimeout of
+// accepting the command, or leaves it before arriving.
+func (s *ActionService) GotoLocation(
+	ctx context.Context,
+	req *connect.Request[flightpath.GotoLocationRequest],
+	stream *connect.ServerStream[flightpath.GotoLocationResponse],
+) error {
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		return err
+	}
+
+	msg := req.Msg
+	if !(msg.Latitude >= -90 && msg.Latitude <= 90) || !(msg.Longitude >= -180 && msg.Longitude <= 180) {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid location %v, %v", msg.Latitude, msg.Longitude))
+	}
+	if math.IsNaN(float64(msg.Altitude)) || math.IsInf(float64(msg.Altitude), 0) {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid altitude %v", msg.Altitude))
+	}
+	if !(msg.GroundSpeed >= 0) {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ground speed %v", msg.GroundSpeed))
+	}
+	if !(msg.AcceptanceRadius >= 0) {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid acceptance radius %v", msg.AcceptanceRadius))
+	}
+	if msg.Yaw != nil && (math.IsNaN(float64(*msg.Yaw)) || math.IsInf(float64(*msg.Yaw), 0)) {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid yaw %v", *msg.Yaw))
+	}
+
+	acceptanceRadius := float64(msg.AcceptanceRadius)
+	if acceptanceRadius == 0 {
+		acceptanceRadius = gotoDefaultAcceptanceRadius
+	}
+
+	// MAV_CMD_DO_REPOSITION expects an AMSL altitude
+	position, err := s.waitForGlobalPosition(ctx, target)
+	if err != nil {
+		return err
+	}
+	homeAltitude := float32(position.Alt-position.RelativeAlt) / 1000
+
+	// Ground speed (-1 = default), flags, loiter radius, yaw (NaN = unchanged), lat, lon, alt
+	nan := float32(math.NaN())
+	cmd := &Command{
+		Target:        target,
+		Command:       common.MAV_CMD_DO_REPOSITION,
+		Params:        [7]float32{-1, float32(common.MAV_DO_REPOSITION_FLAGS_CHANGE_MODE), nan, nan, 0, 0, homeAltitude + msg.Altitude},
+		UseCommandInt: true,
+		Frame:         common.MAV_FRAME_GLOBAL,
+		X:             int32(math.Round(msg.Latitude * 1e7)),
+		Y:             int32(math.Round(msg.Longitude * 1e7)),
+	}
+	if msg.GroundSpeed > 0 {
+		cmd.Params[0] = msg.GroundSpeed
+	}
+	if msg.Yaw != nil {
+		cmd.Params[3] = *msg.Yaw
+	}
+
+	// Subscribe before sending the command so that no mode change is missed
+	heartbeatChan := s.ctx.Dispatcher.SubscribeHeartbeat(ctx)
+	globalPositionIntChan := s.ctx.Dispatcher.SubscribeGlobalPositionInt(ctx)
+
+	if err := s.ctx.Commands.Execute(ctx, cmd); err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(maneuverTelemetryTimeout)
+	defer timer.Stop()
+
+	// The mode is only checked once the drone has been seen in guided/hold mode, as heartbeats
+	// sent before the command was processed still report the previous mode
+	entered := false
+	var lastMode *flightpath.CustomMode
+	modeTimer := time.NewTimer(flightModeConfirmTimeout)
+	defer modeTimer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-timer.C:
+			return connect.NewError(
+				connect.CodeUnavailable,
+				fmt.Errorf("no telemetry received from system %d for %s", target.SystemID, maneuverTelemetryTimeout),
+			)
+
+		case <-modeTimer.C:
+			// Only enforced when the flight mode can be decoded
+			if !entered && lastMode != nil {
+				return connect.NewError(
+					connect.CodeFailedPrecondition,
+					fmt.Errorf(
+						"goto not started: drone did not switch to guided/hold mode within %s (flight mode %s/%s)",
+						flightModeConfirmTimeout, lastMode.GetMainMode(), lastMode.GetSubMode(),
+					),
+				)
+			}
+
+		case event, ok := <-heartbeatChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return connect.NewError(connect.CodeUnavailable, errors.New("message dispatcher stopped"))
+			}
+			if event.SystemID != target.SystemID || event.ComponentID != target.ComponentID {
+				continue
+			}
+			timer.Reset(maneuverTelemetryTimeout)
+
+			mode := event.Heartbeat.GetCustomMode()
+			if mode.GetMainMode() == flightpath.MainMode_MAIN_MODE_UNSPECIFIED {
+				// Flight mode not decoded for this autopilot, cannot be checked
+				continue
+			}
+			lastMode = mode
+			if isGuidedOrHoldMode(mode) {
+				entered = true
+			} else if entered {
+				return connect.NewError(
+					connect.CodeAborted,
+					fmt.Errorf("goto interrupted: flight mode changed to %s/%s", mode.GetMainMode(), mode.GetSubMode()),
+				)
+			}
+
+		case event, ok := <-globalPositionIntChan:
+			if !ok {
+				return connect.NewError(connect.CodeUnavailable, errors.New("message dispatcher stopped"))
+			}
+			if event.SystemID != target.SystemID || event.ComponentID != target.ComponentID {
+				continue
+			}
+			timer.Reset(maneuverTelemetryTimeout)
+
+			progress := gotoProgress(event.GlobalPositionInt, msg.Latitude, msg.Longitude, msg.Altitude)
+			progress.Arrived = float64(progress.Distance) <= acceptanceRadius
+			if err := stream.Send(progress); err != nil {
+				return err
+			}
+			if progress.Arrived {
+				return nil
+			}
+		}
+	}
+}
+
+// gotoProgress
+// Computes the distance, closing speed and ETA from the drone's position to a GotoLocation target.
+func gotoProgress(
+	position *flightpath.GlobalPositionInt,
+	latitude, longitude float64,
+	altitude float32,
+) *flightpath.GotoLocationResponse {
+	north, east := horizontalOffset(
+		float64(position.Lat)/1e7, float64(position.Lon)/1e7,
+		latitude, longitude,
+	)
+	up := float64(altitude) - float64(position.RelativeAlt)/1000
+	distance := math.Sqrt(north*north + east*east + up*up)
+
+	// Project the velocity (NED, cm/s) onto the direction of the target
+	closingSpeed := 0.0
+	if distance > 0 {
+		closingSpeed = (float64(position.Vx)*north + float64(position.Vy)*east - float64(position.Vz)*up) / 100 / distance
+	}
+
+	eta := -1.0
+	if closingSpeed >= gotoMinClosingSpeed {
+		eta = distance / closingSpeed
+	}
+
+	return &flightpath.GotoLocationResponse{
+		TimestampMs:        time.Now().UnixMilli(),
+		Distance:           float32(distance),
+		HorizontalDistance: float32(math.Hypot(north, east)),
+		VerticalDistance:   float32(up),
+		ClosingSpeed:       float32(closingSpeed),
+		Eta:                float32(eta),
+	}
+}
+
+// isGuidedOrHoldMode
+// Reports whether the drone is in a mode in which it flies to a repositioned target:
+// Hold (AUTO_LOITER) on PX4, or Guided (OFFBOARD) on ArduPilot.
+func isGuidedOrHoldMode(mode *flightpath.CustomMode) bool {
+	return mode.GetMainMode() == flightpath.MainMode_MAIN_MODE_OFFBOARD ||
+		(mode.GetMainMode() == flightpath.MainMode_MAIN_MODE_AUTO && mode.GetSubMode() == flightpath.SubMode_SUB_MODE_AUTO_LOITER)
+}
+
+// DoOrbit
+// Starts orbiting around a WGS84 location using MAV_CMD_DO_ORBIT (sent as COMMAND_INT for full
+// latitude/longitude precision). Returns once the drone has acknowledged the command.
+func (s *ActionService) DoOrbit(
+	ctx context.Context,
+	req *connect.Request[flightpath.DoOrbitRequest],
+) (*connect.Response[flightpath.DoOrbitResponse], error) {
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+	if !(msg.Latitude >= -90 && msg.Latitude <= 90) || !(msg.Longitude >= -180 && msg.Longitude <= 180) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid orbit center %v, %v", msg.Latitude, msg.Longitude))
+	}
+	if !(msg.Radius > 0) || math.IsInf(float64(msg.Radius), 0) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid orbit radius %v", msg.Radius))
+	}
+	if !(msg.Velocity >= 0) || math.IsInf(float64(msg.Velocity), 0) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid orbit velocity %v", msg.Velocity))
+	}
+	if !(msg.Turns >= 0) || math.IsInf(float64(msg.Turns), 0) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid number of turns %v", msg.Turns))
+	}
+	if msg.Altitude != nil && (math.IsNaN(float64(*msg.Altitude)) || math.IsInf(float64(*msg.Altitude), 0)) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid altitude %v", *msg.Altitude))
+	}
+	if _, ok := flightpath.OrbitYawBehaviour_name[int32(msg.YawBehaviour)]; !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid yaw behaviour %v", msg.YawBehaviour))
+	}
+
+	// Radius (negative = counter-clockwise), velocity (NaN = default), yaw behaviour,
+	// orbits in radians (0 = forever), lat, lon, alt (NaN = current)
+	nan := float32(math.NaN())
+	cmd := &Command{
+		Target:        target,
+		Command:       common.MAV_CMD_DO_ORBIT,
+		Params:        [7]float32{msg.Radius, nan, float32(common.ORBIT_YAW_BEHAVIOUR_UNCHANGED), 0, 0, 0, nan},
+		UseCommandInt: true,
+		Frame:         common.MAV_FRAME_GLOBAL,
+		X:             int32(math.Round(msg.Latitude * 1e7)),
+		Y:             int32(math.Round(msg.Longitude * 1e7)),
+	}
+	if msg.CounterClockwise {
+		cmd.Params[0] = -msg.Radius
+	}
+	if msg.Velocity > 0 {
+		cmd.Params[1] = msg.Velocity
+	}
+	if msg.YawBehaviour != flightpath.OrbitYawBehaviour_ORBIT_YAW_BEHAVIOUR_UNSPECIFIED {
+		// Proto enum values are incremented by 1 to accommodate UNSPECIFIED at 0
+		cmd.Params[2] = float32(msg.YawBehaviour - 1)
+	}
+	if msg.Turns > 0 {
+		cmd.Params[3] = msg.Turns * 2 * math.Pi
+	}
+	if msg.Altitude != nil {
+		// MAV_CMD_DO_ORBIT expects an AMSL altitude
+		position, err := s.waitForGlobalPosition(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+		homeAltitude := float32(position.Alt-position.RelativeAlt) / 1000
+		cmd.Params[6] = homeAltitude + *msg.Altitude
+	}
+
+	if err := s.ctx.Commands.Execute(ctx, cmd); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&flightpath.DoOrbitResponse{}), nil
+}
+
+// knownCommands
+// MAV_CMD values defined by the common dialect.
+// gomavlib does not export its MAV_CMD label table, so it is rebuilt once from the labels the
+// dialect prints (values without a label are printed as their number).
+var knownCommands = func() map[common.MAV_CMD]struct{} {
+	commands := make(map[common.MAV_CMD]struct{})
+	for value := range math.MaxUint16 + 1 {
+		command := common.MAV_CMD(value)
+		if strings.HasPrefix(command.String(), "MAV_CMD_") {
+			commands[command] = struct{}{}
+		}
+	}
+	return commands
+}()
+
+// SendCommand
+// Sends any MAV_CMD of the common dialect as COMMAND_LONG or COMMAND_INT, through the command
+// manager (retransmissions, ACK correlation). Returns the final COMMAND_ACK: a rejection by the
+// drone is reported in the ACK result rather than as an error.
+func (s *ActionService) SendCommand(
+	ctx context.Context,
+	req *connect.Request[flightpath.SendCommandRequest],
+) (*connect.Response[flightpath.SendCommandResponse], error) {
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+
+	command := common.MAV_CMD(msg.Command)
+	if _, ok := knownCommands[command]; !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown command %d", msg.Command))
+	}
+
+	cmd := &Command{
+		Target:  target,
+		Command: command,
+		Params:  [7]float32{msg.Param1, msg.Param2, msg.Param3, msg.Param4, msg.Param5, msg.Param6, msg.Param7},
+	}
+	if msg.UseCommandInt {
+		if _, ok := flightpath.MavFrame_name[int32(msg.Frame)]; !ok || msg.Frame == flightpath.MavFrame_MAV_FRAME_UNSPECIFIED {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid frame %v", msg.Frame))
+		}
+		cmd.UseCommandInt = true
+		// Proto enum values are incremented by 1 to accommodate UNSPECIFIED at 0
+		cmd.Frame = common.MAV_FRAME(msg.Frame - 1)
+		cmd.X = msg.X
+		cmd.Y = msg.Y
+	}
+
+	ack, err := s.ctx.Commands.Send(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&flightpath.SendCommandResponse{
+		CommandAck: message_converters.CommandAckToProtobuf(ack),
+	}), nil
+}
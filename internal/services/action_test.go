@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v3/pkg/message"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+func TestSendCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  uint32
+		wantCode connect.Code
+	}{
+		{name: "common dialect command", command: uint32(common.MAV_CMD_NAV_TAKEOFF)},
+		{name: "high-numbered common dialect command", command: uint32(common.MAV_CMD_CAN_FORWARD)},
+		{name: "undefined command", command: 65000, wantCode: connect.CodeInvalidArgument},
+		{name: "command out of range", command: uint32(common.MAV_CMD_NAV_TAKEOFF) + 1<<16, wantCode: connect.CodeInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := &fakeWriter{}
+			commands, dispatcher := newTestCommandManager(writer)
+			writer.onWrite = func(msg message.Message) {
+				cmd := msg.(*common.MessageCommandLong)
+				dispatcher.commandAck.broadcast(ackEvent(cmd.TargetSystem, cmd.TargetComponent, cmd.Command, common.MAV_RESULT_ACCEPTED))
+			}
+			service := NewActionService(&ServiceContext{
+				Logger:     log.New(io.Discard, "", 0),
+				Dispatcher: dispatcher,
+				Commands:   commands,
+			})
+
+			res, err := service.SendCommand(context.Background(), connect.NewRequest(&flightpath.SendCommandRequest{Command: tt.command}))
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Fatalf("SendCommand() error = %v, want code %s", err, tt.wantCode)
+				}
+				if len(writer.confirmations()) != 0 {
+					t.Error("SendCommand() sent an invalid command")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SendCommand() error = %v", err)
+			}
+			if res.Msg.CommandAck.GetCommand() != tt.command {
+				t.Errorf("SendCommand() acknowledged command = %d, want %d", res.Msg.CommandAck.GetCommand(), tt.command)
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+	"github.com/flightpath-dev/flightpath/internal/mavlink/message_converters"
+)
+
+const (
+	// How long to wait for VFR_HUD to reflect an in-flight adjustment
+	adjustmentConfirmTimeout = 10 * time.Second
+
+	// Maximum speed (m/s) accepted by ChangeSpeed
+	maxChangeSpeed = 100
+
+	// Maximum difference between the requested and the reported speed (m/s)
+	speedConfirmTolerance = 0.5
+
+	// Maximum difference between the requested and the reported heading (deg)
+	headingConfirmTolerance = 5
+)
+
+// ChangeSpeed
+// Changes the target speed using MAV_CMD_DO_CHANGE_SPEED.
+// If requested, waits for VFR_HUD to report the new speed.
+func (s *ActionService) ChangeSpeed(
+	ctx context.Context,
+	req *connect.Request[flightpath.ChangeSpeedRequest],
+) (*connect.Response[flightpath.ChangeSpeedResponse], error) {
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+	speedType := msg.SpeedType
+	if speedType == flightpath.SpeedType_SPEED_TYPE_UNSPECIFIED {
+		speedType = flightpath.SpeedType_SPEED_TYPE_GROUNDSPEED
+	}
+	if _, ok := flightpath.SpeedType_name[int32(speedType)]; !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid speed type %v", msg.SpeedType))
+	}
+	if !(msg.Speed > 0 && msg.Speed <= maxChangeSpeed) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("invalid speed %v (must be in (0, %d] m/s)", msg.Speed, maxChangeSpeed),
+		)
+	}
+
+	// Speed type, speed, throttle (-1 = no change)
+	// Proto enum values are incremented by 1 to accommodate UNSPECIFIED at 0
+	cmd := &Command{
+		Target:  target,
+		Command: common.MAV_CMD_DO_CHANGE_SPEED,
+		Params:  [7]float32{float32(speedType - 1), msg.Speed, -1},
+	}
+
+	response := &flightpath.ChangeSpeedResponse{}
+	response.CommandAck, response.Confirmed, response.ObservedSpeed, err = s.executeAdjustment(
+		ctx, cmd, msg.WaitForConfirmation,
+		func(vfrHud *flightpath.VfrHud) (float32, bool) {
+			speed := reportedSpeed(vfrHud, speedType)
+			return speed, math.Abs(float64(speed-msg.Speed)) <= speedConfirmTolerance
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// SetYaw
+// Turns to a heading (or by an angle) using MAV_CMD_CONDITION_YAW.
+// If requested, waits for VFR_HUD to report the new heading (absolute headings only, as the
+// heading a relative turn ends at is not known in advance).
+func (s *ActionService) SetYaw(
+	ctx context.Context,
+	req *connect.Request[flightpath.SetYawRequest],
+) (*connect.Response[flightpath.SetYawResponse], error) {
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+	if !(msg.Angle >= 0 && msg.Angle < 360) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid angle %v (must be in [0, 360) deg)", msg.Angle))
+	}
+	if !(msg.AngularSpeed >= 0 && msg.AngularSpeed <= 360) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("invalid angular speed %v (must be in [0, 360] deg/s)", msg.AngularSpeed),
+		)
+	}
+
+	// Direction: -1 = counter-clockwise, 0 = shortest, 1 = clockwise
+	var direction float32
+	switch msg.Direction {
+	case flightpath.YawDirection_YAW_DIRECTION_UNSPECIFIED:
+		if msg.Relative {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("direction is required for a relative turn"))
+		}
+	case flightpath.YawDirection_YAW_DIRECTION_CLOCKWISE:
+		direction = 1
+	case flightpath.YawDirection_YAW_DIRECTION_COUNTER_CLOCKWISE:
+		direction = -1
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid direction %v", msg.Direction))
+	}
+
+	// Angle, angular speed (0 = default), direction, relative (0 = absolute, 1 = relative)
+	cmd := &Command{
+		Target:  target,
+		Command: common.MAV_CMD_CONDITION_YAW,
+		Params:  [7]float32{msg.Angle, msg.AngularSpeed, direction, 0},
+	}
+	if msg.Relative {
+		cmd.Params[3] = 1
+	}
+
+	response := &flightpath.SetYawResponse{}
+	response.CommandAck, response.Confirmed, response.ObservedHeading, err = s.executeAdjustment(
+		ctx, cmd, msg.WaitForConfirmation && !msg.Relative,
+		func(vfrHud *flightpath.VfrHud) (float32, bool) {
+			heading := float32(vfrHud.Heading)
+			difference := math.Mod(math.Abs(float64(heading-msg.Angle)), 360)
+			return heading, math.Min(difference, 360-difference) <= headingConfirmTolerance
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// SetRoiLocation
+// Points the vehicle and its gimbals at a location using MAV_CMD_DO_SET_ROI_LOCATION (sent as
+// COMMAND_INT for full latitude/longitude precision, in the GLOBAL_RELATIVE_ALT frame so that the
+// altitude above home is sent as is). There is no telemetry to confirm it with.
+func (s *ActionService) SetRoiLocation(
+	ctx context.Context,
+	req *connect.Request[flightpath.SetRoiLocationRequest],
+) (*connect.Response[flightpath.SetRoiLocationResponse], error) {
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+	if !(msg.Latitude >= -90 && msg.Latitude <= 90) || !(msg.Longitude >= -180 && msg.Longitude <= 180) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid location %v, %v", msg.Latitude, msg.Longitude))
+	}
+	if math.IsNaN(float64(msg.Altitude)) || math.IsInf(float64(msg.Altitude), 0) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid altitude %v", msg.Altitude))
+	}
+	if msg.GimbalDeviceId > math.MaxUint8 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid gimbal device ID %d", msg.GimbalDeviceId))
+	}
+
+	// Gimbal device ID (0 = all), empty, empty, empty, lat, lon, alt
+	cmd := &Command{
+		Target:        target,
+		Command:       common.MAV_CMD_DO_SET_ROI_LOCATION,
+		Params:        [7]float32{float32(msg.GimbalDeviceId), 0, 0, 0, 0, 0, msg.Altitude},
+		UseCommandInt: true,
+		Frame:         common.MAV_FRAME_GLOBAL_RELATIVE_ALT,
+		X:             int32(math.Round(msg.Latitude * 1e7)),
+		Y:             int32(math.Round(msg.Longitude * 1e7)),
+	}
+
+	ack, _, _, err := s.executeAdjustment(ctx, cmd, false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&flightpath.SetRoiLocationResponse{
+		CommandAck: ack,
+	}), nil
+}
+
+// ClearRoi
+// Cancels the region of interest using MAV_CMD_DO_SET_ROI_NONE.
+func (s *ActionService) ClearRoi(
+	ctx context.Context,
+	req *connect.Request[flightpath.ClearRoiRequest],
+) (*connect.Response[flightpath.ClearRoiResponse], error) {
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.GimbalDeviceId > math.MaxUint8 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid gimbal device ID %d", req.Msg.GimbalDeviceId))
+	}
+
+	// Gimbal device ID (0 = all)
+	cmd := &Command{
+		Target:  target,
+		Command: common.MAV_CMD_DO_SET_ROI_NONE,
+		Params:  [7]float32{float32(req.Msg.GimbalDeviceId)},
+	}
+
+	ack, _, _, err := s.executeAdjustment(ctx, cmd, false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&flightpath.ClearRoiResponse{
+		CommandAck: ack,
+	}), nil
+}
+
+// executeAdjustment
+// Sends an in-flight adjustment command and returns its COMMAND_ACK (a non-accepted result is
+// returned as an error). If confirm is set, then waits up to adjustmentConfirmTimeout for a
+// VFR_HUD from the target for which check reports the adjustment as applied, and returns the
+// last value observed.
+func (s *ActionService) executeAdjustment(
+	ctx context.Context,
+	cmd *Command,
+	confirm bool,
+	check func(vfrHud *flightpath.VfrHud) (observed float32, applied bool),
+) (*flightpath.CommandAck, bool, float32, error) {
+	var vfrHudChan <-chan VfrHudEvent
+	if confirm {
+		if s.ctx.Dispatcher == nil {
+			return nil, false, 0, connect.NewError(connect.CodeFailedPrecondition, errors.New("message dispatcher is not available"))
+		}
+
+		// Subscribe before sending the command so that no update is missed
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		vfrHudChan = s.ctx.Dispatcher.SubscribeVfrHud(ctx)
+	}
+
+	ack, err := s.ctx.Commands.Send(ctx, cmd)
+	if err != nil {
+		return nil, false, 0, err
+	}
+	if err := CommandResultToError(cmd.Command, ack.Result); err != nil {
+		return nil, false, 0, err
+	}
+	pbAck := message_converters.CommandAckToProtobuf(ack)
+
+	if !confirm {
+		return pbAck, false, 0, nil
+	}
+
+	timer := time.NewTimer(adjustmentConfirmTimeout)
+	defer timer.Stop()
+
+	var observed float32
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, false, 0, ctx.Err()
+
+		case <-timer.C:
+			// The command was accepted, the drone just did not reach the target in time
+			return pbAck, false, observed, nil
+
+		case event, ok := <-vfrHudChan:
+			if !ok {
+				return pbAck, false, observed, nil
+			}
+			if event.SystemID != cmd.Target.SystemID || event.ComponentID != cmd.Target.ComponentID {
+				continue
+			}
+			var applied bool
+			observed, applied = check(event.VfrHud)
+			if applied {
+				return pbAck, true, observed, nil
+			}
+		}
+	}
+}
+
+// reportedSpeed
+// Returns the VFR_HUD speed corresponding to a speed type (m/s).
+func reportedSpeed(vfrHud *flightpath.VfrHud, speedType flightpath.SpeedType) float32 {
+	switch speedType {
+	case flightpath.SpeedType_SPEED_TYPE_AIRSPEED:
+		return vfrHud.Airspeed
+	case flightpath.SpeedType_SPEED_TYPE_CLIMB_SPEED:
+		return vfrHud.Climb
+	case flightpath.SpeedType_SPEED_TYPE_DESCENT_SPEED:
+		return -vfrHud.Climb
+	default:
+		return vfrHud.Groundspeed
+	}
+}
@@ -0,0 +1,338 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/bluenviron/gomavlib/v3"
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v3/pkg/message"
+)
+
+const (
+	// How long to wait for a COMMAND_ACK before retransmitting the command
+	commandRetryTimeout = 1 * time.Second
+
+	// How many times a command is transmitted before giving up (first transmission included)
+	commandMaxAttempts = 3
+
+	// How long to wait for a final COMMAND_ACK after the last MAV_RESULT_IN_PROGRESS update
+	commandInProgressTimeout = 10 * time.Second
+
+	// COMMAND_ACK progress value meaning "progress unknown"
+	CommandProgressUnknown = 255
+)
+
+// CommandTarget identifies the system/component a command is addressed to
+type CommandTarget struct {
+	SystemID    uint8
+	ComponentID uint8
+}
+
+// Command describes a MAVLink command to be sent as COMMAND_LONG or COMMAND_INT.
+type Command struct {
+	Target  CommandTarget
+	Command common.MAV_CMD
+
+	// Params holds param1..param7.
+	// For COMMAND_INT, param5 and param6 are ignored in favour of X and Y, and param7 is sent as z.
+	Params [7]float32
+
+	// UseCommandInt sends the command as COMMAND_INT instead of COMMAND_LONG
+	UseCommandInt bool
+
+	// COMMAND_INT only: coordinate frame and integer x/y (e.g. latitude/longitude in degrees * 1E7)
+	Frame common.MAV_FRAME
+	X     int32
+	Y     int32
+
+	// OnProgress is called (if set) for every MAV_RESULT_IN_PROGRESS acknowledgement.
+	// Progress is a percentage [0-100] or CommandProgressUnknown.
+	OnProgress func(progress uint8)
+}
+
+// commandKey identifies commands that cannot be in flight at the same time.
+// COMMAND_ACK only carries the command id, so two transactions with the same
+// command id to the same target could not be told apart.
+type commandKey struct {
+	target  CommandTarget
+	command common.MAV_CMD
+}
+
+// commandWriter is the part of *gomavlib.Node used to transmit commands
+type commandWriter interface {
+	WriteMessageAll(msg message.Message) error
+}
+
+// CommandManager
+// Sends COMMAND_LONG / COMMAND_INT messages and tracks them until the matching COMMAND_ACK arrives.
+// Commands are retransmitted (with an incrementing confirmation field) when no acknowledgement
+// is received in time, and conflicting commands to the same target are serialized.
+// Acknowledgements are received through the MessageDispatcher, so the manager never reads
+// node.Events() directly.
+type CommandManager struct {
+	node       commandWriter
+	dispatcher *MessageDispatcher
+
+	// Our own system ID, which acknowledgements must be addressed to
+	systemID uint8
+
+	// Timeouts, commandRetryTimeout and commandInProgressTimeout unless overridden by tests
+	retryTimeout      time.Duration
+	inProgressTimeout time.Duration
+
+	// One semaphore per in-flight command key
+	inFlight   map[commandKey]chan struct{}
+	inFlightMu sync.Mutex
+}
+
+// NewCommandManager
+// Creates a new command manager that sends commands through the node and
+// receives acknowledgements through the dispatcher.
+func NewCommandManager(node *gomavlib.Node, dispatcher *MessageDispatcher) *CommandManager {
+	m := &CommandManager{
+		dispatcher:        dispatcher,
+		retryTimeout:      commandRetryTimeout,
+		inProgressTimeout: commandInProgressTimeout,
+		inFlight:          make(map[commandKey]chan struct{}),
+	}
+	// Keep m.node a nil interface when there is no node
+	if node != nil {
+		m.node = node
+		m.systemID = node.OutSystemID
+	}
+	return m
+}
+
+// Send
+// Sends a command and waits for its final COMMAND_ACK, which is returned as is.
+// Returns an error only if no final acknowledgement could be obtained (timeout, cancellation,
+// dispatcher stopped); use Execute to also turn a non-accepted result into an error.
+// Retries stop as soon as ctx is done.
+func (m *CommandManager) Send(ctx context.Context, cmd *Command) (*common.MessageCommandAck, error) {
+	if m == nil || m.node == nil || m.dispatcher == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("MAVLink node is not available"))
+	}
+
+	// Wait for any conflicting command to complete
+	release, err := m.acquire(ctx, commandKey{target: cmd.Target, command: cmd.Command})
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	ackCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Subscribe before sending so that the acknowledgement cannot be missed
+	ackChan := m.dispatcher.SubscribeCommandAck(ackCtx)
+
+	// Each transmission increments the confirmation field (0 for the first transmission)
+	attempt := 0
+	transmit := func() error {
+		if err := m.node.WriteMessageAll(cmd.message(uint8(attempt))); err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to send %s: %w", cmd.Command, err))
+		}
+		attempt++
+		return nil
+	}
+	if err := transmit(); err != nil {
+		return nil, err
+	}
+
+	inProgress := false
+	timer := time.NewTimer(m.retryTimeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+
+		case <-timer.C:
+			if inProgress {
+				return nil, connect.NewError(
+					connect.CodeDeadlineExceeded,
+					fmt.Errorf("%s did not complete: no progress update for %s", cmd.Command, m.inProgressTimeout),
+				)
+			}
+			if attempt >= commandMaxAttempts {
+				return nil, connect.NewError(
+					connect.CodeDeadlineExceeded,
+					fmt.Errorf("no COMMAND_ACK received for %s after %d attempts", cmd.Command, attempt),
+				)
+			}
+			if err := transmit(); err != nil {
+				return nil, err
+			}
+			timer.Reset(m.retryTimeout)
+
+		case event, ok := <-ackChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil, connect.NewError(connect.CodeUnavailable, errors.New("message dispatcher stopped"))
+			}
+			if !m.matches(cmd, event) {
+				// Acknowledgement for another command, system or GCS
+				continue
+			}
+
+			ack := event.CommandAck
+			if ack.Result != common.MAV_RESULT_IN_PROGRESS {
+				return ack, nil
+			}
+
+			// The command is being executed: stop retransmitting and wait for the final result
+			inProgress = true
+			timer.Reset(m.inProgressTimeout)
+			if cmd.OnProgress != nil {
+				cmd.OnProgress(ack.Progress)
+			}
+		}
+	}
+}
+
+// Execute
+// Sends a command and waits for its final COMMAND_ACK.
+// Returns nil if the command was accepted, otherwise a connect error derived from the MAV_RESULT.
+func (m *CommandManager) Execute(ctx context.Context, cmd *Command) error {
+	ack, err := m.Send(ctx, cmd)
+	if err != nil {
+		return err
+	}
+	return CommandResultToError(cmd.Command, ack.Result)
+}
+
+// matches
+// Reports whether a COMMAND_ACK event acknowledges the given command.
+func (m *CommandManager) matches(cmd *Command, event CommandAckEvent) bool {
+	ack := event.CommandAck
+	if ack.Command != cmd.Command || event.SystemID != cmd.Target.SystemID {
+		return false
+	}
+	if cmd.Target.ComponentID != 0 && event.ComponentID != cmd.Target.ComponentID {
+		return false
+	}
+	// Older autopilots leave target_system unset; otherwise it must be us
+	if ack.TargetSystem != 0 && ack.TargetSystem != m.systemID {
+		return false
+	}
+	return true
+}
+
+// acquire
+// Blocks until no other command with the same key is in flight, or ctx is done.
+// The returned function must be called to release the key.
+func (m *CommandManager) acquire(ctx context.Context, key commandKey) (func(), error) {
+	for {
+		m.inFlightMu.Lock()
+		busy, ok := m.inFlight[key]
+		if !ok {
+			done := make(chan struct{})
+			m.inFlight[key] = done
+			m.inFlightMu.Unlock()
+
+			return func() {
+				m.inFlightMu.Lock()
+				delete(m.inFlight, key)
+				m.inFlightMu.Unlock()
+				close(done)
+			}, nil
+		}
+		m.inFlightMu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-busy:
+			// Previous command completed, try again
+		}
+	}
+}
+
+// message
+// Builds the MAVLink message for the given transmission attempt.
+func (cmd *Command) message(confirmation uint8) message.Message {
+	if cmd.UseCommandInt {
+		return &common.MessageCommandInt{
+			TargetSystem:    cmd.Target.SystemID,
+			TargetComponent: cmd.Target.ComponentID,
+			Frame:           cmd.Frame,
+			Command:         cmd.Command,
+			Param1:          cmd.Params[0],
+			Param2:          cmd.Params[1],
+			Param3:          cmd.Params[2],
+			Param4:          cmd.Params[3],
+			X:               cmd.X,
+			Y:               cmd.Y,
+			Z:               cmd.Params[6],
+		}
+	}
+
+	return &common.MessageCommandLong{
+		TargetSystem:    cmd.Target.SystemID,
+		TargetComponent: cmd.Target.ComponentID,
+		Command:         cmd.Command,
+		Confirmation:    confirmation,
+		Param1:          cmd.Params[0],
+		Param2:          cmd.Params[1],
+		Param3:          cmd.Params[2],
+		Param4:          cmd.Params[3],
+		Param5:          cmd.Params[4],
+		Param6:          cmd.Params[5],
+		Param7:          cmd.Params[6],
+	}
+}
+
+// ResolveCommandTarget
+// Validates the requested system/component IDs and applies defaults:
+// system ID 1 and component ID MAV_COMP_ID_AUTOPILOT1.
+func ResolveCommandTarget(systemID, componentID uint32) (CommandTarget, error) {
+	if systemID > 255 || componentID > 255 {
+		return CommandTarget{}, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("invalid target %d/%d: system and component IDs must be between 0 and 255", systemID, componentID),
+		)
+	}
+	if systemID == 0 {
+		systemID = 1
+	}
+	if componentID == 0 {
+		componentID = uint32(common.MAV_COMP_ID_AUTOPILOT1)
+	}
+	return CommandTarget{SystemID: uint8(systemID), ComponentID: uint8(componentID)}, nil
+}
+
+// CommandResultToError
+// Maps a MAV_RESULT from a COMMAND_ACK to a connect error.
+// Returns nil for MAV_RESULT_ACCEPTED.
+func CommandResultToError(command common.MAV_CMD, result common.MAV_RESULT) error {
+	var code connect.Code
+	switch result {
+	case common.MAV_RESULT_ACCEPTED:
+		return nil
+	case common.MAV_RESULT_TEMPORARILY_REJECTED:
+		code = connect.CodeUnavailable
+	case common.MAV_RESULT_DENIED:
+		code = connect.CodeFailedPrecondition
+	case common.MAV_RESULT_UNSUPPORTED:
+		code = connect.CodeUnimplemented
+	case common.MAV_RESULT_FAILED:
+		code = connect.CodeAborted
+	case common.MAV_RESULT_CANCELLED:
+		code = connect.CodeCanceled
+	case common.MAV_RESULT_COMMAND_LONG_ONLY,
+		common.MAV_RESULT_COMMAND_INT_ONLY,
+		common.MAV_RESULT_COMMAND_UNSUPPORTED_MAV_FRAME:
+		code = connect.CodeInvalidArgument
+	case common.MAV_RESULT_NOT_IN_CONTROL:
+		code = connect.CodePermissionDenied
+	default:
+		code = connect.CodeUnknown
+	}
+	return connect.NewError(code, fmt.Errorf("%s rejected: %s", command, result))
+}
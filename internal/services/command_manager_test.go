@@ -0,0 +1,471 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v3/pkg/message"
+)
+
+const (
+	testGcsSystemID       = 255
+	testRetryTimeout      = 20 * time.Millisecond
+	testInProgressTimeout = 150 * time.Millisecond
+)
+
+var testTarget = CommandTarget{SystemID: 1, ComponentID: uint8(common.MAV_COMP_ID_AUTOPILOT1)}
+
+// fakeWriter records the transmitted commands and lets the test react to them
+type fakeWriter struct {
+	mu       sync.Mutex
+	messages []message.Message
+	onWrite  func(msg message.Message)
+}
+
+func (w *fakeWriter) WriteMessageAll(msg message.Message) error {
+	w.mu.Lock()
+	w.messages = append(w.messages, msg)
+	onWrite := w.onWrite
+	w.mu.Unlock()
+
+	if onWrite != nil {
+		onWrite(msg)
+	}
+	return nil
+}
+
+// confirmations returns the confirmation field of every transmitted COMMAND_LONG
+func (w *fakeWriter) confirmations() []uint8 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var confirmations []uint8
+	for _, msg := range w.messages {
+		if cmd, ok := msg.(*common.MessageCommandLong); ok {
+			confirmations = append(confirmations, cmd.Confirmation)
+		}
+	}
+	return confirmations
+}
+
+func newTestCommandManager(writer *fakeWriter) (*CommandManager, *MessageDispatcher) {
+	dispatcher := NewMessageDispatcher(nil)
+	return &CommandManager{
+		node:              writer,
+		dispatcher:        dispatcher,
+		systemID:          testGcsSystemID,
+		retryTimeout:      testRetryTimeout,
+		inProgressTimeout: testInProgressTimeout,
+		inFlight:          make(map[commandKey]chan struct{}),
+	}, dispatcher
+}
+
+func ackEvent(systemID, componentID uint8, command common.MAV_CMD, result common.MAV_RESULT) CommandAckEvent {
+	return CommandAckEvent{
+		SystemID:    systemID,
+		ComponentID: componentID,
+		CommandAck: &common.MessageCommandAck{
+			Command:      command,
+			Result:       result,
+			TargetSystem: testGcsSystemID,
+		},
+	}
+}
+
+func TestCommandManagerSend(t *testing.T) {
+	const command = common.MAV_CMD_COMPONENT_ARM_DISARM
+	accepted := ackEvent(testTarget.SystemID, testTarget.ComponentID, command, common.MAV_RESULT_ACCEPTED)
+
+	tests := []struct {
+		name string
+		// respond is called for every transmission with its confirmation value
+		respond           func(d *MessageDispatcher, confirmation uint8)
+		wantResult        common.MAV_RESULT
+		wantCode          connect.Code
+		wantConfirmations []uint8
+		wantProgress      []uint8
+	}{
+		{
+			name: "accepted on first attempt",
+			respond: func(d *MessageDispatcher, confirmation uint8) {
+				d.commandAck.broadcast(accepted)
+			},
+			wantResult:        common.MAV_RESULT_ACCEPTED,
+			wantConfirmations: []uint8{0},
+		},
+		{
+			name: "retries increment confirmation",
+			respond: func(d *MessageDispatcher, confirmation uint8) {
+				if confirmation == 2 {
+					d.commandAck.broadcast(accepted)
+				}
+			},
+			wantResult:        common.MAV_RESULT_ACCEPTED,
+			wantConfirmations: []uint8{0, 1, 2},
+		},
+		{
+			name:              "no acknowledgement after max attempts",
+			respond:           func(d *MessageDispatcher, confirmation uint8) {},
+			wantCode:          connect.CodeDeadlineExceeded,
+			wantConfirmations: []uint8{0, 1, 2},
+		},
+		{
+			name: "stray acknowledgements are ignored",
+			respond: func(d *MessageDispatcher, confirmation uint8) {
+				// Other command
+				d.commandAck.broadcast(ackEvent(testTarget.SystemID, testTarget.ComponentID, common.MAV_CMD_NAV_LAND, common.MAV_RESULT_FAILED))
+				// Other system
+				d.commandAck.broadcast(ackEvent(2, testTarget.ComponentID, command, common.MAV_RESULT_FAILED))
+				// Other component
+				d.commandAck.broadcast(ackEvent(testTarget.SystemID, uint8(common.MAV_COMP_ID_CAMERA), command, common.MAV_RESULT_FAILED))
+				// Addressed to another GCS
+				other := ackEvent(testTarget.SystemID, testTarget.ComponentID, command, common.MAV_RESULT_FAILED)
+				other.CommandAck.TargetSystem = testGcsSystemID - 1
+				d.commandAck.broadcast(other)
+
+				if confirmation == 1 {
+					d.commandAck.broadcast(accepted)
+				}
+			},
+			wantResult:        common.MAV_RESULT_ACCEPTED,
+			wantConfirmations: []uint8{0, 1},
+		},
+		{
+			name: "in progress extends the timeout",
+			respond: func(d *MessageDispatcher, confirmation uint8) {
+				progress := ackEvent(testTarget.SystemID, testTarget.ComponentID, command, common.MAV_RESULT_IN_PROGRESS)
+				progress.CommandAck.Progress = 40
+				d.commandAck.broadcast(progress)
+
+				// Later than all retries would have taken, earlier than the in-progress timeout
+				time.AfterFunc(commandMaxAttempts*testRetryTimeout+testRetryTimeout, func() {
+					d.commandAck.broadcast(accepted)
+				})
+			},
+			wantResult:        common.MAV_RESULT_ACCEPTED,
+			wantConfirmations: []uint8{0},
+			wantProgress:      []uint8{40},
+		},
+		{
+			name: "in progress without final result",
+			respond: func(d *MessageDispatcher, confirmation uint8) {
+				progress := ackEvent(testTarget.SystemID, testTarget.ComponentID, command, common.MAV_RESULT_IN_PROGRESS)
+				progress.CommandAck.Progress = CommandProgressUnknown
+				d.commandAck.broadcast(progress)
+			},
+			wantCode:          connect.CodeDeadlineExceeded,
+			wantConfirmations: []uint8{0},
+			wantProgress:      []uint8{CommandProgressUnknown},
+		},
+		{
+			name: "final result is returned as is",
+			respond: func(d *MessageDispatcher, confirmation uint8) {
+				d.commandAck.broadcast(ackEvent(testTarget.SystemID, testTarget.ComponentID, command, common.MAV_RESULT_DENIED))
+			},
+			wantResult:        common.MAV_RESULT_DENIED,
+			wantConfirmations: []uint8{0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := &fakeWriter{}
+			manager, dispatcher := newTestCommandManager(writer)
+			writer.onWrite = func(msg message.Message) {
+				tt.respond(dispatcher, msg.(*common.MessageCommandLong).Confirmation)
+			}
+
+			var progress []uint8
+			start := time.Now()
+			ack, err := manager.Send(context.Background(), &Command{
+				Target:     testTarget,
+				Command:    command,
+				OnProgress: func(p uint8) { progress = append(progress, p) },
+			})
+
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Fatalf("Send() error = %v, want code %s", err, tt.wantCode)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Send() error = %v", err)
+				}
+				if ack.Result != tt.wantResult {
+					t.Errorf("Send() result = %s, want %s", ack.Result, tt.wantResult)
+				}
+			}
+			if got := writer.confirmations(); !slices.Equal(got, tt.wantConfirmations) {
+				t.Errorf("confirmations = %v, want %v", got, tt.wantConfirmations)
+			}
+			if !slices.Equal(progress, tt.wantProgress) {
+				t.Errorf("progress = %v, want %v", progress, tt.wantProgress)
+			}
+			if tt.wantProgress != nil && tt.wantCode == connect.CodeDeadlineExceeded {
+				if elapsed := time.Since(start); elapsed < testInProgressTimeout {
+					t.Errorf("Send() gave up after %s, want at least %s", elapsed, testInProgressTimeout)
+				}
+			}
+		})
+	}
+}
+
+func TestCommandManagerSendWithoutNode(t *testing.T) {
+	manager := NewCommandManager(nil, NewMessageDispatcher(nil))
+
+	_, err := manager.Send(context.Background(), &Command{Target: testTarget, Command: common.MAV_CMD_COMPONENT_ARM_DISARM})
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("Send() error = %v, want code %s", err, connect.CodeFailedPrecondition)
+	}
+}
+
+func TestCommandManagerExecute(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   common.MAV_RESULT
+		wantCode connect.Code
+	}{
+		{name: "accepted", result: common.MAV_RESULT_ACCEPTED},
+		{name: "denied", result: common.MAV_RESULT_DENIED, wantCode: connect.CodeFailedPrecondition},
+		{name: "unsupported", result: common.MAV_RESULT_UNSUPPORTED, wantCode: connect.CodeUnimplemented},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := &fakeWriter{}
+			manager, dispatcher := newTestCommandManager(writer)
+			writer.onWrite = func(msg message.Message) {
+				dispatcher.commandAck.broadcast(ackEvent(testTarget.SystemID, testTarget.ComponentID, common.MAV_CMD_NAV_TAKEOFF, tt.result))
+			}
+
+			err := manager.Execute(context.Background(), &Command{Target: testTarget, Command: common.MAV_CMD_NAV_TAKEOFF})
+			if tt.wantCode == 0 {
+				if err != nil {
+					t.Fatalf("Execute() error = %v", err)
+				}
+				return
+			}
+			if connect.CodeOf(err) != tt.wantCode {
+				t.Fatalf("Execute() error = %v, want code %s", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestCommandManagerMatches(t *testing.T) {
+	const command = common.MAV_CMD_COMPONENT_ARM_DISARM
+	manager, _ := newTestCommandManager(&fakeWriter{})
+
+	tests := []struct {
+		name   string
+		target CommandTarget
+		event  CommandAckEvent
+		want   bool
+	}{
+		{
+			name:   "matching acknowledgement",
+			target: testTarget,
+			event:  ackEvent(1, 1, command, common.MAV_RESULT_ACCEPTED),
+			want:   true,
+		},
+		{
+			name:   "other command",
+			target: testTarget,
+			event:  ackEvent(1, 1, common.MAV_CMD_NAV_LAND, common.MAV_RESULT_ACCEPTED),
+		},
+		{
+			name:   "other system",
+			target: testTarget,
+			event:  ackEvent(2, 1, command, common.MAV_RESULT_ACCEPTED),
+		},
+		{
+			name:   "other component",
+			target: testTarget,
+			event:  ackEvent(1, uint8(common.MAV_COMP_ID_CAMERA), command, common.MAV_RESULT_ACCEPTED),
+		},
+		{
+			name:   "any component for broadcast target",
+			target: CommandTarget{SystemID: 1},
+			event:  ackEvent(1, uint8(common.MAV_COMP_ID_CAMERA), command, common.MAV_RESULT_ACCEPTED),
+			want:   true,
+		},
+		{
+			name:   "addressed to another GCS",
+			target: testTarget,
+			event: func() CommandAckEvent {
+				event := ackEvent(1, 1, command, common.MAV_RESULT_ACCEPTED)
+				event.CommandAck.TargetSystem = 254
+				return event
+			}(),
+		},
+		{
+			name:   "target system unset",
+			target: testTarget,
+			event: func() CommandAckEvent {
+				event := ackEvent(1, 1, command, common.MAV_RESULT_ACCEPTED)
+				event.CommandAck.TargetSystem = 0
+				return event
+			}(),
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &Command{Target: tt.target, Command: command}
+			if got := manager.matches(cmd, tt.event); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommandManagerAcquire(t *testing.T) {
+	manager, _ := newTestCommandManager(&fakeWriter{})
+	key := commandKey{target: testTarget, command: common.MAV_CMD_COMPONENT_ARM_DISARM}
+
+	release, err := manager.acquire(context.Background(), key)
+	if err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+
+	// Other commands and other targets are not blocked
+	for _, other := range []commandKey{
+		{target: testTarget, command: common.MAV_CMD_NAV_LAND},
+		{target: CommandTarget{SystemID: 2, ComponentID: testTarget.ComponentID}, command: key.command},
+	} {
+		releaseOther, err := manager.acquire(context.Background(), other)
+		if err != nil {
+			t.Fatalf("acquire(%v) error = %v", other, err)
+		}
+		releaseOther()
+	}
+
+	// The same key is blocked until released
+	ctx, cancel := context.WithTimeout(context.Background(), testRetryTimeout)
+	defer cancel()
+	if _, err := manager.acquire(ctx, key); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("acquire() of a busy key error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	acquired := make(chan func())
+	go func() {
+		release, err := manager.acquire(context.Background(), key)
+		if err != nil {
+			t.Errorf("acquire() error = %v", err)
+			close(acquired)
+			return
+		}
+		acquired <- release
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("acquire() returned while the key was still in flight")
+	case <-time.After(testRetryTimeout):
+	}
+
+	release()
+
+	select {
+	case releaseNext, ok := <-acquired:
+		if ok {
+			releaseNext()
+		}
+	case <-time.After(time.Second):
+		t.Fatal("acquire() did not return after the key was released")
+	}
+}
+
+func TestCommandManagerSendSerializesSameCommand(t *testing.T) {
+	const command = common.MAV_CMD_COMPONENT_ARM_DISARM
+	writer := &fakeWriter{}
+	manager, dispatcher := newTestCommandManager(writer)
+
+	// Hold the first command in progress until the test completes it
+	firstWritten := make(chan struct{})
+	var writes int
+	var writesMu sync.Mutex
+	writer.onWrite = func(msg message.Message) {
+		writesMu.Lock()
+		writes++
+		n := writes
+		writesMu.Unlock()
+
+		if n == 1 {
+			dispatcher.commandAck.broadcast(ackEvent(testTarget.SystemID, testTarget.ComponentID, command, common.MAV_RESULT_IN_PROGRESS))
+			close(firstWritten)
+			return
+		}
+		dispatcher.commandAck.broadcast(ackEvent(testTarget.SystemID, testTarget.ComponentID, command, common.MAV_RESULT_ACCEPTED))
+	}
+
+	results := make(chan error, 2)
+	go func() {
+		results <- manager.Execute(context.Background(), &Command{Target: testTarget, Command: command})
+	}()
+	<-firstWritten
+	go func() {
+		results <- manager.Execute(context.Background(), &Command{Target: testTarget, Command: command})
+	}()
+
+	// The second command must not be transmitted while the first one is in flight
+	time.Sleep(2 * testRetryTimeout)
+	writesMu.Lock()
+	if writes != 1 {
+		t.Errorf("transmissions while the first command is in flight = %d, want 1", writes)
+	}
+	writesMu.Unlock()
+
+	dispatcher.commandAck.broadcast(ackEvent(testTarget.SystemID, testTarget.ComponentID, command, common.MAV_RESULT_ACCEPTED))
+
+	for range 2 {
+		if err := <-results; err != nil {
+			t.Errorf("Execute() error = %v", err)
+		}
+	}
+	if got := writer.confirmations(); !slices.Equal(got, []uint8{0, 0}) {
+		t.Errorf("confirmations = %v, want [0 0]", got)
+	}
+}
+
+func TestCommandResultToError(t *testing.T) {
+	tests := []struct {
+		result common.MAV_RESULT
+		want   connect.Code
+	}{
+		{common.MAV_RESULT_ACCEPTED, 0},
+		{common.MAV_RESULT_TEMPORARILY_REJECTED, connect.CodeUnavailable},
+		{common.MAV_RESULT_DENIED, connect.CodeFailedPrecondition},
+		{common.MAV_RESULT_UNSUPPORTED, connect.CodeUnimplemented},
+		{common.MAV_RESULT_FAILED, connect.CodeAborted},
+		{common.MAV_RESULT_IN_PROGRESS, connect.CodeUnknown},
+		{common.MAV_RESULT_CANCELLED, connect.CodeCanceled},
+		{common.MAV_RESULT_COMMAND_LONG_ONLY, connect.CodeInvalidArgument},
+		{common.MAV_RESULT_COMMAND_INT_ONLY, connect.CodeInvalidArgument},
+		{common.MAV_RESULT_COMMAND_UNSUPPORTED_MAV_FRAME, connect.CodeInvalidArgument},
+		{common.MAV_RESULT_NOT_IN_CONTROL, connect.CodePermissionDenied},
+		{common.MAV_RESULT(100), connect.CodeUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.result.String(), func(t *testing.T) {
+			err := CommandResultToError(common.MAV_CMD_COMPONENT_ARM_DISARM, tt.result)
+			if tt.want == 0 {
+				if err != nil {
+					t.Fatalf("CommandResultToError() = %v, want nil", err)
+				}
+				return
+			}
+			if connect.CodeOf(err) != tt.want {
+				t.Errorf("CommandResultToError() = %v, want code %s", err, tt.want)
+			}
+		})
+	}
+}
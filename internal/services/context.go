@@ -15,4 +15,5 @@ type ServiceContext struct {
 	Logger     *log.Logger
 	Node       *gomavlib.Node
 	Dispatcher *MessageDispatcher
+	Commands   *CommandManager
 }
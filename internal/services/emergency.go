@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath/flightpathconnect"
+)
+
+// How long an emergency confirmation token remains valid
+const emergencyTokenTTL = 5 * time.Second
+
+// emergencyConfirmation is a pending confirmation for an emergency action
+type emergencyConfirmation struct {
+	action    flightpath.EmergencyAction
+	target    CommandTarget
+	caller    string
+	expiresAt time.Time
+}
+
+// EmergencyService implements the EmergencyService gRPC service
+type EmergencyService struct {
+	flightpathconnect.UnimplementedEmergencyServiceHandler
+	ctx *ServiceContext
+
+	// Pending confirmations by token
+	confirmations   map[string]emergencyConfirmation
+	confirmationsMu sync.Mutex
+}
+
+// NewEmergencyService creates a new EmergencyService instance
+func NewEmergencyService(ctx *ServiceContext) *EmergencyService {
+	return &EmergencyService{
+		ctx:           ctx,
+		confirmations: make(map[string]emergencyConfirmation),
+	}
+}
+
+// RequestConfirmation
+// Issues a single-use confirmation token for an emergency action on a drone.
+// The token expires after emergencyTokenTTL.
+func (s *EmergencyService) RequestConfirmation(
+	ctx context.Context,
+	req *connect.Request[flightpath.RequestConfirmationRequest],
+) (*connect.Response[flightpath.RequestConfirmationResponse], error) {
+	caller := callerIdentity(req.Peer(), req.Header())
+
+	if req.Msg.Action != flightpath.EmergencyAction_EMERGENCY_ACTION_KILL &&
+		req.Msg.Action != flightpath.EmergencyAction_EMERGENCY_ACTION_FLIGHT_TERMINATION {
+		s.ctx.Logger.Printf("🚨 Emergency confirmation refused for %s: invalid action %s", caller, req.Msg.Action)
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid emergency action %s", req.Msg.Action))
+	}
+
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		s.ctx.Logger.Printf("🚨 Emergency confirmation refused for %s: %v", caller, err)
+		return nil, err
+	}
+
+	tokenBytes := make([]byte, 16)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	token := hex.EncodeToString(tokenBytes)
+	expiresAt := time.Now().Add(emergencyTokenTTL)
+
+	s.confirmationsMu.Lock()
+	s.pruneConfirmations()
+	s.confirmations[token] = emergencyConfirmation{
+		action:    req.Msg.Action,
+		target:    target,
+		caller:    caller,
+		expiresAt: expiresAt,
+	}
+	s.confirmationsMu.Unlock()
+
+	s.ctx.Logger.Printf(
+		"🚨 Emergency confirmation issued to %s: %s on system %d component %d",
+		caller, req.Msg.Action, target.SystemID, target.ComponentID,
+	)
+
+	return connect.NewResponse(&flightpath.RequestConfirmationResponse{
+		Token:       token,
+		ExpiresAtMs: expiresAt.UnixMilli(),
+	}), nil
+}
+
+// Kill
+// Kills the drone's motors using MAV_CMD_COMPONENT_ARM_DISARM with the force magic value.
+// Requires a valid confirmation token for EMERGENCY_ACTION_KILL.
+func (s *EmergencyService) Kill(
+	ctx context.Context,
+	req *connect.Request[flightpath.KillRequest],
+) (*connect.Response[flightpath.KillResponse], error) {
+	err := s.execute(ctx, req.Msg.Token, callerIdentity(req.Peer(), req.Header()),
+		flightpath.EmergencyAction_EMERGENCY_ACTION_KILL,
+		func(target CommandTarget) *Command {
+			return &Command{
+				Target:  target,
+				Command: common.MAV_CMD_COMPONENT_ARM_DISARM,
+				Params:  [7]float32{0, armDisarmForceMagic},
+			}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&flightpath.KillResponse{}), nil
+}
+
+// FlightTermination
+// Terminates the flight using MAV_CMD_DO_FLIGHTTERMINATION.
+// Requires a valid confirmation token for EMERGENCY_ACTION_FLIGHT_TERMINATION.
+func (s *EmergencyService) FlightTermination(
+	ctx context.Context,
+	req *connect.Request[flightpath.FlightTerminationRequest],
+) (*connect.Response[flightpath.FlightTerminationResponse], error) {
+	err := s.execute(ctx, req.Msg.Token, callerIdentity(req.Peer(), req.Header()),
+		flightpath.EmergencyAction_EMERGENCY_ACTION_FLIGHT_TERMINATION,
+		func(target CommandTarget) *Command {
+			return &Command{
+				Target:  target,
+				Command: common.MAV_CMD_DO_FLIGHTTERMINATION,
+				Params:  [7]float32{1},
+			}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&flightpath.FlightTerminationResponse{}), nil
+}
+
+// execute
+// Consumes a confirmation token and, if it was issued to the same caller for the action, sends
+// the action's command to the drone the token was issued for. Every attempt and its outcome is logged.
+func (s *EmergencyService) execute(
+	ctx context.Context,
+	token string,
+	caller string,
+	action flightpath.EmergencyAction,
+	command func(target CommandTarget) *Command,
+) error {
+	s.confirmationsMu.Lock()
+	s.pruneConfirmations()
+	confirmation, ok := s.confirmations[token]
+	if ok && confirmation.action == action && confirmation.caller == caller {
+		// Tokens are single-use
+		delete(s.confirmations, token)
+	}
+	s.confirmationsMu.Unlock()
+
+	if !ok {
+		s.ctx.Logger.Printf("🚨 %s refused for %s: invalid or expired confirmation token", action, caller)
+		return connect.NewError(connect.CodePermissionDenied, errors.New("invalid or expired confirmation token"))
+	}
+	if confirmation.action != action {
+		s.ctx.Logger.Printf("🚨 %s refused for %s: confirmation token was issued for %s", action, caller, confirmation.action)
+		return connect.NewError(
+			connect.CodePermissionDenied,
+			fmt.Errorf("confirmation token was issued for %s", confirmation.action),
+		)
+	}
+	if confirmation.caller != caller {
+		s.ctx.Logger.Printf("🚨 %s refused for %s: confirmation token was issued to %s", action, caller, confirmation.caller)
+		return connect.NewError(connect.CodePermissionDenied, errors.New("confirmation token was issued to another client"))
+	}
+
+	target := confirmation.target
+	s.ctx.Logger.Printf(
+		"🚨 %s confirmed by %s: sending to system %d component %d",
+		action, caller, target.SystemID, target.ComponentID,
+	)
+
+	if err := s.ctx.Commands.Execute(ctx, command(target)); err != nil {
+		s.ctx.Logger.Printf("🚨 %s on system %d failed: %v", action, target.SystemID, err)
+		return err
+	}
+
+	s.ctx.Logger.Printf("🚨 %s on system %d accepted", action, target.SystemID)
+	return nil
+}
+
+// pruneConfirmations
+// Removes expired confirmations. Must be called with confirmationsMu held.
+func (s *EmergencyService) pruneConfirmations() {
+	now := time.Now()
+	for token, confirmation := range s.confirmations {
+		if now.After(confirmation.expiresAt) {
+			delete(s.confirmations, token)
+		}
+	}
+}
+
+// callerIdentity
+// Describes the client making a request (host, protocol and user agent) for audit logs and to
+// check that a confirmation token is used by the client it was issued to.
+// The port is left out, as a client may open a new connection for each request.
+func callerIdentity(peer connect.Peer, header http.Header) string {
+	host := peer.Addr
+	if h, _, err := net.SplitHostPort(peer.Addr); err == nil {
+		host = h
+	}
+	identity := fmt.Sprintf("%s (%s)", host, peer.Protocol)
+	if userAgent := header.Get("User-Agent"); userAgent != "" {
+		identity += fmt.Sprintf(" [%s]", userAgent)
+	}
+	return identity
+}
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v3/pkg/message"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+const (
+	testCaller      = "192.0.2.1 (connect) [flightpath-ui]"
+	testOtherCaller = "192.0.2.2 (connect) [flightpath-ui]"
+	testToken       = "0123456789abcdef"
+)
+
+func newTestEmergencyService() (*EmergencyService, *fakeWriter) {
+	writer := &fakeWriter{}
+	commands, dispatcher := newTestCommandManager(writer)
+	writer.onWrite = func(msg message.Message) {
+		cmd := msg.(*common.MessageCommandLong)
+		dispatcher.commandAck.broadcast(ackEvent(cmd.TargetSystem, cmd.TargetComponent, cmd.Command, common.MAV_RESULT_ACCEPTED))
+	}
+
+	return NewEmergencyService(&ServiceContext{
+		Logger:     log.New(io.Discard, "", 0),
+		Dispatcher: dispatcher,
+		Commands:   commands,
+	}), writer
+}
+
+func TestEmergencyServiceExecute(t *testing.T) {
+	const (
+		kill        = flightpath.EmergencyAction_EMERGENCY_ACTION_KILL
+		termination = flightpath.EmergencyAction_EMERGENCY_ACTION_FLIGHT_TERMINATION
+	)
+
+	type attempt struct {
+		token    string
+		caller   string
+		action   flightpath.EmergencyAction
+		wantCode connect.Code
+	}
+
+	tests := []struct {
+		name       string
+		expiresIn  time.Duration
+		attempts   []attempt
+		wantWrites int
+	}{
+		{
+			name:       "valid token",
+			expiresIn:  emergencyTokenTTL,
+			attempts:   []attempt{{testToken, testCaller, kill, 0}},
+			wantWrites: 1,
+		},
+		{
+			name:      "unknown token",
+			expiresIn: emergencyTokenTTL,
+			attempts:  []attempt{{"fedcba9876543210", testCaller, kill, connect.CodePermissionDenied}},
+		},
+		{
+			name:      "expired token",
+			expiresIn: -time.Millisecond,
+			attempts:  []attempt{{testToken, testCaller, kill, connect.CodePermissionDenied}},
+		},
+		{
+			name:      "single use",
+			expiresIn: emergencyTokenTTL,
+			attempts: []attempt{
+				{testToken, testCaller, kill, 0},
+				{testToken, testCaller, kill, connect.CodePermissionDenied},
+			},
+			wantWrites: 1,
+		},
+		{
+			name:      "action mismatch does not consume the token",
+			expiresIn: emergencyTokenTTL,
+			attempts: []attempt{
+				{testToken, testCaller, termination, connect.CodePermissionDenied},
+				{testToken, testCaller, kill, 0},
+			},
+			wantWrites: 1,
+		},
+		{
+			name:      "caller mismatch does not consume the token",
+			expiresIn: emergencyTokenTTL,
+			attempts: []attempt{
+				{testToken, testOtherCaller, kill, connect.CodePermissionDenied},
+				{testToken, testCaller, kill, 0},
+			},
+			wantWrites: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, writer := newTestEmergencyService()
+			service.confirmations[testToken] = emergencyConfirmation{
+				action:    kill,
+				target:    testTarget,
+				caller:    testCaller,
+				expiresAt: time.Now().Add(tt.expiresIn),
+			}
+
+			for i, a := range tt.attempts {
+				err := service.execute(context.Background(), a.token, a.caller, a.action,
+					func(target CommandTarget) *Command {
+						return &Command{Target: target, Command: common.MAV_CMD_COMPONENT_ARM_DISARM}
+					},
+				)
+				if a.wantCode == 0 {
+					if err != nil {
+						t.Fatalf("attempt %d: execute() error = %v", i, err)
+					}
+					continue
+				}
+				if connect.CodeOf(err) != a.wantCode {
+					t.Fatalf("attempt %d: execute() error = %v, want code %s", i, err, a.wantCode)
+				}
+			}
+
+			if got := len(writer.confirmations()); got != tt.wantWrites {
+				t.Errorf("commands sent = %d, want %d", got, tt.wantWrites)
+			}
+		})
+	}
+}
+
+func TestCallerIdentity(t *testing.T) {
+	tests := []struct {
+		name      string
+		addr      string
+		userAgent string
+		want      string
+	}{
+		{"IPv4 with port", "192.0.2.1:52100", "flightpath-ui", "192.0.2.1 (connect) [flightpath-ui]"},
+		{"IPv6 with port", "[2001:db8::1]:52100", "", "2001:db8::1 (connect)"},
+		{"address without port", "192.0.2.1", "", "192.0.2.1 (connect)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.userAgent != "" {
+				header.Set("User-Agent", tt.userAgent)
+			}
+			got := callerIdentity(connect.Peer{Addr: tt.addr, Protocol: connect.ProtocolConnect}, header)
+			if got != tt.want {
+				t.Errorf("callerIdentity() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// Requests from the same client on different connections have the same identity
+	first := callerIdentity(connect.Peer{Addr: "192.0.2.1:52100", Protocol: connect.ProtocolConnect}, http.Header{})
+	second := callerIdentity(connect.Peer{Addr: "192.0.2.1:52101", Protocol: connect.ProtocolConnect}, http.Header{})
+	if first != second {
+		t.Errorf("callerIdentity() differs between connections: %q, %q", first, second)
+	}
+}
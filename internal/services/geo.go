@@ -0,0 +1,24 @@
+package services
+
+import "math"
+
+// Mean Earth radius (m)
+const earthRadius = 6371000.0
+
+// horizontalOffset
+// Returns the north/east offset (m) from one WGS84 position (degrees) to another.
+// Uses an equirectangular approximation, which is accurate for the distances flown by a drone.
+func horizontalOffset(fromLat, fromLon, toLat, toLon float64) (north, east float64) {
+	dLon := toLon - fromLon
+	// Take the short way around the antimeridian
+	if dLon > 180 {
+		dLon -= 360
+	} else if dLon < -180 {
+		dLon += 360
+	}
+
+	meanLat := (fromLat + toLat) / 2 * math.Pi / 180
+	north = (toLat - fromLat) * math.Pi / 180 * earthRadius
+	east = dLon * math.Pi / 180 * earthRadius * math.Cos(meanLat)
+	return north, east
+}
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+	"github.com/flightpath-dev/flightpath/internal/mavlink/message_converters"
+)
+
+// GPS instances, MAVLink sends the first receiver in GPS_RAW_INT/GPS_RTK and the second in GPS2_RAW/GPS2_RTK
+const (
+	firstGpsInstance  = 0
+	secondGpsInstance = 1
+)
+
+// SubscribeGpsReceivers
+// Streams the readings of the first (GPS_RAW_INT) and second (GPS2_RAW) GPS receivers from the
+// MAVLink connection, tagged with the instance of the receiver they came from.
+func (s *TelemetryService) SubscribeGpsReceivers(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeGpsReceiversRequest],
+	stream *connect.ServerStream[flightpath.SubscribeGpsReceiversResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to GPS_RAW_INT and GPS2_RAW events from the centralized dispatcher
+	gpsRawIntChan := s.ctx.Dispatcher.SubscribeGpsRawInt(ctx)
+	gps2RawChan := s.ctx.Dispatcher.SubscribeGps2Raw(ctx)
+
+	// Stream GPS_RAW_INT and GPS2_RAW messages to client
+	for {
+		var response *flightpath.SubscribeGpsReceiversResponse
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-gpsRawIntChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			response = &flightpath.SubscribeGpsReceiversResponse{
+				TimestampMs: time.Now().UnixMilli(),
+				SystemId:    uint32(event.SystemID),
+				ComponentId: uint32(event.ComponentID),
+				GpsInstance: firstGpsInstance,
+				Receiver:    message_converters.GpsRawIntToGpsReceiver(event.GpsRawInt),
+				GpsRawInt:   event.GpsRawInt,
+			}
+		case event, ok := <-gps2RawChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			response = &flightpath.SubscribeGpsReceiversResponse{
+				TimestampMs: time.Now().UnixMilli(),
+				SystemId:    uint32(event.SystemID),
+				ComponentId: uint32(event.ComponentID),
+				GpsInstance: secondGpsInstance,
+				Receiver:    message_converters.Gps2RawToGpsReceiver(event.Gps2Raw),
+				Gps2Raw:     event.Gps2Raw,
+			}
+		}
+
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+	}
+}
+
+// SubscribeGpsStatus
+// Streams GPS_STATUS messages from the MAVLink connection with the visible satellites listed.
+func (s *TelemetryService) SubscribeGpsStatus(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeGpsStatusRequest],
+	stream *connect.ServerStream[flightpath.SubscribeGpsStatusResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to GPS_STATUS events from the centralized dispatcher
+	gpsStatusChan := s.ctx.Dispatcher.SubscribeGpsStatus(ctx)
+
+	// Stream GPS_STATUS messages to client
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-gpsStatusChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+
+			response := &flightpath.SubscribeGpsStatusResponse{
+				TimestampMs: time.Now().UnixMilli(),
+				SystemId:    uint32(event.SystemID),
+				ComponentId: uint32(event.ComponentID),
+				Satellites:  message_converters.GpsStatusToSatellites(event.GpsStatus),
+				GpsStatus:   event.GpsStatus,
+			}
+
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SubscribeGpsRtk
+// Streams the RTK status of the first (GPS_RTK) and second (GPS2_RTK) GPS receivers from the
+// MAVLink connection, tagged with the instance of the receiver they came from.
+func (s *TelemetryService) SubscribeGpsRtk(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeGpsRtkRequest],
+	stream *connect.ServerStream[flightpath.SubscribeGpsRtkResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to GPS_RTK and GPS2_RTK events from the centralized dispatcher
+	gpsRtkChan := s.ctx.Dispatcher.SubscribeGpsRtk(ctx)
+	gps2RtkChan := s.ctx.Dispatcher.SubscribeGps2Rtk(ctx)
+
+	// Stream GPS_RTK and GPS2_RTK messages to client
+	for {
+		var systemID, componentID uint8
+		var gpsInstance uint32
+		var gpsRtk *flightpath.GpsRtk
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-gpsRtkChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			systemID, componentID, gpsInstance, gpsRtk = event.SystemID, event.ComponentID, firstGpsInstance, event.GpsRtk
+		case event, ok := <-gps2RtkChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			systemID, componentID, gpsInstance, gpsRtk = event.SystemID, event.ComponentID, secondGpsInstance, event.Gps2Rtk
+		}
+
+		response := &flightpath.SubscribeGpsRtkResponse{
+			TimestampMs: time.Now().UnixMilli(),
+			SystemId:    uint32(systemID),
+			ComponentId: uint32(componentID),
+			GpsInstance: gpsInstance,
+			Baseline:    message_converters.GpsRtkToRtkBaseline(gpsRtk),
+			GpsRtk:      gpsRtk,
+		}
+
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+	"github.com/flightpath-dev/flightpath/internal/mavlink/message_converters"
+)
+
+// How long to wait for HOME_POSITION after requesting it
+const homePositionRequestTimeout = 5 * time.Second
+
+// GetHomePosition
+// Returns the latest HOME_POSITION received from the drone.
+// If none has been received yet, requests it using MAV_CMD_REQUEST_MESSAGE and waits for it.
+func (s *TelemetryService) GetHomePosition(
+	ctx context.Context,
+	req *connect.Request[flightpath.GetHomePositionRequest],
+) (*connect.Response[flightpath.GetHomePositionResponse], error) {
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		return nil, err
+	}
+	if s.ctx.Dispatcher == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("message dispatcher is not available"))
+	}
+
+	homePosition, err := s.requestHomePosition(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&flightpath.GetHomePositionResponse{
+		Location:     message_converters.HomePositionToHomeLocation(homePosition),
+		HomePosition: homePosition,
+	}), nil
+}
+
+// requestHomePosition
+// Returns the cached home position of the target, or requests HOME_POSITION and waits up to
+// homePositionRequestTimeout for it.
+func (s *TelemetryService) requestHomePosition(ctx context.Context, target CommandTarget) (*flightpath.HomePosition, error) {
+	if event, ok := s.ctx.Dispatcher.LatestHomePosition(target.SystemID, target.ComponentID); ok {
+		return event.HomePosition, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, homePositionRequestTimeout)
+	defer cancel()
+
+	// Subscribe before sending the request so that the reply is not missed
+	homePositionChan := s.ctx.Dispatcher.SubscribeHomePosition(ctx)
+
+	// Message ID (HOME_POSITION)
+	err := s.ctx.Commands.Execute(ctx, &Command{
+		Target:  target,
+		Command: common.MAV_CMD_REQUEST_MESSAGE,
+		Params:  [7]float32{float32((&common.MessageHomePosition{}).GetID())},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, connect.NewError(
+					connect.CodeDeadlineExceeded,
+					fmt.Errorf("no HOME_POSITION received from system %d within %s", target.SystemID, homePositionRequestTimeout),
+				)
+			}
+			return nil, ctx.Err()
+
+		case event, ok := <-homePositionChan:
+			if !ok {
+				return nil, connect.NewError(connect.CodeUnavailable, errors.New("message dispatcher stopped"))
+			}
+			if event.SystemID == target.SystemID && event.ComponentID == target.ComponentID {
+				return event.HomePosition, nil
+			}
+		}
+	}
+}
+
+// SubscribeHomePosition
+// Streams HOME_POSITION messages from the MAVLink connection.
+// Drones may repeat HOME_POSITION periodically, so a message is only sent when the home location
+// of its component differs from the previous message (the first message of a component is
+// always sent).
+func (s *TelemetryService) SubscribeHomePosition(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeHomePositionRequest],
+	stream *connect.ServerStream[flightpath.SubscribeHomePositionResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to HOME_POSITION events from the centralized dispatcher
+	homePositionChan := s.ctx.Dispatcher.SubscribeHomePosition(ctx)
+
+	// Last home position sent for every component
+	lastHomePositions := make(map[componentKey]*flightpath.HomePosition)
+
+	// Stream HOME_POSITION messages to client
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-homePositionChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+
+			key := componentKey{event.SystemID, event.ComponentID}
+			homePosition := event.HomePosition
+			if last, ok := lastHomePositions[key]; ok && last.Latitude == homePosition.Latitude &&
+				last.Longitude == homePosition.Longitude && last.Altitude == homePosition.Altitude {
+				continue
+			}
+			lastHomePositions[key] = homePosition
+
+			// HOME_POSITION is already converted to protobuf by the dispatcher
+			response := &flightpath.SubscribeHomePositionResponse{
+				TimestampMs:  time.Now().UnixMilli(),
+				SystemId:     uint32(event.SystemID),
+				ComponentId:  uint32(event.ComponentID),
+				Location:     message_converters.HomePositionToHomeLocation(homePosition),
+				HomePosition: homePosition,
+			}
+
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SetHomePosition
+// Sets the home position using MAV_CMD_DO_SET_HOME (sent as COMMAND_INT for full
+// latitude/longitude precision), either to the current location or to the requested location.
+func (s *ActionService) SetHomePosition(
+	ctx context.Context,
+	req *connect.Request[flightpath.SetHomePositionRequest],
+) (*connect.Response[flightpath.SetHomePositionResponse], error) {
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+
+	// Use current (1 = current location, 0 = specified location), empty, empty, yaw (NaN = unchanged), lat, lon, alt
+	cmd := &Command{
+		Target:        target,
+		Command:       common.MAV_CMD_DO_SET_HOME,
+		Params:        [7]float32{1, 0, 0, float32(math.NaN())},
+		UseCommandInt: true,
+		Frame:         common.MAV_FRAME_GLOBAL,
+	}
+	if !msg.UseCurrentLocation {
+		if !(msg.Latitude >= -90 && msg.Latitude <= 90) || !(msg.Longitude >= -180 && msg.Longitude <= 180) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid location %v, %v", msg.Latitude, msg.Longitude))
+		}
+		if math.IsNaN(float64(msg.Altitude)) || math.IsInf(float64(msg.Altitude), 0) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid altitude %v", msg.Altitude))
+		}
+
+		cmd.Params[0] = 0
+		cmd.Params[6] = msg.Altitude
+		cmd.X = int32(math.Round(msg.Latitude * 1e7))
+		cmd.Y = int32(math.Round(msg.Longitude * 1e7))
+	}
+
+	ack, _, _, err := s.executeAdjustment(ctx, cmd, false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&flightpath.SetHomePositionResponse{
+		CommandAck: ack,
+	}), nil
+}
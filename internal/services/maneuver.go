@@ -0,0 +1,318 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+const (
+	// How long a manoeuvre may go without telemetry from the drone before it is considered lost
+	maneuverTelemetryTimeout = 5 * time.Second
+
+	// How close to the target altitude (m) a takeoff is considered complete
+	takeoffAltitudeTolerance = 0.5
+)
+
+// maneuverState holds the latest telemetry of the drone performing a manoeuvre
+type maneuverState struct {
+	HasHeartbeat bool
+	Armed        bool
+	Autopilot    flightpath.MavAutopilot
+	MainMode     flightpath.MainMode
+	SubMode      flightpath.SubMode
+
+	// Landed state from EXTENDED_SYS_STATE (UNSPECIFIED if the drone does not send it)
+	LandedState flightpath.MavLandedState
+
+	// Altitude above home from GLOBAL_POSITION_INT (m)
+	HasAltitude      bool
+	RelativeAltitude float32
+}
+
+// modeKnown
+// Reports whether the flight mode can be used to track manoeuvres (currently PX4 only, as
+// other autopilots fly takeoff and landing in modes that do not map to dedicated sub modes).
+// Manoeuvre phases fall back to landed state and altitude when it is not.
+func (st *maneuverState) modeKnown() bool {
+	return st.Autopilot == flightpath.MavAutopilot_MAV_AUTOPILOT_PX4 &&
+		st.MainMode != flightpath.MainMode_MAIN_MODE_UNSPECIFIED
+}
+
+// maneuverPhaseFunc
+// Derives the current flight phase from the drone's telemetry.
+// Returns done=true once the manoeuvre has completed, or an error if it failed.
+// FLIGHT_PHASE_UNSPECIFIED means "no change".
+type maneuverPhaseFunc func(st *maneuverState) (phase flightpath.FlightPhase, done bool, err error)
+
+// maneuverReportFunc
+// Reports a flight phase change to the client.
+type maneuverReportFunc func(phase flightpath.FlightPhase, st *maneuverState) error
+
+// runManeuver
+// Sends the command that starts a manoeuvre (takeoff, land, RTL...), then tracks the drone's
+// HEARTBEAT, EXTENDED_SYS_STATE and GLOBAL_POSITION_INT messages and reports every flight
+// phase change until the manoeuvre completes or fails.
+func (s *ActionService) runManeuver(
+	ctx context.Context,
+	cmd *Command,
+	phaseFunc maneuverPhaseFunc,
+	report maneuverReportFunc,
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("message dispatcher is not available"))
+	}
+
+	// Subscribe before sending the command so that no state change is missed
+	heartbeatChan := s.ctx.Dispatcher.SubscribeHeartbeat(ctx)
+	extendedSysStateChan := s.ctx.Dispatcher.SubscribeExtendedSysState(ctx)
+	globalPositionIntChan := s.ctx.Dispatcher.SubscribeGlobalPositionInt(ctx)
+
+	if err := s.ctx.Commands.Execute(ctx, cmd); err != nil {
+		return err
+	}
+
+	st := &maneuverState{}
+	phase := flightpath.FlightPhase_FLIGHT_PHASE_ACCEPTED
+	if err := report(phase, st); err != nil {
+		return err
+	}
+
+	target := cmd.Target
+	timer := time.NewTimer(maneuverTelemetryTimeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-timer.C:
+			return connect.NewError(
+				connect.CodeUnavailable,
+				fmt.Errorf("no telemetry received from system %d for %s", target.SystemID, maneuverTelemetryTimeout),
+			)
+
+		case event, ok := <-heartbeatChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return connect.NewError(connect.CodeUnavailable, errors.New("message dispatcher stopped"))
+			}
+			if event.SystemID != target.SystemID || event.ComponentID != target.ComponentID {
+				continue
+			}
+			st.HasHeartbeat = true
+			st.Armed = event.Heartbeat.GetBaseMode().GetSafetyArmed()
+			st.Autopilot = event.Heartbeat.GetAutopilot()
+			st.MainMode = event.Heartbeat.GetCustomMode().GetMainMode()
+			st.SubMode = event.Heartbeat.GetCustomMode().GetSubMode()
+
+		case event, ok := <-extendedSysStateChan:
+			if !ok {
+				return connect.NewError(connect.CodeUnavailable, errors.New("message dispatcher stopped"))
+			}
+			if event.SystemID != target.SystemID || event.ComponentID != target.ComponentID {
+				continue
+			}
+			st.LandedState = event.ExtendedSysState.LandedState
+
+		case event, ok := <-globalPositionIntChan:
+			if !ok {
+				return connect.NewError(connect.CodeUnavailable, errors.New("message dispatcher stopped"))
+			}
+			if event.SystemID != target.SystemID || event.ComponentID != target.ComponentID {
+				continue
+			}
+			st.HasAltitude = true
+			st.RelativeAltitude = float32(event.GlobalPositionInt.RelativeAlt) / 1000
+		}
+
+		// Telemetry received from the target, reset the watchdog
+		timer.Reset(maneuverTelemetryTimeout)
+
+		next, done, err := phaseFunc(st)
+		if err != nil {
+			return err
+		}
+		if next != flightpath.FlightPhase_FLIGHT_PHASE_UNSPECIFIED && next != phase {
+			phase = next
+			if err := report(phase, st); err != nil {
+				return err
+			}
+		}
+		if done {
+			return nil
+		}
+	}
+}
+
+// waitForGlobalPosition
+// Waits for the next GLOBAL_POSITION_INT from the target, e.g. to convert a relative altitude
+// into the AMSL altitude expected by MAV_CMD_NAV_TAKEOFF on PX4.
+func (s *ActionService) waitForGlobalPosition(
+	ctx context.Context,
+	target CommandTarget,
+) (*flightpath.GlobalPositionInt, error) {
+	if s.ctx.Dispatcher == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("message dispatcher is not available"))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, maneuverTelemetryTimeout)
+	defer cancel()
+
+	globalPositionIntChan := s.ctx.Dispatcher.SubscribeGlobalPositionInt(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, connect.NewError(
+					connect.CodeUnavailable,
+					fmt.Errorf("no position received from system %d for %s", target.SystemID, maneuverTelemetryTimeout),
+				)
+			}
+			return nil, ctx.Err()
+
+		case event, ok := <-globalPositionIntChan:
+			if !ok {
+				return nil, connect.NewError(connect.CodeUnavailable, errors.New("message dispatcher stopped"))
+			}
+			if event.SystemID == target.SystemID && event.ComponentID == target.ComponentID {
+				return event.GlobalPositionInt, nil
+			}
+		}
+	}
+}
+
+// takeoffPhases
+// Tracks a takeoff: CLIMBING until the drone is in the air and has either reached the
+// target altitude (if known) or switched from AUTO_TAKEOFF to AUTO_LOITER, then HOVERING.
+// Without a target altitude nor a known flight mode, the takeoff completes once the drone is in
+// the air, as there is nothing else to tell that the climb has ended.
+func takeoffPhases(targetAltitude float32, hasTargetAltitude bool) maneuverPhaseFunc {
+	entered := false
+	return func(st *maneuverState) (flightpath.FlightPhase, bool, error) {
+		if !st.HasHeartbeat {
+			return flightpath.FlightPhase_FLIGHT_PHASE_UNSPECIFIED, false, nil
+		}
+		if isTakeoffSubMode(st.SubMode) || !st.modeKnown() {
+			entered = true
+		}
+		if !entered {
+			// Telemetry from before the mode switch
+			return flightpath.FlightPhase_FLIGHT_PHASE_UNSPECIFIED, false, nil
+		}
+		if !st.Armed {
+			return 0, false, connect.NewError(connect.CodeAborted, errors.New("takeoff aborted: drone disarmed"))
+		}
+
+		inAir := st.LandedState == flightpath.MavLandedState_MAV_LANDED_STATE_IN_AIR
+		reached := hasTargetAltitude && st.HasAltitude && st.RelativeAltitude >= targetAltitude-takeoffAltitudeTolerance
+		if st.modeKnown() && !isTakeoffSubMode(st.SubMode) {
+			if st.SubMode == flightpath.SubMode_SUB_MODE_AUTO_LOITER || reached {
+				return flightpath.FlightPhase_FLIGHT_PHASE_HOVERING, true, nil
+			}
+			return 0, false, connect.NewError(
+				connect.CodeAborted,
+				fmt.Errorf("takeoff interrupted: flight mode changed to %s/%s", st.MainMode, st.SubMode),
+			)
+		}
+		if inAir && (reached || (!hasTargetAltitude && !st.modeKnown())) {
+			return flightpath.FlightPhase_FLIGHT_PHASE_HOVERING, true, nil
+		}
+		if inAir || st.LandedState == flightpath.MavLandedState_MAV_LANDED_STATE_TAKEOFF {
+			return flightpath.FlightPhase_FLIGHT_PHASE_CLIMBING, false, nil
+		}
+		return flightpath.FlightPhase_FLIGHT_PHASE_UNSPECIFIED, false, nil
+	}
+}
+
+// landPhases
+// Tracks a landing: DESCENDING while in AUTO_LAND, LANDED once on the ground, DISARMED once
+// the autopilot has disarmed (which completes the manoeuvre).
+func landPhases() maneuverPhaseFunc {
+	entered := false
+	return func(st *maneuverState) (flightpath.FlightPhase, bool, error) {
+		if !st.HasHeartbeat {
+			return flightpath.FlightPhase_FLIGHT_PHASE_UNSPECIFIED, false, nil
+		}
+		if isLandSubMode(st.SubMode) || !st.modeKnown() {
+			entered = true
+		}
+		if !entered {
+			return flightpath.FlightPhase_FLIGHT_PHASE_UNSPECIFIED, false, nil
+		}
+		return landingPhase(st, "landing", !st.modeKnown() || isLandSubMode(st.SubMode))
+	}
+}
+
+// returnToLaunchPhases
+// Tracks a return-to-launch: RETURNING while flying back, then DESCENDING, LANDED and DISARMED.
+// If the autopilot is configured to hover above home instead of landing (AUTO_LOITER after
+// AUTO_RTL), the manoeuvre completes with HOVERING.
+func returnToLaunchPhases() maneuverPhaseFunc {
+	entered := false
+	return func(st *maneuverState) (flightpath.FlightPhase, bool, error) {
+		if !st.HasHeartbeat {
+			return flightpath.FlightPhase_FLIGHT_PHASE_UNSPECIFIED, false, nil
+		}
+		if st.SubMode == flightpath.SubMode_SUB_MODE_AUTO_RTL || !st.modeKnown() {
+			entered = true
+		}
+		if !entered {
+			return flightpath.FlightPhase_FLIGHT_PHASE_UNSPECIFIED, false, nil
+		}
+
+		onGround := st.LandedState == flightpath.MavLandedState_MAV_LANDED_STATE_ON_GROUND
+		if st.modeKnown() && st.SubMode != flightpath.SubMode_SUB_MODE_AUTO_RTL && st.Armed && !onGround {
+			if st.SubMode == flightpath.SubMode_SUB_MODE_AUTO_LOITER {
+				return flightpath.FlightPhase_FLIGHT_PHASE_HOVERING, true, nil
+			}
+			if !isLandSubMode(st.SubMode) {
+				return 0, false, connect.NewError(
+					connect.CodeAborted,
+					fmt.Errorf("return to launch interrupted: flight mode changed to %s/%s", st.MainMode, st.SubMode),
+				)
+			}
+		}
+		if st.Armed && st.LandedState == flightpath.MavLandedState_MAV_LANDED_STATE_IN_AIR && !isLandSubMode(st.SubMode) {
+			return flightpath.FlightPhase_FLIGHT_PHASE_RETURNING, false, nil
+		}
+		descentMode := !st.modeKnown() || isLandSubMode(st.SubMode) || st.SubMode == flightpath.SubMode_SUB_MODE_AUTO_RTL
+		return landingPhase(st, "return to launch", descentMode)
+	}
+}
+
+// landingPhase
+// Derives the phase of the final descent shared by Land and ReturnToLaunch.
+// descentMode reports whether the current flight mode is one the descent is expected in.
+func landingPhase(st *maneuverState, maneuver string, descentMode bool) (flightpath.FlightPhase, bool, error) {
+	if !st.Armed {
+		return flightpath.FlightPhase_FLIGHT_PHASE_DISARMED, true, nil
+	}
+	if st.LandedState == flightpath.MavLandedState_MAV_LANDED_STATE_ON_GROUND {
+		return flightpath.FlightPhase_FLIGHT_PHASE_LANDED, false, nil
+	}
+	if !descentMode {
+		return 0, false, connect.NewError(
+			connect.CodeAborted,
+			fmt.Errorf("%s interrupted: flight mode changed to %s/%s", maneuver, st.MainMode, st.SubMode),
+		)
+	}
+	return flightpath.FlightPhase_FLIGHT_PHASE_DESCENDING, false, nil
+}
+
+// isTakeoffSubMode reports whether the sub mode is one of the takeoff modes
+func isTakeoffSubMode(subMode flightpath.SubMode) bool {
+	return subMode == flightpath.SubMode_SUB_MODE_AUTO_TAKEOFF ||
+		subMode == flightpath.SubMode_SUB_MODE_AUTO_VTOL_TAKEOFF
+}
+
+// isLandSubMode reports whether the sub mode is one of the landing modes
+func isLandSubMode(subMode flightpath.SubMode) bool {
+	return subMode == flightpath.SubMode_SUB_MODE_AUTO_LAND ||
+		subMode == flightpath.SubMode_SUB_MODE_AUTO_PRECLAND
+}
@@ -0,0 +1,252 @@
+package services
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// phaseStep is a telemetry update fed to a maneuverPhaseFunc and the expected outcome
+type phaseStep struct {
+	st       maneuverState
+	want     flightpath.FlightPhase
+	wantDone bool
+	wantCode connect.Code
+}
+
+// px4State returns the state of an armed PX4 drone in the given flight mode
+func px4State(mainMode flightpath.MainMode, subMode flightpath.SubMode, landedState flightpath.MavLandedState) maneuverState {
+	return maneuverState{
+		HasHeartbeat: true,
+		Armed:        true,
+		Autopilot:    flightpath.MavAutopilot_MAV_AUTOPILOT_PX4,
+		MainMode:     mainMode,
+		SubMode:      subMode,
+		LandedState:  landedState,
+	}
+}
+
+// unknownModeState returns the state of an armed drone whose flight mode cannot be decoded
+func unknownModeState(landedState flightpath.MavLandedState) maneuverState {
+	return maneuverState{
+		HasHeartbeat: true,
+		Armed:        true,
+		LandedState:  landedState,
+	}
+}
+
+func withAltitude(st maneuverState, altitude float32) maneuverState {
+	st.HasAltitude = true
+	st.RelativeAltitude = altitude
+	return st
+}
+
+func disarmed(st maneuverState) maneuverState {
+	st.Armed = false
+	return st
+}
+
+const (
+	auto          = flightpath.MainMode_MAIN_MODE_AUTO
+	posctl        = flightpath.MainMode_MAIN_MODE_POSCTL
+	autoTakeoff   = flightpath.SubMode_SUB_MODE_AUTO_TAKEOFF
+	autoLoiter    = flightpath.SubMode_SUB_MODE_AUTO_LOITER
+	autoLand      = flightpath.SubMode_SUB_MODE_AUTO_LAND
+	autoRtl       = flightpath.SubMode_SUB_MODE_AUTO_RTL
+	noSubMode     = flightpath.SubMode_SUB_MODE_UNSPECIFIED
+	onGround      = flightpath.MavLandedState_MAV_LANDED_STATE_ON_GROUND
+	takingOff     = flightpath.MavLandedState_MAV_LANDED_STATE_TAKEOFF
+	inAir         = flightpath.MavLandedState_MAV_LANDED_STATE_IN_AIR
+	noPhase       = flightpath.FlightPhase_FLIGHT_PHASE_UNSPECIFIED
+	climbing      = flightpath.FlightPhase_FLIGHT_PHASE_CLIMBING
+	hovering      = flightpath.FlightPhase_FLIGHT_PHASE_HOVERING
+	descending    = flightpath.FlightPhase_FLIGHT_PHASE_DESCENDING
+	landed        = flightpath.FlightPhase_FLIGHT_PHASE_LANDED
+	disarmedPhase = flightpath.FlightPhase_FLIGHT_PHASE_DISARMED
+	returning     = flightpath.FlightPhase_FLIGHT_PHASE_RETURNING
+)
+
+func runPhaseSteps(t *testing.T, phases maneuverPhaseFunc, steps []phaseStep) {
+	t.Helper()
+
+	for i, step := range steps {
+		st := step.st
+		phase, done, err := phases(&st)
+		if step.wantCode != 0 {
+			if connect.CodeOf(err) != step.wantCode {
+				t.Fatalf("step %d: error = %v, want code %s", i, err, step.wantCode)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("step %d: unexpected error %v", i, err)
+		}
+		if phase != step.want || done != step.wantDone {
+			t.Fatalf("step %d: got (%s, done=%v), want (%s, done=%v)", i, phase, done, step.want, step.wantDone)
+		}
+	}
+}
+
+func TestTakeoffPhases(t *testing.T) {
+	tests := []struct {
+		name              string
+		targetAltitude    float32
+		hasTargetAltitude bool
+		steps             []phaseStep
+	}{
+		{
+			name: "no heartbeat yet",
+			steps: []phaseStep{
+				{st: maneuverState{LandedState: onGround}, want: noPhase},
+			},
+		},
+		{
+			name:              "px4 climbs then hovers in loiter",
+			targetAltitude:    10,
+			hasTargetAltitude: true,
+			steps: []phaseStep{
+				// Heartbeat from before the mode switch is ignored
+				{st: px4State(posctl, noSubMode, onGround), want: noPhase},
+				{st: px4State(auto, autoTakeoff, onGround), want: noPhase},
+				{st: px4State(auto, autoTakeoff, takingOff), want: climbing},
+				{st: withAltitude(px4State(auto, autoTakeoff, inAir), 5), want: climbing},
+				{st: withAltitude(px4State(auto, autoLoiter, inAir), 10), want: hovering, wantDone: true},
+			},
+		},
+		{
+			name: "px4 default altitude hovers in loiter",
+			steps: []phaseStep{
+				{st: px4State(auto, autoTakeoff, inAir), want: climbing},
+				{st: px4State(auto, autoLoiter, inAir), want: hovering, wantDone: true},
+			},
+		},
+		{
+			name:              "px4 mode change aborts",
+			targetAltitude:    10,
+			hasTargetAltitude: true,
+			steps: []phaseStep{
+				{st: px4State(auto, autoTakeoff, inAir), want: climbing},
+				{st: withAltitude(px4State(posctl, noSubMode, inAir), 3), wantCode: connect.CodeAborted},
+			},
+		},
+		{
+			name:              "disarm aborts",
+			targetAltitude:    10,
+			hasTargetAltitude: true,
+			steps: []phaseStep{
+				{st: px4State(auto, autoTakeoff, takingOff), want: climbing},
+				{st: disarmed(px4State(auto, autoTakeoff, onGround)), wantCode: connect.CodeAborted},
+			},
+		},
+		{
+			name:              "unknown mode completes at target altitude",
+			targetAltitude:    10,
+			hasTargetAltitude: true,
+			steps: []phaseStep{
+				{st: unknownModeState(onGround), want: noPhase},
+				{st: withAltitude(unknownModeState(inAir), 5), want: climbing},
+				{st: withAltitude(unknownModeState(inAir), 9.6), want: hovering, wantDone: true},
+			},
+		},
+		{
+			name: "unknown mode without target altitude completes in the air",
+			steps: []phaseStep{
+				{st: unknownModeState(takingOff), want: climbing},
+				{st: unknownModeState(inAir), want: hovering, wantDone: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runPhaseSteps(t, takeoffPhases(tt.targetAltitude, tt.hasTargetAltitude), tt.steps)
+		})
+	}
+}
+
+func TestLandPhases(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []phaseStep
+	}{
+		{
+			name: "px4 descends, lands and disarms",
+			steps: []phaseStep{
+				// Heartbeat from before the mode switch is ignored
+				{st: px4State(auto, autoLoiter, inAir), want: noPhase},
+				{st: px4State(auto, autoLand, inAir), want: descending},
+				{st: px4State(auto, autoLand, onGround), want: landed},
+				{st: disarmed(px4State(auto, autoLand, onGround)), want: disarmedPhase, wantDone: true},
+			},
+		},
+		{
+			name: "px4 mode change aborts",
+			steps: []phaseStep{
+				{st: px4State(auto, autoLand, inAir), want: descending},
+				{st: px4State(posctl, noSubMode, inAir), wantCode: connect.CodeAborted},
+			},
+		},
+		{
+			name: "unknown mode descends, lands and disarms",
+			steps: []phaseStep{
+				{st: unknownModeState(inAir), want: descending},
+				{st: unknownModeState(onGround), want: landed},
+				{st: disarmed(unknownModeState(onGround)), want: disarmedPhase, wantDone: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runPhaseSteps(t, landPhases(), tt.steps)
+		})
+	}
+}
+
+func TestReturnToLaunchPhases(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []phaseStep
+	}{
+		{
+			name: "px4 returns, lands and disarms",
+			steps: []phaseStep{
+				// Heartbeat from before the mode switch is ignored
+				{st: px4State(posctl, noSubMode, inAir), want: noPhase},
+				{st: px4State(auto, autoRtl, inAir), want: returning},
+				{st: px4State(auto, autoLand, inAir), want: descending},
+				{st: px4State(auto, autoLand, onGround), want: landed},
+				{st: disarmed(px4State(auto, autoLand, onGround)), want: disarmedPhase, wantDone: true},
+			},
+		},
+		{
+			name: "px4 hovers above home",
+			steps: []phaseStep{
+				{st: px4State(auto, autoRtl, inAir), want: returning},
+				{st: px4State(auto, autoLoiter, inAir), want: hovering, wantDone: true},
+			},
+		},
+		{
+			name: "px4 mode change aborts",
+			steps: []phaseStep{
+				{st: px4State(auto, autoRtl, inAir), want: returning},
+				{st: px4State(posctl, noSubMode, inAir), wantCode: connect.CodeAborted},
+			},
+		},
+		{
+			name: "unknown mode returns, lands and disarms",
+			steps: []phaseStep{
+				{st: unknownModeState(inAir), want: returning},
+				{st: unknownModeState(onGround), want: landed},
+				{st: disarmed(unknownModeState(onGround)), want: disarmedPhase, wantDone: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runPhaseSteps(t, returnToLaunchPhases(), tt.steps)
+		})
+	}
+}
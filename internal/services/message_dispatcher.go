@@ -24,139 +24,1000 @@ type GpsRawIntEvent struct {
 	GpsRawInt   *flightpath.GpsRawInt
 }
 
+// GlobalPositionIntEvent contains a converted protobuf GLOBAL_POSITION_INT message with its system/component IDs
+type GlobalPositionIntEvent struct {
+	SystemID          uint8
+	ComponentID       uint8
+	GlobalPositionInt *flightpath.GlobalPositionInt
+}
+
+// ExtendedSysStateEvent contains a converted protobuf EXTENDED_SYS_STATE message with its system/component IDs
+type ExtendedSysStateEvent struct {
+	SystemID         uint8
+	ComponentID      uint8
+	ExtendedSysState *flightpath.ExtendedSysState
+}
+
+// OrbitExecutionStatusEvent contains a converted protobuf ORBIT_EXECUTION_STATUS message with its system/component IDs
+type OrbitExecutionStatusEvent struct {
+	SystemID             uint8
+	ComponentID          uint8
+	OrbitExecutionStatus *flightpath.OrbitExecutionStatus
+}
+
+// VfrHudEvent contains a converted protobuf VFR_HUD message with its system/component IDs
+type VfrHudEvent struct {
+	SystemID    uint8
+	ComponentID uint8
+	VfrHud      *flightpath.VfrHud
+}
+
+// AttitudeEvent contains a converted protobuf ATTITUDE message with its system/component IDs
+type AttitudeEvent struct {
+	SystemID    uint8
+	ComponentID uint8
+	Attitude    *flightpath.Attitude
+}
+
+// AttitudeQuaternionEvent contains a converted protobuf ATTITUDE_QUATERNION message with its system/component IDs
+type AttitudeQuaternionEvent struct {
+	SystemID           uint8
+	ComponentID        uint8
+	AttitudeQuaternion *flightpath.AttitudeQuaternion
+}
+
+// LocalPositionNedEvent contains a converted protobuf LOCAL_POSITION_NED message with its system/component IDs
+type LocalPositionNedEvent struct {
+	SystemID         uint8
+	ComponentID      uint8
+	LocalPositionNed *flightpath.LocalPositionNed
+}
+
+// OdometryEvent contains a converted protobuf ODOMETRY message with its system/component IDs
+type OdometryEvent struct {
+	SystemID    uint8
+	ComponentID uint8
+	Odometry    *flightpath.Odometry
+}
+
+// BatteryStatusEvent contains a converted protobuf BATTERY_STATUS message with its system/component IDs
+type BatteryStatusEvent struct {
+	SystemID      uint8
+	ComponentID   uint8
+	BatteryStatus *flightpath.BatteryStatus
+}
+
+// SysStatusEvent contains a converted protobuf SYS_STATUS message with its system/component IDs
+type SysStatusEvent struct {
+	SystemID    uint8
+	ComponentID uint8
+	SysStatus   *flightpath.SysStatus
+}
+
+// StatusTextEvent contains a converted protobuf STATUSTEXT message with its system/component IDs
+type StatusTextEvent struct {
+	SystemID    uint8
+	ComponentID uint8
+	StatusText  *flightpath.StatusText
+}
+
+// HomePositionEvent contains a converted protobuf HOME_POSITION message with its system/component IDs
+type HomePositionEvent struct {
+	SystemID     uint8
+	ComponentID  uint8
+	HomePosition *flightpath.HomePosition
+}
+
+// RcChannelsEvent contains a converted protobuf RC_CHANNELS message with its system/component IDs
+type RcChannelsEvent struct {
+	SystemID    uint8
+	ComponentID uint8
+	RcChannels  *flightpath.RcChannels
+}
+
+// ServoOutputRawEvent contains a converted protobuf SERVO_OUTPUT_RAW message with its system/component IDs
+type ServoOutputRawEvent struct {
+	SystemID       uint8
+	ComponentID    uint8
+	ServoOutputRaw *flightpath.ServoOutputRaw
+}
+
+// ActuatorOutputStatusEvent contains a converted protobuf ACTUATOR_OUTPUT_STATUS message with its system/component IDs
+type ActuatorOutputStatusEvent struct {
+	SystemID             uint8
+	ComponentID          uint8
+	ActuatorOutputStatus *flightpath.ActuatorOutputStatus
+}
+
+// EstimatorStatusEvent contains a converted protobuf ESTIMATOR_STATUS message with its system/component IDs
+type EstimatorStatusEvent struct {
+	SystemID        uint8
+	ComponentID     uint8
+	EstimatorStatus *flightpath.EstimatorStatus
+}
+
+// VibrationEvent contains a converted protobuf VIBRATION message with its system/component IDs
+type VibrationEvent struct {
+	SystemID    uint8
+	ComponentID uint8
+	Vibration   *flightpath.Vibration
+}
+
+// WindCovEvent contains a converted protobuf WIND_COV message with its system/component IDs
+type WindCovEvent struct {
+	SystemID    uint8
+	ComponentID uint8
+	WindCov     *flightpath.WindCov
+}
+
+// DistanceSensorEvent contains a converted protobuf DISTANCE_SENSOR message with its system/component IDs
+type DistanceSensorEvent struct {
+	SystemID       uint8
+	ComponentID    uint8
+	DistanceSensor *flightpath.DistanceSensor
+}
+
+// ObstacleDistanceEvent contains a converted protobuf OBSTACLE_DISTANCE message with its system/component IDs
+type ObstacleDistanceEvent struct {
+	SystemID         uint8
+	ComponentID      uint8
+	ObstacleDistance *flightpath.ObstacleDistance
+}
+
+// Gps2RawEvent contains a converted protobuf GPS2_RAW message with its system/component IDs
+type Gps2RawEvent struct {
+	SystemID    uint8
+	ComponentID uint8
+	Gps2Raw     *flightpath.Gps2Raw
+}
+
+// GpsStatusEvent contains a converted protobuf GPS_STATUS message with its system/component IDs
+type GpsStatusEvent struct {
+	SystemID    uint8
+	ComponentID uint8
+	GpsStatus   *flightpath.GpsStatus
+}
+
+// GpsRtkEvent contains a converted protobuf GPS_RTK message with its system/component IDs
+type GpsRtkEvent struct {
+	SystemID    uint8
+	ComponentID uint8
+	GpsRtk      *flightpath.GpsRtk
+}
+
+// Gps2RtkEvent contains a converted protobuf GPS2_RTK message with its system/component IDs
+type Gps2RtkEvent struct {
+	SystemID    uint8
+	ComponentID uint8
+	Gps2Rtk     *flightpath.GpsRtk
+}
+
+// AdsbVehicleEvent contains a converted protobuf ADSB_VEHICLE message with its system/component IDs
+type AdsbVehicleEvent struct {
+	SystemID    uint8
+	ComponentID uint8
+	AdsbVehicle *flightpath.AdsbVehicle
+}
+
+// CommandAckEvent contains a COMMAND_ACK message with its system/component IDs.
+// COMMAND_ACK is only consumed internally (by command senders), so it is not converted to protobuf.
+type CommandAckEvent struct {
+	SystemID    uint8
+	ComponentID uint8
+	CommandAck  *common.MessageCommandAck
+}
+
+// componentKey identifies a MAVLink component
+type componentKey struct {
+	systemID    uint8
+	componentID uint8
+}
+
+// subscriberList
+// Holds the subscriber channels of a single message topic.
+type subscriberList[T any] struct {
+	subscribers []chan T
+	mu          sync.RWMutex
+}
+
+// add
+// Creates a new subscriber channel and adds it to the list.
+func (l *subscriberList[T]) add() chan T {
+	ch := make(chan T, 10)
+
+	l.mu.Lock()
+	l.subscribers = append(l.subscribers, ch)
+	l.mu.Unlock()
+
+	return ch
+}
+
+// remove
+// Removes a subscriber channel from the list and closes it.
+func (l *subscriberList[T]) remove(ch chan T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i, subscriber := range l.subscribers {
+		if subscriber == ch {
+			// Remove from slice
+			l.subscribers = append(l.subscribers[:i], l.subscribers[i+1:]...)
+			close(ch)
+			return
+		}
+	}
+}
+
+// closeAll
+// Closes all subscriber channels and empties the list.
+func (l *subscriberList[T]) closeAll() {
+	l.mu.Lock()
+	for _, ch := range l.subscribers {
+		close(ch)
+	}
+	l.subscribers = nil
+	l.mu.Unlock()
+}
+
+// broadcast
+// Sends an event to all subscribers (non-blocking).
+// The read lock is held while sending so that a concurrent remove() cannot close a channel mid-send.
+func (l *subscriberList[T]) broadcast(event T) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	for _, ch := range l.subscribers {
+		select {
+		case ch <- event:
+		default:
+			// Channel full, skip this subscriber to avoid blocking
+		}
+	}
+}
+
 // MessageDispatcher
 // Central dispatcher that reads from MAVLink node events and routes messages
 // to topic-specific channels. Supports multiple subscribers per message type.
 type MessageDispatcher struct {
 	node *gomavlib.Node
 
-	// Heartbeat subscribers
-	heartbeatSubscribers []chan HeartbeatEvent
-	heartbeatMu          sync.RWMutex
+	// Subscribers per message type
+	heartbeat            subscriberList[HeartbeatEvent]
+	gpsRawInt            subscriberList[GpsRawIntEvent]
+	globalPositionInt    subscriberList[GlobalPositionIntEvent]
+	extendedSysState     subscriberList[ExtendedSysStateEvent]
+	orbitExecutionStatus subscriberList[OrbitExecutionStatusEvent]
+	vfrHud               subscriberList[VfrHudEvent]
+	attitude             subscriberList[AttitudeEvent]
+	attitudeQuaternion   subscriberList[AttitudeQuaternionEvent]
+	localPositionNed     subscriberList[LocalPositionNedEvent]
+	odometry             subscriberList[OdometryEvent]
+	batteryStatus        subscriberList[BatteryStatusEvent]
+	sysStatus            subscriberList[SysStatusEvent]
+	statusText           subscriberList[StatusTextEvent]
+	homePosition         subscriberList[HomePositionEvent]
+	rcChannels           subscriberList[RcChannelsEvent]
+	servoOutputRaw       subscriberList[ServoOutputRawEvent]
+	actuatorOutputStatus subscriberList[ActuatorOutputStatusEvent]
+	estimatorStatus      subscriberList[EstimatorStatusEvent]
+	vibration            subscriberList[VibrationEvent]
+	windCov              subscriberList[WindCovEvent]
+	distanceSensor       subscriberList[DistanceSensorEvent]
+	obstacleDistance     subscriberList[ObstacleDistanceEvent]
+	gps2Raw              subscriberList[Gps2RawEvent]
+	gpsStatus            subscriberList[GpsStatusEvent]
+	gpsRtk               subscriberList[GpsRtkEvent]
+	gps2Rtk              subscriberList[Gps2RtkEvent]
+	adsbVehicle          subscriberList[AdsbVehicleEvent]
+	commandAck           subscriberList[CommandAckEvent]
+
+	// Latest heartbeat per component, for services that need the autopilot/vehicle type
+	// or current state without waiting for the next heartbeat
+	latestHeartbeats   map[componentKey]HeartbeatEvent
+	latestHeartbeatsMu sync.RWMutex
+
+	// Latest home position per component, as HOME_POSITION is only sent occasionally
+	latestHomePositions   map[componentKey]HomePositionEvent
+	latestHomePositionsMu sync.RWMutex
+
+	// Context for graceful shutdown
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewMessageDispatcher
+// Creates a new message dispatcher that will start processing events from the node.
+func NewMessageDispatcher(node *gomavlib.Node) *MessageDispatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &MessageDispatcher{
+		node:                node,
+		latestHeartbeats:    make(map[componentKey]HeartbeatEvent),
+		latestHomePositions: make(map[componentKey]HomePositionEvent),
+		ctx:                 ctx,
+		cancel:              cancel,
+	}
+}
+
+// Start
+// Starts the dispatcher goroutine that reads from node.Events() and routes messages.
+// This should be called once when the server starts.
+func (d *MessageDispatcher) Start() {
+	d.wg.Add(1)
+	go d.run()
+}
+
+// Stop
+// Stops the dispatcher and closes all subscriber channels.
+func (d *MessageDispatcher) Stop() {
+	d.cancel()
+	d.wg.Wait()
+
+	// Close all subscriber channels
+	d.heartbeat.closeAll()
+	d.gpsRawInt.closeAll()
+	d.globalPositionInt.closeAll()
+	d.extendedSysState.closeAll()
+	d.orbitExecutionStatus.closeAll()
+	d.vfrHud.closeAll()
+	d.attitude.closeAll()
+	d.attitudeQuaternion.closeAll()
+	d.localPositionNed.closeAll()
+	d.odometry.closeAll()
+	d.batteryStatus.closeAll()
+	d.sysStatus.closeAll()
+	d.statusText.closeAll()
+	d.homePosition.closeAll()
+	d.rcChannels.closeAll()
+	d.servoOutputRaw.closeAll()
+	d.actuatorOutputStatus.closeAll()
+	d.estimatorStatus.closeAll()
+	d.vibration.closeAll()
+	d.windCov.closeAll()
+	d.distanceSensor.closeAll()
+	d.obstacleDistance.closeAll()
+	d.gps2Raw.closeAll()
+	d.gpsStatus.closeAll()
+	d.gpsRtk.closeAll()
+	d.gps2Rtk.closeAll()
+	d.adsbVehicle.closeAll()
+	d.commandAck.closeAll()
+}
+
+// SubscribeHeartbeat
+// Subscribes to heartbeat messages. Returns a channel that will receive heartbeat events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeHeartbeat is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeHeartbeat(ctx context.Context) <-chan HeartbeatEvent {
+	ch := d.heartbeat.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeHeartbeat(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeHeartbeat
+// Removes a heartbeat subscriber channel.
+func (d *MessageDispatcher) UnsubscribeHeartbeat(ch chan HeartbeatEvent) {
+	d.heartbeat.remove(ch)
+}
+
+// LatestHeartbeat
+// Returns the most recent heartbeat received from the given component, if any.
+func (d *MessageDispatcher) LatestHeartbeat(systemID, componentID uint8) (HeartbeatEvent, bool) {
+	d.latestHeartbeatsMu.RLock()
+	defer d.latestHeartbeatsMu.RUnlock()
+
+	event, ok := d.latestHeartbeats[componentKey{systemID, componentID}]
+	return event, ok
+}
+
+// SubscribeGpsRawInt
+// Subscribes to GPS_RAW_INT messages. Returns a channel that will receive GPS_RAW_INT events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeGpsRawInt is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeGpsRawInt(ctx context.Context) <-chan GpsRawIntEvent {
+	ch := d.gpsRawInt.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeGpsRawInt(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeGpsRawInt
+// Removes a GPS_RAW_INT subscriber channel.
+func (d *MessageDispatcher) UnsubscribeGpsRawInt(ch chan GpsRawIntEvent) {
+	d.gpsRawInt.remove(ch)
+}
+
+// SubscribeGlobalPositionInt
+// Subscribes to GLOBAL_POSITION_INT messages. Returns a channel that will receive GLOBAL_POSITION_INT events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeGlobalPositionInt is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeGlobalPositionInt(ctx context.Context) <-chan GlobalPositionIntEvent {
+	ch := d.globalPositionInt.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeGlobalPositionInt(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeGlobalPositionInt
+// Removes a GLOBAL_POSITION_INT subscriber channel.
+func (d *MessageDispatcher) UnsubscribeGlobalPositionInt(ch chan GlobalPositionIntEvent) {
+	d.globalPositionInt.remove(ch)
+}
+
+// SubscribeExtendedSysState
+// Subscribes to EXTENDED_SYS_STATE messages. Returns a channel that will receive EXTENDED_SYS_STATE events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeExtendedSysState is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeExtendedSysState(ctx context.Context) <-chan ExtendedSysStateEvent {
+	ch := d.extendedSysState.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeExtendedSysState(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeExtendedSysState
+// Removes an EXTENDED_SYS_STATE subscriber channel.
+func (d *MessageDispatcher) UnsubscribeExtendedSysState(ch chan ExtendedSysStateEvent) {
+	d.extendedSysState.remove(ch)
+}
+
+// SubscribeOrbitExecutionStatus
+// Subscribes to ORBIT_EXECUTION_STATUS messages. Returns a channel that will receive ORBIT_EXECUTION_STATUS events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeOrbitExecutionStatus is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeOrbitExecutionStatus(ctx context.Context) <-chan OrbitExecutionStatusEvent {
+	ch := d.orbitExecutionStatus.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeOrbitExecutionStatus(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeOrbitExecutionStatus
+// Removes an ORBIT_EXECUTION_STATUS subscriber channel.
+func (d *MessageDispatcher) UnsubscribeOrbitExecutionStatus(ch chan OrbitExecutionStatusEvent) {
+	d.orbitExecutionStatus.remove(ch)
+}
+
+// SubscribeVfrHud
+// Subscribes to VFR_HUD messages. Returns a channel that will receive VFR_HUD events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeVfrHud is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeVfrHud(ctx context.Context) <-chan VfrHudEvent {
+	ch := d.vfrHud.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeVfrHud(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeVfrHud
+// Removes a VFR_HUD subscriber channel.
+func (d *MessageDispatcher) UnsubscribeVfrHud(ch chan VfrHudEvent) {
+	d.vfrHud.remove(ch)
+}
+
+// SubscribeAttitude
+// Subscribes to ATTITUDE messages. Returns a channel that will receive ATTITUDE events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeAttitude is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeAttitude(ctx context.Context) <-chan AttitudeEvent {
+	ch := d.attitude.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeAttitude(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeAttitude
+// Removes an ATTITUDE subscriber channel.
+func (d *MessageDispatcher) UnsubscribeAttitude(ch chan AttitudeEvent) {
+	d.attitude.remove(ch)
+}
+
+// SubscribeAttitudeQuaternion
+// Subscribes to ATTITUDE_QUATERNION messages. Returns a channel that will receive ATTITUDE_QUATERNION events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeAttitudeQuaternion is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeAttitudeQuaternion(ctx context.Context) <-chan AttitudeQuaternionEvent {
+	ch := d.attitudeQuaternion.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeAttitudeQuaternion(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeAttitudeQuaternion
+// Removes an ATTITUDE_QUATERNION subscriber channel.
+func (d *MessageDispatcher) UnsubscribeAttitudeQuaternion(ch chan AttitudeQuaternionEvent) {
+	d.attitudeQuaternion.remove(ch)
+}
+
+// SubscribeLocalPositionNed
+// Subscribes to LOCAL_POSITION_NED messages. Returns a channel that will receive LOCAL_POSITION_NED events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeLocalPositionNed is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeLocalPositionNed(ctx context.Context) <-chan LocalPositionNedEvent {
+	ch := d.localPositionNed.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeLocalPositionNed(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeLocalPositionNed
+// Removes a LOCAL_POSITION_NED subscriber channel.
+func (d *MessageDispatcher) UnsubscribeLocalPositionNed(ch chan LocalPositionNedEvent) {
+	d.localPositionNed.remove(ch)
+}
+
+// SubscribeOdometry
+// Subscribes to ODOMETRY messages. Returns a channel that will receive ODOMETRY events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeOdometry is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeOdometry(ctx context.Context) <-chan OdometryEvent {
+	ch := d.odometry.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeOdometry(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeOdometry
+// Removes an ODOMETRY subscriber channel.
+func (d *MessageDispatcher) UnsubscribeOdometry(ch chan OdometryEvent) {
+	d.odometry.remove(ch)
+}
+
+// SubscribeBatteryStatus
+// Subscribes to BATTERY_STATUS messages. Returns a channel that will receive BATTERY_STATUS events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeBatteryStatus is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeBatteryStatus(ctx context.Context) <-chan BatteryStatusEvent {
+	ch := d.batteryStatus.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeBatteryStatus(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeBatteryStatus
+// Removes a BATTERY_STATUS subscriber channel.
+func (d *MessageDispatcher) UnsubscribeBatteryStatus(ch chan BatteryStatusEvent) {
+	d.batteryStatus.remove(ch)
+}
+
+// SubscribeSysStatus
+// Subscribes to SYS_STATUS messages. Returns a channel that will receive SYS_STATUS events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeSysStatus is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeSysStatus(ctx context.Context) <-chan SysStatusEvent {
+	ch := d.sysStatus.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeSysStatus(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeSysStatus
+// Removes an SYS_STATUS subscriber channel.
+func (d *MessageDispatcher) UnsubscribeSysStatus(ch chan SysStatusEvent) {
+	d.sysStatus.remove(ch)
+}
+
+// SubscribeStatusText
+// Subscribes to STATUSTEXT messages. Returns a channel that will receive STATUSTEXT events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeStatusText is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeStatusText(ctx context.Context) <-chan StatusTextEvent {
+	ch := d.statusText.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeStatusText(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeStatusText
+// Removes a STATUSTEXT subscriber channel.
+func (d *MessageDispatcher) UnsubscribeStatusText(ch chan StatusTextEvent) {
+	d.statusText.remove(ch)
+}
+
+// SubscribeHomePosition
+// Subscribes to HOME_POSITION messages. Returns a channel that will receive HOME_POSITION events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeHomePosition is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeHomePosition(ctx context.Context) <-chan HomePositionEvent {
+	ch := d.homePosition.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeHomePosition(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeHomePosition
+// Removes a HOME_POSITION subscriber channel.
+func (d *MessageDispatcher) UnsubscribeHomePosition(ch chan HomePositionEvent) {
+	d.homePosition.remove(ch)
+}
+
+// LatestHomePosition
+// Returns the most recent home position received from the given component, if any.
+func (d *MessageDispatcher) LatestHomePosition(systemID, componentID uint8) (HomePositionEvent, bool) {
+	d.latestHomePositionsMu.RLock()
+	defer d.latestHomePositionsMu.RUnlock()
+
+	event, ok := d.latestHomePositions[componentKey{systemID, componentID}]
+	return event, ok
+}
+
+// SubscribeRcChannels
+// Subscribes to RC_CHANNELS messages. Returns a channel that will receive RC_CHANNELS events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeRcChannels is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeRcChannels(ctx context.Context) <-chan RcChannelsEvent {
+	ch := d.rcChannels.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeRcChannels(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeRcChannels
+// Removes an RC_CHANNELS subscriber channel.
+func (d *MessageDispatcher) UnsubscribeRcChannels(ch chan RcChannelsEvent) {
+	d.rcChannels.remove(ch)
+}
+
+// SubscribeServoOutputRaw
+// Subscribes to SERVO_OUTPUT_RAW messages. Returns a channel that will receive SERVO_OUTPUT_RAW events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeServoOutputRaw is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeServoOutputRaw(ctx context.Context) <-chan ServoOutputRawEvent {
+	ch := d.servoOutputRaw.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeServoOutputRaw(ch)
+	}()
+
+	return ch
+}
 
-	// GPS_RAW_INT subscribers
-	gpsRawIntSubscribers []chan GpsRawIntEvent
-	gpsRawIntMu          sync.RWMutex
+// UnsubscribeServoOutputRaw
+// Removes a SERVO_OUTPUT_RAW subscriber channel.
+func (d *MessageDispatcher) UnsubscribeServoOutputRaw(ch chan ServoOutputRawEvent) {
+	d.servoOutputRaw.remove(ch)
+}
 
-	// Context for graceful shutdown
-	ctx    context.Context
-	cancel context.CancelFunc
-	wg     sync.WaitGroup
+// SubscribeActuatorOutputStatus
+// Subscribes to ACTUATOR_OUTPUT_STATUS messages. Returns a channel that will receive ACTUATOR_OUTPUT_STATUS events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeActuatorOutputStatus is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeActuatorOutputStatus(ctx context.Context) <-chan ActuatorOutputStatusEvent {
+	ch := d.actuatorOutputStatus.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeActuatorOutputStatus(ch)
+	}()
+
+	return ch
 }
 
-// NewMessageDispatcher
-// Creates a new message dispatcher that will start processing events from the node.
-func NewMessageDispatcher(node *gomavlib.Node) *MessageDispatcher {
-	ctx, cancel := context.WithCancel(context.Background())
-	return &MessageDispatcher{
-		node:                 node,
-		heartbeatSubscribers: make([]chan HeartbeatEvent, 0),
-		gpsRawIntSubscribers: make([]chan GpsRawIntEvent, 0),
-		ctx:                  ctx,
-		cancel:               cancel,
-	}
+// UnsubscribeActuatorOutputStatus
+// Removes an ACTUATOR_OUTPUT_STATUS subscriber channel.
+func (d *MessageDispatcher) UnsubscribeActuatorOutputStatus(ch chan ActuatorOutputStatusEvent) {
+	d.actuatorOutputStatus.remove(ch)
 }
 
-// Start
-// Starts the dispatcher goroutine that reads from node.Events() and routes messages.
-// This should be called once when the server starts.
-func (d *MessageDispatcher) Start() {
-	d.wg.Add(1)
-	go d.run()
+// SubscribeEstimatorStatus
+// Subscribes to ESTIMATOR_STATUS messages. Returns a channel that will receive ESTIMATOR_STATUS events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeEstimatorStatus is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeEstimatorStatus(ctx context.Context) <-chan EstimatorStatusEvent {
+	ch := d.estimatorStatus.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeEstimatorStatus(ch)
+	}()
+
+	return ch
 }
 
-// Stop
-// Stops the dispatcher and closes all subscriber channels.
-func (d *MessageDispatcher) Stop() {
-	d.cancel()
-	d.wg.Wait()
+// UnsubscribeEstimatorStatus
+// Removes an ESTIMATOR_STATUS subscriber channel.
+func (d *MessageDispatcher) UnsubscribeEstimatorStatus(ch chan EstimatorStatusEvent) {
+	d.estimatorStatus.remove(ch)
+}
 
-	// Close all subscriber channels
-	d.heartbeatMu.Lock()
-	for _, ch := range d.heartbeatSubscribers {
-		close(ch)
-	}
-	d.heartbeatSubscribers = nil
-	d.heartbeatMu.Unlock()
+// SubscribeVibration
+// Subscribes to VIBRATION messages. Returns a channel that will receive VIBRATION events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeVibration is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeVibration(ctx context.Context) <-chan VibrationEvent {
+	ch := d.vibration.add()
 
-	d.gpsRawIntMu.Lock()
-	for _, ch := range d.gpsRawIntSubscribers {
-		close(ch)
-	}
-	d.gpsRawIntSubscribers = nil
-	d.gpsRawIntMu.Unlock()
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeVibration(ch)
+	}()
+
+	return ch
 }
 
-// SubscribeHeartbeat
-// Subscribes to heartbeat messages. Returns a channel that will receive heartbeat events.
-// The channel will be closed when the dispatcher stops or when UnsubscribeHeartbeat is called.
+// UnsubscribeVibration
+// Removes a VIBRATION subscriber channel.
+func (d *MessageDispatcher) UnsubscribeVibration(ch chan VibrationEvent) {
+	d.vibration.remove(ch)
+}
+
+// SubscribeWindCov
+// Subscribes to WIND_COV messages. Returns a channel that will receive WIND_COV events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeWindCov is called.
 // The caller should handle context cancellation to unsubscribe.
-func (d *MessageDispatcher) SubscribeHeartbeat(ctx context.Context) <-chan HeartbeatEvent {
-	ch := make(chan HeartbeatEvent, 10)
+func (d *MessageDispatcher) SubscribeWindCov(ctx context.Context) <-chan WindCovEvent {
+	ch := d.windCov.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeWindCov(ch)
+	}()
+
+	return ch
+}
 
-	d.heartbeatMu.Lock()
-	d.heartbeatSubscribers = append(d.heartbeatSubscribers, ch)
-	d.heartbeatMu.Unlock()
+// UnsubscribeWindCov
+// Removes a WIND_COV subscriber channel.
+func (d *MessageDispatcher) UnsubscribeWindCov(ch chan WindCovEvent) {
+	d.windCov.remove(ch)
+}
+
+// SubscribeDistanceSensor
+// Subscribes to DISTANCE_SENSOR messages. Returns a channel that will receive DISTANCE_SENSOR events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeDistanceSensor is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeDistanceSensor(ctx context.Context) <-chan DistanceSensorEvent {
+	ch := d.distanceSensor.add()
 
 	// Unsubscribe when context is cancelled
 	go func() {
 		<-ctx.Done()
-		d.UnsubscribeHeartbeat(ch)
+		d.UnsubscribeDistanceSensor(ch)
 	}()
 
 	return ch
 }
 
-// UnsubscribeHeartbeat
-// Removes a heartbeat subscriber channel.
-func (d *MessageDispatcher) UnsubscribeHeartbeat(ch chan HeartbeatEvent) {
-	d.heartbeatMu.Lock()
-	defer d.heartbeatMu.Unlock()
+// UnsubscribeDistanceSensor
+// Removes a DISTANCE_SENSOR subscriber channel.
+func (d *MessageDispatcher) UnsubscribeDistanceSensor(ch chan DistanceSensorEvent) {
+	d.distanceSensor.remove(ch)
+}
 
-	for i, subscriber := range d.heartbeatSubscribers {
-		if subscriber == ch {
-			// Remove from slice
-			d.heartbeatSubscribers = append(d.heartbeatSubscribers[:i], d.heartbeatSubscribers[i+1:]...)
-			close(ch)
-			return
-		}
-	}
+// SubscribeObstacleDistance
+// Subscribes to OBSTACLE_DISTANCE messages. Returns a channel that will receive OBSTACLE_DISTANCE events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeObstacleDistance is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeObstacleDistance(ctx context.Context) <-chan ObstacleDistanceEvent {
+	ch := d.obstacleDistance.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeObstacleDistance(ch)
+	}()
+
+	return ch
 }
 
-// SubscribeGpsRawInt
-// Subscribes to GPS_RAW_INT messages. Returns a channel that will receive GPS_RAW_INT events.
-// The channel will be closed when the dispatcher stops or when UnsubscribeGpsRawInt is called.
+// UnsubscribeObstacleDistance
+// Removes an OBSTACLE_DISTANCE subscriber channel.
+func (d *MessageDispatcher) UnsubscribeObstacleDistance(ch chan ObstacleDistanceEvent) {
+	d.obstacleDistance.remove(ch)
+}
+
+// SubscribeGps2Raw
+// Subscribes to GPS2_RAW messages. Returns a channel that will receive GPS2_RAW events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeGps2Raw is called.
 // The caller should handle context cancellation to unsubscribe.
-func (d *MessageDispatcher) SubscribeGpsRawInt(ctx context.Context) <-chan GpsRawIntEvent {
-	ch := make(chan GpsRawIntEvent, 10)
+func (d *MessageDispatcher) SubscribeGps2Raw(ctx context.Context) <-chan Gps2RawEvent {
+	ch := d.gps2Raw.add()
 
-	d.gpsRawIntMu.Lock()
-	d.gpsRawIntSubscribers = append(d.gpsRawIntSubscribers, ch)
-	d.gpsRawIntMu.Unlock()
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeGps2Raw(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeGps2Raw
+// Removes a GPS2_RAW subscriber channel.
+func (d *MessageDispatcher) UnsubscribeGps2Raw(ch chan Gps2RawEvent) {
+	d.gps2Raw.remove(ch)
+}
+
+// SubscribeGpsStatus
+// Subscribes to GPS_STATUS messages. Returns a channel that will receive GPS_STATUS events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeGpsStatus is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeGpsStatus(ctx context.Context) <-chan GpsStatusEvent {
+	ch := d.gpsStatus.add()
 
 	// Unsubscribe when context is cancelled
 	go func() {
 		<-ctx.Done()
-		d.UnsubscribeGpsRawInt(ch)
+		d.UnsubscribeGpsStatus(ch)
 	}()
 
 	return ch
 }
 
-// UnsubscribeGpsRawInt
-// Removes a GPS_RAW_INT subscriber channel.
-func (d *MessageDispatcher) UnsubscribeGpsRawInt(ch chan GpsRawIntEvent) {
-	d.gpsRawIntMu.Lock()
-	defer d.gpsRawIntMu.Unlock()
+// UnsubscribeGpsStatus
+// Removes a GPS_STATUS subscriber channel.
+func (d *MessageDispatcher) UnsubscribeGpsStatus(ch chan GpsStatusEvent) {
+	d.gpsStatus.remove(ch)
+}
 
-	for i, subscriber := range d.gpsRawIntSubscribers {
-		if subscriber == ch {
-			// Remove from slice
-			d.gpsRawIntSubscribers = append(d.gpsRawIntSubscribers[:i], d.gpsRawIntSubscribers[i+1:]...)
-			close(ch)
-			return
-		}
-	}
+// SubscribeGpsRtk
+// Subscribes to GPS_RTK messages. Returns a channel that will receive GPS_RTK events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeGpsRtk is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeGpsRtk(ctx context.Context) <-chan GpsRtkEvent {
+	ch := d.gpsRtk.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeGpsRtk(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeGpsRtk
+// Removes a GPS_RTK subscriber channel.
+func (d *MessageDispatcher) UnsubscribeGpsRtk(ch chan GpsRtkEvent) {
+	d.gpsRtk.remove(ch)
+}
+
+// SubscribeGps2Rtk
+// Subscribes to GPS2_RTK messages. Returns a channel that will receive GPS2_RTK events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeGps2Rtk is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeGps2Rtk(ctx context.Context) <-chan Gps2RtkEvent {
+	ch := d.gps2Rtk.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeGps2Rtk(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeGps2Rtk
+// Removes a GPS2_RTK subscriber channel.
+func (d *MessageDispatcher) UnsubscribeGps2Rtk(ch chan Gps2RtkEvent) {
+	d.gps2Rtk.remove(ch)
+}
+
+// SubscribeAdsbVehicle
+// Subscribes to ADSB_VEHICLE messages. Returns a channel that will receive ADSB_VEHICLE events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeAdsbVehicle is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeAdsbVehicle(ctx context.Context) <-chan AdsbVehicleEvent {
+	ch := d.adsbVehicle.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeAdsbVehicle(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeAdsbVehicle
+// Removes an ADSB_VEHICLE subscriber channel.
+func (d *MessageDispatcher) UnsubscribeAdsbVehicle(ch chan AdsbVehicleEvent) {
+	d.adsbVehicle.remove(ch)
+}
+
+// SubscribeCommandAck
+// Subscribes to COMMAND_ACK messages. Returns a channel that will receive COMMAND_ACK events.
+// The channel will be closed when the dispatcher stops or when UnsubscribeCommandAck is called.
+// The caller should handle context cancellation to unsubscribe.
+func (d *MessageDispatcher) SubscribeCommandAck(ctx context.Context) <-chan CommandAckEvent {
+	ch := d.commandAck.add()
+
+	// Unsubscribe when context is cancelled
+	go func() {
+		<-ctx.Done()
+		d.UnsubscribeCommandAck(ch)
+	}()
+
+	return ch
+}
+
+// UnsubscribeCommandAck
+// Removes a COMMAND_ACK subscriber channel.
+func (d *MessageDispatcher) UnsubscribeCommandAck(ch chan CommandAckEvent) {
+	d.commandAck.remove(ch)
 }
 
 // run
@@ -186,6 +1047,58 @@ func (d *MessageDispatcher) run() {
 					d.broadcastHeartbeat(systemID, componentID, msg)
 				case *common.MessageGpsRawInt:
 					d.broadcastGpsRawInt(systemID, componentID, msg)
+				case *common.MessageGlobalPositionInt:
+					d.broadcastGlobalPositionInt(systemID, componentID, msg)
+				case *common.MessageExtendedSysState:
+					d.broadcastExtendedSysState(systemID, componentID, msg)
+				case *common.MessageOrbitExecutionStatus:
+					d.broadcastOrbitExecutionStatus(systemID, componentID, msg)
+				case *common.MessageVfrHud:
+					d.broadcastVfrHud(systemID, componentID, msg)
+				case *common.MessageAttitude:
+					d.broadcastAttitude(systemID, componentID, msg)
+				case *common.MessageAttitudeQuaternion:
+					d.broadcastAttitudeQuaternion(systemID, componentID, msg)
+				case *common.MessageLocalPositionNed:
+					d.broadcastLocalPositionNed(systemID, componentID, msg)
+				case *common.MessageOdometry:
+					d.broadcastOdometry(systemID, componentID, msg)
+				case *common.MessageBatteryStatus:
+					d.broadcastBatteryStatus(systemID, componentID, msg)
+				case *common.MessageSysStatus:
+					d.broadcastSysStatus(systemID, componentID, msg)
+				case *common.MessageStatustext:
+					d.broadcastStatusText(systemID, componentID, msg)
+				case *common.MessageHomePosition:
+					d.broadcastHomePosition(systemID, componentID, msg)
+				case *common.MessageRcChannels:
+					d.broadcastRcChannels(systemID, componentID, msg)
+				case *common.MessageServoOutputRaw:
+					d.broadcastServoOutputRaw(systemID, componentID, msg)
+				case *common.MessageActuatorOutputStatus:
+					d.broadcastActuatorOutputStatus(systemID, componentID, msg)
+				case *common.MessageEstimatorStatus:
+					d.broadcastEstimatorStatus(systemID, componentID, msg)
+				case *common.MessageVibration:
+					d.broadcastVibration(systemID, componentID, msg)
+				case *common.MessageWindCov:
+					d.broadcastWindCov(systemID, componentID, msg)
+				case *common.MessageDistanceSensor:
+					d.broadcastDistanceSensor(systemID, componentID, msg)
+				case *common.MessageObstacleDistance:
+					d.broadcastObstacleDistance(systemID, componentID, msg)
+				case *common.MessageGps2Raw:
+					d.broadcastGps2Raw(systemID, componentID, msg)
+				case *common.MessageGpsStatus:
+					d.broadcastGpsStatus(systemID, componentID, msg)
+				case *common.MessageGpsRtk:
+					d.broadcastGpsRtk(systemID, componentID, msg)
+				case *common.MessageGps2Rtk:
+					d.broadcastGps2Rtk(systemID, componentID, msg)
+				case *common.MessageAdsbVehicle:
+					d.broadcastAdsbVehicle(systemID, componentID, msg)
+				case *common.MessageCommandAck:
+					d.broadcastCommandAck(systemID, componentID, msg)
 				}
 			}
 		}
@@ -193,7 +1106,8 @@ func (d *MessageDispatcher) run() {
 }
 
 // broadcastHeartbeat
-// Converts a HEARTBEAT message to protobuf and broadcasts it to all subscribers.
+// Converts a HEARTBEAT message to protobuf, records it as the latest heartbeat of its component
+// and broadcasts it to all subscribers.
 func (d *MessageDispatcher) broadcastHeartbeat(systemID, componentID uint8, msg *common.MessageHeartbeat) {
 	pbHeartbeat := message_converters.HeartbeatToProtobuf(msg)
 	event := HeartbeatEvent{
@@ -202,42 +1116,312 @@ func (d *MessageDispatcher) broadcastHeartbeat(systemID, componentID uint8, msg
 		Heartbeat:   pbHeartbeat,
 	}
 
-	d.heartbeatMu.RLock()
-	subscribers := make([]chan HeartbeatEvent, len(d.heartbeatSubscribers))
-	copy(subscribers, d.heartbeatSubscribers)
-	d.heartbeatMu.RUnlock()
+	d.latestHeartbeatsMu.Lock()
+	d.latestHeartbeats[componentKey{systemID, componentID}] = event
+	d.latestHeartbeatsMu.Unlock()
 
-	// Send to all subscribers (non-blocking)
-	for _, ch := range subscribers {
-		select {
-		case ch <- event:
-		default:
-			// Channel full, skip this subscriber to avoid blocking
-		}
-	}
+	d.heartbeat.broadcast(event)
 }
 
 // broadcastGpsRawInt
 // Converts a GPS_RAW_INT message to protobuf and broadcasts it to all subscribers.
 func (d *MessageDispatcher) broadcastGpsRawInt(systemID, componentID uint8, msg *common.MessageGpsRawInt) {
 	pbGpsRawInt := message_converters.GpsRawIntToProtobuf(msg)
-	event := GpsRawIntEvent{
+	d.gpsRawInt.broadcast(GpsRawIntEvent{
 		SystemID:    systemID,
 		ComponentID: componentID,
 		GpsRawInt:   pbGpsRawInt,
-	}
+	})
+}
 
-	d.gpsRawIntMu.RLock()
-	subscribers := make([]chan GpsRawIntEvent, len(d.gpsRawIntSubscribers))
-	copy(subscribers, d.gpsRawIntSubscribers)
-	d.gpsRawIntMu.RUnlock()
+// broadcastGlobalPositionInt
+// Converts a GLOBAL_POSITION_INT message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastGlobalPositionInt(systemID, componentID uint8, msg *common.MessageGlobalPositionInt) {
+	pbGlobalPositionInt := message_converters.GlobalPositionIntToProtobuf(msg)
+	d.globalPositionInt.broadcast(GlobalPositionIntEvent{
+		SystemID:          systemID,
+		ComponentID:       componentID,
+		GlobalPositionInt: pbGlobalPositionInt,
+	})
+}
 
-	// Send to all subscribers (non-blocking)
-	for _, ch := range subscribers {
-		select {
-		case ch <- event:
-		default:
-			// Channel full, skip this subscriber to avoid blocking
-		}
+// broadcastExtendedSysState
+// Converts an EXTENDED_SYS_STATE message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastExtendedSysState(systemID, componentID uint8, msg *common.MessageExtendedSysState) {
+	pbExtendedSysState := message_converters.ExtendedSysStateToProtobuf(msg)
+	d.extendedSysState.broadcast(ExtendedSysStateEvent{
+		SystemID:         systemID,
+		ComponentID:      componentID,
+		ExtendedSysState: pbExtendedSysState,
+	})
+}
+
+// broadcastOrbitExecutionStatus
+// Converts an ORBIT_EXECUTION_STATUS message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastOrbitExecutionStatus(systemID, componentID uint8, msg *common.MessageOrbitExecutionStatus) {
+	pbOrbitExecutionStatus := message_converters.OrbitExecutionStatusToProtobuf(msg)
+	d.orbitExecutionStatus.broadcast(OrbitExecutionStatusEvent{
+		SystemID:             systemID,
+		ComponentID:          componentID,
+		OrbitExecutionStatus: pbOrbitExecutionStatus,
+	})
+}
+
+// broadcastVfrHud
+// Converts a VFR_HUD message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastVfrHud(systemID, componentID uint8, msg *common.MessageVfrHud) {
+	pbVfrHud := message_converters.VfrHudToProtobuf(msg)
+	d.vfrHud.broadcast(VfrHudEvent{
+		SystemID:    systemID,
+		ComponentID: componentID,
+		VfrHud:      pbVfrHud,
+	})
+}
+
+// broadcastAttitude
+// Converts an ATTITUDE message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastAttitude(systemID, componentID uint8, msg *common.MessageAttitude) {
+	pbAttitude := message_converters.AttitudeToProtobuf(msg)
+	d.attitude.broadcast(AttitudeEvent{
+		SystemID:    systemID,
+		ComponentID: componentID,
+		Attitude:    pbAttitude,
+	})
+}
+
+// broadcastAttitudeQuaternion
+// Converts an ATTITUDE_QUATERNION message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastAttitudeQuaternion(systemID, componentID uint8, msg *common.MessageAttitudeQuaternion) {
+	pbAttitudeQuaternion := message_converters.AttitudeQuaternionToProtobuf(msg)
+	d.attitudeQuaternion.broadcast(AttitudeQuaternionEvent{
+		SystemID:           systemID,
+		ComponentID:        componentID,
+		AttitudeQuaternion: pbAttitudeQuaternion,
+	})
+}
+
+// broadcastLocalPositionNed
+// Converts a LOCAL_POSITION_NED message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastLocalPositionNed(systemID, componentID uint8, msg *common.MessageLocalPositionNed) {
+	pbLocalPositionNed := message_converters.LocalPositionNedToProtobuf(msg)
+	d.localPositionNed.broadcast(LocalPositionNedEvent{
+		SystemID:         systemID,
+		ComponentID:      componentID,
+		LocalPositionNed: pbLocalPositionNed,
+	})
+}
+
+// broadcastOdometry
+// Converts an ODOMETRY message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastOdometry(systemID, componentID uint8, msg *common.MessageOdometry) {
+	pbOdometry := message_converters.OdometryToProtobuf(msg)
+	d.odometry.broadcast(OdometryEvent{
+		SystemID:    systemID,
+		ComponentID: componentID,
+		Odometry:    pbOdometry,
+	})
+}
+
+// broadcastBatteryStatus
+// Converts a BATTERY_STATUS message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastBatteryStatus(systemID, componentID uint8, msg *common.MessageBatteryStatus) {
+	pbBatteryStatus := message_converters.BatteryStatusToProtobuf(msg)
+	d.batteryStatus.broadcast(BatteryStatusEvent{
+		SystemID:      systemID,
+		ComponentID:   componentID,
+		BatteryStatus: pbBatteryStatus,
+	})
+}
+
+// broadcastSysStatus
+// Converts an SYS_STATUS message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastSysStatus(systemID, componentID uint8, msg *common.MessageSysStatus) {
+	pbSysStatus := message_converters.SysStatusToProtobuf(msg)
+	d.sysStatus.broadcast(SysStatusEvent{
+		SystemID:    systemID,
+		ComponentID: componentID,
+		SysStatus:   pbSysStatus,
+	})
+}
+
+// broadcastStatusText
+// Converts a STATUSTEXT message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastStatusText(systemID, componentID uint8, msg *common.MessageStatustext) {
+	pbStatusText := message_converters.StatusTextToProtobuf(msg)
+	d.statusText.broadcast(StatusTextEvent{
+		SystemID:    systemID,
+		ComponentID: componentID,
+		StatusText:  pbStatusText,
+	})
+}
+
+// broadcastHomePosition
+// Converts a HOME_POSITION message to protobuf, records it as the latest home position of its
+// component and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastHomePosition(systemID, componentID uint8, msg *common.MessageHomePosition) {
+	pbHomePosition := message_converters.HomePositionToProtobuf(msg)
+	event := HomePositionEvent{
+		SystemID:     systemID,
+		ComponentID:  componentID,
+		HomePosition: pbHomePosition,
 	}
+
+	d.latestHomePositionsMu.Lock()
+	d.latestHomePositions[componentKey{systemID, componentID}] = event
+	d.latestHomePositionsMu.Unlock()
+
+	d.homePosition.broadcast(event)
+}
+
+// broadcastRcChannels
+// Converts an RC_CHANNELS message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastRcChannels(systemID, componentID uint8, msg *common.MessageRcChannels) {
+	pbRcChannels := message_converters.RcChannelsToProtobuf(msg)
+	d.rcChannels.broadcast(RcChannelsEvent{
+		SystemID:    systemID,
+		ComponentID: componentID,
+		RcChannels:  pbRcChannels,
+	})
+}
+
+// broadcastServoOutputRaw
+// Converts a SERVO_OUTPUT_RAW message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastServoOutputRaw(systemID, componentID uint8, msg *common.MessageServoOutputRaw) {
+	pbServoOutputRaw := message_converters.ServoOutputRawToProtobuf(msg)
+	d.servoOutputRaw.broadcast(ServoOutputRawEvent{
+		SystemID:       systemID,
+		ComponentID:    componentID,
+		ServoOutputRaw: pbServoOutputRaw,
+	})
+}
+
+// broadcastActuatorOutputStatus
+// Converts an ACTUATOR_OUTPUT_STATUS message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastActuatorOutputStatus(systemID, componentID uint8, msg *common.MessageActuatorOutputStatus) {
+	pbActuatorOutputStatus := message_converters.ActuatorOutputStatusToProtobuf(msg)
+	d.actuatorOutputStatus.broadcast(ActuatorOutputStatusEvent{
+		SystemID:             systemID,
+		ComponentID:          componentID,
+		ActuatorOutputStatus: pbActuatorOutputStatus,
+	})
+}
+
+// broadcastEstimatorStatus
+// Converts an ESTIMATOR_STATUS message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastEstimatorStatus(systemID, componentID uint8, msg *common.MessageEstimatorStatus) {
+	pbEstimatorStatus := message_converters.EstimatorStatusToProtobuf(msg)
+	d.estimatorStatus.broadcast(EstimatorStatusEvent{
+		SystemID:        systemID,
+		ComponentID:     componentID,
+		EstimatorStatus: pbEstimatorStatus,
+	})
+}
+
+// broadcastVibration
+// Converts a VIBRATION message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastVibration(systemID, componentID uint8, msg *common.MessageVibration) {
+	pbVibration := message_converters.VibrationToProtobuf(msg)
+	d.vibration.broadcast(VibrationEvent{
+		SystemID:    systemID,
+		ComponentID: componentID,
+		Vibration:   pbVibration,
+	})
+}
+
+// broadcastWindCov
+// Converts a WIND_COV message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastWindCov(systemID, componentID uint8, msg *common.MessageWindCov) {
+	pbWindCov := message_converters.WindCovToProtobuf(msg)
+	d.windCov.broadcast(WindCovEvent{
+		SystemID:    systemID,
+		ComponentID: componentID,
+		WindCov:     pbWindCov,
+	})
+}
+
+// broadcastDistanceSensor
+// Converts a DISTANCE_SENSOR message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastDistanceSensor(systemID, componentID uint8, msg *common.MessageDistanceSensor) {
+	pbDistanceSensor := message_converters.DistanceSensorToProtobuf(msg)
+	d.distanceSensor.broadcast(DistanceSensorEvent{
+		SystemID:       systemID,
+		ComponentID:    componentID,
+		DistanceSensor: pbDistanceSensor,
+	})
+}
+
+// broadcastObstacleDistance
+// Converts an OBSTACLE_DISTANCE message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastObstacleDistance(systemID, componentID uint8, msg *common.MessageObstacleDistance) {
+	pbObstacleDistance := message_converters.ObstacleDistanceToProtobuf(msg)
+	d.obstacleDistance.broadcast(ObstacleDistanceEvent{
+		SystemID:         systemID,
+		ComponentID:      componentID,
+		ObstacleDistance: pbObstacleDistance,
+	})
+}
+
+// broadcastGps2Raw
+// Converts a GPS2_RAW message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastGps2Raw(systemID, componentID uint8, msg *common.MessageGps2Raw) {
+	pbGps2Raw := message_converters.Gps2RawToProtobuf(msg)
+	d.gps2Raw.broadcast(Gps2RawEvent{
+		SystemID:    systemID,
+		ComponentID: componentID,
+		Gps2Raw:     pbGps2Raw,
+	})
+}
+
+// broadcastGpsStatus
+// Converts a GPS_STATUS message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastGpsStatus(systemID, componentID uint8, msg *common.MessageGpsStatus) {
+	pbGpsStatus := message_converters.GpsStatusToProtobuf(msg)
+	d.gpsStatus.broadcast(GpsStatusEvent{
+		SystemID:    systemID,
+		ComponentID: componentID,
+		GpsStatus:   pbGpsStatus,
+	})
+}
+
+// broadcastGpsRtk
+// Converts a GPS_RTK message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastGpsRtk(systemID, componentID uint8, msg *common.MessageGpsRtk) {
+	pbGpsRtk := message_converters.GpsRtkToProtobuf(msg)
+	d.gpsRtk.broadcast(GpsRtkEvent{
+		SystemID:    systemID,
+		ComponentID: componentID,
+		GpsRtk:      pbGpsRtk,
+	})
+}
+
+// broadcastGps2Rtk
+// Converts a GPS2_RTK message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastGps2Rtk(systemID, componentID uint8, msg *common.MessageGps2Rtk) {
+	pbGps2Rtk := message_converters.Gps2RtkToProtobuf(msg)
+	d.gps2Rtk.broadcast(Gps2RtkEvent{
+		SystemID:    systemID,
+		ComponentID: componentID,
+		Gps2Rtk:     pbGps2Rtk,
+	})
+}
+
+// broadcastAdsbVehicle
+// Converts an ADSB_VEHICLE message to protobuf and broadcasts it to all subscribers.
+func (d *MessageDispatcher) broadcastAdsbVehicle(systemID, componentID uint8, msg *common.MessageAdsbVehicle) {
+	pbAdsbVehicle := message_converters.AdsbVehicleToProtobuf(msg)
+	d.adsbVehicle.broadcast(AdsbVehicleEvent{
+		SystemID:    systemID,
+		ComponentID: componentID,
+		AdsbVehicle: pbAdsbVehicle,
+	})
+}
+
+// broadcastCommandAck
+// Broadcasts a COMMAND_ACK message to all subscribers.
+func (d *MessageDispatcher) broadcastCommandAck(systemID, componentID uint8, msg *common.MessageCommandAck) {
+	d.commandAck.broadcast(CommandAckEvent{
+		SystemID:    systemID,
+		ComponentID: componentID,
+		CommandAck:  msg,
+	})
 }
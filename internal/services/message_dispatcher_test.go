@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/bluenviron/gomavlib/v3"
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// freeTCPAddress returns a local TCP address that is not in use
+func freeTCPAddress(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	return listener.Addr().String()
+}
+
+// startTestDrone starts a node connecting to address and sending PX4 heartbeats as system 1
+func startTestDrone(t *testing.T, address string) *gomavlib.Node {
+	t.Helper()
+
+	drone := &gomavlib.Node{
+		Endpoints:              []gomavlib.EndpointConf{gomavlib.EndpointTCPClient{Address: address}},
+		Dialect:                common.Dialect,
+		OutVersion:             gomavlib.V2,
+		OutSystemID:            1,
+		HeartbeatPeriod:        50 * time.Millisecond,
+		HeartbeatSystemType:    int(common.MAV_TYPE_QUADROTOR),
+		HeartbeatAutopilotType: int(common.MAV_AUTOPILOT_PX4),
+	}
+	if err := drone.Initialize(); err != nil {
+		t.Fatal(err)
+	}
+	return drone
+}
+
+// waitForDroneHeartbeat waits for a heartbeat from system 1 on a dispatcher subscription
+func waitForDroneHeartbeat(t *testing.T, heartbeatChan <-chan HeartbeatEvent) {
+	t.Helper()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case event, ok := <-heartbeatChan:
+			if !ok {
+				t.Fatal("heartbeat subscription closed")
+			}
+			if event.SystemID == 1 {
+				return
+			}
+		case <-timeout:
+			t.Fatal("no heartbeat received from the drone")
+		}
+	}
+}
+
+func TestMessageDispatcherSurvivesDroneReconnection(t *testing.T) {
+	address := freeTCPAddress(t)
+
+	gcs := &gomavlib.Node{
+		Endpoints:        []gomavlib.EndpointConf{gomavlib.EndpointTCPServer{Address: address}},
+		Dialect:          common.Dialect,
+		OutVersion:       gomavlib.V2,
+		OutSystemID:      254,
+		HeartbeatDisable: true,
+	}
+	if err := gcs.Initialize(); err != nil {
+		t.Fatal(err)
+	}
+	defer gcs.Close()
+
+	dispatcher := NewMessageDispatcher(gcs)
+	dispatcher.Start()
+	defer dispatcher.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	heartbeatChan := dispatcher.SubscribeHeartbeat(ctx)
+
+	drone := startTestDrone(t, address)
+	waitForDroneHeartbeat(t, heartbeatChan)
+
+	// The drone disappears (e.g. reboots): the connection is closed
+	drone.Close()
+
+	// Drain the heartbeats sent before the connection was closed
+	quiet := time.NewTimer(heartbeatLossTimeout / 10)
+	for drained := false; !drained; {
+		select {
+		case _, ok := <-heartbeatChan:
+			if !ok {
+				t.Fatal("heartbeat subscription closed when the drone disconnected")
+			}
+			quiet.Reset(heartbeatLossTimeout / 10)
+		case <-quiet.C:
+			drained = true
+		}
+	}
+
+	// The drone comes back: the same subscription receives its heartbeats
+	drone = startTestDrone(t, address)
+	defer drone.Close()
+	waitForDroneHeartbeat(t, heartbeatChan)
+
+	latest, ok := dispatcher.LatestHeartbeat(1, 1)
+	if !ok {
+		t.Fatal("LatestHeartbeat() found no heartbeat after reconnection")
+	}
+	if autopilot := latest.Heartbeat.GetAutopilot(); autopilot != flightpath.MavAutopilot_MAV_AUTOPILOT_PX4 {
+		t.Errorf("LatestHeartbeat() autopilot = %s, want %s", autopilot, flightpath.MavAutopilot_MAV_AUTOPILOT_PX4)
+	}
+}
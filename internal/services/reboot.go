@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+const (
+	// How long without a heartbeat before the drone is considered gone (heartbeats are sent at 1 Hz)
+	heartbeatLossTimeout = 3 * time.Second
+
+	// How long the drone may keep sending heartbeats after accepting a reboot/shutdown
+	rebootShutdownLossTimeout = 15 * time.Second
+
+	// How long a rebooting drone may stay silent before the reboot is considered failed
+	rebootReturnTimeout = 90 * time.Second
+
+	// MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN param1 values
+	rebootAutopilot   = 1
+	shutdownAutopilot = 2
+)
+
+// RebootAutopilot
+// Reboots the autopilot using MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN and streams updates until the
+// drone's heartbeats are received again.
+func (s *ActionService) RebootAutopilot(
+	ctx context.Context,
+	req *connect.Request[flightpath.RebootAutopilotRequest],
+	stream *connect.ServerStream[flightpath.RebootAutopilotResponse],
+) error {
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		return err
+	}
+
+	return s.rebootShutdown(ctx, target, rebootAutopilot, func(phase flightpath.RebootShutdownPhase) error {
+		return stream.Send(&flightpath.RebootAutopilotResponse{
+			TimestampMs: time.Now().UnixMilli(),
+			Phase:       phase,
+		})
+	})
+}
+
+// ShutdownAutopilot
+// Shuts down the autopilot using MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN and streams updates until the
+// drone's heartbeats have stopped.
+func (s *ActionService) ShutdownAutopilot(
+	ctx context.Context,
+	req *connect.Request[flightpath.ShutdownAutopilotRequest],
+	stream *connect.ServerStream[flightpath.ShutdownAutopilotResponse],
+) error {
+	target, err := ResolveCommandTarget(req.Msg.SystemId, req.Msg.ComponentId)
+	if err != nil {
+		return err
+	}
+
+	return s.rebootShutdown(ctx, target, shutdownAutopilot, func(phase flightpath.RebootShutdownPhase) error {
+		return stream.Send(&flightpath.ShutdownAutopilotResponse{
+			TimestampMs: time.Now().UnixMilli(),
+			Phase:       phase,
+		})
+	})
+}
+
+// rebootShutdown
+// Sends MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN (refused unless the drone's next heartbeat reports it
+// disarmed, as a cached heartbeat may be stale) and tracks the drone's heartbeats: reports
+// HEARTBEAT_LOST once they stop, and for a reboot HEARTBEAT_RESTORED once they come back.
+// The MAVLink node and the dispatcher are not affected by the drone's disappearance (gomavlib
+// endpoints reconnect on their own and the dispatcher ignores channel close events), so the
+// same subscription sees the drone come back.
+func (s *ActionService) rebootShutdown(
+	ctx context.Context,
+	target CommandTarget,
+	action float32,
+	report func(phase flightpath.RebootShutdownPhase) error,
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("message dispatcher is not available"))
+	}
+
+	// Subscribe before sending the command so that no heartbeat is missed
+	heartbeatChan := s.ctx.Dispatcher.SubscribeHeartbeat(ctx)
+
+	// Refuse unless a fresh heartbeat reports the drone disarmed
+	current, err := waitForHeartbeat(ctx, heartbeatChan, target, nil)
+	if err != nil {
+		return err
+	}
+	if current.Heartbeat.GetBaseMode().GetSafetyArmed() {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("refusing to reboot or shut down an armed drone"))
+	}
+
+	err = s.ctx.Commands.Execute(ctx, &Command{
+		Target:  target,
+		Command: common.MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN,
+		Params:  [7]float32{action},
+	})
+	if err != nil {
+		return err
+	}
+
+	phase := flightpath.RebootShutdownPhase_REBOOT_SHUTDOWN_PHASE_ACCEPTED
+	if err := report(phase); err != nil {
+		return err
+	}
+
+	accepted := time.Now()
+	lastHeartbeat := accepted
+	var lostAt time.Time
+
+	ticker := time.NewTicker(heartbeatLossTimeout / 10)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case event, ok := <-heartbeatChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return connect.NewError(connect.CodeUnavailable, errors.New("message dispatcher stopped"))
+			}
+			if event.SystemID != target.SystemID || event.ComponentID != target.ComponentID {
+				continue
+			}
+			lastHeartbeat = time.Now()
+
+			if phase == flightpath.RebootShutdownPhase_REBOOT_SHUTDOWN_PHASE_HEARTBEAT_LOST {
+				// Only reached when rebooting, a shutdown completes once heartbeats are lost
+				return report(flightpath.RebootShutdownPhase_REBOOT_SHUTDOWN_PHASE_HEARTBEAT_RESTORED)
+			}
+
+		case now := <-ticker.C:
+			switch phase {
+			case flightpath.RebootShutdownPhase_REBOOT_SHUTDOWN_PHASE_ACCEPTED:
+				if now.Sub(lastHeartbeat) >= heartbeatLossTimeout {
+					phase = flightpath.RebootShutdownPhase_REBOOT_SHUTDOWN_PHASE_HEARTBEAT_LOST
+					lostAt = now
+					if err := report(phase); err != nil {
+						return err
+					}
+					if action == shutdownAutopilot {
+						return nil
+					}
+				} else if now.Sub(accepted) >= rebootShutdownLossTimeout {
+					return connect.NewError(
+						connect.CodeDeadlineExceeded,
+						fmt.Errorf("system %d still sends heartbeats %s after accepting the command", target.SystemID, rebootShutdownLossTimeout),
+					)
+				}
+
+			case flightpath.RebootShutdownPhase_REBOOT_SHUTDOWN_PHASE_HEARTBEAT_LOST:
+				if now.Sub(lostAt) >= rebootReturnTimeout {
+					return connect.NewError(
+						connect.CodeDeadlineExceeded,
+						fmt.Errorf("system %d not heard from %s after reboot", target.SystemID, rebootReturnTimeout),
+					)
+				}
+			}
+		}
+	}
+}
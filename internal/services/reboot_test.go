@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/bluenviron/gomavlib/v3/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v3/pkg/message"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+func TestRebootShutdown(t *testing.T) {
+	armed, disarmed := true, false
+
+	tests := []struct {
+		name string
+		// Heartbeats sent by the drone (armed state), none if nil
+		armed *bool
+		// Stop the dispatcher once the command has been accepted
+		stopDispatcher bool
+		wantCode       connect.Code
+		wantCommands   int
+		wantPhases     []flightpath.RebootShutdownPhase
+	}{
+		{
+			name:     "no heartbeat",
+			wantCode: connect.CodeUnavailable,
+		},
+		{
+			name:     "armed drone is refused",
+			armed:    &armed,
+			wantCode: connect.CodeFailedPrecondition,
+		},
+		{
+			name:           "dispatcher stopped while tracking heartbeats",
+			armed:          &disarmed,
+			stopDispatcher: true,
+			wantCode:       connect.CodeUnavailable,
+			wantCommands:   1,
+			wantPhases:     []flightpath.RebootShutdownPhase{flightpath.RebootShutdownPhase_REBOOT_SHUTDOWN_PHASE_ACCEPTED},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := &fakeWriter{}
+			commands, dispatcher := newTestCommandManager(writer)
+			writer.onWrite = func(msg message.Message) {
+				cmd := msg.(*common.MessageCommandLong)
+				dispatcher.commandAck.broadcast(ackEvent(cmd.TargetSystem, cmd.TargetComponent, cmd.Command, common.MAV_RESULT_ACCEPTED))
+				if tt.stopDispatcher {
+					time.AfterFunc(testRetryTimeout, dispatcher.Stop)
+				}
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.armed != nil {
+				go func() {
+					ticker := time.NewTicker(10 * time.Millisecond)
+					defer ticker.Stop()
+					for {
+						select {
+						case <-ctx.Done():
+							return
+						case <-ticker.C:
+							dispatcher.heartbeat.broadcast(HeartbeatEvent{
+								SystemID:    testTarget.SystemID,
+								ComponentID: testTarget.ComponentID,
+								Heartbeat:   &flightpath.Heartbeat{BaseMode: &flightpath.BaseMode{SafetyArmed: *tt.armed}},
+							})
+						}
+					}
+				}()
+			}
+
+			service := NewActionService(&ServiceContext{
+				Logger:     log.New(io.Discard, "", 0),
+				Dispatcher: dispatcher,
+				Commands:   commands,
+			})
+
+			var phases []flightpath.RebootShutdownPhase
+			err := service.rebootShutdown(ctx, testTarget, rebootAutopilot, func(phase flightpath.RebootShutdownPhase) error {
+				phases = append(phases, phase)
+				return nil
+			})
+
+			if connect.CodeOf(err) != tt.wantCode {
+				t.Fatalf("rebootShutdown() error = %v, want code %s", err, tt.wantCode)
+			}
+			if got := len(writer.confirmations()); got != tt.wantCommands {
+				t.Errorf("commands sent = %d, want %d", got, tt.wantCommands)
+			}
+			if !slices.Equal(phases, tt.wantPhases) {
+				t.Errorf("phases = %v, want %v", phases, tt.wantPhases)
+			}
+		})
+	}
+}
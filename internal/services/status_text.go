@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+const (
+	// Length of the STATUSTEXT text field, a shorter chunk is the last chunk of a message
+	statusTextChunkLength = 50
+
+	// How long to wait for the missing chunks of a STATUSTEXT message before sending what was received
+	statusTextChunkTimeout = 2 * time.Second
+)
+
+// statusTextKey identifies a STATUSTEXT message split into chunks
+type statusTextKey struct {
+	componentKey
+	id uint32
+}
+
+// statusTextMessage is a STATUSTEXT message after reassembly of its chunks
+type statusTextMessage struct {
+	systemID    uint8
+	componentID uint8
+	severity    flightpath.MavSeverity
+	text        string
+	incomplete  bool
+}
+
+// partialStatusText holds the chunks received so far of a STATUSTEXT message
+type partialStatusText struct {
+	systemID    uint8
+	componentID uint8
+	severity    flightpath.MavSeverity
+	chunks      map[uint32]string
+	lastChunk   int // Sequence number of the last chunk, -1 until it is received
+	lastSeen    time.Time
+}
+
+// complete
+// Reports whether all chunks up to the last one have been received.
+func (p *partialStatusText) complete() bool {
+	return p.lastChunk >= 0 && len(p.chunks) == p.lastChunk+1
+}
+
+// text
+// Joins the received chunks in sequence order (missing chunks are left out).
+func (p *partialStatusText) text() string {
+	var maxSeq uint32
+	for seq := range p.chunks {
+		if seq > maxSeq {
+			maxSeq = seq
+		}
+	}
+
+	var text strings.Builder
+	for seq := uint32(0); seq <= maxSeq; seq++ {
+		text.WriteString(p.chunks[seq])
+	}
+	return text.String()
+}
+
+// message
+// Builds the message from the chunks received so far.
+func (p *partialStatusText) message(incomplete bool) statusTextMessage {
+	return statusTextMessage{
+		systemID:    p.systemID,
+		componentID: p.componentID,
+		severity:    p.severity,
+		text:        p.text(),
+		incomplete:  incomplete,
+	}
+}
+
+// statusTextAssembler
+// Joins the chunks of STATUSTEXT messages that are split over several messages (same non-zero ID),
+// per sending component.
+type statusTextAssembler struct {
+	// Messages whose chunks are still being received
+	partials map[statusTextKey]*partialStatusText
+}
+
+// newStatusTextAssembler creates an empty assembler
+func newStatusTextAssembler() *statusTextAssembler {
+	return &statusTextAssembler{
+		partials: make(map[statusTextKey]*partialStatusText),
+	}
+}
+
+// add
+// Adds a STATUSTEXT received at now. Returns the message once it is complete: immediately for a
+// single chunk message, or when the last chunk (shorter than the text field) completes it.
+func (a *statusTextAssembler) add(event StatusTextEvent, now time.Time) (statusTextMessage, bool) {
+	statusText := event.StatusText
+	if statusText.Id == 0 {
+		// Single chunk message
+		return statusTextMessage{
+			systemID:    event.SystemID,
+			componentID: event.ComponentID,
+			severity:    statusText.Severity,
+			text:        statusText.Text,
+		}, true
+	}
+
+	key := statusTextKey{componentKey{event.SystemID, event.ComponentID}, statusText.Id}
+	partial, ok := a.partials[key]
+	if !ok {
+		partial = &partialStatusText{
+			systemID:    event.SystemID,
+			componentID: event.ComponentID,
+			severity:    statusText.Severity,
+			chunks:      make(map[uint32]string),
+			lastChunk:   -1,
+		}
+		a.partials[key] = partial
+	}
+	partial.chunks[statusText.ChunkSeq] = statusText.Text
+	partial.lastSeen = now
+	if len(statusText.Text) < statusTextChunkLength {
+		partial.lastChunk = int(statusText.ChunkSeq)
+	}
+
+	if !partial.complete() {
+		return statusTextMessage{}, false
+	}
+	delete(a.partials, key)
+	return partial.message(false), true
+}
+
+// expire
+// Removes and returns, marked incomplete, the messages that have not received a chunk for
+// statusTextChunkTimeout.
+func (a *statusTextAssembler) expire(now time.Time) []statusTextMessage {
+	var expired []statusTextMessage
+	for key, partial := range a.partials {
+		if now.Sub(partial.lastSeen) < statusTextChunkTimeout {
+			continue
+		}
+		delete(a.partials, key)
+		expired = append(expired, partial.message(true))
+	}
+	return expired
+}
+
+// SubscribeStatusText
+// Streams STATUSTEXT messages from the MAVLink connection, filtered by minimum severity.
+// Chunks of a long message (same non-zero ID) are joined and sent as a single message once the
+// last chunk (shorter than the text field) has been received. If chunks are still missing after
+// statusTextChunkTimeout, the received ones are sent with incomplete = true.
+func (s *TelemetryService) SubscribeStatusText(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeStatusTextRequest],
+	stream *connect.ServerStream[flightpath.SubscribeStatusTextResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Lower severity values are more severe, UNSPECIFIED sends everything
+	minSeverity := req.Msg.MinSeverity
+	if minSeverity == flightpath.MavSeverity_MAV_SEVERITY_UNSPECIFIED {
+		minSeverity = flightpath.MavSeverity_MAV_SEVERITY_DEBUG
+	}
+
+	send := func(msg statusTextMessage) error {
+		if msg.severity > minSeverity {
+			return nil
+		}
+		return stream.Send(&flightpath.SubscribeStatusTextResponse{
+			TimestampMs: time.Now().UnixMilli(),
+			SystemId:    uint32(msg.systemID),
+			ComponentId: uint32(msg.componentID),
+			Severity:    msg.severity,
+			Text:        msg.text,
+			Incomplete:  msg.incomplete,
+		})
+	}
+
+	// Subscribe to STATUSTEXT events from the centralized dispatcher
+	statusTextChan := s.ctx.Dispatcher.SubscribeStatusText(ctx)
+
+	assembler := newStatusTextAssembler()
+
+	ticker := time.NewTicker(statusTextChunkTimeout / 4)
+	defer ticker.Stop()
+
+	// Stream STATUSTEXT messages to client
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-statusTextChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+
+			if msg, ok := assembler.add(event, time.Now()); ok {
+				if err := send(msg); err != nil {
+					return err
+				}
+			}
+		case now := <-ticker.C:
+			for _, msg := range assembler.expire(now) {
+				if err := send(msg); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+)
+
+// statusTextChunks splits a text into the STATUSTEXT events a component sends for it
+func statusTextChunks(systemID, componentID uint8, id uint32, text string) []StatusTextEvent {
+	var events []StatusTextEvent
+	for seq := uint32(0); ; seq++ {
+		chunk := text[:min(len(text), statusTextChunkLength)]
+		text = text[len(chunk):]
+		events = append(events, StatusTextEvent{
+			SystemID:    systemID,
+			ComponentID: componentID,
+			StatusText: &flightpath.StatusText{
+				Severity: flightpath.MavSeverity_MAV_SEVERITY_WARNING,
+				Text:     chunk,
+				Id:       id,
+				ChunkSeq: seq,
+			},
+		})
+		// A chunk shorter than the text field ends the message
+		if len(chunk) < statusTextChunkLength {
+			return events
+		}
+	}
+}
+
+func TestStatusTextAssembler(t *testing.T) {
+	long := strings.Repeat("Preflight Fail: ", 8) // 128 characters, 3 chunks
+	other := strings.Repeat("Compass 1 uncalibrated, ", 3)
+
+	interleave := func(a, b []StatusTextEvent) []StatusTextEvent {
+		var events []StatusTextEvent
+		for i := range max(len(a), len(b)) {
+			if i < len(a) {
+				events = append(events, a[i])
+			}
+			if i < len(b) {
+				events = append(events, b[i])
+			}
+		}
+		return events
+	}
+
+	tests := []struct {
+		name   string
+		events []StatusTextEvent
+		want   []statusTextMessage
+	}{
+		{
+			name:   "single chunk message",
+			events: statusTextChunks(1, 1, 0, "Takeoff detected"),
+			want:   []statusTextMessage{{1, 1, flightpath.MavSeverity_MAV_SEVERITY_WARNING, "Takeoff detected", false}},
+		},
+		{
+			name:   "message split into 50 character chunks",
+			events: statusTextChunks(1, 1, 7, long),
+			want:   []statusTextMessage{{1, 1, flightpath.MavSeverity_MAV_SEVERITY_WARNING, long, false}},
+		},
+		{
+			name:   "message of exactly two chunks ends with an empty chunk",
+			events: statusTextChunks(1, 1, 7, long[:100]),
+			want:   []statusTextMessage{{1, 1, flightpath.MavSeverity_MAV_SEVERITY_WARNING, long[:100], false}},
+		},
+		{
+			name: "chunks out of order",
+			events: func() []StatusTextEvent {
+				events := statusTextChunks(1, 1, 7, long)
+				return []StatusTextEvent{events[2], events[0], events[1]}
+			}(),
+			want: []statusTextMessage{{1, 1, flightpath.MavSeverity_MAV_SEVERITY_WARNING, long, false}},
+		},
+		{
+			name:   "same ID from two components",
+			events: interleave(statusTextChunks(1, 1, 7, long), statusTextChunks(1, 191, 7, other)),
+			want: []statusTextMessage{
+				{1, 191, flightpath.MavSeverity_MAV_SEVERITY_WARNING, other, false},
+				{1, 1, flightpath.MavSeverity_MAV_SEVERITY_WARNING, long, false},
+			},
+		},
+		{
+			name:   "two IDs from the same component",
+			events: interleave(statusTextChunks(1, 1, 7, long), statusTextChunks(1, 1, 8, other)),
+			want: []statusTextMessage{
+				{1, 1, flightpath.MavSeverity_MAV_SEVERITY_WARNING, other, false},
+				{1, 1, flightpath.MavSeverity_MAV_SEVERITY_WARNING, long, false},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assembler := newStatusTextAssembler()
+			now := time.Now()
+
+			var got []statusTextMessage
+			for _, event := range tt.events {
+				if msg, ok := assembler.add(event, now); ok {
+					got = append(got, msg)
+				}
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("messages = %+v, want %+v", got, tt.want)
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("message %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+			if len(assembler.partials) != 0 {
+				t.Errorf("%d messages still pending", len(assembler.partials))
+			}
+		})
+	}
+}
+
+func TestStatusTextAssemblerLostChunk(t *testing.T) {
+	text := strings.Repeat("x", statusTextChunkLength) + strings.Repeat("y", statusTextChunkLength) + "z"
+	events := statusTextChunks(1, 1, 3, text)
+	assembler := newStatusTextAssembler()
+	start := time.Now()
+
+	// The middle chunk is lost
+	for _, event := range []StatusTextEvent{events[0], events[2]} {
+		if msg, ok := assembler.add(event, start); ok {
+			t.Fatalf("add() returned %+v although a chunk is missing", msg)
+		}
+	}
+
+	if expired := assembler.expire(start.Add(statusTextChunkTimeout - time.Millisecond)); len(expired) != 0 {
+		t.Fatalf("expire() before the timeout = %+v, want none", expired)
+	}
+
+	expired := assembler.expire(start.Add(statusTextChunkTimeout))
+	want := statusTextMessage{1, 1, flightpath.MavSeverity_MAV_SEVERITY_WARNING, strings.Repeat("x", statusTextChunkLength) + "z", true}
+	if len(expired) != 1 || expired[0] != want {
+		t.Fatalf("expire() = %+v, want [%+v]", expired, want)
+	}
+
+	// The late chunk starts a new message rather than completing the flushed one
+	if msg, ok := assembler.add(events[1], start.Add(statusTextChunkTimeout)); ok {
+		t.Errorf("add() of the late chunk returned %+v", msg)
+	}
+}
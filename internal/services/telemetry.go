@@ -7,8 +7,12 @@ import (
 	"connectrpc.com/connect"
 	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
 	"github.com/flightpath-dev/flightpath/gen/go/flightpath/flightpathconnect"
+	"github.com/flightpath-dev/flightpath/internal/mavlink/message_converters"
 )
 
+// How long without ORBIT_EXECUTION_STATUS before an orbit is reported as no longer active
+const orbitExecutionStatusTimeout = 2 * time.Second
+
 // TelemetryService implements the TelemetryService gRPC service
 type TelemetryService struct {
 	flightpathconnect.UnimplementedTelemetryServiceHandler
@@ -51,9 +55,359 @@ func (s *TelemetryService) SubscribeRawGps(
 			// GPS_RAW_INT is already converted to protobuf by the dispatcher
 			response := &flightpath.SubscribeRawGpsResponse{
 				TimestampMs: time.Now().UnixMilli(),
+				SystemId:    uint32(event.SystemID),
+				ComponentId: uint32(event.ComponentID),
+				GpsRawInt:   event.GpsRawInt,
+			}
+
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SubscribeOrbitExecutionStatus
+// Streams ORBIT_EXECUTION_STATUS messages from the MAVLink connection.
+// The drone only sends ORBIT_EXECUTION_STATUS while orbiting, so when a component stops sending
+// it for orbitExecutionStatusTimeout, a final update with active = false is sent with the last
+// reported orbit (e.g. so that clients can remove the orbit from a map).
+func (s *TelemetryService) SubscribeOrbitExecutionStatus(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeOrbitExecutionStatusRequest],
+	stream *connect.ServerStream[flightpath.SubscribeOrbitExecutionStatusResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to ORBIT_EXECUTION_STATUS events from the centralized dispatcher
+	orbitExecutionStatusChan := s.ctx.Dispatcher.SubscribeOrbitExecutionStatus(ctx)
+
+	// Last orbit status and reception time of every component currently orbiting
+	type activeOrbit struct {
+		event    OrbitExecutionStatusEvent
+		lastSeen time.Time
+	}
+	activeOrbits := make(map[componentKey]activeOrbit)
+
+	ticker := time.NewTicker(orbitExecutionStatusTimeout / 4)
+	defer ticker.Stop()
+
+	// Stream ORBIT_EXECUTION_STATUS messages to client
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-orbitExecutionStatusChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+
+			activeOrbits[componentKey{event.SystemID, event.ComponentID}] = activeOrbit{
+				event:    event,
+				lastSeen: time.Now(),
+			}
+
+			// ORBIT_EXECUTION_STATUS is already converted to protobuf by the dispatcher
+			response := &flightpath.SubscribeOrbitExecutionStatusResponse{
+				TimestampMs:          time.Now().UnixMilli(),
+				SystemId:             uint32(event.SystemID),
+				ComponentId:          uint32(event.ComponentID),
+				Active:               true,
+				OrbitExecutionStatus: event.OrbitExecutionStatus,
+			}
+
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		case now := <-ticker.C:
+			for key, orbit := range activeOrbits {
+				if now.Sub(orbit.lastSeen) < orbitExecutionStatusTimeout {
+					continue
+				}
+				delete(activeOrbits, key)
+
+				response := &flightpath.SubscribeOrbitExecutionStatusResponse{
+					TimestampMs:          now.UnixMilli(),
+					SystemId:             uint32(orbit.event.SystemID),
+					ComponentId:          uint32(orbit.event.ComponentID),
+					Active:               false,
+					OrbitExecutionStatus: orbit.event.OrbitExecutionStatus,
+				}
+
+				if err := stream.Send(response); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// SubscribeAttitude
+// Streams the attitude of every component from ATTITUDE and ATTITUDE_QUATERNION messages.
+// An update is sent whenever either message is received and carries the latest of both messages
+// from the same component (a message that has not been received yet is left unset).
+func (s *TelemetryService) SubscribeAttitude(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeAttitudeRequest],
+	stream *connect.ServerStream[flightpath.SubscribeAttitudeResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to ATTITUDE and ATTITUDE_QUATERNION events from the centralized dispatcher
+	attitudeChan := s.ctx.Dispatcher.SubscribeAttitude(ctx)
+	attitudeQuaternionChan := s.ctx.Dispatcher.SubscribeAttitudeQuaternion(ctx)
+
+	// Latest attitude of every component
+	latest := make(map[componentKey]*flightpath.SubscribeAttitudeResponse)
+	update := func(systemID, componentID uint8) *flightpath.SubscribeAttitudeResponse {
+		key := componentKey{systemID, componentID}
+		response, ok := latest[key]
+		if !ok {
+			response = &flightpath.SubscribeAttitudeResponse{
+				SystemId:    uint32(systemID),
+				ComponentId: uint32(componentID),
+			}
+			latest[key] = response
+		}
+		response.TimestampMs = time.Now().UnixMilli()
+		return response
+	}
+
+	// Stream attitude updates to client
+	for {
+		var response *flightpath.SubscribeAttitudeResponse
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-attitudeChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			response = update(event.SystemID, event.ComponentID)
+			response.Attitude = event.Attitude
+		case event, ok := <-attitudeQuaternionChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			response = update(event.SystemID, event.ComponentID)
+			response.AttitudeQuaternion = event.AttitudeQuaternion
+		}
+
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+	}
+}
+
+// SubscribePosition
+// Streams GLOBAL_POSITION_INT messages from the MAVLink connection.
+// Each message includes the position in normalized units as well as the raw integer fields.
+func (s *TelemetryService) SubscribePosition(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribePositionRequest],
+	stream *connect.ServerStream[flightpath.SubscribePositionResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to GLOBAL_POSITION_INT events from the centralized dispatcher
+	globalPositionIntChan := s.ctx.Dispatcher.SubscribeGlobalPositionInt(ctx)
+
+	// Stream GLOBAL_POSITION_INT messages to client
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-globalPositionIntChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+
+			response := &flightpath.SubscribePositionResponse{
+				TimestampMs:       time.Now().UnixMilli(),
+				SystemId:          uint32(event.SystemID),
+				ComponentId:       uint32(event.ComponentID),
+				Position:          message_converters.GlobalPositionIntToPosition(event.GlobalPositionInt),
+				GlobalPositionInt: event.GlobalPositionInt,
+			}
+
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SubscribeLocalPosition
+// Streams the local position of every component from LOCAL_POSITION_NED and ODOMETRY messages.
+// An update is sent whenever either message is received and carries the latest of both messages
+// from the same component (a message that has not been received yet is left unset).
+func (s *TelemetryService) SubscribeLocalPosition(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeLocalPositionRequest],
+	stream *connect.ServerStream[flightpath.SubscribeLocalPositionResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to LOCAL_POSITION_NED and ODOMETRY events from the centralized dispatcher
+	localPositionNedChan := s.ctx.Dispatcher.SubscribeLocalPositionNed(ctx)
+	odometryChan := s.ctx.Dispatcher.SubscribeOdometry(ctx)
+
+	// Latest local position of every component
+	latest := make(map[componentKey]*flightpath.SubscribeLocalPositionResponse)
+	update := func(systemID, componentID uint8) *flightpath.SubscribeLocalPositionResponse {
+		key := componentKey{systemID, componentID}
+		response, ok := latest[key]
+		if !ok {
+			response = &flightpath.SubscribeLocalPositionResponse{
+				SystemId:    uint32(systemID),
+				ComponentId: uint32(componentID),
+			}
+			latest[key] = response
+		}
+		response.TimestampMs = time.Now().UnixMilli()
+		return response
+	}
+
+	// Stream local position updates to client
+	for {
+		var response *flightpath.SubscribeLocalPositionResponse
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-localPositionNedChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			response = update(event.SystemID, event.ComponentID)
+			response.LocalPositionNed = event.LocalPositionNed
+		case event, ok := <-odometryChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			response = update(event.SystemID, event.ComponentID)
+			response.Odometry = event.Odometry
+		}
+
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+	}
+}
+
+// SubscribeBattery
+// Streams the state of every battery from BATTERY_STATUS messages (one update per battery ID).
+// For systems that have not sent BATTERY_STATUS on this stream, the battery fields of SYS_STATUS
+// are streamed instead as battery 0.
+func (s *TelemetryService) SubscribeBattery(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeBatteryRequest],
+	stream *connect.ServerStream[flightpath.SubscribeBatteryResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to BATTERY_STATUS and SYS_STATUS events from the centralized dispatcher
+	batteryStatusChan := s.ctx.Dispatcher.SubscribeBatteryStatus(ctx)
+	sysStatusChan := s.ctx.Dispatcher.SubscribeSysStatus(ctx)
+
+	// Systems that send BATTERY_STATUS, for which SYS_STATUS is ignored
+	batteryStatusSystems := make(map[uint8]bool)
+
+	// Stream battery states to client
+	for {
+		var response *flightpath.SubscribeBatteryResponse
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-batteryStatusChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			batteryStatusSystems[event.SystemID] = true
+
+			response = &flightpath.SubscribeBatteryResponse{
+				TimestampMs: time.Now().UnixMilli(),
+				SystemId:    uint32(event.SystemID),
+				ComponentId: uint32(event.ComponentID),
+				Battery:     message_converters.BatteryStatusToBattery(event.BatteryStatus),
+			}
+		case event, ok := <-sysStatusChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			if batteryStatusSystems[event.SystemID] {
+				continue
+			}
+			battery := message_converters.SysStatusToBattery(event.SysStatus)
+			if battery == nil {
+				continue
+			}
+
+			response = &flightpath.SubscribeBatteryResponse{
+				TimestampMs: time.Now().UnixMilli(),
+				SystemId:    uint32(event.SystemID),
+				ComponentId: uint32(event.ComponentID),
+				Battery:     battery,
+			}
+		}
+
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+	}
+}
+
+// SubscribeSystemStatus
+// Streams SYS_STATUS messages from the MAVLink connection with the onboard sensor bitmaps decoded.
+func (s *TelemetryService) SubscribeSystemStatus(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeSystemStatusRequest],
+	stream *connect.ServerStream[flightpath.SubscribeSystemStatusResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to SYS_STATUS events from the centralized dispatcher
+	sysStatusChan := s.ctx.Dispatcher.SubscribeSysStatus(ctx)
+
+	// Stream SYS_STATUS messages to client
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-sysStatusChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+
+			response := &flightpath.SubscribeSystemStatusResponse{
+				TimestampMs:  time.Now().UnixMilli(),
 				SystemId:     uint32(event.SystemID),
 				ComponentId:  uint32(event.ComponentID),
-				GpsRawInt:    event.GpsRawInt,
+				SystemStatus: message_converters.SysStatusToSystemStatus(event.SysStatus),
+				SysStatus:    event.SysStatus,
 			}
 
 			if err := stream.Send(response); err != nil {
@@ -63,3 +417,435 @@ func (s *TelemetryService) SubscribeRawGps(
 	}
 }
 
+// SubscribeFlightMetrics
+// Streams VFR_HUD messages from the MAVLink connection.
+// Each message includes the HUD metrics with system/component IDs.
+func (s *TelemetryService) SubscribeFlightMetrics(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeFlightMetricsRequest],
+	stream *connect.ServerStream[flightpath.SubscribeFlightMetricsResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to VFR_HUD events from the centralized dispatcher
+	vfrHudChan := s.ctx.Dispatcher.SubscribeVfrHud(ctx)
+
+	// Stream VFR_HUD messages to client
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-vfrHudChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+
+			// VFR_HUD is already converted to protobuf by the dispatcher
+			response := &flightpath.SubscribeFlightMetricsResponse{
+				TimestampMs: time.Now().UnixMilli(),
+				SystemId:    uint32(event.SystemID),
+				ComponentId: uint32(event.ComponentID),
+				VfrHud:      event.VfrHud,
+			}
+
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SubscribeLandedState
+// Streams EXTENDED_SYS_STATE messages from the MAVLink connection.
+// Unless every message is requested, a message is only sent when the landed state or VTOL state
+// of its component differs from the previous message (the first message of a component is
+// always sent).
+func (s *TelemetryService) SubscribeLandedState(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeLandedStateRequest],
+	stream *connect.ServerStream[flightpath.SubscribeLandedStateResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to EXTENDED_SYS_STATE events from the centralized dispatcher
+	extendedSysStateChan := s.ctx.Dispatcher.SubscribeExtendedSysState(ctx)
+
+	// Last state sent for every component
+	lastStates := make(map[componentKey]*flightpath.ExtendedSysState)
+
+	// Stream EXTENDED_SYS_STATE messages to client
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-extendedSysStateChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+
+			key := componentKey{event.SystemID, event.ComponentID}
+			state := event.ExtendedSysState
+			if last, ok := lastStates[key]; ok && !req.Msg.EveryMessage &&
+				last.LandedState == state.LandedState && last.VtolState == state.VtolState {
+				continue
+			}
+			lastStates[key] = state
+
+			// EXTENDED_SYS_STATE is already converted to protobuf by the dispatcher
+			response := &flightpath.SubscribeLandedStateResponse{
+				TimestampMs:      time.Now().UnixMilli(),
+				SystemId:         uint32(event.SystemID),
+				ComponentId:      uint32(event.ComponentID),
+				ExtendedSysState: state,
+			}
+
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SubscribeRcChannels
+// Streams RC_CHANNELS messages from the MAVLink connection.
+// Each message includes the used RC channels and the RSSI with system/component IDs.
+func (s *TelemetryService) SubscribeRcChannels(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeRcChannelsRequest],
+	stream *connect.ServerStream[flightpath.SubscribeRcChannelsResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to RC_CHANNELS events from the centralized dispatcher
+	rcChannelsChan := s.ctx.Dispatcher.SubscribeRcChannels(ctx)
+
+	// Stream RC_CHANNELS messages to client
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-rcChannelsChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+
+			response := &flightpath.SubscribeRcChannelsResponse{
+				TimestampMs: time.Now().UnixMilli(),
+				SystemId:    uint32(event.SystemID),
+				ComponentId: uint32(event.ComponentID),
+				RcInput:     message_converters.RcChannelsToRcInput(event.RcChannels),
+			}
+
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SubscribeActuatorOutputs
+// Streams the servo/actuator outputs of every component from SERVO_OUTPUT_RAW and
+// ACTUATOR_OUTPUT_STATUS messages.
+// SERVO_OUTPUT_RAW is sent once per port of 16 outputs, so the latest message of every port is
+// kept and all ports are grouped into a single channel list. An update is sent whenever either
+// message is received and carries the latest outputs from the same component (a message that
+// has not been received yet is left unset).
+func (s *TelemetryService) SubscribeActuatorOutputs(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeActuatorOutputsRequest],
+	stream *connect.ServerStream[flightpath.SubscribeActuatorOutputsResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to SERVO_OUTPUT_RAW and ACTUATOR_OUTPUT_STATUS events from the centralized dispatcher
+	servoOutputRawChan := s.ctx.Dispatcher.SubscribeServoOutputRaw(ctx)
+	actuatorOutputStatusChan := s.ctx.Dispatcher.SubscribeActuatorOutputStatus(ctx)
+
+	// Latest outputs of every component, and latest SERVO_OUTPUT_RAW per port
+	latest := make(map[componentKey]*flightpath.SubscribeActuatorOutputsResponse)
+	servoPorts := make(map[componentKey]map[uint32]*flightpath.ServoOutputRaw)
+	update := func(systemID, componentID uint8) *flightpath.SubscribeActuatorOutputsResponse {
+		key := componentKey{systemID, componentID}
+		response, ok := latest[key]
+		if !ok {
+			response = &flightpath.SubscribeActuatorOutputsResponse{
+				SystemId:    uint32(systemID),
+				ComponentId: uint32(componentID),
+			}
+			latest[key] = response
+		}
+		response.TimestampMs = time.Now().UnixMilli()
+		return response
+	}
+
+	// Stream output updates to client
+	for {
+		var response *flightpath.SubscribeActuatorOutputsResponse
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-servoOutputRawChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+
+			key := componentKey{event.SystemID, event.ComponentID}
+			if servoPorts[key] == nil {
+				servoPorts[key] = make(map[uint32]*flightpath.ServoOutputRaw)
+			}
+			servoPorts[key][event.ServoOutputRaw.Port] = event.ServoOutputRaw
+
+			ports := make([]*flightpath.ServoOutputRaw, 0, len(servoPorts[key]))
+			for _, port := range servoPorts[key] {
+				ports = append(ports, port)
+			}
+			response = update(event.SystemID, event.ComponentID)
+			response.ServoOutputs = message_converters.ServoOutputRawToServoOutputs(ports)
+		case event, ok := <-actuatorOutputStatusChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			response = update(event.SystemID, event.ComponentID)
+			response.ActuatorOutputs = message_converters.ActuatorOutputStatusToActuatorOutputs(event.ActuatorOutputStatus)
+		}
+
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+	}
+}
+
+// SubscribeEstimatorStatus
+// Streams the state estimator health of every component from ESTIMATOR_STATUS and VIBRATION
+// messages. An update is sent whenever either message is received and carries the latest of both
+// messages from the same component (a message that has not been received yet is left unset),
+// with the estimator health derived from them (see estimatorHealthy).
+func (s *TelemetryService) SubscribeEstimatorStatus(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeEstimatorStatusRequest],
+	stream *connect.ServerStream[flightpath.SubscribeEstimatorStatusResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to ESTIMATOR_STATUS and VIBRATION events from the centralized dispatcher
+	estimatorStatusChan := s.ctx.Dispatcher.SubscribeEstimatorStatus(ctx)
+	vibrationChan := s.ctx.Dispatcher.SubscribeVibration(ctx)
+
+	// Latest estimator status of every component
+	latest := make(map[componentKey]*flightpath.SubscribeEstimatorStatusResponse)
+	update := func(systemID, componentID uint8) *flightpath.SubscribeEstimatorStatusResponse {
+		key := componentKey{systemID, componentID}
+		response, ok := latest[key]
+		if !ok {
+			response = &flightpath.SubscribeEstimatorStatusResponse{
+				SystemId:    uint32(systemID),
+				ComponentId: uint32(componentID),
+			}
+			latest[key] = response
+		}
+		response.TimestampMs = time.Now().UnixMilli()
+		return response
+	}
+
+	// Stream estimator status updates to client
+	for {
+		var response *flightpath.SubscribeEstimatorStatusResponse
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-estimatorStatusChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			response = update(event.SystemID, event.ComponentID)
+			response.EstimatorStatus = event.EstimatorStatus
+		case event, ok := <-vibrationChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			response = update(event.SystemID, event.ComponentID)
+			response.Vibration = event.Vibration
+		}
+
+		response.EstimatorHealthy = s.estimatorHealthy(response.EstimatorStatus, response.Vibration)
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+	}
+}
+
+// estimatorHealthy
+// Reports whether the estimator provides a valid attitude, velocity and position without GPS
+// glitch or accelerometer error, with all innovation test ratios and vibration levels (if known)
+// within the thresholds of the estimator configuration.
+func (s *TelemetryService) estimatorHealthy(status *flightpath.EstimatorStatus, vibration *flightpath.Vibration) bool {
+	if status == nil {
+		return false
+	}
+	thresholds := s.ctx.Config.Estimator
+
+	flags := status.Flags
+	if !flags.Attitude || !flags.VelocityHoriz || !flags.VelocityVert ||
+		!(flags.PosHorizRel || flags.PosHorizAbs) || !flags.PosVertAbs ||
+		flags.GpsGlitch || flags.AccelError {
+		return false
+	}
+
+	for _, ratio := range []float32{
+		status.VelRatio, status.PosHorizRatio, status.PosVertRatio,
+		status.MagRatio, status.HaglRatio, status.TasRatio,
+	} {
+		if !(ratio <= thresholds.MaxTestRatio) {
+			return false
+		}
+	}
+
+	if vibration != nil {
+		for _, level := range []float32{vibration.VibrationX, vibration.VibrationY, vibration.VibrationZ} {
+			if !(level <= thresholds.MaxVibration) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// SubscribeWind
+// Streams the wind estimate from WIND_COV messages from the MAVLink connection.
+func (s *TelemetryService) SubscribeWind(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeWindRequest],
+	stream *connect.ServerStream[flightpath.SubscribeWindResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to WIND_COV events from the centralized dispatcher
+	windCovChan := s.ctx.Dispatcher.SubscribeWindCov(ctx)
+
+	// Stream WIND_COV messages to client
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-windCovChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+
+			response := &flightpath.SubscribeWindResponse{
+				TimestampMs: time.Now().UnixMilli(),
+				SystemId:    uint32(event.SystemID),
+				ComponentId: uint32(event.ComponentID),
+				Wind:        message_converters.WindCovToWind(event.WindCov),
+				WindCov:     event.WindCov,
+			}
+
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SubscribeDistanceSensor
+// Streams DISTANCE_SENSOR messages from the MAVLink connection.
+func (s *TelemetryService) SubscribeDistanceSensor(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeDistanceSensorRequest],
+	stream *connect.ServerStream[flightpath.SubscribeDistanceSensorResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to DISTANCE_SENSOR events from the centralized dispatcher
+	distanceSensorChan := s.ctx.Dispatcher.SubscribeDistanceSensor(ctx)
+
+	// Stream DISTANCE_SENSOR messages to client
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-distanceSensorChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+
+			response := &flightpath.SubscribeDistanceSensorResponse{
+				TimestampMs:    time.Now().UnixMilli(),
+				SystemId:       uint32(event.SystemID),
+				ComponentId:    uint32(event.ComponentID),
+				DistanceSensor: event.DistanceSensor,
+			}
+
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SubscribeObstacleDistance
+// Streams OBSTACLE_DISTANCE messages from the MAVLink connection, with the direction of every
+// distance resolved.
+func (s *TelemetryService) SubscribeObstacleDistance(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeObstacleDistanceRequest],
+	stream *connect.ServerStream[flightpath.SubscribeObstacleDistanceResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to OBSTACLE_DISTANCE events from the centralized dispatcher
+	obstacleDistanceChan := s.ctx.Dispatcher.SubscribeObstacleDistance(ctx)
+
+	// Stream OBSTACLE_DISTANCE messages to client
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-obstacleDistanceChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+
+			response := &flightpath.SubscribeObstacleDistanceResponse{
+				TimestampMs:      time.Now().UnixMilli(),
+				SystemId:         uint32(event.SystemID),
+				ComponentId:      uint32(event.ComponentID),
+				Sectors:          message_converters.ObstacleDistanceToSectors(event.ObstacleDistance),
+				ObstacleDistance: event.ObstacleDistance,
+			}
+
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
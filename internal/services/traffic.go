@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+	"github.com/flightpath-dev/flightpath/internal/mavlink/message_converters"
+)
+
+const (
+	// How long an aircraft may go unreported before its track is dropped
+	trafficTrackTimeout = 10 * time.Second
+
+	// How long the drone's own position remains usable to compute the separation from an aircraft
+	trafficOwnPositionTimeout = 2 * time.Second
+)
+
+// trafficKey identifies an aircraft reported by a system (several drones may report the same aircraft)
+type trafficKey struct {
+	systemID    uint8
+	icaoAddress uint32
+}
+
+// ownPosition is the latest position of a drone
+type ownPosition struct {
+	position *flightpath.GlobalPositionInt
+	received time.Time
+}
+
+// SubscribeTraffic
+// Streams ADSB_VEHICLE messages from the MAVLink connection, with the separation from and closing
+// rate to the drone computed from the latest GLOBAL_POSITION_INT of the system reporting the
+// aircraft. When an aircraft is not reported for trafficTrackTimeout, a final update with
+// expired = true is sent with its last reported track.
+// By default, unmanned aircraft, space vehicles, surface vehicles and point obstacles are left out.
+func (s *TelemetryService) SubscribeTraffic(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeTrafficRequest],
+	stream *connect.ServerStream[flightpath.SubscribeTrafficResponse],
+) error {
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to ADSB_VEHICLE and GLOBAL_POSITION_INT events from the centralized dispatcher
+	adsbVehicleChan := s.ctx.Dispatcher.SubscribeAdsbVehicle(ctx)
+	globalPositionIntChan := s.ctx.Dispatcher.SubscribeGlobalPositionInt(ctx)
+
+	// Latest position of every system
+	ownPositions := make(map[uint8]ownPosition)
+
+	// Last event and reception time of every aircraft currently tracked
+	type trafficTrack struct {
+		event    AdsbVehicleEvent
+		lastSeen time.Time
+	}
+	tracks := make(map[trafficKey]trafficTrack)
+
+	ticker := time.NewTicker(trafficTrackTimeout / 4)
+	defer ticker.Stop()
+
+	// Stream ADSB_VEHICLE messages to client
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-globalPositionIntChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			ownPositions[event.SystemID] = ownPosition{position: event.GlobalPositionInt, received: time.Now()}
+		case event, ok := <-adsbVehicleChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			if !req.Msg.IncludeAllEmitters && !isMannedEmitter(event.AdsbVehicle.EmitterType) {
+				continue
+			}
+
+			now := time.Now()
+			tracks[trafficKey{event.SystemID, event.AdsbVehicle.IcaoAddress}] = trafficTrack{
+				event:    event,
+				lastSeen: now,
+			}
+
+			track := message_converters.AdsbVehicleToTrafficTrack(event.AdsbVehicle)
+			response := &flightpath.SubscribeTrafficResponse{
+				TimestampMs: now.UnixMilli(),
+				SystemId:    uint32(event.SystemID),
+				ComponentId: uint32(event.ComponentID),
+				Track:       track,
+				AdsbVehicle: event.AdsbVehicle,
+			}
+			if own, ok := ownPositions[event.SystemID]; ok && now.Sub(own.received) < trafficOwnPositionTimeout {
+				response.Separation = trafficSeparation(own.position, track)
+			}
+
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		case now := <-ticker.C:
+			for key, track := range tracks {
+				if now.Sub(track.lastSeen) < trafficTrackTimeout {
+					continue
+				}
+				delete(tracks, key)
+
+				response := &flightpath.SubscribeTrafficResponse{
+					TimestampMs: now.UnixMilli(),
+					SystemId:    uint32(track.event.SystemID),
+					ComponentId: uint32(track.event.ComponentID),
+					Track:       message_converters.AdsbVehicleToTrafficTrack(track.event.AdsbVehicle),
+					Expired:     true,
+					AdsbVehicle: track.event.AdsbVehicle,
+				}
+
+				if err := stream.Send(response); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// isMannedEmitter
+// Reports whether an ADS-B emitter type may be a manned aircraft (emitters of unknown type included).
+func isMannedEmitter(emitterType flightpath.AdsbEmitterType) bool {
+	switch emitterType {
+	case flightpath.AdsbEmitterType_ADSB_EMITTER_TYPE_UAV,
+		flightpath.AdsbEmitterType_ADSB_EMITTER_TYPE_SPACE,
+		flightpath.AdsbEmitterType_ADSB_EMITTER_TYPE_EMERGENCY_SURFACE,
+		flightpath.AdsbEmitterType_ADSB_EMITTER_TYPE_SERVICE_SURFACE,
+		flightpath.AdsbEmitterType_ADSB_EMITTER_TYPE_POINT_OBSTACLE:
+		return false
+	default:
+		return true
+	}
+}
+
+// trafficSeparation
+// Returns the separation between a drone and an aircraft, or nil if the aircraft position is unknown.
+// The closing rate is the rate at which the distance between them decreases, computed from both
+// velocities (in 3D if the aircraft altitude and vertical speed are known, horizontally otherwise).
+// The aircraft altitude may be barometric (QNH) while the drone's is not, so the vertical
+// separation is only as accurate as the altimeter setting.
+func trafficSeparation(own *flightpath.GlobalPositionInt, track *flightpath.TrafficTrack) *flightpath.TrafficSeparation {
+	if track.Latitude == nil || track.Longitude == nil {
+		return nil
+	}
+
+	north, east := horizontalOffset(float64(own.Lat)/1e7, float64(own.Lon)/1e7, *track.Latitude, *track.Longitude)
+	separation := &flightpath.TrafficSeparation{
+		HorizontalDistance: float32(math.Hypot(north, east)),
+		Bearing:            float32(math.Mod(math.Atan2(east, north)*180/math.Pi+360, 360)),
+	}
+
+	var up float64
+	if track.Altitude != nil {
+		up = float64(*track.Altitude) - float64(own.Alt)/1000
+		verticalSeparation := float32(up)
+		separation.VerticalSeparation = &verticalSeparation
+	}
+
+	if track.Heading == nil || track.HorizontalSpeed == nil {
+		return separation
+	}
+
+	// Velocity of the aircraft relative to the drone (m/s, north/east/up)
+	heading := float64(*track.Heading) * math.Pi / 180
+	relativeNorth := float64(*track.HorizontalSpeed)*math.Cos(heading) - float64(own.Vx)/100
+	relativeEast := float64(*track.HorizontalSpeed)*math.Sin(heading) - float64(own.Vy)/100
+	var relativeUp float64
+	if track.Altitude != nil && track.VerticalSpeed != nil {
+		// GLOBAL_POSITION_INT vz is positive down
+		relativeUp = float64(*track.VerticalSpeed) + float64(own.Vz)/100
+	} else {
+		up = 0
+	}
+
+	distance := math.Sqrt(north*north + east*east + up*up)
+	if distance == 0 {
+		return separation
+	}
+	closingRate := float32(-(north*relativeNorth + east*relativeEast + up*relativeUp) / distance)
+	separation.ClosingRate = &closingRate
+	return separation
+}
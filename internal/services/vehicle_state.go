@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/flightpath-dev/flightpath/gen/go/flightpath"
+	"github.com/flightpath-dev/flightpath/internal/mavlink/message_converters"
+)
+
+const (
+	// Rate at which vehicle state snapshots are sent if the client does not choose one (Hz)
+	defaultVehicleStateRate = 1
+
+	// Maximum rate at which vehicle state snapshots can be sent (Hz)
+	maxVehicleStateRate = 50
+)
+
+// vehicleStateAggregate is the state of a drone being aggregated from its messages
+type vehicleStateAggregate struct {
+	state *flightpath.VehicleState
+
+	// Latest state of every battery by ID
+	batteries map[uint32]*flightpath.Battery
+
+	// Whether the drone sends BATTERY_STATUS, in which case SYS_STATUS is ignored
+	sendsBatteryStatus bool
+}
+
+// SubscribeVehicleState
+// Aggregates the latest HEARTBEAT (of the autopilot), GLOBAL_POSITION_INT, ATTITUDE, BATTERY_STATUS
+// (or SYS_STATUS), GPS_RAW_INT and EXTENDED_SYS_STATE of every system and streams a snapshot of
+// every system at the requested rate. Messages from all components of a system are aggregated.
+func (s *TelemetryService) SubscribeVehicleState(
+	ctx context.Context,
+	req *connect.Request[flightpath.SubscribeVehicleStateRequest],
+	stream *connect.ServerStream[flightpath.SubscribeVehicleStateResponse],
+) error {
+	msg := req.Msg
+	rate := float64(msg.RateHz)
+	if rate == 0 {
+		rate = defaultVehicleStateRate
+	}
+	if !(rate > 0 && rate <= maxVehicleStateRate) {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("invalid rate %v (must be in (0, %d] Hz)", msg.RateHz, maxVehicleStateRate),
+		)
+	}
+	if msg.SystemId > math.MaxUint8 {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid system ID %d", msg.SystemId))
+	}
+	if s.ctx.Dispatcher == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	// Subscribe to the aggregated messages from the centralized dispatcher
+	heartbeatChan := s.ctx.Dispatcher.SubscribeHeartbeat(ctx)
+	globalPositionIntChan := s.ctx.Dispatcher.SubscribeGlobalPositionInt(ctx)
+	attitudeChan := s.ctx.Dispatcher.SubscribeAttitude(ctx)
+	batteryStatusChan := s.ctx.Dispatcher.SubscribeBatteryStatus(ctx)
+	sysStatusChan := s.ctx.Dispatcher.SubscribeSysStatus(ctx)
+	gpsRawIntChan := s.ctx.Dispatcher.SubscribeGpsRawInt(ctx)
+	extendedSysStateChan := s.ctx.Dispatcher.SubscribeExtendedSysState(ctx)
+
+	// State of every system
+	aggregates := make(map[uint8]*vehicleStateAggregate)
+
+	// Returns the state of a system to update, or nil if the system is filtered out
+	update := func(systemID uint8) *vehicleStateAggregate {
+		if msg.SystemId != 0 && uint32(systemID) != msg.SystemId {
+			return nil
+		}
+		aggregate, ok := aggregates[systemID]
+		if !ok {
+			aggregate = &vehicleStateAggregate{
+				state:     &flightpath.VehicleState{},
+				batteries: make(map[uint32]*flightpath.Battery),
+			}
+			aggregates[systemID] = aggregate
+		}
+		aggregate.state.LastMessageMs = time.Now().UnixMilli()
+		return aggregate
+	}
+
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / rate))
+	defer ticker.Stop()
+
+	// Aggregate messages and stream snapshots to client
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-heartbeatChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			// Only the autopilot's heartbeat describes the drone (not a gimbal's or camera's)
+			if event.Heartbeat.Autopilot == flightpath.MavAutopilot_MAV_AUTOPILOT_INVALID {
+				continue
+			}
+			if aggregate := update(event.SystemID); aggregate != nil {
+				aggregate.state.Heartbeat = event.Heartbeat
+				aggregate.state.Armed = event.Heartbeat.GetBaseMode().GetSafetyArmed()
+				aggregate.state.FlightMode = event.Heartbeat.CustomMode
+			}
+		case event, ok := <-globalPositionIntChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			if aggregate := update(event.SystemID); aggregate != nil {
+				aggregate.state.Position = message_converters.GlobalPositionIntToPosition(event.GlobalPositionInt)
+			}
+		case event, ok := <-attitudeChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			if aggregate := update(event.SystemID); aggregate != nil {
+				aggregate.state.Attitude = event.Attitude
+			}
+		case event, ok := <-batteryStatusChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			if aggregate := update(event.SystemID); aggregate != nil {
+				if !aggregate.sendsBatteryStatus {
+					// Drop the battery decoded from SYS_STATUS
+					aggregate.sendsBatteryStatus = true
+					clear(aggregate.batteries)
+				}
+				battery := message_converters.BatteryStatusToBattery(event.BatteryStatus)
+				aggregate.batteries[battery.Id] = battery
+			}
+		case event, ok := <-sysStatusChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			if aggregate := update(event.SystemID); aggregate != nil && !aggregate.sendsBatteryStatus {
+				if battery := message_converters.SysStatusToBattery(event.SysStatus); battery != nil {
+					aggregate.batteries[battery.Id] = battery
+				}
+			}
+		case event, ok := <-gpsRawIntChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			if aggregate := update(event.SystemID); aggregate != nil {
+				aggregate.state.Gps = message_converters.GpsRawIntToGpsReceiver(event.GpsRawInt)
+			}
+		case event, ok := <-extendedSysStateChan:
+			if !ok {
+				// Channel closed, dispatcher might have stopped
+				return nil
+			}
+			if aggregate := update(event.SystemID); aggregate != nil {
+				aggregate.state.LandedState = event.ExtendedSysState.LandedState
+			}
+		case now := <-ticker.C:
+			for _, systemID := range slices.Sorted(maps.Keys(aggregates)) {
+				aggregate := aggregates[systemID]
+
+				// Batteries are sent in ID order
+				aggregate.state.Batteries = aggregate.state.Batteries[:0]
+				for _, id := range slices.Sorted(maps.Keys(aggregate.batteries)) {
+					aggregate.state.Batteries = append(aggregate.state.Batteries, aggregate.batteries[id])
+				}
+
+				response := &flightpath.SubscribeVehicleStateResponse{
+					TimestampMs:  now.UnixMilli(),
+					SystemId:     uint32(systemID),
+					VehicleState: aggregate.state,
+				}
+
+				if err := stream.Send(response); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}